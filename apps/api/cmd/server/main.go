@@ -6,9 +6,12 @@ import (
 
 	"go-todo/db/sqlc"
 	"go-todo/internal/auth"
+	"go-todo/internal/caldav"
 	"go-todo/internal/config"
 	"go-todo/internal/database"
 	"go-todo/internal/handler"
+	"go-todo/internal/notify/email"
+	"go-todo/internal/notify/webhook"
 	"go-todo/internal/router"
 	"go-todo/internal/service"
 
@@ -52,21 +55,90 @@ func main() {
 	auth.InitGothic(sessionManager)
 
 	// サービスの初期化
-	todoService := service.NewTodoService(queries)
+	todoService := service.NewTodoService(queries, pool, cfg.Batch.MaxItems)
 	userService := service.NewUserService(queries, pool)
+	syncService := service.NewSyncService(queries, pool)
+	idempotencyService := service.NewIdempotencyService(queries, cfg.Idempotency.TTL, cfg.Idempotency.LockTimeout)
+	statusService := service.NewStatusService(queries, pool)
+	dependencyService := service.NewDependencyService(queries, pool)
+	jobService := service.NewJobService(queries, cfg.Job.LeaseDuration, cfg.Job.MaxAttempts)
+	jobService.RegisterHandler(service.JobTypeCompleteTodos, todoService.CompleteTodosJob)
+	jobService.RegisterHandler(service.JobTypeDeleteTodos, todoService.DeleteTodosJob)
+	externalImportService := service.NewExternalImportService(queries, pool)
+	jobService.RegisterHandler(service.JobTypeImportExternalTodos, externalImportService.ImportJob)
+	projectService := service.NewProjectService(queries)
+	accountExportService := service.NewAccountExportService(queries, pool, userService.DeleteAccount, []byte(cfg.Export.SigningKey), cfg.Export.TTL, cfg.Server.PublicURL)
+	jobService.RegisterHandler(service.JobTypeExportAccountData, accountExportService.ExportJob)
+	calendarFeedService := service.NewCalendarFeedService(queries)
+	personalAccessTokenService := service.NewPersonalAccessTokenService(queries)
+	caldavService := service.NewCalDAVService(queries, pool)
+	todoExportService := service.NewTodoExportService(queries, pool)
+	quickAddService := service.NewQuickAddService(queries, pool)
+	userSettingsService := service.NewUserSettingsService(queries, pool)
+	notificationService := service.NewNotificationService(queries, pool)
+	reminderService := service.NewReminderService(queries, cfg.Reminder.LeaseDuration, cfg.Reminder.MaxAttempts, cfg.Reminder.RetryDelay)
+	reminderService.RegisterChannel(service.ReminderChannelInApp, notificationService)
+	if cfg.SMTP.Enabled() {
+		reminderService.RegisterChannel(service.ReminderChannelEmail, email.NewSender(email.Config{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+			Timeout:  cfg.SMTP.Timeout,
+		}))
+	}
+	if cfg.Webhook.Enabled() {
+		reminderService.RegisterChannel(service.ReminderChannelWebhook, webhook.NewSender(webhook.Config{
+			URL:     cfg.Webhook.URL,
+			Secret:  cfg.Webhook.Secret,
+			Timeout: cfg.Webhook.Timeout,
+		}))
+	}
+
+	// 期限切れの冪等性キーを定期的に削除
+	go idempotencyService.RunCleanup(ctx, cfg.Idempotency.CleanupInterval)
+
+	// 期限切れとダウンロード済みのアカウントエクスポートを定期的に削除
+	go accountExportService.RunCleanup(ctx, cfg.Export.CleanupInterval)
+
+	// 長くなった並び順のキーを定期的に振り直す
+	go todoService.RunRebalance(ctx, cfg.Position.RebalanceInterval, cfg.Position.MaxKeyLength)
+
+	// 非同期ジョブのワーカーを起動
+	go jobService.RunWorkers(ctx, cfg.Job.Workers, cfg.Job.PollInterval)
+
+	// 配信時刻を過ぎたリマインダーを配信
+	go reminderService.RunScheduler(ctx, cfg.Reminder.PollInterval)
 
 	// ハンドラーの初期化
-	todoHandler := handler.NewTodoHandler(todoService)
-	authHandler := handler.NewAuthHandler(userService, sessionManager, cfg.Frontend)
+	todoHandler := handler.NewTodoHandler(todoService, dependencyService, userSettingsService)
+	syncHandler := handler.NewSyncHandler(syncService)
+	jobHandler := handler.NewJobHandler(jobService)
+	statusHandler := handler.NewStatusHandler(statusService)
+	notificationHandler := handler.NewNotificationHandler(reminderService, notificationService)
+	calendarHandler := handler.NewCalendarHandler(calendarFeedService, cfg.Server.PublicURL)
+	personalAccessTokenHandler := handler.NewPersonalAccessTokenHandler(personalAccessTokenService)
+	exportHandler := handler.NewExportHandler(todoExportService)
+	importHandler := handler.NewImportHandler(externalImportService, jobService)
+	projectHandler := handler.NewProjectHandler(projectService)
+	accountExportHandler := handler.NewAccountExportHandler(accountExportService, jobService)
+	quickAddHandler := handler.NewQuickAddHandler(quickAddService)
+	userSettingsHandler := handler.NewUserSettingsHandler(userSettingsService)
+	caldavHandler := caldav.NewHandler(caldavService, router.CalDAVPrefix, service.CalendarProdID)
+	authHandler := handler.NewAuthHandler(userService, jobService, sessionManager, cfg.Frontend)
 
 	// APIHandlerの作成（StrictServerInterface実装）
-	apiHandler := handler.NewAPIHandler(todoHandler)
+	apiHandler := handler.NewAPIHandler(todoHandler, syncHandler, jobHandler, statusHandler, notificationHandler, calendarHandler, personalAccessTokenHandler, exportHandler, importHandler, projectHandler, accountExportHandler, quickAddHandler, userSettingsHandler)
 
 	// Echoインスタンスを作成
 	e := echo.New()
 
 	// ルートを設定
-	router.SetupRoutes(e, apiHandler, authHandler, sessionManager, cfg.Frontend)
+	if err := router.SetupRoutes(e, apiHandler, authHandler, sessionManager, idempotencyService, userSettingsService, cfg.Frontend); err != nil {
+		log.Fatal("Failed to set up routes:", err)
+	}
+	router.SetupCalDAVRoutes(e, caldavHandler, personalAccessTokenService)
 
 	// サーバー起動
 	log.Printf("Server starting on %s...", cfg.Server.Address())
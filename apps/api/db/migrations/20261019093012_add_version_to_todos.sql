@@ -0,0 +1,2 @@
+-- Modify "todos" table
+ALTER TABLE "public"."todos" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
@@ -0,0 +1,17 @@
+-- Create "idempotency_keys" table
+CREATE TABLE "public"."idempotency_keys" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "key" text NOT NULL,
+  "request_hash" text NOT NULL,
+  "status_code" integer NULL,
+  "response_headers" jsonb NULL,
+  "response_body" bytea NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "idempotency_keys_user_id_key_key" UNIQUE ("user_id", "key"),
+  CONSTRAINT "idempotency_keys_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_idempotency_keys_expires_at" to table: "idempotency_keys"
+CREATE INDEX "idx_idempotency_keys_expires_at" ON "public"."idempotency_keys" ("expires_at");
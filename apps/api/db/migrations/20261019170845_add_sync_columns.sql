@@ -0,0 +1,8 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "todo_change_seq" bigint NOT NULL DEFAULT 0;
+-- Modify "todos" table
+ALTER TABLE "public"."todos" ADD COLUMN "client_id" uuid NOT NULL DEFAULT gen_random_uuid(), ADD COLUMN "change_seq" bigint NOT NULL DEFAULT 0, ADD COLUMN "title_updated_at" timestamptz NOT NULL DEFAULT now(), ADD COLUMN "description_updated_at" timestamptz NOT NULL DEFAULT now(), ADD COLUMN "completed_updated_at" timestamptz NOT NULL DEFAULT now();
+-- Create index "idx_todos_user_id_change_seq" to table: "todos"
+CREATE INDEX "idx_todos_user_id_change_seq" ON "public"."todos" ("user_id", "change_seq");
+-- Create index "idx_todos_user_id_client_id" to table: "todos"
+CREATE UNIQUE INDEX "idx_todos_user_id_client_id" ON "public"."todos" ("user_id", "client_id");
@@ -0,0 +1,26 @@
+-- Create "jobs" table
+CREATE TABLE "public"."jobs" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "type" text NOT NULL,
+  "status" text NOT NULL DEFAULT 'pending',
+  "params" jsonb NOT NULL DEFAULT '{}',
+  "result" jsonb NULL,
+  "error" text NULL,
+  "total" integer NOT NULL DEFAULT 0,
+  "processed" integer NOT NULL DEFAULT 0,
+  "cancel_requested" boolean NOT NULL DEFAULT false,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "locked_until" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  "started_at" timestamptz NULL,
+  "finished_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "jobs_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "jobs_status_check" CHECK (status = ANY (ARRAY['pending'::text, 'running'::text, 'succeeded'::text, 'failed'::text, 'canceled'::text]))
+);
+-- Create index "idx_jobs_status_created_at" to table: "jobs"
+CREATE INDEX "idx_jobs_status_created_at" ON "public"."jobs" ("status", "created_at");
+-- Create index "idx_jobs_user_id" to table: "jobs"
+CREATE INDEX "idx_jobs_user_id" ON "public"."jobs" ("user_id");
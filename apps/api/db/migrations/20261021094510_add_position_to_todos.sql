@@ -0,0 +1,6 @@
+-- Modify "todos" table
+ALTER TABLE "public"."todos" ADD COLUMN "position" text COLLATE "C" NOT NULL DEFAULT '';
+-- Backfill "position" in creation order with fixed-width rank keys
+UPDATE "public"."todos" AS t SET "position" = lpad(to_hex(r.rn * 16 + 8), 8, '0') FROM (SELECT "id", row_number() OVER (PARTITION BY "user_id" ORDER BY "created_at", "id") AS rn FROM "public"."todos") AS r WHERE t."id" = r."id";
+-- Create index "idx_todos_user_id_position" to table: "todos"
+CREATE INDEX "idx_todos_user_id_position" ON "public"."todos" ("user_id", "position");
@@ -0,0 +1,21 @@
+-- Create "statuses" table
+CREATE TABLE "public"."statuses" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "name" text NOT NULL,
+  "sort_order" integer NOT NULL DEFAULT 0,
+  "wip_limit" integer NULL,
+  "is_done" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "statuses_user_id_name_key" UNIQUE ("user_id", "name"),
+  CONSTRAINT "statuses_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "statuses_wip_limit_check" CHECK (wip_limit > 0)
+);
+-- Create index "idx_statuses_user_id_done" to table: "statuses"
+CREATE UNIQUE INDEX "idx_statuses_user_id_done" ON "public"."statuses" ("user_id") WHERE is_done;
+-- Modify "todos" table
+ALTER TABLE "public"."todos" ADD COLUMN "status_id" bigint NULL, ADD CONSTRAINT "todos_status_id_fkey" FOREIGN KEY ("status_id") REFERENCES "public"."statuses" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "idx_todos_status_id" to table: "todos"
+CREATE INDEX "idx_todos_status_id" ON "public"."todos" ("status_id");
@@ -0,0 +1,16 @@
+-- Create "todo_dependencies" table
+CREATE TABLE "public"."todo_dependencies" (
+  "todo_id" bigint NOT NULL,
+  "blocker_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("todo_id", "blocker_id"),
+  CONSTRAINT "todo_dependencies_blocker_id_fkey" FOREIGN KEY ("blocker_id") REFERENCES "public"."todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_dependencies_todo_id_fkey" FOREIGN KEY ("todo_id") REFERENCES "public"."todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_dependencies_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "todo_dependencies_check" CHECK (todo_id <> blocker_id)
+);
+-- Create index "idx_todo_dependencies_blocker_id" to table: "todo_dependencies"
+CREATE INDEX "idx_todo_dependencies_blocker_id" ON "public"."todo_dependencies" ("blocker_id");
+-- Create index "idx_todo_dependencies_user_id" to table: "todo_dependencies"
+CREATE INDEX "idx_todo_dependencies_user_id" ON "public"."todo_dependencies" ("user_id");
@@ -0,0 +1,47 @@
+-- Modify "todos" table
+ALTER TABLE "public"."todos" ADD COLUMN "due_at" timestamptz NULL;
+-- Create "reminders" table
+CREATE TABLE "public"."reminders" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "todo_id" bigint NOT NULL,
+  "channel" text NOT NULL,
+  "remind_at" timestamptz NULL,
+  "offset_minutes" integer NULL,
+  "status" text NOT NULL DEFAULT 'pending',
+  "attempts" integer NOT NULL DEFAULT 0,
+  "locked_until" timestamptz NULL,
+  "last_error" text NULL,
+  "fired_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reminders_todo_id_fkey" FOREIGN KEY ("todo_id") REFERENCES "public"."todos" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reminders_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "reminders_channel_check" CHECK (channel = ANY (ARRAY['email'::text, 'in_app'::text, 'webhook'::text])),
+  CONSTRAINT "reminders_check" CHECK ((remind_at IS NULL) <> (offset_minutes IS NULL)),
+  CONSTRAINT "reminders_status_check" CHECK (status = ANY (ARRAY['pending'::text, 'sent'::text, 'failed'::text, 'canceled'::text]))
+);
+-- Create index "idx_reminders_pending" to table: "reminders"
+CREATE INDEX "idx_reminders_pending" ON "public"."reminders" ("id") WHERE (status = 'pending'::text);
+-- Create index "idx_reminders_todo_id" to table: "reminders"
+CREATE INDEX "idx_reminders_todo_id" ON "public"."reminders" ("todo_id");
+-- Create "notifications" table
+CREATE TABLE "public"."notifications" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "type" text NOT NULL,
+  "title" text NOT NULL,
+  "body" text NOT NULL DEFAULT '',
+  "todo_id" bigint NULL,
+  "reminder_id" bigint NULL,
+  "read_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "notifications_reminder_id_key" UNIQUE ("reminder_id"),
+  CONSTRAINT "notifications_reminder_id_fkey" FOREIGN KEY ("reminder_id") REFERENCES "public"."reminders" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "notifications_todo_id_fkey" FOREIGN KEY ("todo_id") REFERENCES "public"."todos" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "notifications_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_notifications_user_id_id" to table: "notifications"
+CREATE INDEX "idx_notifications_user_id_id" ON "public"."notifications" ("user_id", "id");
@@ -0,0 +1,14 @@
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" ADD CONSTRAINT "notifications_type_check" CHECK (type = ANY (ARRAY['reminder'::text, 'shared_list'::text, 'admin'::text]));
+-- Create index "idx_notifications_user_id_unread" to table: "notifications"
+CREATE INDEX "idx_notifications_user_id_unread" ON "public"."notifications" ("user_id") WHERE (read_at IS NULL);
+-- Create "notification_preferences" table
+CREATE TABLE "public"."notification_preferences" (
+  "user_id" bigint NOT NULL,
+  "type" text NOT NULL,
+  "enabled" boolean NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("user_id", "type"),
+  CONSTRAINT "notification_preferences_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "notification_preferences_type_check" CHECK (type = ANY (ARRAY['reminder'::text, 'shared_list'::text, 'admin'::text]))
+);
@@ -0,0 +1,9 @@
+-- Create "calendar_feeds" table
+CREATE TABLE "public"."calendar_feeds" (
+  "user_id" bigint NOT NULL,
+  "token_hash" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("user_id"),
+  CONSTRAINT "calendar_feeds_token_hash_key" UNIQUE ("token_hash"),
+  CONSTRAINT "calendar_feeds_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,18 @@
+-- Modify "todos" table
+ALTER TABLE "public"."todos" ADD COLUMN "ical_uid" text NULL;
+-- Create index "idx_todos_user_id_calendar_uid" to table: "todos"
+CREATE UNIQUE INDEX "idx_todos_user_id_calendar_uid" ON "public"."todos" ("user_id", (COALESCE(ical_uid, (client_id)::text)));
+-- Create "personal_access_tokens" table
+CREATE TABLE "public"."personal_access_tokens" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "name" text NOT NULL,
+  "token_hash" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "last_used_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "personal_access_tokens_token_hash_key" UNIQUE ("token_hash"),
+  CONSTRAINT "personal_access_tokens_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_personal_access_tokens_user_id" to table: "personal_access_tokens"
+CREATE INDEX "idx_personal_access_tokens_user_id" ON "public"."personal_access_tokens" ("user_id");
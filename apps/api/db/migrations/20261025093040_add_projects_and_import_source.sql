@@ -0,0 +1,17 @@
+-- Create "projects" table
+CREATE TABLE "public"."projects" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "name" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "projects_user_id_name_key" UNIQUE ("user_id", "name"),
+  CONSTRAINT "projects_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Modify "todos" table
+ALTER TABLE "public"."todos" ADD COLUMN "project_id" bigint NULL, ADD COLUMN "tags" text[] NOT NULL DEFAULT '{}', ADD COLUMN "import_source" text NULL, ADD COLUMN "import_source_id" text NULL, ADD CONSTRAINT "todos_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "idx_todos_project_id" to table: "todos"
+CREATE INDEX "idx_todos_project_id" ON "public"."todos" ("project_id");
+-- Create index "idx_todos_user_id_import_source" to table: "todos"
+CREATE UNIQUE INDEX "idx_todos_user_id_import_source" ON "public"."todos" ("user_id", "import_source", "import_source_id") WHERE (import_source IS NOT NULL);
@@ -0,0 +1,15 @@
+-- Create "account_exports" table
+CREATE TABLE "public"."account_exports" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "data" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "expires_at" timestamptz NOT NULL,
+  "downloaded_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "account_exports_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_account_exports_expires_at" to table: "account_exports"
+CREATE INDEX "idx_account_exports_expires_at" ON "public"."account_exports" ("expires_at");
+-- Create index "idx_account_exports_user_id" to table: "account_exports"
+CREATE INDEX "idx_account_exports_user_id" ON "public"."account_exports" ("user_id");
@@ -0,0 +1,2 @@
+-- Modify "todos" table
+ALTER TABLE "public"."todos" ADD COLUMN "priority" text NULL, ADD COLUMN "recurrence" text NULL, ADD CONSTRAINT "todos_priority_check" CHECK (priority = ANY (ARRAY['low'::text, 'medium'::text, 'high'::text]));
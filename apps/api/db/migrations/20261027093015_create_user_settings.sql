@@ -0,0 +1,15 @@
+-- Create "user_settings" table
+CREATE TABLE "public"."user_settings" (
+  "user_id" bigint NOT NULL,
+  "timezone" text NOT NULL DEFAULT 'UTC',
+  "locale" text NOT NULL DEFAULT 'en',
+  "week_start" smallint NOT NULL DEFAULT 1,
+  "default_sort" text NOT NULL DEFAULT 'created_at',
+  "default_project_id" bigint NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("user_id"),
+  CONSTRAINT "user_settings_default_project_id_fkey" FOREIGN KEY ("default_project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "user_settings_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user_settings_default_sort_check" CHECK (default_sort = ANY (ARRAY['created_at'::text, 'manual'::text])),
+  CONSTRAINT "user_settings_week_start_check" CHECK ((week_start >= 0) AND (week_start <= 6))
+);
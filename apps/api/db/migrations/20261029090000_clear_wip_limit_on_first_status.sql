@@ -0,0 +1,2 @@
+-- Clear "wip_limit" on the first open status of each user, which can no longer have one
+UPDATE "public"."statuses" AS s SET "wip_limit" = NULL FROM (SELECT DISTINCT ON ("user_id") "id" FROM "public"."statuses" WHERE NOT "is_done" ORDER BY "user_id", "sort_order", "id") AS f WHERE s."id" = f."id" AND s."wip_limit" IS NOT NULL;
@@ -0,0 +1,16 @@
+-- Modify "account_exports" table
+ALTER TABLE "public"."account_exports" ADD COLUMN "size" bigint NOT NULL DEFAULT 0, ADD COLUMN "chunks" integer NOT NULL DEFAULT 0;
+-- Create "account_export_chunks" table
+CREATE TABLE "public"."account_export_chunks" (
+  "export_id" bigint NOT NULL,
+  "seq" integer NOT NULL,
+  "data" bytea NOT NULL,
+  PRIMARY KEY ("export_id", "seq"),
+  CONSTRAINT "account_export_chunks_export_id_fkey" FOREIGN KEY ("export_id") REFERENCES "public"."account_exports" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Move the archives not yet downloaded into a single chunk
+INSERT INTO "public"."account_export_chunks" ("export_id", "seq", "data")
+SELECT "id", 0, "data" FROM "public"."account_exports" WHERE "downloaded_at" IS NULL;
+UPDATE "public"."account_exports" SET "size" = octet_length("data"), "chunks" = 1 WHERE "downloaded_at" IS NULL;
+-- Modify "account_exports" table
+ALTER TABLE "public"."account_exports" DROP COLUMN "data";
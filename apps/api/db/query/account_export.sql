@@ -0,0 +1,27 @@
+-- name: CreateAccountExport :one
+INSERT INTO account_exports (user_id, expires_at)
+VALUES ($1, $2)
+RETURNING id;
+
+-- name: CreateAccountExportChunk :exec
+INSERT INTO account_export_chunks (export_id, seq, data)
+VALUES ($1, $2, $3);
+
+-- name: FinishAccountExport :exec
+UPDATE account_exports
+SET size = @size, chunks = @chunks
+WHERE id = @id;
+
+-- name: ClaimAccountExport :one
+UPDATE account_exports
+SET downloaded_at = NOW()
+WHERE id = @id AND downloaded_at IS NULL AND expires_at > NOW()
+RETURNING chunks, size;
+
+-- name: GetAccountExportChunk :one
+SELECT data FROM account_export_chunks
+WHERE export_id = $1 AND seq = $2;
+
+-- name: DeleteFinishedAccountExports :execrows
+DELETE FROM account_exports
+WHERE expires_at < @before OR downloaded_at < @before;
@@ -0,0 +1,45 @@
+-- name: ListTodosByCalendarUIDs :many
+SELECT * FROM todos
+WHERE user_id = @user_id AND COALESCE(ical_uid, client_id::text) = ANY(@uids::text[]) AND deleted_at IS NULL
+ORDER BY id;
+
+-- name: GetTodoByCalendarUIDForUpdate :one
+SELECT * FROM todos
+WHERE user_id = @user_id AND COALESCE(ical_uid, client_id::text) = @uid::text
+FOR UPDATE;
+
+-- name: CreateCalendarTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+INSERT INTO todos (user_id, ical_uid, title, description, completed, position, due_at, project_id, change_seq)
+VALUES (@user_id, @ical_uid, @title, @description, @completed, @position, @due_at, @project_id, (SELECT todo_change_seq FROM seq))
+RETURNING *;
+
+-- name: UpdateCalendarTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET
+    title = @title,
+    description = @description,
+    completed = @completed,
+    due_at = @due_at,
+    title_updated_at = @title_updated_at,
+    description_updated_at = @description_updated_at,
+    completed_updated_at = @completed_updated_at,
+    status_id = CASE
+        WHEN @completed::boolean = completed THEN status_id
+        WHEN @completed::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = @user_id AND is_done)
+        ELSE NULL
+    END,
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = @id AND user_id = @user_id AND deleted_at IS NULL
+RETURNING *;
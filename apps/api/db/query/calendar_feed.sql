@@ -0,0 +1,15 @@
+-- name: UpsertCalendarFeed :one
+INSERT INTO calendar_feeds (user_id, token_hash)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash, created_at = NOW()
+RETURNING *;
+
+-- name: DeleteCalendarFeed :execrows
+DELETE FROM calendar_feeds
+WHERE user_id = $1;
+
+-- name: GetCalendarFeedUserID :one
+SELECT f.user_id FROM calendar_feeds f
+JOIN users u ON u.id = f.user_id
+WHERE f.token_hash = $1 AND u.deleted_at IS NULL;
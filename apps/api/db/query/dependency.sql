@@ -0,0 +1,42 @@
+-- name: ListTodoDependencyEdges :many
+SELECT todo_id, blocker_id FROM todo_dependencies
+WHERE user_id = $1;
+
+-- name: CreateTodoDependency :execrows
+INSERT INTO todo_dependencies (todo_id, blocker_id, user_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (todo_id, blocker_id) DO NOTHING;
+
+-- name: DeleteTodoDependency :execrows
+DELETE FROM todo_dependencies
+WHERE todo_id = $1 AND blocker_id = $2 AND user_id = $3;
+
+-- name: ListTodoBlockers :many
+SELECT t.* FROM todos t
+JOIN todo_dependencies d ON d.blocker_id = t.id
+WHERE d.todo_id = @todo_id AND d.user_id = @user_id AND t.deleted_at IS NULL
+ORDER BY t.id;
+
+-- name: ListTodoDependents :many
+SELECT t.* FROM todos t
+JOIN todo_dependencies d ON d.todo_id = t.id
+WHERE d.blocker_id = @blocker_id AND d.user_id = @user_id AND t.deleted_at IS NULL
+ORDER BY t.id;
+
+-- name: ListOpenBlockedTodoIDs :many
+SELECT d.todo_id FROM todo_dependencies d
+JOIN todos b ON b.id = d.blocker_id
+WHERE d.user_id = @user_id AND d.todo_id = ANY(@ids::bigint[]) AND NOT (b.id = ANY(@ids::bigint[]))
+    AND NOT b.completed AND b.deleted_at IS NULL
+GROUP BY d.todo_id;
+
+-- name: ListBlockedTodoIDs :many
+SELECT d.todo_id FROM todo_dependencies d
+JOIN todos b ON b.id = d.blocker_id
+WHERE d.user_id = @user_id AND NOT b.completed AND b.deleted_at IS NULL
+GROUP BY d.todo_id;
+
+-- name: ListTodoDependenciesByUser :many
+SELECT * FROM todo_dependencies
+WHERE user_id = $1
+ORDER BY todo_id, blocker_id;
@@ -0,0 +1,31 @@
+-- name: AcquireIdempotencyKey :one
+INSERT INTO idempotency_keys (user_id, key, request_hash, expires_at, locked_until)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    response_headers = NULL,
+    response_body = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at,
+    locked_until = EXCLUDED.locked_until
+WHERE idempotency_keys.expires_at < NOW()
+    OR (idempotency_keys.status_code IS NULL AND idempotency_keys.locked_until < NOW())
+RETURNING *;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE user_id = $1 AND key = $2 AND expires_at >= NOW();
+
+-- name: SaveIdempotencyResponse :execrows
+UPDATE idempotency_keys
+SET status_code = @status_code, response_headers = @response_headers, response_body = @response_body, locked_until = NULL
+WHERE user_id = @user_id AND key = @key AND locked_until = @claimed_until;
+
+-- name: DeleteIdempotencyKey :execrows
+DELETE FROM idempotency_keys
+WHERE user_id = @user_id AND key = @key AND locked_until = @claimed_until;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at < NOW();
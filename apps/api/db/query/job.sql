@@ -0,0 +1,67 @@
+-- name: CreateJob :one
+INSERT INTO jobs (user_id, type, params)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetJobByID :one
+SELECT * FROM jobs
+WHERE id = $1 AND user_id = $2;
+
+-- name: ClaimNextJob :one
+UPDATE jobs
+SET status = 'running',
+    attempts = attempts + 1,
+    locked_until = @locked_until,
+    started_at = COALESCE(started_at, NOW()),
+    updated_at = NOW()
+WHERE id = (
+    SELECT j.id FROM jobs j
+    WHERE (j.status = 'pending' OR (j.status = 'running' AND j.locked_until < NOW()))
+        AND j.attempts < @max_attempts
+    ORDER BY j.created_at
+    FOR UPDATE SKIP LOCKED
+    LIMIT 1
+)
+RETURNING *;
+
+-- name: FailExhaustedJobs :execrows
+UPDATE jobs
+SET status = 'failed', error = @error, locked_until = NULL, finished_at = NOW(), updated_at = NOW()
+WHERE attempts >= @max_attempts
+    AND (status = 'pending' OR (status = 'running' AND locked_until < NOW()));
+
+-- name: ExtendJobLease :one
+UPDATE jobs
+SET locked_until = @locked_until, updated_at = NOW()
+WHERE id = @id AND status = 'running' AND attempts = @attempts
+RETURNING cancel_requested;
+
+-- name: UpdateJobProgress :one
+UPDATE jobs
+SET processed = @processed, total = @total, locked_until = @locked_until, updated_at = NOW()
+WHERE id = @id AND status = 'running' AND attempts = @attempts
+RETURNING cancel_requested;
+
+-- name: FinishJob :execrows
+UPDATE jobs
+SET status = @status, result = @result, error = @error, locked_until = NULL, finished_at = NOW(), updated_at = NOW()
+WHERE id = @id AND status = 'running' AND attempts = @attempts;
+
+-- name: ReleaseJob :execrows
+UPDATE jobs
+SET status = 'pending', attempts = GREATEST(attempts - 1, 0), locked_until = NULL, updated_at = NOW()
+WHERE id = @id AND status = 'running' AND attempts = @attempts;
+
+-- name: CancelJob :one
+UPDATE jobs
+SET cancel_requested = TRUE,
+    status = CASE WHEN status = 'pending' THEN 'canceled' ELSE status END,
+    finished_at = CASE WHEN status = 'pending' THEN NOW() ELSE finished_at END,
+    updated_at = NOW()
+WHERE id = $1 AND user_id = $2 AND status IN ('pending', 'running')
+RETURNING *;
+
+-- name: ListJobsByUser :many
+SELECT * FROM jobs
+WHERE user_id = $1
+ORDER BY id;
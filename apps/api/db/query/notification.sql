@@ -0,0 +1,49 @@
+-- name: CreateNotification :execrows
+INSERT INTO notifications (user_id, type, title, body, todo_id, reminder_id)
+SELECT @user_id::bigint, @type::text, @title::text, @body::text, sqlc.narg(todo_id)::bigint, sqlc.narg(reminder_id)::bigint
+WHERE NOT EXISTS (
+    SELECT 1 FROM notification_preferences p
+    WHERE p.user_id = @user_id::bigint AND p.type = @type::text AND NOT p.enabled
+)
+ON CONFLICT (reminder_id) DO NOTHING;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE user_id = @user_id
+    AND (NOT @unread_only::boolean OR read_at IS NULL)
+    AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id)::bigint)
+ORDER BY id DESC
+LIMIT @max_items;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications
+WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: MarkNotificationRead :one
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = NOW()
+WHERE user_id = @user_id
+    AND read_at IS NULL
+    AND (sqlc.narg(up_to_id)::bigint IS NULL OR id <= sqlc.narg(up_to_id)::bigint);
+
+-- name: ListNotificationPreferences :many
+SELECT * FROM notification_preferences
+WHERE user_id = $1
+ORDER BY type;
+
+-- name: UpsertNotificationPreference :exec
+INSERT INTO notification_preferences (user_id, type, enabled)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, type) DO UPDATE
+SET enabled = EXCLUDED.enabled, updated_at = NOW();
+
+-- name: ListNotificationsByUser :many
+SELECT * FROM notifications
+WHERE user_id = $1
+ORDER BY id;
@@ -0,0 +1,20 @@
+-- name: CreatePersonalAccessToken :one
+INSERT INTO personal_access_tokens (user_id, name, token_hash)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: ListPersonalAccessTokens :many
+SELECT * FROM personal_access_tokens
+WHERE user_id = $1
+ORDER BY id;
+
+-- name: DeletePersonalAccessToken :execrows
+DELETE FROM personal_access_tokens
+WHERE id = $1 AND user_id = $2;
+
+-- name: TouchPersonalAccessToken :one
+UPDATE personal_access_tokens t
+SET last_used_at = NOW()
+FROM users u
+WHERE t.token_hash = $1 AND u.id = t.user_id AND u.deleted_at IS NULL
+RETURNING t.user_id;
@@ -0,0 +1,16 @@
+-- name: ListProjectsByUser :many
+SELECT * FROM projects
+WHERE user_id = $1
+ORDER BY name, id;
+
+-- name: EnsureProject :one
+INSERT INTO projects (user_id, name)
+VALUES ($1, $2)
+ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+RETURNING id;
+
+-- name: ProjectExists :one
+SELECT EXISTS (
+    SELECT 1 FROM projects
+    WHERE id = $1 AND user_id = $2
+);
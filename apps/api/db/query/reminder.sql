@@ -0,0 +1,50 @@
+-- name: ListRemindersByTodo :many
+SELECT * FROM reminders
+WHERE todo_id = $1 AND user_id = $2
+ORDER BY id;
+
+-- name: CreateReminder :one
+INSERT INTO reminders (user_id, todo_id, channel, remind_at, offset_minutes)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: DeleteReminder :execrows
+DELETE FROM reminders
+WHERE id = $1 AND user_id = $2;
+
+-- name: ClaimDueReminder :one
+UPDATE reminders
+SET attempts = attempts + 1,
+    locked_until = @locked_until,
+    updated_at = NOW()
+WHERE id = (
+    SELECT r.id FROM reminders r
+    JOIN todos t ON t.id = r.todo_id
+    WHERE r.status = 'pending'
+        AND (r.locked_until IS NULL OR r.locked_until < NOW())
+        AND COALESCE(r.remind_at, t.due_at + make_interval(mins => r.offset_minutes)) <= NOW()
+    ORDER BY r.id
+    FOR UPDATE OF r SKIP LOCKED
+    LIMIT 1
+)
+RETURNING *;
+
+-- name: GetReminderTarget :one
+SELECT t.title, t.completed, t.deleted_at, t.due_at, u.email, u.name FROM todos t
+JOIN users u ON u.id = t.user_id
+WHERE t.id = $1;
+
+-- name: FinishReminder :execrows
+UPDATE reminders
+SET status = @status, fired_at = @fired_at, last_error = @last_error, locked_until = NULL, updated_at = NOW()
+WHERE id = @id AND status = 'pending' AND locked_until = @claimed_until;
+
+-- name: RetryReminder :execrows
+UPDATE reminders
+SET last_error = @last_error, locked_until = @retry_at, updated_at = NOW()
+WHERE id = @id AND status = 'pending' AND locked_until = @claimed_until;
+
+-- name: ListRemindersByUser :many
+SELECT * FROM reminders
+WHERE user_id = $1
+ORDER BY id;
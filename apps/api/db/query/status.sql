@@ -0,0 +1,71 @@
+-- name: ListStatusesByUser :many
+SELECT * FROM statuses
+WHERE user_id = $1
+ORDER BY sort_order, id;
+
+-- name: CreateStatus :one
+INSERT INTO statuses (user_id, name, sort_order, wip_limit, is_done)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateStatus :one
+UPDATE statuses
+SET name = @name, sort_order = @sort_order, wip_limit = sqlc.narg(wip_limit), is_done = @is_done, updated_at = NOW()
+WHERE id = @id AND user_id = @user_id
+RETURNING *;
+
+-- name: ClearDoneStatus :exec
+UPDATE statuses SET is_done = FALSE, updated_at = NOW()
+WHERE user_id = $1 AND is_done;
+
+-- name: DeleteStatus :exec
+DELETE FROM statuses
+WHERE id = $1 AND user_id = $2;
+
+-- name: CountTodosInStatus :one
+SELECT COUNT(*) FROM todos
+WHERE user_id = @user_id AND deleted_at IS NULL
+    AND status_id = @status_id;
+
+-- name: SetTodoStatus :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET
+    status_id = @status_id::bigint,
+    completed = @completed::boolean,
+    completed_updated_at = CASE WHEN completed = @completed::boolean THEN completed_updated_at ELSE NOW() END,
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = @id AND user_id = @user_id AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ClearTodoStatus :exec
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET status_id = NULL, updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+WHERE user_id = @user_id AND status_id = @status_id::bigint AND deleted_at IS NULL;
+
+-- name: SyncTodoCompletedWithStatus :exec
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET
+    completed = (status_id = @done_status_id::bigint),
+    completed_updated_at = NOW(),
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE user_id = @user_id AND deleted_at IS NULL AND status_id IS NOT NULL
+    AND completed <> (status_id = @done_status_id::bigint);
@@ -0,0 +1,77 @@
+-- name: GetTodoChangeSeq :one
+SELECT todo_change_seq FROM users
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetTodoChangeSeqForUpdate :one
+SELECT todo_change_seq FROM users
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: NextTodoChangeSeq :one
+UPDATE users SET todo_change_seq = todo_change_seq + 1
+WHERE id = $1
+RETURNING todo_change_seq;
+
+-- name: GetTodoByClientIDForUpdate :one
+SELECT * FROM todos
+WHERE user_id = $1 AND client_id = $2
+FOR UPDATE;
+
+-- name: CreateSyncedTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+INSERT INTO todos (
+    user_id, client_id, title, description, completed, position, project_id,
+    title_updated_at, description_updated_at, completed_updated_at, change_seq
+)
+VALUES (
+    @user_id, @client_id, @title, @description, @completed, @position, @project_id,
+    @field_updated_at, @field_updated_at, @field_updated_at, (SELECT todo_change_seq FROM seq)
+)
+RETURNING *;
+
+-- name: ApplySyncedTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET
+    title = @title,
+    description = @description,
+    completed = @completed,
+    title_updated_at = @title_updated_at,
+    description_updated_at = @description_updated_at,
+    completed_updated_at = @completed_updated_at,
+    status_id = CASE
+        WHEN @completed::boolean = completed THEN status_id
+        WHEN @completed::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = @user_id AND is_done)
+        ELSE NULL
+    END,
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = @id AND user_id = @user_id AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteSyncedTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = @id AND user_id = @user_id AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListTodoChangesPage :many
+SELECT * FROM todos
+WHERE user_id = @user_id
+    AND (change_seq > @since OR (change_seq = @since AND id > sqlc.narg(after_id)::bigint))
+ORDER BY change_seq, id
+LIMIT @max_rows;
@@ -7,37 +7,232 @@ SELECT * FROM todos
 WHERE user_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC;
 
+-- name: ListTodosByUserManual :many
+SELECT * FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL
+ORDER BY position, id;
+
 -- name: CreateTodo :one
-INSERT INTO todos (user_id, title, description)
-VALUES ($1, $2, $3)
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+INSERT INTO todos (user_id, title, description, position, due_at, project_id, change_seq)
+VALUES (@user_id, @title, @description, @position, @due_at, @project_id, (SELECT todo_change_seq FROM seq))
 RETURNING *;
 
 -- name: UpdateTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
 UPDATE todos
 SET
     title = COALESCE(sqlc.narg(title), title),
     description = COALESCE(sqlc.narg(description), description),
     completed = COALESCE(sqlc.narg(completed), completed),
-    updated_at = NOW()
-WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+    title_updated_at = CASE WHEN sqlc.narg(title)::text IS NULL THEN title_updated_at ELSE NOW() END,
+    description_updated_at = CASE WHEN sqlc.narg(description)::text IS NULL THEN description_updated_at ELSE NOW() END,
+    completed_updated_at = CASE WHEN sqlc.narg(completed)::boolean IS NULL THEN completed_updated_at ELSE NOW() END,
+    status_id = CASE
+        WHEN sqlc.narg(completed)::boolean IS NULL OR sqlc.narg(completed)::boolean = completed THEN status_id
+        WHEN sqlc.narg(completed)::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = @user_id AND is_done)
+        ELSE NULL
+    END,
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = @id AND user_id = @user_id AND deleted_at IS NULL
+    AND (sqlc.narg(expected_versions)::integer[] IS NULL OR version = ANY(sqlc.narg(expected_versions)::integer[]))
 RETURNING *;
 
--- name: DeleteTodo :exec
+-- name: SetTodoDueAt :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
 UPDATE todos
-SET deleted_at = NOW(), updated_at = NOW()
-WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;
+SET
+    due_at = sqlc.narg(due_at),
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = @id AND user_id = @user_id AND deleted_at IS NULL
+    AND (sqlc.narg(expected_versions)::integer[] IS NULL OR version = ANY(sqlc.narg(expected_versions)::integer[]))
+RETURNING *;
+
+-- name: DeleteTodo :execrows
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = @id AND user_id = @user_id AND deleted_at IS NULL
+    AND (sqlc.narg(expected_versions)::integer[] IS NULL OR version = ANY(sqlc.narg(expected_versions)::integer[]));
 
--- name: GetTodosByIDs :many
+-- name: GetTodosByIDsForUpdate :many
 SELECT * FROM todos
-WHERE id = ANY(@ids::bigint[]) AND user_id = @user_id AND deleted_at IS NULL;
+WHERE id = ANY(@ids::bigint[]) AND user_id = @user_id AND deleted_at IS NULL
+ORDER BY id
+FOR UPDATE;
 
 -- name: BatchCompleteTodos :many
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
 UPDATE todos
-SET completed = TRUE, updated_at = NOW()
+SET
+    completed = TRUE,
+    completed_updated_at = NOW(),
+    status_id = (SELECT id FROM statuses WHERE statuses.user_id = @user_id AND is_done),
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
 WHERE id = ANY(@ids::bigint[]) AND user_id = @user_id AND deleted_at IS NULL
 RETURNING *;
 
 -- name: BatchDeleteTodos :exec
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
 UPDATE todos
-SET deleted_at = NOW(), updated_at = NOW()
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
 WHERE id = ANY(@ids::bigint[]) AND user_id = @user_id AND deleted_at IS NULL;
+
+-- name: BatchUpdateTodos :many
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET
+    title = COALESCE(sqlc.narg(title), title),
+    description = COALESCE(sqlc.narg(description), description),
+    completed = COALESCE(sqlc.narg(completed), completed),
+    title_updated_at = CASE WHEN sqlc.narg(title)::text IS NULL THEN title_updated_at ELSE NOW() END,
+    description_updated_at = CASE WHEN sqlc.narg(description)::text IS NULL THEN description_updated_at ELSE NOW() END,
+    completed_updated_at = CASE WHEN sqlc.narg(completed)::boolean IS NULL THEN completed_updated_at ELSE NOW() END,
+    status_id = CASE
+        WHEN sqlc.narg(completed)::boolean IS NULL OR sqlc.narg(completed)::boolean = completed THEN status_id
+        WHEN sqlc.narg(completed)::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = @user_id AND is_done)
+        ELSE NULL
+    END,
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = ANY(@ids::bigint[]) AND user_id = @user_id AND deleted_at IS NULL
+RETURNING *;
+
+-- name: CopyTodos :copyfrom
+INSERT INTO todos (user_id, title, description, completed, position, change_seq, due_at, project_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: CopyImportedTodos :copyfrom
+INSERT INTO todos (user_id, title, description, completed, position, change_seq, due_at, project_id, tags, import_source, import_source_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: ListImportedSourceIDs :many
+SELECT import_source_id::text FROM todos
+WHERE user_id = @user_id AND import_source = @import_source::text AND import_source_id = ANY(@source_ids::text[]);
+
+-- name: ListExistingTodoTitles :many
+SELECT title FROM todos
+WHERE user_id = @user_id AND deleted_at IS NULL AND title = ANY(@titles::text[])
+GROUP BY title;
+
+-- name: CountTodosByFilter :one
+SELECT COUNT(*) FROM todos
+WHERE user_id = @user_id AND deleted_at IS NULL
+    AND (sqlc.narg(ids)::bigint[] IS NULL OR id = ANY(sqlc.narg(ids)::bigint[]))
+    AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+    AND (sqlc.narg(completed)::boolean IS NULL OR completed = sqlc.narg(completed)::boolean)
+    AND (sqlc.narg(project_id)::bigint IS NULL OR project_id = sqlc.narg(project_id)::bigint);
+
+-- name: ListTodoIDsByFilter :many
+SELECT id FROM todos
+WHERE user_id = @user_id AND deleted_at IS NULL AND id > @after_id
+    AND (sqlc.narg(ids)::bigint[] IS NULL OR id = ANY(sqlc.narg(ids)::bigint[]))
+    AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before)::timestamptz)
+    AND (sqlc.narg(completed)::boolean IS NULL OR completed = sqlc.narg(completed)::boolean)
+    AND (sqlc.narg(project_id)::bigint IS NULL OR project_id = sqlc.narg(project_id)::bigint)
+ORDER BY id
+LIMIT @max_rows;
+
+-- name: GetLastTodoPosition :one
+SELECT COALESCE(MAX(position), '')::text AS position FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL;
+
+-- name: GetNextTodoPosition :one
+SELECT position FROM todos
+WHERE user_id = @user_id AND deleted_at IS NULL AND id <> @exclude_id
+    AND (position, id) > (@anchor_position::text, @anchor_id::bigint)
+ORDER BY position, id
+LIMIT 1;
+
+-- name: GetPrevTodoPosition :one
+SELECT position FROM todos
+WHERE user_id = @user_id AND deleted_at IS NULL AND id <> @exclude_id
+    AND (position, id) < (@anchor_position::text, @anchor_id::bigint)
+ORDER BY position DESC, id DESC
+LIMIT 1;
+
+-- name: UpdateTodoPosition :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET position = @position, updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = @id AND user_id = @user_id AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListTodoIDsByPosition :many
+SELECT id FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL
+ORDER BY position, id;
+
+-- name: SetTodoPositions :exec
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET position = u.position, updated_at = NOW(), version = todos.version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+FROM (SELECT unnest(@ids::bigint[]) AS id, unnest(@positions::text[]) AS position) AS u
+WHERE todos.id = u.id AND todos.user_id = @user_id AND todos.position IS DISTINCT FROM u.position;
+
+-- name: ListUsersWithLongTodoPositions :many
+SELECT user_id FROM todos
+WHERE deleted_at IS NULL
+GROUP BY user_id
+HAVING MAX(length(position)) > @max_length::integer;
+
+-- name: ListTodosForExportPage :many
+SELECT * FROM todos
+WHERE user_id = @user_id
+    AND (@include_deleted::boolean OR deleted_at IS NULL)
+    AND (position, id) > (@after_position::text, @after_id::bigint)
+ORDER BY position, id
+LIMIT @max_rows;
+
+-- name: CreateQuickAddTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
+INSERT INTO todos (user_id, title, description, position, due_at, project_id, tags, priority, recurrence, change_seq)
+VALUES (@user_id, @title, @description, @position, @due_at, @project_id, @tags, @priority, @recurrence, (SELECT todo_change_seq FROM seq))
+RETURNING *;
@@ -25,6 +25,11 @@ SET deleted_at = NOW(), updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: DeleteTodosByUserID :exec
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = @user_id
+    RETURNING todo_change_seq
+)
 UPDATE todos
-SET deleted_at = NOW(), updated_at = NOW()
-WHERE user_id = $1 AND deleted_at IS NULL;
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+WHERE user_id = @user_id AND deleted_at IS NULL;
@@ -0,0 +1,15 @@
+-- name: GetUserSettings :one
+SELECT * FROM user_settings
+WHERE user_id = $1;
+
+-- name: UpsertUserSettings :one
+INSERT INTO user_settings (user_id, timezone, locale, week_start, default_sort, default_project_id)
+VALUES (@user_id, @timezone, @locale, @week_start, @default_sort, @default_project_id)
+ON CONFLICT (user_id) DO UPDATE
+SET timezone = EXCLUDED.timezone,
+    locale = EXCLUDED.locale,
+    week_start = EXCLUDED.week_start,
+    default_sort = EXCLUDED.default_sort,
+    default_project_id = EXCLUDED.default_project_id,
+    updated_at = NOW()
+RETURNING *;
@@ -8,9 +8,31 @@ CREATE TABLE users (
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     deleted_at TIMESTAMPTZ DEFAULT NULL,
+    todo_change_seq BIGINT NOT NULL DEFAULT 0,
     UNIQUE(provider, provider_id)
 );
 
+CREATE TABLE statuses (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    wip_limit INTEGER CHECK (wip_limit > 0),
+    is_done BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(user_id, name)
+);
+
+CREATE TABLE projects (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(user_id, name)
+);
+
 CREATE TABLE todos (
     id BIGSERIAL PRIMARY KEY,
     user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
@@ -19,9 +41,172 @@ CREATE TABLE todos (
     completed BOOLEAN NOT NULL DEFAULT FALSE,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    deleted_at TIMESTAMPTZ DEFAULT NULL
+    deleted_at TIMESTAMPTZ DEFAULT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    client_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    change_seq BIGINT NOT NULL DEFAULT 0,
+    title_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    description_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    position TEXT COLLATE "C" NOT NULL DEFAULT '',
+    status_id BIGINT REFERENCES statuses(id) ON DELETE SET NULL,
+    due_at TIMESTAMPTZ,
+    ical_uid TEXT,
+    project_id BIGINT REFERENCES projects(id) ON DELETE SET NULL,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    import_source TEXT,
+    import_source_id TEXT,
+    priority TEXT CHECK (priority IN ('low', 'medium', 'high')),
+    -- 繰り返し（iCalendarのRRULE、例: FREQ=WEEKLY;BYDAY=MO）
+    recurrence TEXT
+);
+
+CREATE TABLE todo_dependencies (
+    todo_id BIGINT NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    blocker_id BIGINT NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (todo_id, blocker_id),
+    CHECK (todo_id <> blocker_id)
+);
+
+CREATE TABLE reminders (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    todo_id BIGINT NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    channel TEXT NOT NULL CHECK (channel IN ('email', 'in_app', 'webhook')),
+    remind_at TIMESTAMPTZ,
+    offset_minutes INTEGER,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed', 'canceled')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    last_error TEXT,
+    fired_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((remind_at IS NULL) <> (offset_minutes IS NULL))
+);
+
+CREATE TABLE notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('reminder', 'shared_list', 'admin')),
+    title TEXT NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    todo_id BIGINT REFERENCES todos(id) ON DELETE SET NULL,
+    reminder_id BIGINT UNIQUE REFERENCES reminders(id) ON DELETE SET NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE notification_preferences (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('reminder', 'shared_list', 'admin')),
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, type)
+);
+
+-- ユーザーごとの設定。行がない場合は既定値を使う
+CREATE TABLE user_settings (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    -- IANAのタイムゾーン名。「今日」や日付だけの期限はこのタイムゾーンで解釈する
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    -- BCP 47の言語タグ
+    locale TEXT NOT NULL DEFAULT 'en',
+    -- 週の始まりの曜日（0 = 日曜日）
+    week_start SMALLINT NOT NULL DEFAULT 1 CHECK (week_start BETWEEN 0 AND 6),
+    default_sort TEXT NOT NULL DEFAULT 'created_at' CHECK (default_sort IN ('created_at', 'manual')),
+    -- プロジェクトを指定せずに作成したTodoが属するプロジェクト
+    default_project_id BIGINT REFERENCES projects(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE calendar_feeds (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE personal_access_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE TABLE idempotency_keys (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    status_code INTEGER,
+    response_headers JSONB,
+    response_body BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ,
+    UNIQUE(user_id, key)
+);
+
+CREATE TABLE account_exports (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    size BIGINT NOT NULL DEFAULT 0,
+    chunks INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    downloaded_at TIMESTAMPTZ
+);
+
+CREATE TABLE account_export_chunks (
+    export_id BIGINT NOT NULL REFERENCES account_exports(id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    data BYTEA NOT NULL,
+    PRIMARY KEY (export_id, seq)
+);
+
+CREATE TABLE jobs (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'succeeded', 'failed', 'canceled')),
+    params JSONB NOT NULL DEFAULT '{}',
+    result JSONB,
+    error TEXT,
+    total INTEGER NOT NULL DEFAULT 0,
+    processed INTEGER NOT NULL DEFAULT 0,
+    cancel_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ
 );
 
 CREATE INDEX idx_todos_user_id ON todos(user_id);
 CREATE INDEX idx_todos_deleted_at ON todos(deleted_at);
+CREATE UNIQUE INDEX idx_todos_user_id_client_id ON todos(user_id, client_id);
+CREATE UNIQUE INDEX idx_todos_user_id_calendar_uid ON todos(user_id, (COALESCE(ical_uid, client_id::text)));
+CREATE INDEX idx_todos_user_id_change_seq ON todos(user_id, change_seq);
+CREATE INDEX idx_todos_user_id_position ON todos(user_id, position);
 CREATE INDEX idx_users_deleted_at ON users(deleted_at);
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
+CREATE INDEX idx_jobs_user_id ON jobs(user_id);
+CREATE INDEX idx_personal_access_tokens_user_id ON personal_access_tokens(user_id);
+CREATE INDEX idx_jobs_status_created_at ON jobs(status, created_at);
+CREATE UNIQUE INDEX idx_statuses_user_id_done ON statuses(user_id) WHERE is_done;
+CREATE INDEX idx_todos_status_id ON todos(status_id);
+CREATE INDEX idx_todos_project_id ON todos(project_id);
+CREATE INDEX idx_account_exports_user_id ON account_exports(user_id);
+CREATE INDEX idx_account_exports_expires_at ON account_exports(expires_at);
+CREATE UNIQUE INDEX idx_todos_user_id_import_source ON todos(user_id, import_source, import_source_id) WHERE import_source IS NOT NULL;
+CREATE INDEX idx_todo_dependencies_blocker_id ON todo_dependencies(blocker_id);
+CREATE INDEX idx_todo_dependencies_user_id ON todo_dependencies(user_id);
+CREATE INDEX idx_reminders_todo_id ON reminders(todo_id);
+CREATE INDEX idx_reminders_pending ON reminders(id) WHERE status = 'pending';
+CREATE INDEX idx_notifications_user_id_id ON notifications(user_id, id);
+CREATE INDEX idx_notifications_user_id_unread ON notifications(user_id) WHERE read_at IS NULL;
@@ -10,25 +10,176 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type Todo struct {
-	ID          int64              `json:"id"`
-	UserID      int64              `json:"user_id"`
-	Title       string             `json:"title"`
-	Description *string            `json:"description"`
-	Completed   bool               `json:"completed"`
-	CreatedAt   time.Time          `json:"created_at"`
-	UpdatedAt   time.Time          `json:"updated_at"`
-	DeletedAt   pgtype.Timestamptz `json:"deleted_at"`
+type AccountExport struct {
+	ID           int64              `json:"id"`
+	UserID       int64              `json:"user_id"`
+	Size         int64              `json:"size"`
+	Chunks       int32              `json:"chunks"`
+	CreatedAt    time.Time          `json:"created_at"`
+	ExpiresAt    time.Time          `json:"expires_at"`
+	DownloadedAt pgtype.Timestamptz `json:"downloaded_at"`
 }
 
-type User struct {
+type AccountExportChunk struct {
+	ExportID int64  `json:"export_id"`
+	Seq      int32  `json:"seq"`
+	Data     []byte `json:"data"`
+}
+
+type CalendarFeed struct {
+	UserID    int64     `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	ID              int64              `json:"id"`
+	UserID          int64              `json:"user_id"`
+	Key             string             `json:"key"`
+	RequestHash     string             `json:"request_hash"`
+	StatusCode      *int32             `json:"status_code"`
+	ResponseHeaders []byte             `json:"response_headers"`
+	ResponseBody    []byte             `json:"response_body"`
+	CreatedAt       time.Time          `json:"created_at"`
+	ExpiresAt       time.Time          `json:"expires_at"`
+	LockedUntil     pgtype.Timestamptz `json:"locked_until"`
+}
+
+type Job struct {
+	ID              int64              `json:"id"`
+	UserID          int64              `json:"user_id"`
+	Type            string             `json:"type"`
+	Status          string             `json:"status"`
+	Params          []byte             `json:"params"`
+	Result          []byte             `json:"result"`
+	Error           *string            `json:"error"`
+	Total           int32              `json:"total"`
+	Processed       int32              `json:"processed"`
+	CancelRequested bool               `json:"cancel_requested"`
+	Attempts        int32              `json:"attempts"`
+	LockedUntil     pgtype.Timestamptz `json:"locked_until"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	StartedAt       pgtype.Timestamptz `json:"started_at"`
+	FinishedAt      pgtype.Timestamptz `json:"finished_at"`
+}
+
+type Notification struct {
+	ID         int64              `json:"id"`
+	UserID     int64              `json:"user_id"`
+	Type       string             `json:"type"`
+	Title      string             `json:"title"`
+	Body       string             `json:"body"`
+	TodoID     *int64             `json:"todo_id"`
+	ReminderID *int64             `json:"reminder_id"`
+	ReadAt     pgtype.Timestamptz `json:"read_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+type NotificationPreference struct {
+	UserID    int64     `json:"user_id"`
+	Type      string    `json:"type"`
+	Enabled   bool      `json:"enabled"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PersonalAccessToken struct {
 	ID         int64              `json:"id"`
-	Email      string             `json:"email"`
+	UserID     int64              `json:"user_id"`
 	Name       string             `json:"name"`
-	AvatarUrl  *string            `json:"avatar_url"`
-	Provider   string             `json:"provider"`
-	ProviderID string             `json:"provider_id"`
+	TokenHash  string             `json:"token_hash"`
 	CreatedAt  time.Time          `json:"created_at"`
-	UpdatedAt  time.Time          `json:"updated_at"`
-	DeletedAt  pgtype.Timestamptz `json:"deleted_at"`
+	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
+}
+
+type Project struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Reminder struct {
+	ID            int64              `json:"id"`
+	UserID        int64              `json:"user_id"`
+	TodoID        int64              `json:"todo_id"`
+	Channel       string             `json:"channel"`
+	RemindAt      pgtype.Timestamptz `json:"remind_at"`
+	OffsetMinutes *int32             `json:"offset_minutes"`
+	Status        string             `json:"status"`
+	Attempts      int32              `json:"attempts"`
+	LockedUntil   pgtype.Timestamptz `json:"locked_until"`
+	LastError     *string            `json:"last_error"`
+	FiredAt       pgtype.Timestamptz `json:"fired_at"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+type Status struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Name      string    `json:"name"`
+	SortOrder int32     `json:"sort_order"`
+	WipLimit  *int32    `json:"wip_limit"`
+	IsDone    bool      `json:"is_done"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Todo struct {
+	ID                   int64              `json:"id"`
+	UserID               int64              `json:"user_id"`
+	Title                string             `json:"title"`
+	Description          *string            `json:"description"`
+	Completed            bool               `json:"completed"`
+	CreatedAt            time.Time          `json:"created_at"`
+	UpdatedAt            time.Time          `json:"updated_at"`
+	DeletedAt            pgtype.Timestamptz `json:"deleted_at"`
+	Version              int32              `json:"version"`
+	ClientID             pgtype.UUID        `json:"client_id"`
+	ChangeSeq            int64              `json:"change_seq"`
+	TitleUpdatedAt       time.Time          `json:"title_updated_at"`
+	DescriptionUpdatedAt time.Time          `json:"description_updated_at"`
+	CompletedUpdatedAt   time.Time          `json:"completed_updated_at"`
+	Position             string             `json:"position"`
+	StatusID             *int64             `json:"status_id"`
+	DueAt                pgtype.Timestamptz `json:"due_at"`
+	IcalUid              *string            `json:"ical_uid"`
+	ProjectID            *int64             `json:"project_id"`
+	Tags                 []string           `json:"tags"`
+	ImportSource         *string            `json:"import_source"`
+	ImportSourceID       *string            `json:"import_source_id"`
+	Priority             *string            `json:"priority"`
+	Recurrence           *string            `json:"recurrence"`
+}
+
+type TodoDependency struct {
+	TodoID    int64     `json:"todo_id"`
+	BlockerID int64     `json:"blocker_id"`
+	UserID    int64     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type User struct {
+	ID            int64              `json:"id"`
+	Email         string             `json:"email"`
+	Name          string             `json:"name"`
+	AvatarUrl     *string            `json:"avatar_url"`
+	Provider      string             `json:"provider"`
+	ProviderID    string             `json:"provider_id"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+	TodoChangeSeq int64              `json:"todo_change_seq"`
+}
+
+type UserSetting struct {
+	UserID           int64     `json:"user_id"`
+	Timezone         string    `json:"timezone"`
+	Locale           string    `json:"locale"`
+	WeekStart        int16     `json:"week_start"`
+	DefaultSort      string    `json:"default_sort"`
+	DefaultProjectID *int64    `json:"default_project_id"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
@@ -6,44 +6,388 @@ package sqlc
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	//AcquireIdempotencyKey
+	//
+	//  INSERT INTO idempotency_keys (user_id, key, request_hash, expires_at, locked_until)
+	//  VALUES ($1, $2, $3, $4, $5)
+	//  ON CONFLICT (user_id, key) DO UPDATE
+	//  SET request_hash = EXCLUDED.request_hash,
+	//      status_code = NULL,
+	//      response_headers = NULL,
+	//      response_body = NULL,
+	//      created_at = NOW(),
+	//      expires_at = EXCLUDED.expires_at,
+	//      locked_until = EXCLUDED.locked_until
+	//  WHERE idempotency_keys.expires_at < NOW()
+	//      OR (idempotency_keys.status_code IS NULL AND idempotency_keys.locked_until < NOW())
+	//  RETURNING id, user_id, key, request_hash, status_code, response_headers, response_body, created_at, expires_at, locked_until
+	AcquireIdempotencyKey(ctx context.Context, arg AcquireIdempotencyKeyParams) (IdempotencyKey, error)
+	//ApplySyncedTodo
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET
+	//      title = $2,
+	//      description = $3,
+	//      completed = $4,
+	//      title_updated_at = $5,
+	//      description_updated_at = $6,
+	//      completed_updated_at = $7,
+	//      status_id = CASE
+	//          WHEN $4::boolean = completed THEN status_id
+	//          WHEN $4::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done)
+	//          ELSE NULL
+	//      END,
+	//      updated_at = NOW(),
+	//      version = version + 1,
+	//      change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = $8 AND user_id = $1 AND deleted_at IS NULL
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	ApplySyncedTodo(ctx context.Context, arg ApplySyncedTodoParams) (Todo, error)
 	//BatchCompleteTodos
 	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
 	//  UPDATE todos
-	//  SET completed = TRUE, updated_at = NOW()
-	//  WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
-	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+	//  SET
+	//      completed = TRUE,
+	//      completed_updated_at = NOW(),
+	//      status_id = (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done),
+	//      updated_at = NOW(),
+	//      version = version + 1,
+	//      change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = ANY($2::bigint[]) AND user_id = $1 AND deleted_at IS NULL
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 	BatchCompleteTodos(ctx context.Context, arg BatchCompleteTodosParams) ([]Todo, error)
 	//BatchDeleteTodos
 	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
 	//  UPDATE todos
-	//  SET deleted_at = NOW(), updated_at = NOW()
-	//  WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
+	//  SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = ANY($2::bigint[]) AND user_id = $1 AND deleted_at IS NULL
 	BatchDeleteTodos(ctx context.Context, arg BatchDeleteTodosParams) error
-	//CreateTodo
+	//BatchUpdateTodos
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET
+	//      title = COALESCE($2, title),
+	//      description = COALESCE($3, description),
+	//      completed = COALESCE($4, completed),
+	//      title_updated_at = CASE WHEN $2::text IS NULL THEN title_updated_at ELSE NOW() END,
+	//      description_updated_at = CASE WHEN $3::text IS NULL THEN description_updated_at ELSE NOW() END,
+	//      completed_updated_at = CASE WHEN $4::boolean IS NULL THEN completed_updated_at ELSE NOW() END,
+	//      status_id = CASE
+	//          WHEN $4::boolean IS NULL OR $4::boolean = completed THEN status_id
+	//          WHEN $4::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done)
+	//          ELSE NULL
+	//      END,
+	//      updated_at = NOW(),
+	//      version = version + 1,
+	//      change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = ANY($5::bigint[]) AND user_id = $1 AND deleted_at IS NULL
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	BatchUpdateTodos(ctx context.Context, arg BatchUpdateTodosParams) ([]Todo, error)
+	//CancelJob
+	//
+	//  UPDATE jobs
+	//  SET cancel_requested = TRUE,
+	//      status = CASE WHEN status = 'pending' THEN 'canceled' ELSE status END,
+	//      finished_at = CASE WHEN status = 'pending' THEN NOW() ELSE finished_at END,
+	//      updated_at = NOW()
+	//  WHERE id = $1 AND user_id = $2 AND status IN ('pending', 'running')
+	//  RETURNING id, user_id, type, status, params, result, error, total, processed, cancel_requested, attempts, locked_until, created_at, updated_at, started_at, finished_at
+	CancelJob(ctx context.Context, arg CancelJobParams) (Job, error)
+	//ClaimAccountExport
+	//
+	//  UPDATE account_exports
+	//  SET downloaded_at = NOW()
+	//  WHERE id = $1 AND downloaded_at IS NULL AND expires_at > NOW()
+	//  RETURNING chunks, size
+	ClaimAccountExport(ctx context.Context, id int64) (ClaimAccountExportRow, error)
+	//ClaimDueReminder
+	//
+	//  UPDATE reminders
+	//  SET attempts = attempts + 1,
+	//      locked_until = $1,
+	//      updated_at = NOW()
+	//  WHERE id = (
+	//      SELECT r.id FROM reminders r
+	//      JOIN todos t ON t.id = r.todo_id
+	//      WHERE r.status = 'pending'
+	//          AND (r.locked_until IS NULL OR r.locked_until < NOW())
+	//          AND COALESCE(r.remind_at, t.due_at + make_interval(mins => r.offset_minutes)) <= NOW()
+	//      ORDER BY r.id
+	//      FOR UPDATE OF r SKIP LOCKED
+	//      LIMIT 1
+	//  )
+	//  RETURNING id, user_id, todo_id, channel, remind_at, offset_minutes, status, attempts, locked_until, last_error, fired_at, created_at, updated_at
+	ClaimDueReminder(ctx context.Context, lockedUntil pgtype.Timestamptz) (Reminder, error)
+	//ClaimNextJob
+	//
+	//  UPDATE jobs
+	//  SET status = 'running',
+	//      attempts = attempts + 1,
+	//      locked_until = $1,
+	//      started_at = COALESCE(started_at, NOW()),
+	//      updated_at = NOW()
+	//  WHERE id = (
+	//      SELECT j.id FROM jobs j
+	//      WHERE (j.status = 'pending' OR (j.status = 'running' AND j.locked_until < NOW()))
+	//          AND j.attempts < $2
+	//      ORDER BY j.created_at
+	//      FOR UPDATE SKIP LOCKED
+	//      LIMIT 1
+	//  )
+	//  RETURNING id, user_id, type, status, params, result, error, total, processed, cancel_requested, attempts, locked_until, created_at, updated_at, started_at, finished_at
+	ClaimNextJob(ctx context.Context, arg ClaimNextJobParams) (Job, error)
+	//ClearDoneStatus
+	//
+	//  UPDATE statuses SET is_done = FALSE, updated_at = NOW()
+	//  WHERE user_id = $1 AND is_done
+	ClearDoneStatus(ctx context.Context, userID int64) error
+	//ClearTodoStatus
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET status_id = NULL, updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE user_id = $1 AND status_id = $2::bigint AND deleted_at IS NULL
+	ClearTodoStatus(ctx context.Context, arg ClearTodoStatusParams) error
+	//CopyImportedTodos
+	//
+	//  INSERT INTO todos (user_id, title, description, completed, position, change_seq, due_at, project_id, tags, import_source, import_source_id)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	CopyImportedTodos(ctx context.Context, arg []CopyImportedTodosParams) (int64, error)
+	//CopyTodos
+	//
+	//  INSERT INTO todos (user_id, title, description, completed, position, change_seq, due_at, project_id)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	CopyTodos(ctx context.Context, arg []CopyTodosParams) (int64, error)
+	//CountTodosByFilter
+	//
+	//  SELECT COUNT(*) FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL
+	//      AND ($2::bigint[] IS NULL OR id = ANY($2::bigint[]))
+	//      AND ($3::timestamptz IS NULL OR created_at < $3::timestamptz)
+	//      AND ($4::boolean IS NULL OR completed = $4::boolean)
+	//      AND ($5::bigint IS NULL OR project_id = $5::bigint)
+	CountTodosByFilter(ctx context.Context, arg CountTodosByFilterParams) (int64, error)
+	//CountTodosInStatus
+	//
+	//  SELECT COUNT(*) FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL
+	//      AND status_id = $2
+	CountTodosInStatus(ctx context.Context, arg CountTodosInStatusParams) (int64, error)
+	//CountUnreadNotifications
+	//
+	//  SELECT COUNT(*) FROM notifications
+	//  WHERE user_id = $1 AND read_at IS NULL
+	CountUnreadNotifications(ctx context.Context, userID int64) (int64, error)
+	//CreateAccountExport
+	//
+	//  INSERT INTO account_exports (user_id, expires_at)
+	//  VALUES ($1, $2)
+	//  RETURNING id
+	CreateAccountExport(ctx context.Context, arg CreateAccountExportParams) (int64, error)
+	//CreateAccountExportChunk
 	//
-	//  INSERT INTO todos (user_id, title, description)
+	//  INSERT INTO account_export_chunks (export_id, seq, data)
 	//  VALUES ($1, $2, $3)
-	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+	CreateAccountExportChunk(ctx context.Context, arg CreateAccountExportChunkParams) error
+	//CreateCalendarTodo
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  INSERT INTO todos (user_id, ical_uid, title, description, completed, position, due_at, project_id, change_seq)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, (SELECT todo_change_seq FROM seq))
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	CreateCalendarTodo(ctx context.Context, arg CreateCalendarTodoParams) (Todo, error)
+	//CreateJob
+	//
+	//  INSERT INTO jobs (user_id, type, params)
+	//  VALUES ($1, $2, $3)
+	//  RETURNING id, user_id, type, status, params, result, error, total, processed, cancel_requested, attempts, locked_until, created_at, updated_at, started_at, finished_at
+	CreateJob(ctx context.Context, arg CreateJobParams) (Job, error)
+	//CreateNotification
+	//
+	//  INSERT INTO notifications (user_id, type, title, body, todo_id, reminder_id)
+	//  SELECT $1::bigint, $2::text, $3::text, $4::text, $5::bigint, $6::bigint
+	//  WHERE NOT EXISTS (
+	//      SELECT 1 FROM notification_preferences p
+	//      WHERE p.user_id = $1::bigint AND p.type = $2::text AND NOT p.enabled
+	//  )
+	//  ON CONFLICT (reminder_id) DO NOTHING
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) (int64, error)
+	//CreatePersonalAccessToken
+	//
+	//  INSERT INTO personal_access_tokens (user_id, name, token_hash)
+	//  VALUES ($1, $2, $3)
+	//  RETURNING id, user_id, name, token_hash, created_at, last_used_at
+	CreatePersonalAccessToken(ctx context.Context, arg CreatePersonalAccessTokenParams) (PersonalAccessToken, error)
+	//CreateQuickAddTodo
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  INSERT INTO todos (user_id, title, description, position, due_at, project_id, tags, priority, recurrence, change_seq)
+	//  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, (SELECT todo_change_seq FROM seq))
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	CreateQuickAddTodo(ctx context.Context, arg CreateQuickAddTodoParams) (Todo, error)
+	//CreateReminder
+	//
+	//  INSERT INTO reminders (user_id, todo_id, channel, remind_at, offset_minutes)
+	//  VALUES ($1, $2, $3, $4, $5)
+	//  RETURNING id, user_id, todo_id, channel, remind_at, offset_minutes, status, attempts, locked_until, last_error, fired_at, created_at, updated_at
+	CreateReminder(ctx context.Context, arg CreateReminderParams) (Reminder, error)
+	//CreateStatus
+	//
+	//  INSERT INTO statuses (user_id, name, sort_order, wip_limit, is_done)
+	//  VALUES ($1, $2, $3, $4, $5)
+	//  RETURNING id, user_id, name, sort_order, wip_limit, is_done, created_at, updated_at
+	CreateStatus(ctx context.Context, arg CreateStatusParams) (Status, error)
+	//CreateSyncedTodo
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  INSERT INTO todos (
+	//      user_id, client_id, title, description, completed, position, project_id,
+	//      title_updated_at, description_updated_at, completed_updated_at, change_seq
+	//  )
+	//  VALUES (
+	//      $1, $2, $3, $4, $5, $6, $7,
+	//      $8, $8, $8, (SELECT todo_change_seq FROM seq)
+	//  )
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	CreateSyncedTodo(ctx context.Context, arg CreateSyncedTodoParams) (Todo, error)
+	//CreateTodo
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  INSERT INTO todos (user_id, title, description, position, due_at, project_id, change_seq)
+	//  VALUES ($1, $2, $3, $4, $5, $6, (SELECT todo_change_seq FROM seq))
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 	CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, error)
+	//CreateTodoDependency
+	//
+	//  INSERT INTO todo_dependencies (todo_id, blocker_id, user_id)
+	//  VALUES ($1, $2, $3)
+	//  ON CONFLICT (todo_id, blocker_id) DO NOTHING
+	CreateTodoDependency(ctx context.Context, arg CreateTodoDependencyParams) (int64, error)
 	//CreateUser
 	//
 	//  INSERT INTO users (email, name, avatar_url, provider, provider_id)
 	//  VALUES ($1, $2, $3, $4, $5)
-	//  RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at
+	//  RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	//DeleteCalendarFeed
+	//
+	//  DELETE FROM calendar_feeds
+	//  WHERE user_id = $1
+	DeleteCalendarFeed(ctx context.Context, userID int64) (int64, error)
+	//DeleteExpiredIdempotencyKeys
+	//
+	//  DELETE FROM idempotency_keys
+	//  WHERE expires_at < NOW()
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	//DeleteFinishedAccountExports
+	//
+	//  DELETE FROM account_exports
+	//  WHERE expires_at < $1 OR downloaded_at < $1
+	DeleteFinishedAccountExports(ctx context.Context, before time.Time) (int64, error)
+	//DeleteIdempotencyKey
+	//
+	//  DELETE FROM idempotency_keys
+	//  WHERE user_id = $1 AND key = $2 AND locked_until = $3
+	DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) (int64, error)
+	//DeletePersonalAccessToken
+	//
+	//  DELETE FROM personal_access_tokens
+	//  WHERE id = $1 AND user_id = $2
+	DeletePersonalAccessToken(ctx context.Context, arg DeletePersonalAccessTokenParams) (int64, error)
+	//DeleteReminder
+	//
+	//  DELETE FROM reminders
+	//  WHERE id = $1 AND user_id = $2
+	DeleteReminder(ctx context.Context, arg DeleteReminderParams) (int64, error)
+	//DeleteStatus
+	//
+	//  DELETE FROM statuses
+	//  WHERE id = $1 AND user_id = $2
+	DeleteStatus(ctx context.Context, arg DeleteStatusParams) error
+	//DeleteSyncedTodo
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = $2 AND user_id = $1 AND deleted_at IS NULL
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	DeleteSyncedTodo(ctx context.Context, arg DeleteSyncedTodoParams) (Todo, error)
 	//DeleteTodo
 	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
 	//  UPDATE todos
-	//  SET deleted_at = NOW(), updated_at = NOW()
-	//  WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
-	DeleteTodo(ctx context.Context, arg DeleteTodoParams) error
+	//  SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = $2 AND user_id = $1 AND deleted_at IS NULL
+	//      AND ($3::integer[] IS NULL OR version = ANY($3::integer[]))
+	DeleteTodo(ctx context.Context, arg DeleteTodoParams) (int64, error)
+	//DeleteTodoDependency
+	//
+	//  DELETE FROM todo_dependencies
+	//  WHERE todo_id = $1 AND blocker_id = $2 AND user_id = $3
+	DeleteTodoDependency(ctx context.Context, arg DeleteTodoDependencyParams) (int64, error)
 	//DeleteTodosByUserID
 	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
 	//  UPDATE todos
-	//  SET deleted_at = NOW(), updated_at = NOW()
+	//  SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
 	//  WHERE user_id = $1 AND deleted_at IS NULL
 	DeleteTodosByUserID(ctx context.Context, userID int64) error
 	//DeleteUser
@@ -52,42 +396,507 @@ type Querier interface {
 	//  SET deleted_at = NOW(), updated_at = NOW()
 	//  WHERE id = $1 AND deleted_at IS NULL
 	DeleteUser(ctx context.Context, id int64) error
+	//EnsureProject
+	//
+	//  INSERT INTO projects (user_id, name)
+	//  VALUES ($1, $2)
+	//  ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+	//  RETURNING id
+	EnsureProject(ctx context.Context, arg EnsureProjectParams) (int64, error)
+	//ExtendJobLease
+	//
+	//  UPDATE jobs
+	//  SET locked_until = $1, updated_at = NOW()
+	//  WHERE id = $2 AND status = 'running' AND attempts = $3
+	//  RETURNING cancel_requested
+	ExtendJobLease(ctx context.Context, arg ExtendJobLeaseParams) (bool, error)
+	//FailExhaustedJobs
+	//
+	//  UPDATE jobs
+	//  SET status = 'failed', error = $1, locked_until = NULL, finished_at = NOW(), updated_at = NOW()
+	//  WHERE attempts >= $2
+	//      AND (status = 'pending' OR (status = 'running' AND locked_until < NOW()))
+	FailExhaustedJobs(ctx context.Context, arg FailExhaustedJobsParams) (int64, error)
+	//FinishAccountExport
+	//
+	//  UPDATE account_exports
+	//  SET size = $1, chunks = $2
+	//  WHERE id = $3
+	FinishAccountExport(ctx context.Context, arg FinishAccountExportParams) error
+	//FinishJob
+	//
+	//  UPDATE jobs
+	//  SET status = $1, result = $2, error = $3, locked_until = NULL, finished_at = NOW(), updated_at = NOW()
+	//  WHERE id = $4 AND status = 'running' AND attempts = $5
+	FinishJob(ctx context.Context, arg FinishJobParams) (int64, error)
+	//FinishReminder
+	//
+	//  UPDATE reminders
+	//  SET status = $1, fired_at = $2, last_error = $3, locked_until = NULL, updated_at = NOW()
+	//  WHERE id = $4 AND status = 'pending' AND locked_until = $5
+	FinishReminder(ctx context.Context, arg FinishReminderParams) (int64, error)
+	//GetAccountExportChunk
+	//
+	//  SELECT data FROM account_export_chunks
+	//  WHERE export_id = $1 AND seq = $2
+	GetAccountExportChunk(ctx context.Context, arg GetAccountExportChunkParams) ([]byte, error)
+	//GetCalendarFeedUserID
+	//
+	//  SELECT f.user_id FROM calendar_feeds f
+	//  JOIN users u ON u.id = f.user_id
+	//  WHERE f.token_hash = $1 AND u.deleted_at IS NULL
+	GetCalendarFeedUserID(ctx context.Context, tokenHash string) (int64, error)
+	//GetIdempotencyKey
+	//
+	//  SELECT id, user_id, key, request_hash, status_code, response_headers, response_body, created_at, expires_at, locked_until FROM idempotency_keys
+	//  WHERE user_id = $1 AND key = $2 AND expires_at >= NOW()
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	//GetJobByID
+	//
+	//  SELECT id, user_id, type, status, params, result, error, total, processed, cancel_requested, attempts, locked_until, created_at, updated_at, started_at, finished_at FROM jobs
+	//  WHERE id = $1 AND user_id = $2
+	GetJobByID(ctx context.Context, arg GetJobByIDParams) (Job, error)
+	//GetLastTodoPosition
+	//
+	//  SELECT COALESCE(MAX(position), '')::text AS position FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL
+	GetLastTodoPosition(ctx context.Context, userID int64) (string, error)
+	//GetNextTodoPosition
+	//
+	//  SELECT position FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL AND id <> $2
+	//      AND (position, id) > ($3::text, $4::bigint)
+	//  ORDER BY position, id
+	//  LIMIT 1
+	GetNextTodoPosition(ctx context.Context, arg GetNextTodoPositionParams) (string, error)
+	//GetPrevTodoPosition
+	//
+	//  SELECT position FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL AND id <> $2
+	//      AND (position, id) < ($3::text, $4::bigint)
+	//  ORDER BY position DESC, id DESC
+	//  LIMIT 1
+	GetPrevTodoPosition(ctx context.Context, arg GetPrevTodoPositionParams) (string, error)
+	//GetReminderTarget
+	//
+	//  SELECT t.title, t.completed, t.deleted_at, t.due_at, u.email, u.name FROM todos t
+	//  JOIN users u ON u.id = t.user_id
+	//  WHERE t.id = $1
+	GetReminderTarget(ctx context.Context, id int64) (GetReminderTargetRow, error)
+	//GetTodoByCalendarUIDForUpdate
+	//
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+	//  WHERE user_id = $1 AND COALESCE(ical_uid, client_id::text) = $2::text
+	//  FOR UPDATE
+	GetTodoByCalendarUIDForUpdate(ctx context.Context, arg GetTodoByCalendarUIDForUpdateParams) (Todo, error)
+	//GetTodoByClientIDForUpdate
+	//
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+	//  WHERE user_id = $1 AND client_id = $2
+	//  FOR UPDATE
+	GetTodoByClientIDForUpdate(ctx context.Context, arg GetTodoByClientIDForUpdateParams) (Todo, error)
 	//GetTodoByID
 	//
-	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 	//  WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 	GetTodoByID(ctx context.Context, arg GetTodoByIDParams) (Todo, error)
-	//GetTodosByIDs
+	//GetTodoChangeSeq
+	//
+	//  SELECT todo_change_seq FROM users
+	//  WHERE id = $1 AND deleted_at IS NULL
+	GetTodoChangeSeq(ctx context.Context, id int64) (int64, error)
+	//GetTodoChangeSeqForUpdate
 	//
-	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+	//  SELECT todo_change_seq FROM users
+	//  WHERE id = $1 AND deleted_at IS NULL
+	//  FOR UPDATE
+	GetTodoChangeSeqForUpdate(ctx context.Context, id int64) (int64, error)
+	//GetTodosByIDsForUpdate
+	//
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 	//  WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
-	GetTodosByIDs(ctx context.Context, arg GetTodosByIDsParams) ([]Todo, error)
+	//  ORDER BY id
+	//  FOR UPDATE
+	GetTodosByIDsForUpdate(ctx context.Context, arg GetTodosByIDsForUpdateParams) ([]Todo, error)
 	//GetUserByID
 	//
-	//  SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at FROM users WHERE id = $1 AND deleted_at IS NULL
+	//  SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq FROM users WHERE id = $1 AND deleted_at IS NULL
 	GetUserByID(ctx context.Context, id int64) (User, error)
 	//GetUserByProviderID
 	//
-	//  SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at FROM users
+	//  SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq FROM users
 	//  WHERE provider = $1 AND provider_id = $2 AND deleted_at IS NULL
 	GetUserByProviderID(ctx context.Context, arg GetUserByProviderIDParams) (User, error)
+	//GetUserSettings
+	//
+	//  SELECT user_id, timezone, locale, week_start, default_sort, default_project_id, updated_at FROM user_settings
+	//  WHERE user_id = $1
+	GetUserSettings(ctx context.Context, userID int64) (UserSetting, error)
+	//ListBlockedTodoIDs
+	//
+	//  SELECT d.todo_id FROM todo_dependencies d
+	//  JOIN todos b ON b.id = d.blocker_id
+	//  WHERE d.user_id = $1 AND NOT b.completed AND b.deleted_at IS NULL
+	//  GROUP BY d.todo_id
+	ListBlockedTodoIDs(ctx context.Context, userID int64) ([]int64, error)
+	//ListExistingTodoTitles
+	//
+	//  SELECT title FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL AND title = ANY($2::text[])
+	//  GROUP BY title
+	ListExistingTodoTitles(ctx context.Context, arg ListExistingTodoTitlesParams) ([]string, error)
+	//ListImportedSourceIDs
+	//
+	//  SELECT import_source_id::text FROM todos
+	//  WHERE user_id = $1 AND import_source = $2::text AND import_source_id = ANY($3::text[])
+	ListImportedSourceIDs(ctx context.Context, arg ListImportedSourceIDsParams) ([]string, error)
+	//ListJobsByUser
+	//
+	//  SELECT id, user_id, type, status, params, result, error, total, processed, cancel_requested, attempts, locked_until, created_at, updated_at, started_at, finished_at FROM jobs
+	//  WHERE user_id = $1
+	//  ORDER BY id
+	ListJobsByUser(ctx context.Context, userID int64) ([]Job, error)
+	//ListNotificationPreferences
+	//
+	//  SELECT user_id, type, enabled, updated_at FROM notification_preferences
+	//  WHERE user_id = $1
+	//  ORDER BY type
+	ListNotificationPreferences(ctx context.Context, userID int64) ([]NotificationPreference, error)
+	//ListNotifications
+	//
+	//  SELECT id, user_id, type, title, body, todo_id, reminder_id, read_at, created_at FROM notifications
+	//  WHERE user_id = $1
+	//      AND (NOT $2::boolean OR read_at IS NULL)
+	//      AND ($3::bigint IS NULL OR id < $3::bigint)
+	//  ORDER BY id DESC
+	//  LIMIT $4
+	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error)
+	//ListNotificationsByUser
+	//
+	//  SELECT id, user_id, type, title, body, todo_id, reminder_id, read_at, created_at FROM notifications
+	//  WHERE user_id = $1
+	//  ORDER BY id
+	ListNotificationsByUser(ctx context.Context, userID int64) ([]Notification, error)
+	//ListOpenBlockedTodoIDs
+	//
+	//  SELECT d.todo_id FROM todo_dependencies d
+	//  JOIN todos b ON b.id = d.blocker_id
+	//  WHERE d.user_id = $1 AND d.todo_id = ANY($2::bigint[]) AND NOT (b.id = ANY($2::bigint[]))
+	//      AND NOT b.completed AND b.deleted_at IS NULL
+	//  GROUP BY d.todo_id
+	ListOpenBlockedTodoIDs(ctx context.Context, arg ListOpenBlockedTodoIDsParams) ([]int64, error)
+	//ListPersonalAccessTokens
+	//
+	//  SELECT id, user_id, name, token_hash, created_at, last_used_at FROM personal_access_tokens
+	//  WHERE user_id = $1
+	//  ORDER BY id
+	ListPersonalAccessTokens(ctx context.Context, userID int64) ([]PersonalAccessToken, error)
+	//ListProjectsByUser
+	//
+	//  SELECT id, user_id, name, created_at, updated_at FROM projects
+	//  WHERE user_id = $1
+	//  ORDER BY name, id
+	ListProjectsByUser(ctx context.Context, userID int64) ([]Project, error)
+	//ListRemindersByTodo
+	//
+	//  SELECT id, user_id, todo_id, channel, remind_at, offset_minutes, status, attempts, locked_until, last_error, fired_at, created_at, updated_at FROM reminders
+	//  WHERE todo_id = $1 AND user_id = $2
+	//  ORDER BY id
+	ListRemindersByTodo(ctx context.Context, arg ListRemindersByTodoParams) ([]Reminder, error)
+	//ListRemindersByUser
+	//
+	//  SELECT id, user_id, todo_id, channel, remind_at, offset_minutes, status, attempts, locked_until, last_error, fired_at, created_at, updated_at FROM reminders
+	//  WHERE user_id = $1
+	//  ORDER BY id
+	ListRemindersByUser(ctx context.Context, userID int64) ([]Reminder, error)
+	//ListStatusesByUser
+	//
+	//  SELECT id, user_id, name, sort_order, wip_limit, is_done, created_at, updated_at FROM statuses
+	//  WHERE user_id = $1
+	//  ORDER BY sort_order, id
+	ListStatusesByUser(ctx context.Context, userID int64) ([]Status, error)
+	//ListTodoBlockers
+	//
+	//  SELECT t.id, t.user_id, t.title, t.description, t.completed, t.created_at, t.updated_at, t.deleted_at, t.version, t.client_id, t.change_seq, t.title_updated_at, t.description_updated_at, t.completed_updated_at, t.position, t.status_id, t.due_at, t.ical_uid, t.project_id, t.tags, t.import_source, t.import_source_id, t.priority, t.recurrence FROM todos t
+	//  JOIN todo_dependencies d ON d.blocker_id = t.id
+	//  WHERE d.todo_id = $1 AND d.user_id = $2 AND t.deleted_at IS NULL
+	//  ORDER BY t.id
+	ListTodoBlockers(ctx context.Context, arg ListTodoBlockersParams) ([]Todo, error)
+	//ListTodoChangesPage
+	//
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+	//  WHERE user_id = $1
+	//      AND (change_seq > $2 OR (change_seq = $2 AND id > $3::bigint))
+	//  ORDER BY change_seq, id
+	//  LIMIT $4
+	ListTodoChangesPage(ctx context.Context, arg ListTodoChangesPageParams) ([]Todo, error)
+	//ListTodoDependenciesByUser
+	//
+	//  SELECT todo_id, blocker_id, user_id, created_at FROM todo_dependencies
+	//  WHERE user_id = $1
+	//  ORDER BY todo_id, blocker_id
+	ListTodoDependenciesByUser(ctx context.Context, userID int64) ([]TodoDependency, error)
+	//ListTodoDependencyEdges
+	//
+	//  SELECT todo_id, blocker_id FROM todo_dependencies
+	//  WHERE user_id = $1
+	ListTodoDependencyEdges(ctx context.Context, userID int64) ([]ListTodoDependencyEdgesRow, error)
+	//ListTodoDependents
+	//
+	//  SELECT t.id, t.user_id, t.title, t.description, t.completed, t.created_at, t.updated_at, t.deleted_at, t.version, t.client_id, t.change_seq, t.title_updated_at, t.description_updated_at, t.completed_updated_at, t.position, t.status_id, t.due_at, t.ical_uid, t.project_id, t.tags, t.import_source, t.import_source_id, t.priority, t.recurrence FROM todos t
+	//  JOIN todo_dependencies d ON d.todo_id = t.id
+	//  WHERE d.blocker_id = $1 AND d.user_id = $2 AND t.deleted_at IS NULL
+	//  ORDER BY t.id
+	ListTodoDependents(ctx context.Context, arg ListTodoDependentsParams) ([]Todo, error)
+	//ListTodoIDsByFilter
+	//
+	//  SELECT id FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL AND id > $2
+	//      AND ($3::bigint[] IS NULL OR id = ANY($3::bigint[]))
+	//      AND ($4::timestamptz IS NULL OR created_at < $4::timestamptz)
+	//      AND ($5::boolean IS NULL OR completed = $5::boolean)
+	//      AND ($6::bigint IS NULL OR project_id = $6::bigint)
+	//  ORDER BY id
+	//  LIMIT $7
+	ListTodoIDsByFilter(ctx context.Context, arg ListTodoIDsByFilterParams) ([]int64, error)
+	//ListTodoIDsByPosition
+	//
+	//  SELECT id FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL
+	//  ORDER BY position, id
+	ListTodoIDsByPosition(ctx context.Context, userID int64) ([]int64, error)
+	//ListTodosByCalendarUIDs
+	//
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+	//  WHERE user_id = $1 AND COALESCE(ical_uid, client_id::text) = ANY($2::text[]) AND deleted_at IS NULL
+	//  ORDER BY id
+	ListTodosByCalendarUIDs(ctx context.Context, arg ListTodosByCalendarUIDsParams) ([]Todo, error)
 	//ListTodosByUser
 	//
-	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 	//  WHERE user_id = $1 AND deleted_at IS NULL
 	//  ORDER BY created_at DESC
 	ListTodosByUser(ctx context.Context, userID int64) ([]Todo, error)
+	//ListTodosByUserManual
+	//
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+	//  WHERE user_id = $1 AND deleted_at IS NULL
+	//  ORDER BY position, id
+	ListTodosByUserManual(ctx context.Context, userID int64) ([]Todo, error)
+	//ListTodosForExportPage
+	//
+	//  SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+	//  WHERE user_id = $1
+	//      AND ($2::boolean OR deleted_at IS NULL)
+	//      AND (position, id) > ($3::text, $4::bigint)
+	//  ORDER BY position, id
+	//  LIMIT $5
+	ListTodosForExportPage(ctx context.Context, arg ListTodosForExportPageParams) ([]Todo, error)
+	//ListUsersWithLongTodoPositions
+	//
+	//  SELECT user_id FROM todos
+	//  WHERE deleted_at IS NULL
+	//  GROUP BY user_id
+	//  HAVING MAX(length(position)) > $1::integer
+	ListUsersWithLongTodoPositions(ctx context.Context, maxLength int32) ([]int64, error)
+	//MarkAllNotificationsRead
+	//
+	//  UPDATE notifications
+	//  SET read_at = NOW()
+	//  WHERE user_id = $1
+	//      AND read_at IS NULL
+	//      AND ($2::bigint IS NULL OR id <= $2::bigint)
+	MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) (int64, error)
+	//MarkNotificationRead
+	//
+	//  UPDATE notifications
+	//  SET read_at = COALESCE(read_at, NOW())
+	//  WHERE id = $1 AND user_id = $2
+	//  RETURNING id, user_id, type, title, body, todo_id, reminder_id, read_at, created_at
+	MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (Notification, error)
+	//NextTodoChangeSeq
+	//
+	//  UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//  WHERE id = $1
+	//  RETURNING todo_change_seq
+	NextTodoChangeSeq(ctx context.Context, id int64) (int64, error)
+	//ProjectExists
+	//
+	//  SELECT EXISTS (
+	//      SELECT 1 FROM projects
+	//      WHERE id = $1 AND user_id = $2
+	//  )
+	ProjectExists(ctx context.Context, arg ProjectExistsParams) (bool, error)
+	//ReleaseJob
+	//
+	//  UPDATE jobs
+	//  SET status = 'pending', attempts = GREATEST(attempts - 1, 0), locked_until = NULL, updated_at = NOW()
+	//  WHERE id = $1 AND status = 'running' AND attempts = $2
+	ReleaseJob(ctx context.Context, arg ReleaseJobParams) (int64, error)
+	//RetryReminder
+	//
+	//  UPDATE reminders
+	//  SET last_error = $1, locked_until = $2, updated_at = NOW()
+	//  WHERE id = $3 AND status = 'pending' AND locked_until = $4
+	RetryReminder(ctx context.Context, arg RetryReminderParams) (int64, error)
+	//SaveIdempotencyResponse
+	//
+	//  UPDATE idempotency_keys
+	//  SET status_code = $1, response_headers = $2, response_body = $3, locked_until = NULL
+	//  WHERE user_id = $4 AND key = $5 AND locked_until = $6
+	SaveIdempotencyResponse(ctx context.Context, arg SaveIdempotencyResponseParams) (int64, error)
+	//SetTodoDueAt
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET
+	//      due_at = $2,
+	//      updated_at = NOW(),
+	//      version = version + 1,
+	//      change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = $3 AND user_id = $1 AND deleted_at IS NULL
+	//      AND ($4::integer[] IS NULL OR version = ANY($4::integer[]))
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	SetTodoDueAt(ctx context.Context, arg SetTodoDueAtParams) (Todo, error)
+	//SetTodoPositions
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET position = u.position, updated_at = NOW(), version = todos.version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+	//  FROM (SELECT unnest($2::bigint[]) AS id, unnest($3::text[]) AS position) AS u
+	//  WHERE todos.id = u.id AND todos.user_id = $1 AND todos.position IS DISTINCT FROM u.position
+	SetTodoPositions(ctx context.Context, arg SetTodoPositionsParams) error
+	//SetTodoStatus
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET
+	//      status_id = $2::bigint,
+	//      completed = $3::boolean,
+	//      completed_updated_at = CASE WHEN completed = $3::boolean THEN completed_updated_at ELSE NOW() END,
+	//      updated_at = NOW(),
+	//      version = version + 1,
+	//      change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = $4 AND user_id = $1 AND deleted_at IS NULL
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	SetTodoStatus(ctx context.Context, arg SetTodoStatusParams) (Todo, error)
+	//SyncTodoCompletedWithStatus
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET
+	//      completed = (status_id = $2::bigint),
+	//      completed_updated_at = NOW(),
+	//      updated_at = NOW(),
+	//      version = version + 1,
+	//      change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE user_id = $1 AND deleted_at IS NULL AND status_id IS NOT NULL
+	//      AND completed <> (status_id = $2::bigint)
+	SyncTodoCompletedWithStatus(ctx context.Context, arg SyncTodoCompletedWithStatusParams) error
+	//TouchPersonalAccessToken
+	//
+	//  UPDATE personal_access_tokens t
+	//  SET last_used_at = NOW()
+	//  FROM users u
+	//  WHERE t.token_hash = $1 AND u.id = t.user_id AND u.deleted_at IS NULL
+	//  RETURNING t.user_id
+	TouchPersonalAccessToken(ctx context.Context, tokenHash string) (int64, error)
+	//UpdateCalendarTodo
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET
+	//      title = $2,
+	//      description = $3,
+	//      completed = $4,
+	//      due_at = $5,
+	//      title_updated_at = $6,
+	//      description_updated_at = $7,
+	//      completed_updated_at = $8,
+	//      status_id = CASE
+	//          WHEN $4::boolean = completed THEN status_id
+	//          WHEN $4::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done)
+	//          ELSE NULL
+	//      END,
+	//      updated_at = NOW(),
+	//      version = version + 1,
+	//      change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = $9 AND user_id = $1 AND deleted_at IS NULL
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	UpdateCalendarTodo(ctx context.Context, arg UpdateCalendarTodoParams) (Todo, error)
+	//UpdateJobProgress
+	//
+	//  UPDATE jobs
+	//  SET processed = $1, total = $2, locked_until = $3, updated_at = NOW()
+	//  WHERE id = $4 AND status = 'running' AND attempts = $5
+	//  RETURNING cancel_requested
+	UpdateJobProgress(ctx context.Context, arg UpdateJobProgressParams) (bool, error)
+	//UpdateStatus
+	//
+	//  UPDATE statuses
+	//  SET name = $1, sort_order = $2, wip_limit = $3, is_done = $4, updated_at = NOW()
+	//  WHERE id = $5 AND user_id = $6
+	//  RETURNING id, user_id, name, sort_order, wip_limit, is_done, created_at, updated_at
+	UpdateStatus(ctx context.Context, arg UpdateStatusParams) (Status, error)
 	//UpdateTodo
 	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
 	//  UPDATE todos
 	//  SET
-	//      title = COALESCE($3, title),
-	//      description = COALESCE($4, description),
-	//      completed = COALESCE($5, completed),
-	//      updated_at = NOW()
-	//  WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
-	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+	//      title = COALESCE($2, title),
+	//      description = COALESCE($3, description),
+	//      completed = COALESCE($4, completed),
+	//      title_updated_at = CASE WHEN $2::text IS NULL THEN title_updated_at ELSE NOW() END,
+	//      description_updated_at = CASE WHEN $3::text IS NULL THEN description_updated_at ELSE NOW() END,
+	//      completed_updated_at = CASE WHEN $4::boolean IS NULL THEN completed_updated_at ELSE NOW() END,
+	//      status_id = CASE
+	//          WHEN $4::boolean IS NULL OR $4::boolean = completed THEN status_id
+	//          WHEN $4::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done)
+	//          ELSE NULL
+	//      END,
+	//      updated_at = NOW(),
+	//      version = version + 1,
+	//      change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = $5 AND user_id = $1 AND deleted_at IS NULL
+	//      AND ($6::integer[] IS NULL OR version = ANY($6::integer[]))
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 	UpdateTodo(ctx context.Context, arg UpdateTodoParams) (Todo, error)
+	//UpdateTodoPosition
+	//
+	//  WITH seq AS (
+	//      UPDATE users SET todo_change_seq = todo_change_seq + 1
+	//      WHERE users.id = $1
+	//      RETURNING todo_change_seq
+	//  )
+	//  UPDATE todos
+	//  SET position = $2, updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+	//  WHERE id = $3 AND user_id = $1 AND deleted_at IS NULL
+	//  RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+	UpdateTodoPosition(ctx context.Context, arg UpdateTodoPositionParams) (Todo, error)
 	//UpdateUser
 	//
 	//  UPDATE users
@@ -96,8 +905,36 @@ type Querier interface {
 	//      avatar_url = $3,
 	//      updated_at = NOW()
 	//  WHERE id = $1 AND deleted_at IS NULL
-	//  RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at
+	//  RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	//UpsertCalendarFeed
+	//
+	//  INSERT INTO calendar_feeds (user_id, token_hash)
+	//  VALUES ($1, $2)
+	//  ON CONFLICT (user_id) DO UPDATE
+	//  SET token_hash = EXCLUDED.token_hash, created_at = NOW()
+	//  RETURNING user_id, token_hash, created_at
+	UpsertCalendarFeed(ctx context.Context, arg UpsertCalendarFeedParams) (CalendarFeed, error)
+	//UpsertNotificationPreference
+	//
+	//  INSERT INTO notification_preferences (user_id, type, enabled)
+	//  VALUES ($1, $2, $3)
+	//  ON CONFLICT (user_id, type) DO UPDATE
+	//  SET enabled = EXCLUDED.enabled, updated_at = NOW()
+	UpsertNotificationPreference(ctx context.Context, arg UpsertNotificationPreferenceParams) error
+	//UpsertUserSettings
+	//
+	//  INSERT INTO user_settings (user_id, timezone, locale, week_start, default_sort, default_project_id)
+	//  VALUES ($1, $2, $3, $4, $5, $6)
+	//  ON CONFLICT (user_id) DO UPDATE
+	//  SET timezone = EXCLUDED.timezone,
+	//      locale = EXCLUDED.locale,
+	//      week_start = EXCLUDED.week_start,
+	//      default_sort = EXCLUDED.default_sort,
+	//      default_project_id = EXCLUDED.default_project_id,
+	//      updated_at = NOW()
+	//  RETURNING user_id, timezone, locale, week_start, default_sort, default_project_id, updated_at
+	UpsertUserSettings(ctx context.Context, arg UpsertUserSettingsParams) (UserSetting, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -7,28 +7,52 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const batchCompleteTodos = `-- name: BatchCompleteTodos :many
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
 UPDATE todos
-SET completed = TRUE, updated_at = NOW()
-WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
-RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+SET
+    completed = TRUE,
+    completed_updated_at = NOW(),
+    status_id = (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done),
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = ANY($2::bigint[]) AND user_id = $1 AND deleted_at IS NULL
+RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 `
 
 type BatchCompleteTodosParams struct {
-	Ids    []int64 `json:"ids"`
 	UserID int64   `json:"user_id"`
+	Ids    []int64 `json:"ids"`
 }
 
 // BatchCompleteTodos
 //
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
 //	UPDATE todos
-//	SET completed = TRUE, updated_at = NOW()
-//	WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
-//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+//	SET
+//	    completed = TRUE,
+//	    completed_updated_at = NOW(),
+//	    status_id = (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done),
+//	    updated_at = NOW(),
+//	    version = version + 1,
+//	    change_seq = (SELECT todo_change_seq FROM seq)
+//	WHERE id = ANY($2::bigint[]) AND user_id = $1 AND deleted_at IS NULL
+//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 func (q *Queries) BatchCompleteTodos(ctx context.Context, arg BatchCompleteTodosParams) ([]Todo, error) {
-	rows, err := q.db.Query(ctx, batchCompleteTodos, arg.Ids, arg.UserID)
+	rows, err := q.db.Query(ctx, batchCompleteTodos, arg.UserID, arg.Ids)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +69,22 @@ func (q *Queries) BatchCompleteTodos(ctx context.Context, arg BatchCompleteTodos
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Version,
+			&i.ClientID,
+			&i.ChangeSeq,
+			&i.TitleUpdatedAt,
+			&i.DescriptionUpdatedAt,
+			&i.CompletedUpdatedAt,
+			&i.Position,
+			&i.StatusID,
+			&i.DueAt,
+			&i.IcalUid,
+			&i.ProjectID,
+			&i.Tags,
+			&i.ImportSource,
+			&i.ImportSourceID,
+			&i.Priority,
+			&i.Recurrence,
 		); err != nil {
 			return nil, err
 		}
@@ -57,45 +97,323 @@ func (q *Queries) BatchCompleteTodos(ctx context.Context, arg BatchCompleteTodos
 }
 
 const batchDeleteTodos = `-- name: BatchDeleteTodos :exec
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
 UPDATE todos
-SET deleted_at = NOW(), updated_at = NOW()
-WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = ANY($2::bigint[]) AND user_id = $1 AND deleted_at IS NULL
 `
 
 type BatchDeleteTodosParams struct {
-	Ids    []int64 `json:"ids"`
 	UserID int64   `json:"user_id"`
+	Ids    []int64 `json:"ids"`
 }
 
 // BatchDeleteTodos
 //
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
 //	UPDATE todos
-//	SET deleted_at = NOW(), updated_at = NOW()
-//	WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
+//	SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+//	WHERE id = ANY($2::bigint[]) AND user_id = $1 AND deleted_at IS NULL
 func (q *Queries) BatchDeleteTodos(ctx context.Context, arg BatchDeleteTodosParams) error {
-	_, err := q.db.Exec(ctx, batchDeleteTodos, arg.Ids, arg.UserID)
+	_, err := q.db.Exec(ctx, batchDeleteTodos, arg.UserID, arg.Ids)
 	return err
 }
 
-const createTodo = `-- name: CreateTodo :one
-INSERT INTO todos (user_id, title, description)
-VALUES ($1, $2, $3)
-RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+const batchUpdateTodos = `-- name: BatchUpdateTodos :many
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET
+    title = COALESCE($2, title),
+    description = COALESCE($3, description),
+    completed = COALESCE($4, completed),
+    title_updated_at = CASE WHEN $2::text IS NULL THEN title_updated_at ELSE NOW() END,
+    description_updated_at = CASE WHEN $3::text IS NULL THEN description_updated_at ELSE NOW() END,
+    completed_updated_at = CASE WHEN $4::boolean IS NULL THEN completed_updated_at ELSE NOW() END,
+    status_id = CASE
+        WHEN $4::boolean IS NULL OR $4::boolean = completed THEN status_id
+        WHEN $4::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done)
+        ELSE NULL
+    END,
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = ANY($5::bigint[]) AND user_id = $1 AND deleted_at IS NULL
+RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 `
 
-type CreateTodoParams struct {
+type BatchUpdateTodosParams struct {
 	UserID      int64   `json:"user_id"`
-	Title       string  `json:"title"`
+	Title       *string `json:"title"`
 	Description *string `json:"description"`
+	Completed   *bool   `json:"completed"`
+	Ids         []int64 `json:"ids"`
+}
+
+// BatchUpdateTodos
+//
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
+//	UPDATE todos
+//	SET
+//	    title = COALESCE($2, title),
+//	    description = COALESCE($3, description),
+//	    completed = COALESCE($4, completed),
+//	    title_updated_at = CASE WHEN $2::text IS NULL THEN title_updated_at ELSE NOW() END,
+//	    description_updated_at = CASE WHEN $3::text IS NULL THEN description_updated_at ELSE NOW() END,
+//	    completed_updated_at = CASE WHEN $4::boolean IS NULL THEN completed_updated_at ELSE NOW() END,
+//	    status_id = CASE
+//	        WHEN $4::boolean IS NULL OR $4::boolean = completed THEN status_id
+//	        WHEN $4::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done)
+//	        ELSE NULL
+//	    END,
+//	    updated_at = NOW(),
+//	    version = version + 1,
+//	    change_seq = (SELECT todo_change_seq FROM seq)
+//	WHERE id = ANY($5::bigint[]) AND user_id = $1 AND deleted_at IS NULL
+//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+func (q *Queries) BatchUpdateTodos(ctx context.Context, arg BatchUpdateTodosParams) ([]Todo, error) {
+	rows, err := q.db.Query(ctx, batchUpdateTodos,
+		arg.UserID,
+		arg.Title,
+		arg.Description,
+		arg.Completed,
+		arg.Ids,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Todo{}
+	for rows.Next() {
+		var i Todo
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Description,
+			&i.Completed,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Version,
+			&i.ClientID,
+			&i.ChangeSeq,
+			&i.TitleUpdatedAt,
+			&i.DescriptionUpdatedAt,
+			&i.CompletedUpdatedAt,
+			&i.Position,
+			&i.StatusID,
+			&i.DueAt,
+			&i.IcalUid,
+			&i.ProjectID,
+			&i.Tags,
+			&i.ImportSource,
+			&i.ImportSourceID,
+			&i.Priority,
+			&i.Recurrence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+type CopyImportedTodosParams struct {
+	UserID         int64              `json:"user_id"`
+	Title          string             `json:"title"`
+	Description    *string            `json:"description"`
+	Completed      bool               `json:"completed"`
+	Position       string             `json:"position"`
+	ChangeSeq      int64              `json:"change_seq"`
+	DueAt          pgtype.Timestamptz `json:"due_at"`
+	ProjectID      *int64             `json:"project_id"`
+	Tags           []string           `json:"tags"`
+	ImportSource   *string            `json:"import_source"`
+	ImportSourceID *string            `json:"import_source_id"`
+}
+
+type CopyTodosParams struct {
+	UserID      int64              `json:"user_id"`
+	Title       string             `json:"title"`
+	Description *string            `json:"description"`
+	Completed   bool               `json:"completed"`
+	Position    string             `json:"position"`
+	ChangeSeq   int64              `json:"change_seq"`
+	DueAt       pgtype.Timestamptz `json:"due_at"`
+	ProjectID   *int64             `json:"project_id"`
+}
+
+const countTodosByFilter = `-- name: CountTodosByFilter :one
+SELECT COUNT(*) FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL
+    AND ($2::bigint[] IS NULL OR id = ANY($2::bigint[]))
+    AND ($3::timestamptz IS NULL OR created_at < $3::timestamptz)
+    AND ($4::boolean IS NULL OR completed = $4::boolean)
+    AND ($5::bigint IS NULL OR project_id = $5::bigint)
+`
+
+type CountTodosByFilterParams struct {
+	UserID        int64              `json:"user_id"`
+	Ids           []int64            `json:"ids"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	Completed     *bool              `json:"completed"`
+	ProjectID     *int64             `json:"project_id"`
+}
+
+// CountTodosByFilter
+//
+//	SELECT COUNT(*) FROM todos
+//	WHERE user_id = $1 AND deleted_at IS NULL
+//	    AND ($2::bigint[] IS NULL OR id = ANY($2::bigint[]))
+//	    AND ($3::timestamptz IS NULL OR created_at < $3::timestamptz)
+//	    AND ($4::boolean IS NULL OR completed = $4::boolean)
+//	    AND ($5::bigint IS NULL OR project_id = $5::bigint)
+func (q *Queries) CountTodosByFilter(ctx context.Context, arg CountTodosByFilterParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTodosByFilter,
+		arg.UserID,
+		arg.Ids,
+		arg.CreatedBefore,
+		arg.Completed,
+		arg.ProjectID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createQuickAddTodo = `-- name: CreateQuickAddTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
+INSERT INTO todos (user_id, title, description, position, due_at, project_id, tags, priority, recurrence, change_seq)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, (SELECT todo_change_seq FROM seq))
+RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+`
+
+type CreateQuickAddTodoParams struct {
+	UserID      int64              `json:"user_id"`
+	Title       string             `json:"title"`
+	Description *string            `json:"description"`
+	Position    string             `json:"position"`
+	DueAt       pgtype.Timestamptz `json:"due_at"`
+	ProjectID   *int64             `json:"project_id"`
+	Tags        []string           `json:"tags"`
+	Priority    *string            `json:"priority"`
+	Recurrence  *string            `json:"recurrence"`
+}
+
+// CreateQuickAddTodo
+//
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
+//	INSERT INTO todos (user_id, title, description, position, due_at, project_id, tags, priority, recurrence, change_seq)
+//	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, (SELECT todo_change_seq FROM seq))
+//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+func (q *Queries) CreateQuickAddTodo(ctx context.Context, arg CreateQuickAddTodoParams) (Todo, error) {
+	row := q.db.QueryRow(ctx, createQuickAddTodo,
+		arg.UserID,
+		arg.Title,
+		arg.Description,
+		arg.Position,
+		arg.DueAt,
+		arg.ProjectID,
+		arg.Tags,
+		arg.Priority,
+		arg.Recurrence,
+	)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.Description,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Version,
+		&i.ClientID,
+		&i.ChangeSeq,
+		&i.TitleUpdatedAt,
+		&i.DescriptionUpdatedAt,
+		&i.CompletedUpdatedAt,
+		&i.Position,
+		&i.StatusID,
+		&i.DueAt,
+		&i.IcalUid,
+		&i.ProjectID,
+		&i.Tags,
+		&i.ImportSource,
+		&i.ImportSourceID,
+		&i.Priority,
+		&i.Recurrence,
+	)
+	return i, err
+}
+
+const createTodo = `-- name: CreateTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
+INSERT INTO todos (user_id, title, description, position, due_at, project_id, change_seq)
+VALUES ($1, $2, $3, $4, $5, $6, (SELECT todo_change_seq FROM seq))
+RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+`
+
+type CreateTodoParams struct {
+	UserID      int64              `json:"user_id"`
+	Title       string             `json:"title"`
+	Description *string            `json:"description"`
+	Position    string             `json:"position"`
+	DueAt       pgtype.Timestamptz `json:"due_at"`
+	ProjectID   *int64             `json:"project_id"`
 }
 
 // CreateTodo
 //
-//	INSERT INTO todos (user_id, title, description)
-//	VALUES ($1, $2, $3)
-//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
+//	INSERT INTO todos (user_id, title, description, position, due_at, project_id, change_seq)
+//	VALUES ($1, $2, $3, $4, $5, $6, (SELECT todo_change_seq FROM seq))
+//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 func (q *Queries) CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, error) {
-	row := q.db.QueryRow(ctx, createTodo, arg.UserID, arg.Title, arg.Description)
+	row := q.db.QueryRow(ctx, createTodo,
+		arg.UserID,
+		arg.Title,
+		arg.Description,
+		arg.Position,
+		arg.DueAt,
+		arg.ProjectID,
+	)
 	var i Todo
 	err := row.Scan(
 		&i.ID,
@@ -106,33 +424,149 @@ func (q *Queries) CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Version,
+		&i.ClientID,
+		&i.ChangeSeq,
+		&i.TitleUpdatedAt,
+		&i.DescriptionUpdatedAt,
+		&i.CompletedUpdatedAt,
+		&i.Position,
+		&i.StatusID,
+		&i.DueAt,
+		&i.IcalUid,
+		&i.ProjectID,
+		&i.Tags,
+		&i.ImportSource,
+		&i.ImportSourceID,
+		&i.Priority,
+		&i.Recurrence,
 	)
 	return i, err
 }
 
-const deleteTodo = `-- name: DeleteTodo :exec
+const deleteTodo = `-- name: DeleteTodo :execrows
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
 UPDATE todos
-SET deleted_at = NOW(), updated_at = NOW()
-WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = $2 AND user_id = $1 AND deleted_at IS NULL
+    AND ($3::integer[] IS NULL OR version = ANY($3::integer[]))
 `
 
 type DeleteTodoParams struct {
-	ID     int64 `json:"id"`
-	UserID int64 `json:"user_id"`
+	UserID           int64   `json:"user_id"`
+	ID               int64   `json:"id"`
+	ExpectedVersions []int32 `json:"expected_versions"`
 }
 
 // DeleteTodo
 //
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
 //	UPDATE todos
-//	SET deleted_at = NOW(), updated_at = NOW()
-//	WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
-func (q *Queries) DeleteTodo(ctx context.Context, arg DeleteTodoParams) error {
-	_, err := q.db.Exec(ctx, deleteTodo, arg.ID, arg.UserID)
-	return err
+//	SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+//	WHERE id = $2 AND user_id = $1 AND deleted_at IS NULL
+//	    AND ($3::integer[] IS NULL OR version = ANY($3::integer[]))
+func (q *Queries) DeleteTodo(ctx context.Context, arg DeleteTodoParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTodo, arg.UserID, arg.ID, arg.ExpectedVersions)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getLastTodoPosition = `-- name: GetLastTodoPosition :one
+SELECT COALESCE(MAX(position), '')::text AS position FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL
+`
+
+// GetLastTodoPosition
+//
+//	SELECT COALESCE(MAX(position), '')::text AS position FROM todos
+//	WHERE user_id = $1 AND deleted_at IS NULL
+func (q *Queries) GetLastTodoPosition(ctx context.Context, userID int64) (string, error) {
+	row := q.db.QueryRow(ctx, getLastTodoPosition, userID)
+	var position string
+	err := row.Scan(&position)
+	return position, err
+}
+
+const getNextTodoPosition = `-- name: GetNextTodoPosition :one
+SELECT position FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL AND id <> $2
+    AND (position, id) > ($3::text, $4::bigint)
+ORDER BY position, id
+LIMIT 1
+`
+
+type GetNextTodoPositionParams struct {
+	UserID         int64  `json:"user_id"`
+	ExcludeID      int64  `json:"exclude_id"`
+	AnchorPosition string `json:"anchor_position"`
+	AnchorID       int64  `json:"anchor_id"`
+}
+
+// GetNextTodoPosition
+//
+//	SELECT position FROM todos
+//	WHERE user_id = $1 AND deleted_at IS NULL AND id <> $2
+//	    AND (position, id) > ($3::text, $4::bigint)
+//	ORDER BY position, id
+//	LIMIT 1
+func (q *Queries) GetNextTodoPosition(ctx context.Context, arg GetNextTodoPositionParams) (string, error) {
+	row := q.db.QueryRow(ctx, getNextTodoPosition,
+		arg.UserID,
+		arg.ExcludeID,
+		arg.AnchorPosition,
+		arg.AnchorID,
+	)
+	var position string
+	err := row.Scan(&position)
+	return position, err
+}
+
+const getPrevTodoPosition = `-- name: GetPrevTodoPosition :one
+SELECT position FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL AND id <> $2
+    AND (position, id) < ($3::text, $4::bigint)
+ORDER BY position DESC, id DESC
+LIMIT 1
+`
+
+type GetPrevTodoPositionParams struct {
+	UserID         int64  `json:"user_id"`
+	ExcludeID      int64  `json:"exclude_id"`
+	AnchorPosition string `json:"anchor_position"`
+	AnchorID       int64  `json:"anchor_id"`
+}
+
+// GetPrevTodoPosition
+//
+//	SELECT position FROM todos
+//	WHERE user_id = $1 AND deleted_at IS NULL AND id <> $2
+//	    AND (position, id) < ($3::text, $4::bigint)
+//	ORDER BY position DESC, id DESC
+//	LIMIT 1
+func (q *Queries) GetPrevTodoPosition(ctx context.Context, arg GetPrevTodoPositionParams) (string, error) {
+	row := q.db.QueryRow(ctx, getPrevTodoPosition,
+		arg.UserID,
+		arg.ExcludeID,
+		arg.AnchorPosition,
+		arg.AnchorID,
+	)
+	var position string
+	err := row.Scan(&position)
+	return position, err
 }
 
 const getTodoByID = `-- name: GetTodoByID :one
-SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 `
 
@@ -143,7 +577,7 @@ type GetTodoByIDParams struct {
 
 // GetTodoByID
 //
-//	SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+//	SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 //	WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 func (q *Queries) GetTodoByID(ctx context.Context, arg GetTodoByIDParams) (Todo, error) {
 	row := q.db.QueryRow(ctx, getTodoByID, arg.ID, arg.UserID)
@@ -157,26 +591,46 @@ func (q *Queries) GetTodoByID(ctx context.Context, arg GetTodoByIDParams) (Todo,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Version,
+		&i.ClientID,
+		&i.ChangeSeq,
+		&i.TitleUpdatedAt,
+		&i.DescriptionUpdatedAt,
+		&i.CompletedUpdatedAt,
+		&i.Position,
+		&i.StatusID,
+		&i.DueAt,
+		&i.IcalUid,
+		&i.ProjectID,
+		&i.Tags,
+		&i.ImportSource,
+		&i.ImportSourceID,
+		&i.Priority,
+		&i.Recurrence,
 	)
 	return i, err
 }
 
-const getTodosByIDs = `-- name: GetTodosByIDs :many
-SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+const getTodosByIDsForUpdate = `-- name: GetTodosByIDsForUpdate :many
+SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
+ORDER BY id
+FOR UPDATE
 `
 
-type GetTodosByIDsParams struct {
+type GetTodosByIDsForUpdateParams struct {
 	Ids    []int64 `json:"ids"`
 	UserID int64   `json:"user_id"`
 }
 
-// GetTodosByIDs
+// GetTodosByIDsForUpdate
 //
-//	SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+//	SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 //	WHERE id = ANY($1::bigint[]) AND user_id = $2 AND deleted_at IS NULL
-func (q *Queries) GetTodosByIDs(ctx context.Context, arg GetTodosByIDsParams) ([]Todo, error) {
-	rows, err := q.db.Query(ctx, getTodosByIDs, arg.Ids, arg.UserID)
+//	ORDER BY id
+//	FOR UPDATE
+func (q *Queries) GetTodosByIDsForUpdate(ctx context.Context, arg GetTodosByIDsForUpdateParams) ([]Todo, error) {
+	rows, err := q.db.Query(ctx, getTodosByIDsForUpdate, arg.Ids, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +647,22 @@ func (q *Queries) GetTodosByIDs(ctx context.Context, arg GetTodosByIDsParams) ([
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Version,
+			&i.ClientID,
+			&i.ChangeSeq,
+			&i.TitleUpdatedAt,
+			&i.DescriptionUpdatedAt,
+			&i.CompletedUpdatedAt,
+			&i.Position,
+			&i.StatusID,
+			&i.DueAt,
+			&i.IcalUid,
+			&i.ProjectID,
+			&i.Tags,
+			&i.ImportSource,
+			&i.ImportSourceID,
+			&i.Priority,
+			&i.Recurrence,
 		); err != nil {
 			return nil, err
 		}
@@ -204,15 +674,176 @@ func (q *Queries) GetTodosByIDs(ctx context.Context, arg GetTodosByIDsParams) ([
 	return items, nil
 }
 
+const listExistingTodoTitles = `-- name: ListExistingTodoTitles :many
+SELECT title FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL AND title = ANY($2::text[])
+GROUP BY title
+`
+
+type ListExistingTodoTitlesParams struct {
+	UserID int64    `json:"user_id"`
+	Titles []string `json:"titles"`
+}
+
+// ListExistingTodoTitles
+//
+//	SELECT title FROM todos
+//	WHERE user_id = $1 AND deleted_at IS NULL AND title = ANY($2::text[])
+//	GROUP BY title
+func (q *Queries) ListExistingTodoTitles(ctx context.Context, arg ListExistingTodoTitlesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listExistingTodoTitles, arg.UserID, arg.Titles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var title string
+		if err := rows.Scan(&title); err != nil {
+			return nil, err
+		}
+		items = append(items, title)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listImportedSourceIDs = `-- name: ListImportedSourceIDs :many
+SELECT import_source_id::text FROM todos
+WHERE user_id = $1 AND import_source = $2::text AND import_source_id = ANY($3::text[])
+`
+
+type ListImportedSourceIDsParams struct {
+	UserID       int64    `json:"user_id"`
+	ImportSource string   `json:"import_source"`
+	SourceIds    []string `json:"source_ids"`
+}
+
+// ListImportedSourceIDs
+//
+//	SELECT import_source_id::text FROM todos
+//	WHERE user_id = $1 AND import_source = $2::text AND import_source_id = ANY($3::text[])
+func (q *Queries) ListImportedSourceIDs(ctx context.Context, arg ListImportedSourceIDsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listImportedSourceIDs, arg.UserID, arg.ImportSource, arg.SourceIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var importSourceID string
+		if err := rows.Scan(&importSourceID); err != nil {
+			return nil, err
+		}
+		items = append(items, importSourceID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodoIDsByFilter = `-- name: ListTodoIDsByFilter :many
+SELECT id FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL AND id > $2
+    AND ($3::bigint[] IS NULL OR id = ANY($3::bigint[]))
+    AND ($4::timestamptz IS NULL OR created_at < $4::timestamptz)
+    AND ($5::boolean IS NULL OR completed = $5::boolean)
+    AND ($6::bigint IS NULL OR project_id = $6::bigint)
+ORDER BY id
+LIMIT $7
+`
+
+type ListTodoIDsByFilterParams struct {
+	UserID        int64              `json:"user_id"`
+	AfterID       int64              `json:"after_id"`
+	Ids           []int64            `json:"ids"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	Completed     *bool              `json:"completed"`
+	ProjectID     *int64             `json:"project_id"`
+	MaxRows       int32              `json:"max_rows"`
+}
+
+// ListTodoIDsByFilter
+//
+//	SELECT id FROM todos
+//	WHERE user_id = $1 AND deleted_at IS NULL AND id > $2
+//	    AND ($3::bigint[] IS NULL OR id = ANY($3::bigint[]))
+//	    AND ($4::timestamptz IS NULL OR created_at < $4::timestamptz)
+//	    AND ($5::boolean IS NULL OR completed = $5::boolean)
+//	    AND ($6::bigint IS NULL OR project_id = $6::bigint)
+//	ORDER BY id
+//	LIMIT $7
+func (q *Queries) ListTodoIDsByFilter(ctx context.Context, arg ListTodoIDsByFilterParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listTodoIDsByFilter,
+		arg.UserID,
+		arg.AfterID,
+		arg.Ids,
+		arg.CreatedBefore,
+		arg.Completed,
+		arg.ProjectID,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodoIDsByPosition = `-- name: ListTodoIDsByPosition :many
+SELECT id FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL
+ORDER BY position, id
+`
+
+// ListTodoIDsByPosition
+//
+//	SELECT id FROM todos
+//	WHERE user_id = $1 AND deleted_at IS NULL
+//	ORDER BY position, id
+func (q *Queries) ListTodoIDsByPosition(ctx context.Context, userID int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listTodoIDsByPosition, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTodosByUser = `-- name: ListTodosByUser :many
-SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 WHERE user_id = $1 AND deleted_at IS NULL
 ORDER BY created_at DESC
 `
 
 // ListTodosByUser
 //
-//	SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at FROM todos
+//	SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
 //	WHERE user_id = $1 AND deleted_at IS NULL
 //	ORDER BY created_at DESC
 func (q *Queries) ListTodosByUser(ctx context.Context, userID int64) ([]Todo, error) {
@@ -233,6 +864,22 @@ func (q *Queries) ListTodosByUser(ctx context.Context, userID int64) ([]Todo, er
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Version,
+			&i.ClientID,
+			&i.ChangeSeq,
+			&i.TitleUpdatedAt,
+			&i.DescriptionUpdatedAt,
+			&i.CompletedUpdatedAt,
+			&i.Position,
+			&i.StatusID,
+			&i.DueAt,
+			&i.IcalUid,
+			&i.ProjectID,
+			&i.Tags,
+			&i.ImportSource,
+			&i.ImportSourceID,
+			&i.Priority,
+			&i.Recurrence,
 		); err != nil {
 			return nil, err
 		}
@@ -244,43 +891,414 @@ func (q *Queries) ListTodosByUser(ctx context.Context, userID int64) ([]Todo, er
 	return items, nil
 }
 
+const listTodosByUserManual = `-- name: ListTodosByUserManual :many
+SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+WHERE user_id = $1 AND deleted_at IS NULL
+ORDER BY position, id
+`
+
+// ListTodosByUserManual
+//
+//	SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+//	WHERE user_id = $1 AND deleted_at IS NULL
+//	ORDER BY position, id
+func (q *Queries) ListTodosByUserManual(ctx context.Context, userID int64) ([]Todo, error) {
+	rows, err := q.db.Query(ctx, listTodosByUserManual, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Todo{}
+	for rows.Next() {
+		var i Todo
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Description,
+			&i.Completed,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Version,
+			&i.ClientID,
+			&i.ChangeSeq,
+			&i.TitleUpdatedAt,
+			&i.DescriptionUpdatedAt,
+			&i.CompletedUpdatedAt,
+			&i.Position,
+			&i.StatusID,
+			&i.DueAt,
+			&i.IcalUid,
+			&i.ProjectID,
+			&i.Tags,
+			&i.ImportSource,
+			&i.ImportSourceID,
+			&i.Priority,
+			&i.Recurrence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodosForExportPage = `-- name: ListTodosForExportPage :many
+SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+WHERE user_id = $1
+    AND ($2::boolean OR deleted_at IS NULL)
+    AND (position, id) > ($3::text, $4::bigint)
+ORDER BY position, id
+LIMIT $5
+`
+
+type ListTodosForExportPageParams struct {
+	UserID         int64  `json:"user_id"`
+	IncludeDeleted bool   `json:"include_deleted"`
+	AfterPosition  string `json:"after_position"`
+	AfterID        int64  `json:"after_id"`
+	MaxRows        int32  `json:"max_rows"`
+}
+
+// ListTodosForExportPage
+//
+//	SELECT id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence FROM todos
+//	WHERE user_id = $1
+//	    AND ($2::boolean OR deleted_at IS NULL)
+//	    AND (position, id) > ($3::text, $4::bigint)
+//	ORDER BY position, id
+//	LIMIT $5
+func (q *Queries) ListTodosForExportPage(ctx context.Context, arg ListTodosForExportPageParams) ([]Todo, error) {
+	rows, err := q.db.Query(ctx, listTodosForExportPage,
+		arg.UserID,
+		arg.IncludeDeleted,
+		arg.AfterPosition,
+		arg.AfterID,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Todo{}
+	for rows.Next() {
+		var i Todo
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Description,
+			&i.Completed,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Version,
+			&i.ClientID,
+			&i.ChangeSeq,
+			&i.TitleUpdatedAt,
+			&i.DescriptionUpdatedAt,
+			&i.CompletedUpdatedAt,
+			&i.Position,
+			&i.StatusID,
+			&i.DueAt,
+			&i.IcalUid,
+			&i.ProjectID,
+			&i.Tags,
+			&i.ImportSource,
+			&i.ImportSourceID,
+			&i.Priority,
+			&i.Recurrence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersWithLongTodoPositions = `-- name: ListUsersWithLongTodoPositions :many
+SELECT user_id FROM todos
+WHERE deleted_at IS NULL
+GROUP BY user_id
+HAVING MAX(length(position)) > $1::integer
+`
+
+// ListUsersWithLongTodoPositions
+//
+//	SELECT user_id FROM todos
+//	WHERE deleted_at IS NULL
+//	GROUP BY user_id
+//	HAVING MAX(length(position)) > $1::integer
+func (q *Queries) ListUsersWithLongTodoPositions(ctx context.Context, maxLength int32) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listUsersWithLongTodoPositions, maxLength)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		items = append(items, userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setTodoDueAt = `-- name: SetTodoDueAt :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET
+    due_at = $2,
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = $3 AND user_id = $1 AND deleted_at IS NULL
+    AND ($4::integer[] IS NULL OR version = ANY($4::integer[]))
+RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+`
+
+type SetTodoDueAtParams struct {
+	UserID           int64              `json:"user_id"`
+	DueAt            pgtype.Timestamptz `json:"due_at"`
+	ID               int64              `json:"id"`
+	ExpectedVersions []int32            `json:"expected_versions"`
+}
+
+// SetTodoDueAt
+//
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
+//	UPDATE todos
+//	SET
+//	    due_at = $2,
+//	    updated_at = NOW(),
+//	    version = version + 1,
+//	    change_seq = (SELECT todo_change_seq FROM seq)
+//	WHERE id = $3 AND user_id = $1 AND deleted_at IS NULL
+//	    AND ($4::integer[] IS NULL OR version = ANY($4::integer[]))
+//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+func (q *Queries) SetTodoDueAt(ctx context.Context, arg SetTodoDueAtParams) (Todo, error) {
+	row := q.db.QueryRow(ctx, setTodoDueAt,
+		arg.UserID,
+		arg.DueAt,
+		arg.ID,
+		arg.ExpectedVersions,
+	)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.Description,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Version,
+		&i.ClientID,
+		&i.ChangeSeq,
+		&i.TitleUpdatedAt,
+		&i.DescriptionUpdatedAt,
+		&i.CompletedUpdatedAt,
+		&i.Position,
+		&i.StatusID,
+		&i.DueAt,
+		&i.IcalUid,
+		&i.ProjectID,
+		&i.Tags,
+		&i.ImportSource,
+		&i.ImportSourceID,
+		&i.Priority,
+		&i.Recurrence,
+	)
+	return i, err
+}
+
+const setTodoPositions = `-- name: SetTodoPositions :exec
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET position = u.position, updated_at = NOW(), version = todos.version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+FROM (SELECT unnest($2::bigint[]) AS id, unnest($3::text[]) AS position) AS u
+WHERE todos.id = u.id AND todos.user_id = $1 AND todos.position IS DISTINCT FROM u.position
+`
+
+type SetTodoPositionsParams struct {
+	UserID    int64    `json:"user_id"`
+	Ids       []int64  `json:"ids"`
+	Positions []string `json:"positions"`
+}
+
+// SetTodoPositions
+//
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
+//	UPDATE todos
+//	SET position = u.position, updated_at = NOW(), version = todos.version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+//	FROM (SELECT unnest($2::bigint[]) AS id, unnest($3::text[]) AS position) AS u
+//	WHERE todos.id = u.id AND todos.user_id = $1 AND todos.position IS DISTINCT FROM u.position
+func (q *Queries) SetTodoPositions(ctx context.Context, arg SetTodoPositionsParams) error {
+	_, err := q.db.Exec(ctx, setTodoPositions, arg.UserID, arg.Ids, arg.Positions)
+	return err
+}
+
 const updateTodo = `-- name: UpdateTodo :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
 UPDATE todos
 SET
-    title = COALESCE($3, title),
-    description = COALESCE($4, description),
-    completed = COALESCE($5, completed),
-    updated_at = NOW()
-WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
-RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+    title = COALESCE($2, title),
+    description = COALESCE($3, description),
+    completed = COALESCE($4, completed),
+    title_updated_at = CASE WHEN $2::text IS NULL THEN title_updated_at ELSE NOW() END,
+    description_updated_at = CASE WHEN $3::text IS NULL THEN description_updated_at ELSE NOW() END,
+    completed_updated_at = CASE WHEN $4::boolean IS NULL THEN completed_updated_at ELSE NOW() END,
+    status_id = CASE
+        WHEN $4::boolean IS NULL OR $4::boolean = completed THEN status_id
+        WHEN $4::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done)
+        ELSE NULL
+    END,
+    updated_at = NOW(),
+    version = version + 1,
+    change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = $5 AND user_id = $1 AND deleted_at IS NULL
+    AND ($6::integer[] IS NULL OR version = ANY($6::integer[]))
+RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 `
 
 type UpdateTodoParams struct {
-	ID          int64   `json:"id"`
-	UserID      int64   `json:"user_id"`
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	Completed   *bool   `json:"completed"`
+	UserID           int64   `json:"user_id"`
+	Title            *string `json:"title"`
+	Description      *string `json:"description"`
+	Completed        *bool   `json:"completed"`
+	ID               int64   `json:"id"`
+	ExpectedVersions []int32 `json:"expected_versions"`
 }
 
 // UpdateTodo
 //
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
 //	UPDATE todos
 //	SET
-//	    title = COALESCE($3, title),
-//	    description = COALESCE($4, description),
-//	    completed = COALESCE($5, completed),
-//	    updated_at = NOW()
-//	WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
-//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at
+//	    title = COALESCE($2, title),
+//	    description = COALESCE($3, description),
+//	    completed = COALESCE($4, completed),
+//	    title_updated_at = CASE WHEN $2::text IS NULL THEN title_updated_at ELSE NOW() END,
+//	    description_updated_at = CASE WHEN $3::text IS NULL THEN description_updated_at ELSE NOW() END,
+//	    completed_updated_at = CASE WHEN $4::boolean IS NULL THEN completed_updated_at ELSE NOW() END,
+//	    status_id = CASE
+//	        WHEN $4::boolean IS NULL OR $4::boolean = completed THEN status_id
+//	        WHEN $4::boolean THEN (SELECT id FROM statuses WHERE statuses.user_id = $1 AND is_done)
+//	        ELSE NULL
+//	    END,
+//	    updated_at = NOW(),
+//	    version = version + 1,
+//	    change_seq = (SELECT todo_change_seq FROM seq)
+//	WHERE id = $5 AND user_id = $1 AND deleted_at IS NULL
+//	    AND ($6::integer[] IS NULL OR version = ANY($6::integer[]))
+//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
 func (q *Queries) UpdateTodo(ctx context.Context, arg UpdateTodoParams) (Todo, error) {
 	row := q.db.QueryRow(ctx, updateTodo,
-		arg.ID,
 		arg.UserID,
 		arg.Title,
 		arg.Description,
 		arg.Completed,
+		arg.ID,
+		arg.ExpectedVersions,
+	)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.Description,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Version,
+		&i.ClientID,
+		&i.ChangeSeq,
+		&i.TitleUpdatedAt,
+		&i.DescriptionUpdatedAt,
+		&i.CompletedUpdatedAt,
+		&i.Position,
+		&i.StatusID,
+		&i.DueAt,
+		&i.IcalUid,
+		&i.ProjectID,
+		&i.Tags,
+		&i.ImportSource,
+		&i.ImportSourceID,
+		&i.Priority,
+		&i.Recurrence,
 	)
+	return i, err
+}
+
+const updateTodoPosition = `-- name: UpdateTodoPosition :one
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
+UPDATE todos
+SET position = $2, updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+WHERE id = $3 AND user_id = $1 AND deleted_at IS NULL
+RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+`
+
+type UpdateTodoPositionParams struct {
+	UserID   int64  `json:"user_id"`
+	Position string `json:"position"`
+	ID       int64  `json:"id"`
+}
+
+// UpdateTodoPosition
+//
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
+//	UPDATE todos
+//	SET position = $2, updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
+//	WHERE id = $3 AND user_id = $1 AND deleted_at IS NULL
+//	RETURNING id, user_id, title, description, completed, created_at, updated_at, deleted_at, version, client_id, change_seq, title_updated_at, description_updated_at, completed_updated_at, position, status_id, due_at, ical_uid, project_id, tags, import_source, import_source_id, priority, recurrence
+func (q *Queries) UpdateTodoPosition(ctx context.Context, arg UpdateTodoPositionParams) (Todo, error) {
+	row := q.db.QueryRow(ctx, updateTodoPosition, arg.UserID, arg.Position, arg.ID)
 	var i Todo
 	err := row.Scan(
 		&i.ID,
@@ -291,6 +1309,22 @@ func (q *Queries) UpdateTodo(ctx context.Context, arg UpdateTodoParams) (Todo, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Version,
+		&i.ClientID,
+		&i.ChangeSeq,
+		&i.TitleUpdatedAt,
+		&i.DescriptionUpdatedAt,
+		&i.CompletedUpdatedAt,
+		&i.Position,
+		&i.StatusID,
+		&i.DueAt,
+		&i.IcalUid,
+		&i.ProjectID,
+		&i.Tags,
+		&i.ImportSource,
+		&i.ImportSourceID,
+		&i.Priority,
+		&i.Recurrence,
 	)
 	return i, err
 }
@@ -12,7 +12,7 @@ import (
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (email, name, avatar_url, provider, provider_id)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at
+RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq
 `
 
 type CreateUserParams struct {
@@ -27,7 +27,7 @@ type CreateUserParams struct {
 //
 //	INSERT INTO users (email, name, avatar_url, provider, provider_id)
 //	VALUES ($1, $2, $3, $4, $5)
-//	RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at
+//	RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
 	row := q.db.QueryRow(ctx, createUser,
 		arg.Email,
@@ -47,20 +47,31 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TodoChangeSeq,
 	)
 	return i, err
 }
 
 const deleteTodosByUserID = `-- name: DeleteTodosByUserID :exec
+WITH seq AS (
+    UPDATE users SET todo_change_seq = todo_change_seq + 1
+    WHERE users.id = $1
+    RETURNING todo_change_seq
+)
 UPDATE todos
-SET deleted_at = NOW(), updated_at = NOW()
+SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
 WHERE user_id = $1 AND deleted_at IS NULL
 `
 
 // DeleteTodosByUserID
 //
+//	WITH seq AS (
+//	    UPDATE users SET todo_change_seq = todo_change_seq + 1
+//	    WHERE users.id = $1
+//	    RETURNING todo_change_seq
+//	)
 //	UPDATE todos
-//	SET deleted_at = NOW(), updated_at = NOW()
+//	SET deleted_at = NOW(), updated_at = NOW(), version = version + 1, change_seq = (SELECT todo_change_seq FROM seq)
 //	WHERE user_id = $1 AND deleted_at IS NULL
 func (q *Queries) DeleteTodosByUserID(ctx context.Context, userID int64) error {
 	_, err := q.db.Exec(ctx, deleteTodosByUserID, userID)
@@ -84,12 +95,12 @@ func (q *Queries) DeleteUser(ctx context.Context, id int64) error {
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at FROM users WHERE id = $1 AND deleted_at IS NULL
+SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq FROM users WHERE id = $1 AND deleted_at IS NULL
 `
 
 // GetUserByID
 //
-//	SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at FROM users WHERE id = $1 AND deleted_at IS NULL
+//	SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq FROM users WHERE id = $1 AND deleted_at IS NULL
 func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 	row := q.db.QueryRow(ctx, getUserByID, id)
 	var i User
@@ -103,12 +114,13 @@ func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TodoChangeSeq,
 	)
 	return i, err
 }
 
 const getUserByProviderID = `-- name: GetUserByProviderID :one
-SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at FROM users
+SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq FROM users
 WHERE provider = $1 AND provider_id = $2 AND deleted_at IS NULL
 `
 
@@ -119,7 +131,7 @@ type GetUserByProviderIDParams struct {
 
 // GetUserByProviderID
 //
-//	SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at FROM users
+//	SELECT id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq FROM users
 //	WHERE provider = $1 AND provider_id = $2 AND deleted_at IS NULL
 func (q *Queries) GetUserByProviderID(ctx context.Context, arg GetUserByProviderIDParams) (User, error) {
 	row := q.db.QueryRow(ctx, getUserByProviderID, arg.Provider, arg.ProviderID)
@@ -134,6 +146,7 @@ func (q *Queries) GetUserByProviderID(ctx context.Context, arg GetUserByProvider
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TodoChangeSeq,
 	)
 	return i, err
 }
@@ -145,7 +158,7 @@ SET
     avatar_url = $3,
     updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at
+RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq
 `
 
 type UpdateUserParams struct {
@@ -162,7 +175,7 @@ type UpdateUserParams struct {
 //	    avatar_url = $3,
 //	    updated_at = NOW()
 //	WHERE id = $1 AND deleted_at IS NULL
-//	RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at
+//	RETURNING id, email, name, avatar_url, provider, provider_id, created_at, updated_at, deleted_at, todo_change_seq
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
 	row := q.db.QueryRow(ctx, updateUser, arg.ID, arg.Name, arg.AvatarUrl)
 	var i User
@@ -176,6 +189,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TodoChangeSeq,
 	)
 	return i, err
 }
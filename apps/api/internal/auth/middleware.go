@@ -4,6 +4,9 @@ import (
 	"context"
 	"net/http"
 
+	"go-todo/internal/gen"
+	"go-todo/internal/problem"
+
 	"github.com/labstack/echo/v4"
 )
 
@@ -17,7 +20,7 @@ func RequireAuth(sm *SessionManager) echo.MiddlewareFunc {
 		return func(c echo.Context) error {
 			userID, err := sm.GetUserID(c.Request())
 			if err != nil {
-				return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+				return problem.NewError(http.StatusUnauthorized, gen.ErrorCodeUnauthorized)
 			}
 
 			// 標準contextにUserIDを設定
@@ -0,0 +1,87 @@
+// Package caldav は Todo を VTODO として読み書きする CalDAV (RFC 4791) サーバー
+//
+// ユーザーごとに1つのカレンダーコレクションを持ち、各Todoをその中の1件のリソースとして公開する。
+// リソース名は Todo の client_id（UID と同じ値）に ".ics" を付けたもので、ETag には Todo のバージョン番号を使う。
+// 保存するのは件名・説明・完了状態・期限だけのため、それ以外のプロパティ（VALARM など）は保存されない。
+//
+// URL の構成（prefix は Handler の設定）:
+//
+//	{prefix}/                                  ルート
+//	{prefix}/principals/{user_id}/             プリンシパル
+//	{prefix}/calendars/{user_id}/              カレンダーホーム
+//	{prefix}/calendars/{user_id}/todos/        カレンダーコレクション
+//	{prefix}/calendars/{user_id}/todos/{uid}.ics  Todo
+package caldav
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"go-todo/internal/ical"
+)
+
+// ハンドラーが受け付けるHTTPメソッド
+var Methods = []string{"OPTIONS", "PROPFIND", "REPORT", "GET", "HEAD", "PUT", "DELETE"}
+
+var (
+	ErrNotFound           = errors.New("caldav: not found")
+	ErrPreconditionFailed = errors.New("caldav: precondition failed")
+	// 保存できないリソース（UID がリソース名と一致しない、件名がないなど）
+	ErrInvalidObject = errors.New("caldav: invalid calendar object")
+	// 現在の状態と矛盾する変更（削除済みのTodoの再作成など）
+	ErrConflict = errors.New("caldav: conflict")
+)
+
+// カレンダーコレクション内の1件のリソース
+type Object struct {
+	// リソース名（".ics" を除く）。UID と同じ値
+	Name    string
+	Version int32
+	Item    ical.Item
+}
+
+// PUT の条件（If-Match / If-None-Match ヘッダー）
+type PutCondition struct {
+	// nil の場合はバージョンを検証しない
+	IfMatch *int32
+	// true の場合はリソースが存在しない場合だけ作成する（If-None-Match: *）
+	IfNoneMatch bool
+}
+
+// Todo の保存先
+type Backend interface {
+	// コレクション内のいずれかのリソースが変わると変わる値（CTag）
+	CollectionTag(ctx context.Context, userID int64) (string, error)
+	ListObjects(ctx context.Context, userID int64) ([]Object, error)
+	// 存在しない名前は結果に含めない
+	GetObjects(ctx context.Context, userID int64, names []string) ([]Object, error)
+	// data は PUT されたカレンダーオブジェクト。日付だけの期限などはユーザーのタイムゾーンで読むため、ここで ical.ParseTodo する
+	// 読み込めない場合は ical.ErrUnsupportedComponent / ical.ErrMalformed を返す。作成した場合は created が true になる
+	PutObject(ctx context.Context, userID int64, name string, data io.Reader, cond PutCondition) (created bool, err error)
+	DeleteObject(ctx context.Context, userID int64, name string, ifMatch *int32) error
+}
+
+// リソースの ETag（バージョン番号ベースの強いETag）
+func etag(version int32) string {
+	return `"` + strconv.Itoa(int(version)) + `"`
+}
+
+// If-Match ヘッダーからバージョンを取り出す。"*" と空の場合は nil を返す
+func parseIfMatch(value string) (*int32, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "*" {
+		return nil, true
+	}
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return nil, false
+	}
+	v, err := strconv.ParseInt(value[1:len(value)-1], 10, 32)
+	if err != nil || v < 1 {
+		return nil, false
+	}
+	version := int32(v)
+	return &version, true
+}
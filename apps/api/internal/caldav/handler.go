@@ -0,0 +1,446 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/ical"
+)
+
+// リクエストボディの上限
+const maxRequestBytes = 1 << 20
+
+const (
+	calendarName       = "todos"
+	calendarObjectExt  = ".ics"
+	calendarObjectType = "text/calendar; charset=utf-8; component=VTODO"
+)
+
+type resourceKind int
+
+const (
+	kindRoot resourceKind = iota
+	kindPrincipal
+	kindHome
+	kindCalendar
+	kindObject
+)
+
+// リクエストのパスが指すリソース
+type target struct {
+	kind resourceKind
+	// kindObject の場合のリソース名
+	name string
+}
+
+// CalDAVのHTTPハンドラー
+// 認証はこのハンドラーの外側で行い、リクエストのコンテキストにユーザーIDを設定しておく
+type Handler struct {
+	backend Backend
+	prefix  string
+	prodID  string
+}
+
+// 新しいHandlerを作成（prefix はマウントするパス。例: "/dav"）
+func NewHandler(backend Backend, prefix, prodID string) *Handler {
+	return &Handler{
+		backend: backend,
+		prefix:  strings.TrimSuffix(prefix, "/"),
+		prodID:  prodID,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	t, ok := h.resolve(r.URL.Path, userID)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodOptions:
+		w.Header().Set("DAV", "1, calendar-access")
+		w.Header().Set("Allow", strings.Join(Methods, ", "))
+		w.WriteHeader(http.StatusOK)
+	case "PROPFIND":
+		h.propfind(w, r, userID, t)
+	case "REPORT":
+		h.report(w, r, userID, t)
+	case http.MethodGet, http.MethodHead:
+		h.get(w, r, userID, t)
+	case http.MethodPut:
+		h.put(w, r, userID, t)
+	case http.MethodDelete:
+		h.delete(w, r, userID, t)
+	default:
+		w.Header().Set("Allow", strings.Join(Methods, ", "))
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// パスをリソースに変換する。他のユーザーのリソースは存在しないものとして扱う
+func (h *Handler) resolve(path string, userID int64) (target, bool) {
+	rest, ok := strings.CutPrefix(path, h.prefix)
+	if !ok || rest != "" && rest[0] != '/' {
+		return target{}, false
+	}
+	rest = strings.Trim(rest, "/")
+	if rest == "" {
+		return target{kind: kindRoot}, true
+	}
+	segments := strings.Split(rest, "/")
+	if len(segments) < 2 || segments[1] != strconv.FormatInt(userID, 10) {
+		return target{}, false
+	}
+	switch {
+	case segments[0] == "principals" && len(segments) == 2:
+		return target{kind: kindPrincipal}, true
+	case segments[0] != "calendars":
+		return target{}, false
+	case len(segments) == 2:
+		return target{kind: kindHome}, true
+	case segments[2] != calendarName:
+		return target{}, false
+	case len(segments) == 3:
+		return target{kind: kindCalendar}, true
+	case len(segments) == 4:
+		name, ok := strings.CutSuffix(segments[3], calendarObjectExt)
+		if !ok || name == "" {
+			return target{}, false
+		}
+		return target{kind: kindObject, name: name}, true
+	}
+	return target{}, false
+}
+
+func (h *Handler) principalHref(userID int64) string {
+	return h.prefix + "/principals/" + strconv.FormatInt(userID, 10) + "/"
+}
+
+func (h *Handler) homeHref(userID int64) string {
+	return h.prefix + "/calendars/" + strconv.FormatInt(userID, 10) + "/"
+}
+
+func (h *Handler) calendarHref(userID int64) string {
+	return h.homeHref(userID) + calendarName + "/"
+}
+
+func (h *Handler) objectHref(userID int64, name string) string {
+	return h.calendarHref(userID) + url.PathEscape(name) + calendarObjectExt
+}
+
+// PROPFIND（RFC 4918 9.1）。Depth は 0 と 1 だけを受け付ける
+func (h *Handler) propfind(w http.ResponseWriter, r *http.Request, userID int64, t target) {
+	depth := r.Header.Get("Depth")
+	if depth != "0" && depth != "1" {
+		writeErrorBody(w, http.StatusForbidden, xml.Name{Space: nsDAV, Local: "propfind-finite-depth"})
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	// ボディがない場合は allprop として扱う
+	var req propfindRequest
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := xml.Unmarshal(body, &req); err != nil {
+			http.Error(w, "invalid PROPFIND body", http.StatusBadRequest)
+			return
+		}
+	}
+	sel := propSelection{names: req.Prop.names(), nameOnly: req.PropName != nil}
+
+	ms := newMultistatus()
+	switch t.kind {
+	case kindObject:
+		objects, err := h.backend.GetObjects(r.Context(), userID, []string{t.name})
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		if len(objects) == 0 {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		h.addObject(ms, userID, &objects[0], sel)
+	case kindCalendar:
+		ctag, err := h.backend.CollectionTag(r.Context(), userID)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		h.addCollection(ms, h.calendarHref(userID), h.calendarProps(userID, ctag), sel)
+		if depth == "1" {
+			objects, err := h.backend.ListObjects(r.Context(), userID)
+			if err != nil {
+				h.writeError(w, err)
+				return
+			}
+			for i := range objects {
+				h.addObject(ms, userID, &objects[i], sel)
+			}
+		}
+	case kindHome:
+		h.addCollection(ms, h.homeHref(userID), h.homeProps(userID), sel)
+		if depth == "1" {
+			ctag, err := h.backend.CollectionTag(r.Context(), userID)
+			if err != nil {
+				h.writeError(w, err)
+				return
+			}
+			h.addCollection(ms, h.calendarHref(userID), h.calendarProps(userID, ctag), sel)
+		}
+	case kindPrincipal:
+		h.addCollection(ms, h.principalHref(userID), h.principalProps(userID), sel)
+	case kindRoot:
+		h.addCollection(ms, h.prefix+"/", h.rootProps(userID), sel)
+	}
+	writeMultistatus(w, ms)
+}
+
+// REPORT（RFC 4791 7）。カレンダーコレクションに対する calendar-query と calendar-multiget を受け付ける
+func (h *Handler) report(w http.ResponseWriter, r *http.Request, userID int64, t target) {
+	if t.kind != kindCalendar {
+		writeErrorBody(w, http.StatusForbidden, xml.Name{Space: nsDAV, Local: "supported-report"})
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	name, err := reportName(body)
+	if err != nil {
+		http.Error(w, "invalid REPORT body", http.StatusBadRequest)
+		return
+	}
+
+	ms := newMultistatus()
+	switch name {
+	case xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}:
+		var req multigetRequest
+		if err := xml.Unmarshal(body, &req); err != nil {
+			http.Error(w, "invalid calendar-multiget body", http.StatusBadRequest)
+			return
+		}
+		if err := h.multiget(r, ms, userID, req); err != nil {
+			h.writeError(w, err)
+			return
+		}
+	case xml.Name{Space: nsCalDAV, Local: "calendar-query"}:
+		var req queryRequest
+		if err := xml.Unmarshal(body, &req); err != nil {
+			http.Error(w, "invalid calendar-query body", http.StatusBadRequest)
+			return
+		}
+		objects, err := h.backend.ListObjects(r.Context(), userID)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		sel := propSelection{names: req.Prop.names()}
+		for i := range objects {
+			if req.Filter == nil || matchFilter(req.Filter.CompFilter, &objects[i].Item) {
+				h.addObject(ms, userID, &objects[i], sel)
+			}
+		}
+	default:
+		writeErrorBody(w, http.StatusForbidden, xml.Name{Space: nsDAV, Local: "supported-report"})
+		return
+	}
+	writeMultistatus(w, ms)
+}
+
+// 指定された href の順にリソースを返す。存在しないものは 404 として返す
+func (h *Handler) multiget(r *http.Request, ms *multistatus, userID int64, req multigetRequest) error {
+	names := make([]string, 0, len(req.Hrefs))
+	for _, href := range req.Hrefs {
+		if t, ok := h.resolveHref(href, userID); ok {
+			names = append(names, t.name)
+		}
+	}
+	objects, err := h.backend.GetObjects(r.Context(), userID, names)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*Object, len(objects))
+	for i := range objects {
+		byName[objects[i].Name] = &objects[i]
+	}
+
+	sel := propSelection{names: req.Prop.names()}
+	for _, href := range req.Hrefs {
+		t, ok := h.resolveHref(href, userID)
+		if !ok {
+			ms.addStatus(href, http.StatusNotFound)
+			continue
+		}
+		object, ok := byName[t.name]
+		if !ok {
+			ms.addStatus(href, http.StatusNotFound)
+			continue
+		}
+		h.addObject(ms, userID, object, sel)
+	}
+	return nil
+}
+
+// multiget の href（絶対URLも可）をリソースに変換する
+func (h *Handler) resolveHref(href string, userID int64) (target, bool) {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return target{}, false
+	}
+	t, ok := h.resolve(u.Path, userID)
+	return t, ok && t.kind == kindObject
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request, userID int64, t target) {
+	if t.kind != kindObject {
+		w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	objects, err := h.backend.GetObjects(r.Context(), userID, []string{t.name})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if len(objects) == 0 {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	data, err := h.calendarData(&objects[0])
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", calendarObjectType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("ETag", etag(objects[0].Version))
+	w.Header().Set("Last-Modified", objects[0].Item.LastModified.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(data)
+	}
+}
+
+// PUT（RFC 4791 5.3.2）
+// 保存する内容はリクエストと同じにならないため、レスポンスには ETag を含めない（RFC 4791 5.3.4）
+func (h *Handler) put(w http.ResponseWriter, r *http.Request, userID int64, t target) {
+	if t.kind != kindObject {
+		w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		if mediaType, _, err := mime.ParseMediaType(ct); err != nil || mediaType != "text/calendar" {
+			writeErrorBody(w, http.StatusUnsupportedMediaType, xml.Name{Space: nsCalDAV, Local: "supported-calendar-data"})
+			return
+		}
+	}
+	ifMatch, ok := parseIfMatch(r.Header.Get("If-Match"))
+	if !ok {
+		http.Error(w, "invalid If-Match header", http.StatusBadRequest)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	created, err := h.backend.PutObject(r.Context(), userID, t.name, bytes.NewReader(body), PutCondition{
+		IfMatch:     ifMatch,
+		IfNoneMatch: strings.TrimSpace(r.Header.Get("If-None-Match")) == "*",
+	})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	if created {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, userID int64, t target) {
+	if t.kind != kindObject {
+		w.Header().Set("Allow", "OPTIONS, PROPFIND, REPORT")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	ifMatch, ok := parseIfMatch(r.Header.Get("If-Match"))
+	if !ok {
+		http.Error(w, "invalid If-Match header", http.StatusBadRequest)
+		return
+	}
+	if err := h.backend.DeleteObject(r.Context(), userID, t.name, ifMatch); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case errors.Is(err, ErrPreconditionFailed):
+		http.Error(w, http.StatusText(http.StatusPreconditionFailed), http.StatusPreconditionFailed)
+	case errors.Is(err, ErrConflict):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, ErrInvalidObject):
+		writeErrorBody(w, http.StatusForbidden, xml.Name{Space: nsCalDAV, Local: "valid-calendar-object-resource"})
+	case errors.Is(err, ical.ErrUnsupportedComponent):
+		writeErrorBody(w, http.StatusForbidden, xml.Name{Space: nsCalDAV, Local: "supported-calendar-component"})
+	case errors.Is(err, ical.ErrMalformed):
+		writeErrorBody(w, http.StatusForbidden, xml.Name{Space: nsCalDAV, Local: "valid-calendar-data"})
+	default:
+		log.Printf("CalDAV request failed: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) calendarData(object *Object) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := ical.WriteObject(&buf, h.prodID, object.Item); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ボディを上限まで読む。読めなかった場合はレスポンスを書いて false を返す
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return nil, false
+		}
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return nil, false
+	}
+	return body, true
+}
+
+func writeMultistatus(w http.ResponseWriter, ms *multistatus) {
+	body := ms.bytes()
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusMultiStatus)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,264 @@
+package caldav
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/ical"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update recorded responses")
+
+const (
+	testUserID = int64(1)
+	testProdID = "-//go-todo//Todo API//EN"
+)
+
+// メモリ上のBackend
+type fakeBackend struct {
+	objects map[string]Object
+	ctag    string
+}
+
+// 未完了のTodoと、期限付きで完了済みのTodoを持つBackend
+func newFakeBackend() *fakeBackend {
+	created := time.Date(2026, 10, 20, 9, 30, 0, 0, time.UTC)
+	modified := time.Date(2026, 10, 21, 18, 5, 10, 0, time.UTC)
+	due := time.Date(2026, 10, 30, 1, 0, 0, 0, time.UTC)
+	b := &fakeBackend{objects: map[string]Object{}, ctag: "42"}
+	for _, o := range []Object{
+		{
+			Name:    "0b7c6f5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f",
+			Version: 1,
+			Item: ical.Item{
+				UID:          "0b7c6f5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f",
+				Summary:      "Buy milk",
+				Created:      created,
+				LastModified: created,
+			},
+		},
+		{
+			Name:    "5f0e8d7c-6b5a-4938-8271-6a5b4c3d2e1f",
+			Version: 3,
+			Item: ical.Item{
+				UID:          "5f0e8d7c-6b5a-4938-8271-6a5b4c3d2e1f",
+				Summary:      "Write report",
+				Description:  "Q3 numbers <draft> & notes",
+				Created:      created,
+				LastModified: modified,
+				Due:          &due,
+				Completed:    true,
+				CompletedAt:  modified,
+				Sequence:     2,
+			},
+		},
+	} {
+		b.objects[o.Name] = o
+	}
+	return b
+}
+
+func (b *fakeBackend) CollectionTag(ctx context.Context, userID int64) (string, error) {
+	return b.ctag, nil
+}
+
+func (b *fakeBackend) ListObjects(ctx context.Context, userID int64) ([]Object, error) {
+	names := make([]string, 0, len(b.objects))
+	for name := range b.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return b.GetObjects(ctx, userID, names)
+}
+
+func (b *fakeBackend) GetObjects(ctx context.Context, userID int64, names []string) ([]Object, error) {
+	objects := []Object{}
+	for _, name := range names {
+		if o, ok := b.objects[name]; ok {
+			objects = append(objects, o)
+		}
+	}
+	return objects, nil
+}
+
+func (b *fakeBackend) PutObject(ctx context.Context, userID int64, name string, data io.Reader, cond PutCondition) (bool, error) {
+	item, err := ical.ParseTodo(data, time.UTC)
+	if err != nil {
+		return false, err
+	}
+	if item.UID != name {
+		return false, ErrInvalidObject
+	}
+	existing, ok := b.objects[name]
+	switch {
+	case ok && cond.IfNoneMatch:
+		return false, ErrPreconditionFailed
+	case !ok && cond.IfMatch != nil:
+		return false, ErrPreconditionFailed
+	case ok && cond.IfMatch != nil && *cond.IfMatch != existing.Version:
+		return false, ErrPreconditionFailed
+	}
+	b.objects[name] = Object{Name: name, Version: existing.Version + 1, Item: item}
+	return !ok, nil
+}
+
+func (b *fakeBackend) DeleteObject(ctx context.Context, userID int64, name string, ifMatch *int32) error {
+	existing, ok := b.objects[name]
+	if !ok {
+		return ErrNotFound
+	}
+	if ifMatch != nil && *ifMatch != existing.Version {
+		return ErrPreconditionFailed
+	}
+	delete(b.objects, name)
+	return nil
+}
+
+// 記録したリクエストを読む。空行までをヘッダー、それ以降をボディとして扱う
+func readRecordedRequest(t *testing.T, path string) *http.Request {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	head, body, _ := strings.Cut(string(data), "\n\n")
+
+	req, err := http.ReadRequest(bufio.NewReader(strings.NewReader(head + "\n\n")))
+	require.NoError(t, err)
+	req.Body = io.NopCloser(strings.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return req.WithContext(auth.WithUserID(context.Background(), testUserID))
+}
+
+// レスポンスをステータス行・ヘッダー（名前順）・ボディの順に書き出す
+func dumpResponse(rec *httptest.ResponseRecorder) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "HTTP/1.1 %d %s\n", rec.Code, http.StatusText(rec.Code))
+	keys := make([]string, 0, len(rec.Header()))
+	for k := range rec.Header() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range rec.Header()[k] {
+			fmt.Fprintf(&b, "%s: %s\n", k, v)
+		}
+	}
+	b.WriteString("\n")
+	b.Write(rec.Body.Bytes())
+	return []byte(b.String())
+}
+
+// testdata/*.req のリクエストを送り、レスポンスを *.resp の記録と比較する（-update で記録し直す）
+func TestHandler_RecordedFixtures(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "*.req"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".req")
+		t.Run(name, func(t *testing.T) {
+			h := NewHandler(newFakeBackend(), "/dav", testProdID)
+			req := readRecordedRequest(t, path)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			got := dumpResponse(rec)
+			respPath := strings.TrimSuffix(path, ".req") + ".resp"
+			if *update {
+				require.NoError(t, os.WriteFile(respPath, got, 0o644))
+			}
+			want, err := os.ReadFile(respPath)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got))
+		})
+	}
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Run("正常系: PUTで更新した内容をGETで取得できる", func(t *testing.T) {
+		backend := newFakeBackend()
+		h := NewHandler(backend, "/dav", testProdID)
+		path := "/dav/calendars/1/todos/0b7c6f5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f.ics"
+		ctx := auth.WithUserID(context.Background(), testUserID)
+
+		put := httptest.NewRequest(http.MethodPut, path, strings.NewReader(
+			"BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nUID:0b7c6f5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f\r\nSUMMARY:Buy oat milk\r\nEND:VTODO\r\nEND:VCALENDAR\r\n",
+		)).WithContext(ctx)
+		put.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, put)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil).WithContext(ctx))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		assert.Contains(t, rec.Body.String(), "SUMMARY:Buy oat milk\r\n")
+	})
+
+	t.Run("異常系: ユーザーIDがない場合は401", func(t *testing.T) {
+		h := NewHandler(newFakeBackend(), "/dav", testProdID)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodOptions, "/dav/", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("異常系: 上限を超えるボディは413", func(t *testing.T) {
+		h := NewHandler(newFakeBackend(), "/dav", testProdID)
+		body := strings.Repeat("x", maxRequestBytes+1)
+		req := httptest.NewRequest("PROPFIND", "/dav/", strings.NewReader(body)).
+			WithContext(auth.WithUserID(context.Background(), testUserID))
+		req.Header.Set("Depth", "0")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+}
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  *int32
+		ok    bool
+	}{
+		{name: "正常系: 空", value: "", want: nil, ok: true},
+		{name: "正常系: *", value: "*", want: nil, ok: true},
+		{name: "正常系: バージョン", value: `"7"`, want: ptrInt32(7), ok: true},
+		{name: "異常系: 弱いETag", value: `W/"7"`, ok: false},
+		{name: "異常系: 数値でない", value: `"abc"`, ok: false},
+		{name: "異常系: 0", value: `"0"`, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseIfMatch(tt.value)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func ptrInt32(v int32) *int32 {
+	return &v
+}
@@ -0,0 +1,162 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"net/http"
+	"strings"
+
+	"go-todo/internal/ical"
+)
+
+// 返すプロパティの指定。names が空で nameOnly が false の場合は allprop
+type propSelection struct {
+	names    []xml.Name
+	nameOnly bool
+}
+
+// 指定されたプロパティを見つかったものと見つからなかったものに分ける
+// extra は props に含まれない、明示的に指定された場合だけ返すプロパティ（calendar-data など）を作る
+func (s propSelection) apply(props []property, extra func(xml.Name) (property, bool)) ([]property, []xml.Name) {
+	if s.nameOnly {
+		names := make([]property, len(props))
+		for i, p := range props {
+			names[i] = property{name: p.name}
+		}
+		return names, nil
+	}
+	if len(s.names) == 0 {
+		return props, nil
+	}
+
+	var found []property
+	var notFound []xml.Name
+	for _, name := range s.names {
+		if p, ok := findProperty(props, name); ok {
+			found = append(found, p)
+			continue
+		}
+		if extra != nil {
+			if p, ok := extra(name); ok {
+				found = append(found, p)
+				continue
+			}
+		}
+		notFound = append(notFound, name)
+	}
+	return found, notFound
+}
+
+func findProperty(props []property, name xml.Name) (property, bool) {
+	for _, p := range props {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return property{}, false
+}
+
+func (h *Handler) addCollection(ms *multistatus, href string, props []property, sel propSelection) {
+	found, notFound := sel.apply(props, nil)
+	ms.addPropstat(href, found, notFound)
+}
+
+func (h *Handler) addObject(ms *multistatus, userID int64, object *Object, sel propSelection) {
+	props := []property{
+		{name: propResourceType},
+		{name: propGetETag, value: escapeXML(etag(object.Version))},
+		{name: propGetContentType, value: escapeXML(calendarObjectType)},
+		{name: propGetLastModified, value: object.Item.LastModified.UTC().Format(http.TimeFormat)},
+	}
+	found, notFound := sel.apply(props, func(name xml.Name) (property, bool) {
+		if name != propCalendarData {
+			return property{}, false
+		}
+		data, err := h.calendarData(object)
+		if err != nil {
+			return property{}, false
+		}
+		return property{name: propCalendarData, value: escapeXML(string(data))}, true
+	})
+	ms.addPropstat(h.objectHref(userID, object.Name), found, notFound)
+}
+
+func (h *Handler) rootProps(userID int64) []property {
+	return []property{
+		{name: propResourceType, value: "<d:collection/>"},
+		{name: propCurrentUserPrincipal, value: hrefValue(h.principalHref(userID))},
+	}
+}
+
+func (h *Handler) principalProps(userID int64) []property {
+	return []property{
+		{name: propResourceType, value: "<d:principal/>"},
+		{name: propCurrentUserPrincipal, value: hrefValue(h.principalHref(userID))},
+		{name: propPrincipalURL, value: hrefValue(h.principalHref(userID))},
+		{name: propCalendarHomeSet, value: hrefValue(h.homeHref(userID))},
+	}
+}
+
+func (h *Handler) homeProps(userID int64) []property {
+	return []property{
+		{name: propResourceType, value: "<d:collection/>"},
+		{name: propCurrentUserPrincipal, value: hrefValue(h.principalHref(userID))},
+	}
+}
+
+func (h *Handler) calendarProps(userID int64, ctag string) []property {
+	return []property{
+		{name: propResourceType, value: "<d:collection/><c:calendar/>"},
+		{name: propDisplayName, value: "Todos"},
+		{name: propCurrentUserPrincipal, value: hrefValue(h.principalHref(userID))},
+		{name: propCurrentUserPrivilegeSet, value: "<d:privilege><d:read/></d:privilege>" +
+			"<d:privilege><d:write/></d:privilege>" +
+			"<d:privilege><d:write-content/></d:privilege>" +
+			"<d:privilege><d:bind/></d:privilege>" +
+			"<d:privilege><d:unbind/></d:privilege>"},
+		{name: propSupportedComponentSet, value: `<c:comp name="VTODO"/>`},
+		{name: propSupportedReportSet, value: "<d:supported-report><d:report><c:calendar-query/></d:report></d:supported-report>" +
+			"<d:supported-report><d:report><c:calendar-multiget/></d:report></d:supported-report>"},
+		{name: propGetCTag, value: escapeXML(ctag)},
+	}
+}
+
+// calendar-query のフィルターに一致するか（RFC 4791 9.7）
+// コンポーネントとプロパティの有無だけを判定し、time-range と text-match は常に一致するものとして扱う
+func matchFilter(f compFilter, item *ical.Item) bool {
+	if !strings.EqualFold(f.Name, "VCALENDAR") {
+		return f.IsNotDefined != nil
+	}
+	for _, comp := range f.CompFilters {
+		if !strings.EqualFold(comp.Name, "VTODO") {
+			// VTODO 以外のコンポーネントは存在しない
+			if comp.IsNotDefined == nil {
+				return false
+			}
+			continue
+		}
+		if comp.IsNotDefined != nil {
+			return false
+		}
+		for _, prop := range comp.PropFilters {
+			if hasProperty(item, prop.Name) == (prop.IsNotDefined != nil) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ical.WriteObject が書き出すプロパティのうち、値によって有無が変わるものを判定する
+func hasProperty(item *ical.Item, name string) bool {
+	switch strings.ToUpper(name) {
+	case "UID", "DTSTAMP", "CREATED", "LAST-MODIFIED", "SEQUENCE", "SUMMARY", "STATUS":
+		return true
+	case "DESCRIPTION":
+		return item.Description != ""
+	case "DUE":
+		return item.Due != nil
+	case "COMPLETED", "PERCENT-COMPLETE":
+		return item.Completed
+	}
+	return false
+}
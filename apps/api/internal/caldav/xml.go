@@ -0,0 +1,213 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	nsDAV    = "DAV:"
+	nsCalDAV = "urn:ietf:params:xml:ns:caldav"
+	// CTag (getctag) の名前空間
+	nsCalendarServer = "http://calendarserver.org/ns/"
+)
+
+// レスポンスで使う名前空間の接頭辞
+var nsPrefixes = map[string]string{
+	nsDAV:            "d",
+	nsCalDAV:         "c",
+	nsCalendarServer: "cs",
+}
+
+var (
+	propResourceType            = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propDisplayName             = xml.Name{Space: nsDAV, Local: "displayname"}
+	propCurrentUserPrincipal    = xml.Name{Space: nsDAV, Local: "current-user-principal"}
+	propPrincipalURL            = xml.Name{Space: nsDAV, Local: "principal-URL"}
+	propCurrentUserPrivilegeSet = xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}
+	propSupportedReportSet      = xml.Name{Space: nsDAV, Local: "supported-report-set"}
+	propGetETag                 = xml.Name{Space: nsDAV, Local: "getetag"}
+	propGetContentType          = xml.Name{Space: nsDAV, Local: "getcontenttype"}
+	propGetLastModified         = xml.Name{Space: nsDAV, Local: "getlastmodified"}
+	propCalendarHomeSet         = xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}
+	propSupportedComponentSet   = xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}
+	propCalendarData            = xml.Name{Space: nsCalDAV, Local: "calendar-data"}
+	propGetCTag                 = xml.Name{Space: nsCalendarServer, Local: "getctag"}
+)
+
+// PROPFIND のリクエストボディ（RFC 4918 14.20）
+type propfindRequest struct {
+	XMLName  xml.Name  `xml:"DAV: propfind"`
+	AllProp  *struct{} `xml:"DAV: allprop"`
+	PropName *struct{} `xml:"DAV: propname"`
+	Prop     *propList `xml:"DAV: prop"`
+}
+
+type propList struct {
+	Names []anyElement `xml:",any"`
+}
+
+type anyElement struct {
+	XMLName xml.Name
+}
+
+func (p *propList) names() []xml.Name {
+	if p == nil {
+		return nil
+	}
+	names := make([]xml.Name, len(p.Names))
+	for i, e := range p.Names {
+		names[i] = e.XMLName
+	}
+	return names
+}
+
+// calendar-multiget レポート（RFC 4791 7.9）
+type multigetRequest struct {
+	XMLName xml.Name  `xml:"urn:ietf:params:xml:ns:caldav calendar-multiget"`
+	Prop    *propList `xml:"DAV: prop"`
+	Hrefs   []string  `xml:"DAV: href"`
+}
+
+// calendar-query レポート（RFC 4791 7.8）
+type queryRequest struct {
+	XMLName xml.Name  `xml:"urn:ietf:params:xml:ns:caldav calendar-query"`
+	Prop    *propList `xml:"DAV: prop"`
+	Filter  *struct {
+		CompFilter compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	} `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+type compFilter struct {
+	Name         string       `xml:"name,attr"`
+	IsNotDefined *struct{}    `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+	CompFilters  []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	PropFilters  []propFilter `xml:"urn:ietf:params:xml:ns:caldav prop-filter"`
+}
+
+type propFilter struct {
+	Name         string    `xml:"name,attr"`
+	IsNotDefined *struct{} `xml:"urn:ietf:params:xml:ns:caldav is-not-defined"`
+}
+
+// REPORT のボディのルート要素名を読む
+func reportName(body []byte) (xml.Name, error) {
+	d := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return xml.Name{}, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return start.Name, nil
+		}
+	}
+}
+
+// 1件のプロパティ。value は要素の中身としてそのまま書き出すXML
+type property struct {
+	name  xml.Name
+	value string
+}
+
+// 207 Multi-Status のレスポンスボディ（RFC 4918 13）
+type multistatus struct {
+	buf bytes.Buffer
+}
+
+func newMultistatus() *multistatus {
+	m := &multistatus{}
+	m.buf.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	fmt.Fprintf(&m.buf, `<d:multistatus xmlns:d="%s" xmlns:c="%s" xmlns:cs="%s">`+"\n", nsDAV, nsCalDAV, nsCalendarServer)
+	return m
+}
+
+// 見つかったプロパティと見つからなかったプロパティを propstat に分けて書き出す
+func (m *multistatus) addPropstat(href string, found []property, notFound []xml.Name) {
+	m.buf.WriteString("<d:response>\n")
+	m.writeHref(href)
+	if len(found) > 0 {
+		m.buf.WriteString("<d:propstat>\n<d:prop>\n")
+		for _, p := range found {
+			writeElement(&m.buf, p.name, p.value)
+		}
+		m.buf.WriteString("</d:prop>\n")
+		m.writeStatus(http.StatusOK)
+		m.buf.WriteString("</d:propstat>\n")
+	}
+	if len(notFound) > 0 {
+		m.buf.WriteString("<d:propstat>\n<d:prop>\n")
+		for _, name := range notFound {
+			writeElement(&m.buf, name, "")
+		}
+		m.buf.WriteString("</d:prop>\n")
+		m.writeStatus(http.StatusNotFound)
+		m.buf.WriteString("</d:propstat>\n")
+	}
+	m.buf.WriteString("</d:response>\n")
+}
+
+// プロパティを含まない、ステータスだけのレスポンスを書き出す
+func (m *multistatus) addStatus(href string, status int) {
+	m.buf.WriteString("<d:response>\n")
+	m.writeHref(href)
+	m.writeStatus(status)
+	m.buf.WriteString("</d:response>\n")
+}
+
+func (m *multistatus) writeHref(href string) {
+	m.buf.WriteString(hrefValue(href) + "\n")
+}
+
+func (m *multistatus) writeStatus(status int) {
+	fmt.Fprintf(&m.buf, "<d:status>HTTP/1.1 %d %s</d:status>\n", status, http.StatusText(status))
+}
+
+func (m *multistatus) bytes() []byte {
+	m.buf.WriteString("</d:multistatus>\n")
+	return m.buf.Bytes()
+}
+
+// 要素を書き出す。既知の名前空間は接頭辞を使い、それ以外はその場で宣言する
+func writeElement(w *bytes.Buffer, name xml.Name, value string) {
+	tag := name.Local
+	decl := ""
+	if prefix, ok := nsPrefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+	} else if name.Space != "" {
+		tag = "x:" + name.Local
+		decl = ` xmlns:x="` + escapeXML(name.Space) + `"`
+	}
+	if value == "" {
+		fmt.Fprintf(w, "<%s%s/>\n", tag, decl)
+		return
+	}
+	fmt.Fprintf(w, "<%s%s>%s</%s>\n", tag, decl, value, tag)
+}
+
+// テキストをXMLの文字データや属性値として書き出せる形にする
+func escapeXML(s string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// href を1つ含む値
+func hrefValue(href string) string {
+	return "<d:href>" + escapeXML(href) + "</d:href>"
+}
+
+// DAV:error の本文を書き出す（RFC 4918 16）
+func writeErrorBody(w http.ResponseWriter, status int, condition xml.Name) {
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	fmt.Fprintf(&b, `<d:error xmlns:d="%s" xmlns:c="%s">`+"\n", nsDAV, nsCalDAV)
+	writeElement(&b, condition, "")
+	b.WriteString("</d:error>\n")
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = io.Copy(w, &b)
+}
@@ -3,18 +3,27 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
 
 // Config holds all application configuration
 type Config struct {
-	Database DatabaseConfig
-	Redis    RedisConfig
-	OAuth    OAuthConfig
-	Server   ServerConfig
-	Frontend FrontendConfig
-	Cookie   CookieConfig
+	Database    DatabaseConfig
+	Redis       RedisConfig
+	OAuth       OAuthConfig
+	Server      ServerConfig
+	Frontend    FrontendConfig
+	Cookie      CookieConfig
+	Idempotency IdempotencyConfig
+	Job         JobConfig
+	Batch       BatchConfig
+	Position    PositionConfig
+	Reminder    ReminderConfig
+	SMTP        SMTPConfig
+	Webhook     WebhookConfig
+	Export      AccountExportConfig
 }
 
 // Validate checks if the configuration is valid
@@ -37,6 +46,30 @@ func (c *Config) Validate() error {
 	if err := c.Cookie.Validate(); err != nil {
 		return fmt.Errorf("cookie config: %w", err)
 	}
+	if err := c.Idempotency.Validate(); err != nil {
+		return fmt.Errorf("idempotency config: %w", err)
+	}
+	if err := c.Job.Validate(); err != nil {
+		return fmt.Errorf("job config: %w", err)
+	}
+	if err := c.Batch.Validate(); err != nil {
+		return fmt.Errorf("batch config: %w", err)
+	}
+	if err := c.Position.Validate(); err != nil {
+		return fmt.Errorf("position config: %w", err)
+	}
+	if err := c.Reminder.Validate(); err != nil {
+		return fmt.Errorf("reminder config: %w", err)
+	}
+	if err := c.SMTP.Validate(); err != nil {
+		return fmt.Errorf("smtp config: %w", err)
+	}
+	if err := c.Webhook.Validate(); err != nil {
+		return fmt.Errorf("webhook config: %w", err)
+	}
+	if err := c.Export.Validate(); err != nil {
+		return fmt.Errorf("account export config: %w", err)
+	}
 	return nil
 }
 
@@ -126,6 +159,8 @@ func (o *OAuthConfig) Validate() error {
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	Port int `envconfig:"BACKEND_CONTAINER_PORT" default:"4000"`
+	// PublicURL is the externally reachable base URL of the API, used for links handed out to other apps
+	PublicURL string `envconfig:"API_PUBLIC_URL" default:"http://localhost:4000"`
 }
 
 // Address returns server listen address
@@ -138,6 +173,13 @@ func (s *ServerConfig) Validate() error {
 	if s.Port < 1 || s.Port > 65535 {
 		return fmt.Errorf("invalid port: %d (must be 1-65535)", s.Port)
 	}
+	u, err := url.Parse(s.PublicURL)
+	if err != nil {
+		return fmt.Errorf("invalid public URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("public URL must use http or https scheme, got: %s", u.Scheme)
+	}
 	return nil
 }
 
@@ -170,6 +212,216 @@ func (c *CookieConfig) Validate() error {
 	return nil
 }
 
+// IdempotencyConfig holds Idempotency-Key storage configuration
+type IdempotencyConfig struct {
+	TTL             time.Duration `envconfig:"IDEMPOTENCY_KEY_TTL" default:"24h"`
+	CleanupInterval time.Duration `envconfig:"IDEMPOTENCY_CLEANUP_INTERVAL" default:"1h"`
+	// How long an in-flight key stays locked. A retry after this re-claims a key whose request crashed
+	LockTimeout time.Duration `envconfig:"IDEMPOTENCY_LOCK_TIMEOUT" default:"1m"`
+}
+
+// Validate checks if the idempotency configuration is valid
+func (i *IdempotencyConfig) Validate() error {
+	if i.TTL <= 0 {
+		return fmt.Errorf("invalid key TTL: %s (must be positive)", i.TTL)
+	}
+	if i.CleanupInterval <= 0 {
+		return fmt.Errorf("invalid cleanup interval: %s (must be positive)", i.CleanupInterval)
+	}
+	if i.LockTimeout <= 0 || i.LockTimeout > i.TTL {
+		return fmt.Errorf("invalid lock timeout: %s (must be positive and not exceed the key TTL)", i.LockTimeout)
+	}
+	return nil
+}
+
+// JobConfig holds background job worker configuration
+type JobConfig struct {
+	Workers       int           `envconfig:"JOB_WORKERS" default:"2"`
+	PollInterval  time.Duration `envconfig:"JOB_POLL_INTERVAL" default:"2s"`
+	LeaseDuration time.Duration `envconfig:"JOB_LEASE_DURATION" default:"5m"`
+	MaxAttempts   int32         `envconfig:"JOB_MAX_ATTEMPTS" default:"3"`
+}
+
+// Validate checks if the job configuration is valid
+func (j *JobConfig) Validate() error {
+	if j.Workers < 1 {
+		return fmt.Errorf("invalid worker count: %d (must be at least 1)", j.Workers)
+	}
+	if j.PollInterval <= 0 {
+		return fmt.Errorf("invalid poll interval: %s (must be positive)", j.PollInterval)
+	}
+	if j.LeaseDuration <= 0 {
+		return fmt.Errorf("invalid lease duration: %s (must be positive)", j.LeaseDuration)
+	}
+	if j.MaxAttempts < 1 {
+		return fmt.Errorf("invalid max attempts: %d (must be at least 1)", j.MaxAttempts)
+	}
+	return nil
+}
+
+// BatchConfig holds limits for synchronous batch endpoints
+type BatchConfig struct {
+	MaxItems int `envconfig:"BATCH_MAX_ITEMS" default:"100"`
+}
+
+// Validate checks if the batch configuration is valid
+func (b *BatchConfig) Validate() error {
+	if b.MaxItems < 1 {
+		return fmt.Errorf("invalid max items: %d (must be at least 1)", b.MaxItems)
+	}
+	return nil
+}
+
+// PositionConfig holds settings for rebalancing manual todo positions
+type PositionConfig struct {
+	MaxKeyLength      int           `envconfig:"POSITION_MAX_KEY_LENGTH" default:"32"`
+	RebalanceInterval time.Duration `envconfig:"POSITION_REBALANCE_INTERVAL" default:"10m"`
+}
+
+// Validate checks if the position configuration is valid
+func (p *PositionConfig) Validate() error {
+	if p.MaxKeyLength < 8 {
+		return fmt.Errorf("invalid max key length: %d (must be at least 8)", p.MaxKeyLength)
+	}
+	if p.RebalanceInterval <= 0 {
+		return fmt.Errorf("invalid rebalance interval: %s (must be positive)", p.RebalanceInterval)
+	}
+	return nil
+}
+
+// ReminderConfig holds reminder scheduler configuration
+type ReminderConfig struct {
+	PollInterval  time.Duration `envconfig:"REMINDER_POLL_INTERVAL" default:"30s"`
+	LeaseDuration time.Duration `envconfig:"REMINDER_LEASE_DURATION" default:"2m"`
+	MaxAttempts   int32         `envconfig:"REMINDER_MAX_ATTEMPTS" default:"5"`
+	RetryDelay    time.Duration `envconfig:"REMINDER_RETRY_DELAY" default:"1m"`
+}
+
+// Validate checks if the reminder configuration is valid
+func (r *ReminderConfig) Validate() error {
+	if r.PollInterval <= 0 {
+		return fmt.Errorf("invalid poll interval: %s (must be positive)", r.PollInterval)
+	}
+	if r.LeaseDuration <= 0 {
+		return fmt.Errorf("invalid lease duration: %s (must be positive)", r.LeaseDuration)
+	}
+	if r.MaxAttempts < 1 {
+		return fmt.Errorf("invalid max attempts: %d (must be at least 1)", r.MaxAttempts)
+	}
+	if r.RetryDelay <= 0 {
+		return fmt.Errorf("invalid retry delay: %s (must be positive)", r.RetryDelay)
+	}
+	return nil
+}
+
+// SMTPConfig holds the email reminder channel configuration.
+// The channel is disabled when Host is empty.
+type SMTPConfig struct {
+	Host     string        `envconfig:"SMTP_HOST"`
+	Port     int           `envconfig:"SMTP_PORT" default:"587"`
+	Username string        `envconfig:"SMTP_USERNAME"`
+	Password string        `envconfig:"SMTP_PASSWORD"`
+	From     string        `envconfig:"SMTP_FROM"`
+	Timeout  time.Duration `envconfig:"SMTP_TIMEOUT" default:"10s"`
+}
+
+// Enabled reports whether email reminders can be sent
+func (s *SMTPConfig) Enabled() bool {
+	return s.Host != ""
+}
+
+// String returns a safe string representation with masked password
+func (s *SMTPConfig) String() string {
+	return fmt.Sprintf(
+		"SMTPConfig{Host:%s, Port:%d, Username:%s, Password:***, From:%s, Timeout:%s}",
+		s.Host, s.Port, s.Username, s.From, s.Timeout,
+	)
+}
+
+// Validate checks if the SMTP configuration is valid
+func (s *SMTPConfig) Validate() error {
+	if !s.Enabled() {
+		return nil
+	}
+	if s.Port < 1 || s.Port > 65535 {
+		return fmt.Errorf("invalid port: %d (must be 1-65535)", s.Port)
+	}
+	if s.From == "" {
+		return fmt.Errorf("from address is required when SMTP_HOST is set")
+	}
+	if s.Timeout <= 0 {
+		return fmt.Errorf("invalid timeout: %s (must be positive)", s.Timeout)
+	}
+	return nil
+}
+
+// WebhookConfig holds the webhook reminder channel configuration.
+// The channel is disabled when URL is empty.
+type WebhookConfig struct {
+	URL     string        `envconfig:"NOTIFY_WEBHOOK_URL"`
+	Secret  string        `envconfig:"NOTIFY_WEBHOOK_SECRET"`
+	Timeout time.Duration `envconfig:"NOTIFY_WEBHOOK_TIMEOUT" default:"10s"`
+}
+
+// Enabled reports whether webhook reminders can be sent
+func (w *WebhookConfig) Enabled() bool {
+	return w.URL != ""
+}
+
+// String returns a safe string representation with masked secret
+func (w *WebhookConfig) String() string {
+	return fmt.Sprintf("WebhookConfig{URL:%s, Secret:***, Timeout:%s}", w.URL, w.Timeout)
+}
+
+// Validate checks if the webhook configuration is valid
+func (w *WebhookConfig) Validate() error {
+	if !w.Enabled() {
+		return nil
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("webhook URL must use http or https scheme, got: %s", u.Scheme)
+	}
+	if w.Timeout <= 0 {
+		return fmt.Errorf("invalid timeout: %s (must be positive)", w.Timeout)
+	}
+	return nil
+}
+
+// AccountExportConfig holds the account data export (takeout) configuration.
+// SigningKey is required so that download links stay valid across restarts
+// and across instances.
+type AccountExportConfig struct {
+	SigningKey      string        `envconfig:"ACCOUNT_EXPORT_SIGNING_KEY" required:"true"`
+	TTL             time.Duration `envconfig:"ACCOUNT_EXPORT_TTL" default:"24h"`
+	CleanupInterval time.Duration `envconfig:"ACCOUNT_EXPORT_CLEANUP_INTERVAL" default:"1h"`
+}
+
+// MinAccountExportSigningKeyLength is the minimum length of ACCOUNT_EXPORT_SIGNING_KEY
+const MinAccountExportSigningKeyLength = 32
+
+// String returns a safe string representation with masked signing key
+func (a *AccountExportConfig) String() string {
+	return fmt.Sprintf("AccountExportConfig{SigningKey:***, TTL:%s, CleanupInterval:%s}", a.TTL, a.CleanupInterval)
+}
+
+// Validate checks if the account export configuration is valid
+func (a *AccountExportConfig) Validate() error {
+	if len(a.SigningKey) < MinAccountExportSigningKeyLength {
+		return fmt.Errorf("signing key must be at least %d bytes", MinAccountExportSigningKeyLength)
+	}
+	if a.TTL <= 0 {
+		return fmt.Errorf("invalid link TTL: %s (must be positive)", a.TTL)
+	}
+	if a.CleanupInterval <= 0 {
+		return fmt.Errorf("invalid cleanup interval: %s (must be positive)", a.CleanupInterval)
+	}
+	return nil
+}
+
 // Load reads configuration from environment variables
 func Load() (*Config, error) {
 	var cfg Config
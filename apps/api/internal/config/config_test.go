@@ -2,7 +2,9 @@ package config
 
 import (
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -136,6 +138,34 @@ func TestServerConfig_Address(t *testing.T) {
 	assert.Equal(t, expected, cfg.Address())
 }
 
+func TestServerConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		port      int
+		publicURL string
+		wantErr   bool
+	}{
+		{name: "valid", port: 4000, publicURL: "https://api.example.com", wantErr: false},
+		{name: "invalid port", port: 0, publicURL: "https://api.example.com", wantErr: true},
+		{name: "invalid public URL scheme", port: 4000, publicURL: "webcal://api.example.com", wantErr: true},
+		{name: "empty public URL", port: 4000, publicURL: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := ServerConfig{Port: tt.port, PublicURL: tt.publicURL}
+
+			err := cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestOAuthConfig_String(t *testing.T) {
 	cfg := OAuthConfig{
 		GoogleClientID:     "client123",
@@ -221,6 +251,265 @@ func TestFrontendConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestIdempotencyConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		ttl             time.Duration
+		cleanupInterval time.Duration
+		lockTimeout     time.Duration
+		wantErr         bool
+	}{
+		{name: "valid", ttl: 24 * time.Hour, cleanupInterval: time.Hour, lockTimeout: time.Minute, wantErr: false},
+		{name: "zero TTL", ttl: 0, cleanupInterval: time.Hour, lockTimeout: time.Minute, wantErr: true},
+		{name: "negative TTL", ttl: -time.Minute, cleanupInterval: time.Hour, lockTimeout: time.Minute, wantErr: true},
+		{name: "zero cleanup interval", ttl: time.Hour, cleanupInterval: 0, lockTimeout: time.Minute, wantErr: true},
+		{name: "zero lock timeout", ttl: time.Hour, cleanupInterval: time.Hour, lockTimeout: 0, wantErr: true},
+		{name: "lock timeout longer than TTL", ttl: time.Hour, cleanupInterval: time.Hour, lockTimeout: 2 * time.Hour, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := IdempotencyConfig{
+				TTL:             tt.ttl,
+				CleanupInterval: tt.cleanupInterval,
+				LockTimeout:     tt.lockTimeout,
+			}
+
+			err := cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		workers       int
+		pollInterval  time.Duration
+		leaseDuration time.Duration
+		maxAttempts   int32
+		wantErr       bool
+	}{
+		{name: "valid", workers: 2, pollInterval: 2 * time.Second, leaseDuration: 5 * time.Minute, maxAttempts: 3, wantErr: false},
+		{name: "zero workers", workers: 0, pollInterval: 2 * time.Second, leaseDuration: 5 * time.Minute, maxAttempts: 3, wantErr: true},
+		{name: "zero poll interval", workers: 2, pollInterval: 0, leaseDuration: 5 * time.Minute, maxAttempts: 3, wantErr: true},
+		{name: "negative lease duration", workers: 2, pollInterval: 2 * time.Second, leaseDuration: -time.Minute, maxAttempts: 3, wantErr: true},
+		{name: "zero max attempts", workers: 2, pollInterval: 2 * time.Second, leaseDuration: 5 * time.Minute, maxAttempts: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := JobConfig{
+				Workers:       tt.workers,
+				PollInterval:  tt.pollInterval,
+				LeaseDuration: tt.leaseDuration,
+				MaxAttempts:   tt.maxAttempts,
+			}
+
+			err := cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPositionConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name              string
+		maxKeyLength      int
+		rebalanceInterval time.Duration
+		wantErr           bool
+	}{
+		{name: "valid", maxKeyLength: 32, rebalanceInterval: 10 * time.Minute, wantErr: false},
+		{name: "minimum key length", maxKeyLength: 8, rebalanceInterval: 10 * time.Minute, wantErr: false},
+		{name: "too short key length", maxKeyLength: 7, rebalanceInterval: 10 * time.Minute, wantErr: true},
+		{name: "zero rebalance interval", maxKeyLength: 32, rebalanceInterval: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := PositionConfig{
+				MaxKeyLength:      tt.maxKeyLength,
+				RebalanceInterval: tt.rebalanceInterval,
+			}
+
+			err := cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBatchConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxItems int
+		wantErr  bool
+	}{
+		{name: "valid", maxItems: 100, wantErr: false},
+		{name: "minimum", maxItems: 1, wantErr: false},
+		{name: "zero", maxItems: 0, wantErr: true},
+		{name: "negative", maxItems: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := BatchConfig{MaxItems: tt.maxItems}
+
+			err := cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReminderConfig_Validate(t *testing.T) {
+	valid := ReminderConfig{
+		PollInterval:  30 * time.Second,
+		LeaseDuration: 2 * time.Minute,
+		MaxAttempts:   5,
+		RetryDelay:    time.Minute,
+	}
+	tests := []struct {
+		name    string
+		modify  func(*ReminderConfig)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(*ReminderConfig) {}, wantErr: false},
+		{name: "zero poll interval", modify: func(c *ReminderConfig) { c.PollInterval = 0 }, wantErr: true},
+		{name: "zero lease duration", modify: func(c *ReminderConfig) { c.LeaseDuration = 0 }, wantErr: true},
+		{name: "zero max attempts", modify: func(c *ReminderConfig) { c.MaxAttempts = 0 }, wantErr: true},
+		{name: "negative retry delay", modify: func(c *ReminderConfig) { c.RetryDelay = -time.Second }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.modify(&cfg)
+
+			err := cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSMTPConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     SMTPConfig
+		wantErr bool
+	}{
+		{name: "disabled", cfg: SMTPConfig{}, wantErr: false},
+		{name: "valid", cfg: SMTPConfig{Host: "smtp.example.com", Port: 587, From: "todo@example.com", Timeout: 10 * time.Second}, wantErr: false},
+		{name: "missing from", cfg: SMTPConfig{Host: "smtp.example.com", Port: 587, Timeout: 10 * time.Second}, wantErr: true},
+		{name: "invalid port", cfg: SMTPConfig{Host: "smtp.example.com", Port: 0, From: "todo@example.com", Timeout: 10 * time.Second}, wantErr: true},
+		{name: "zero timeout", cfg: SMTPConfig{Host: "smtp.example.com", Port: 587, From: "todo@example.com"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSMTPConfig_String(t *testing.T) {
+	cfg := SMTPConfig{Host: "smtp.example.com", Port: 587, Username: "user", Password: "secret", From: "todo@example.com"}
+
+	result := cfg.String()
+
+	assert.NotContains(t, result, "secret")
+	assert.Contains(t, result, "Password:***")
+}
+
+func TestWebhookConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     WebhookConfig
+		wantErr bool
+	}{
+		{name: "disabled", cfg: WebhookConfig{}, wantErr: false},
+		{name: "valid", cfg: WebhookConfig{URL: "https://hooks.example.com/todo", Timeout: 10 * time.Second}, wantErr: false},
+		{name: "invalid scheme", cfg: WebhookConfig{URL: "ftp://hooks.example.com", Timeout: 10 * time.Second}, wantErr: true},
+		{name: "zero timeout", cfg: WebhookConfig{URL: "https://hooks.example.com/todo"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAccountExportConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     AccountExportConfig
+		wantErr bool
+	}{
+		{name: "missing key", cfg: AccountExportConfig{TTL: 24 * time.Hour, CleanupInterval: time.Hour}, wantErr: true},
+		{name: "valid key", cfg: AccountExportConfig{SigningKey: strings.Repeat("k", 32), TTL: 24 * time.Hour, CleanupInterval: time.Hour}, wantErr: false},
+		{name: "short key", cfg: AccountExportConfig{SigningKey: "short", TTL: 24 * time.Hour, CleanupInterval: time.Hour}, wantErr: true},
+		{name: "zero ttl", cfg: AccountExportConfig{SigningKey: strings.Repeat("k", 32), CleanupInterval: time.Hour}, wantErr: true},
+		{name: "zero cleanup interval", cfg: AccountExportConfig{SigningKey: strings.Repeat("k", 32), TTL: 24 * time.Hour}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAccountExportConfig_String(t *testing.T) {
+	cfg := AccountExportConfig{SigningKey: "super-secret-signing-key", TTL: 24 * time.Hour, CleanupInterval: time.Hour}
+
+	result := cfg.String()
+
+	assert.NotContains(t, result, "super-secret")
+	assert.Contains(t, result, "SigningKey:***")
+}
+
 func TestLoad_Success(t *testing.T) {
 	// 環境変数を設定（t.Setenvを使用して自動クリーンアップ）
 	t.Setenv("POSTGRES_HOST", "localhost")
@@ -233,6 +522,7 @@ func TestLoad_Success(t *testing.T) {
 	t.Setenv("GOOGLE_CLIENT_ID", "client")
 	t.Setenv("GOOGLE_CLIENT_SECRET", "secret")
 	t.Setenv("OAUTH_CALLBACK_URL", "http://localhost:4000/callback")
+	t.Setenv("ACCOUNT_EXPORT_SIGNING_KEY", strings.Repeat("k", 32))
 
 	cfg, err := Load()
 
@@ -240,6 +530,21 @@ func TestLoad_Success(t *testing.T) {
 	assert.Equal(t, "localhost", cfg.Database.Host)
 	assert.Equal(t, 5432, cfg.Database.Port)
 	assert.Equal(t, "testdb", cfg.Database.Database)
+	assert.Equal(t, 24*time.Hour, cfg.Idempotency.TTL)
+	assert.Equal(t, time.Minute, cfg.Idempotency.LockTimeout)
+	assert.Equal(t, 2, cfg.Job.Workers)
+	assert.Equal(t, int32(3), cfg.Job.MaxAttempts)
+	assert.Equal(t, 100, cfg.Batch.MaxItems)
+	assert.Equal(t, 32, cfg.Position.MaxKeyLength)
+	assert.Equal(t, 10*time.Minute, cfg.Position.RebalanceInterval)
+	assert.Equal(t, 30*time.Second, cfg.Reminder.PollInterval)
+	assert.Equal(t, 2*time.Minute, cfg.Reminder.LeaseDuration)
+	assert.Equal(t, int32(5), cfg.Reminder.MaxAttempts)
+	assert.Equal(t, time.Minute, cfg.Reminder.RetryDelay)
+	assert.False(t, cfg.SMTP.Enabled())
+	assert.Equal(t, 587, cfg.SMTP.Port)
+	assert.False(t, cfg.Webhook.Enabled())
+	assert.Equal(t, "http://localhost:4000", cfg.Server.PublicURL)
 }
 
 func TestLoad_MissingRequired(t *testing.T) {
@@ -251,6 +556,25 @@ func TestLoad_MissingRequired(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestLoad_MissingAccountExportSigningKey(t *testing.T) {
+	// 署名の鍵だけを設定しない（起動時にエラーにする）
+	t.Setenv("POSTGRES_HOST", "localhost")
+	t.Setenv("POSTGRES_DB", "testdb")
+	t.Setenv("POSTGRES_USER", "user")
+	t.Setenv("POSTGRES_PASSWORD", "pass")
+	t.Setenv("REDIS_HOST", "localhost")
+	t.Setenv("GOOGLE_CLIENT_ID", "client")
+	t.Setenv("GOOGLE_CLIENT_SECRET", "secret")
+	t.Setenv("OAUTH_CALLBACK_URL", "http://localhost:4000/callback")
+	t.Setenv("ACCOUNT_EXPORT_SIGNING_KEY", "")
+	os.Unsetenv("ACCOUNT_EXPORT_SIGNING_KEY")
+
+	_, err := Load()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ACCOUNT_EXPORT_SIGNING_KEY")
+}
+
 func TestLoad_InvalidPort(t *testing.T) {
 	// 無効なポート番号を設定（t.Setenvを使用して自動クリーンアップ）
 	t.Setenv("POSTGRES_HOST", "localhost")
@@ -262,6 +586,7 @@ func TestLoad_InvalidPort(t *testing.T) {
 	t.Setenv("GOOGLE_CLIENT_ID", "client")
 	t.Setenv("GOOGLE_CLIENT_SECRET", "secret")
 	t.Setenv("OAUTH_CALLBACK_URL", "http://localhost:4000/callback")
+	t.Setenv("ACCOUNT_EXPORT_SIGNING_KEY", strings.Repeat("k", 32))
 
 	_, err := Load()
 
@@ -289,11 +614,41 @@ func TestConfig_Validate(t *testing.T) {
 				CallbackURL:        "http://localhost:4000/callback",
 			},
 			Server: ServerConfig{
-				Port: 4000,
+				Port:      4000,
+				PublicURL: "http://localhost:4000",
 			},
 			Frontend: FrontendConfig{
 				URL: "http://localhost:3000",
 			},
+			Idempotency: IdempotencyConfig{
+				TTL:             24 * time.Hour,
+				CleanupInterval: time.Hour,
+				LockTimeout:     time.Minute,
+			},
+			Job: JobConfig{
+				Workers:       2,
+				PollInterval:  2 * time.Second,
+				LeaseDuration: 5 * time.Minute,
+				MaxAttempts:   3,
+			},
+			Batch: BatchConfig{
+				MaxItems: 100,
+			},
+			Position: PositionConfig{
+				MaxKeyLength:      32,
+				RebalanceInterval: 10 * time.Minute,
+			},
+			Reminder: ReminderConfig{
+				PollInterval:  30 * time.Second,
+				LeaseDuration: 2 * time.Minute,
+				MaxAttempts:   5,
+				RetryDelay:    time.Minute,
+			},
+			Export: AccountExportConfig{
+				SigningKey:      strings.Repeat("k", 32),
+				TTL:             24 * time.Hour,
+				CleanupInterval: time.Hour,
+			},
 		}
 
 		err := cfg.Validate()
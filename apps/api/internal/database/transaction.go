@@ -14,14 +14,24 @@ type TxManager interface {
 
 type txManager struct {
 	pool *pgxpool.Pool
+	opts pgx.TxOptions
 }
 
 func NewTxManager(pool *pgxpool.Pool) TxManager {
 	return &txManager{pool: pool}
 }
 
+// 読み取り専用のスナップショット（REPEATABLE READ）でトランザクションを実行する
+// 複数のクエリに分けて読み込んでも、すべて同じ時点のデータになる
+func NewSnapshotTxManager(pool *pgxpool.Pool) TxManager {
+	return &txManager{
+		pool: pool,
+		opts: pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly},
+	}
+}
+
 func (m *txManager) RunInTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
-	tx, err := m.pool.Begin(ctx)
+	tx, err := m.pool.BeginTx(ctx, m.opts)
 	if err != nil {
 		return err
 	}
@@ -63,3 +63,33 @@ func TestTxManager_RunInTx_Rollback(t *testing.T) {
 
 	assert.ErrorIs(t, err, testErr)
 }
+
+func TestSnapshotTxManager_RunInTx_ReadOnly(t *testing.T) {
+	t.Skip("Integration test - requires database")
+
+	ctx := context.Background()
+	cfg := config.DatabaseConfig{
+		Host:     "localhost",
+		Port:     5432,
+		Database: "go_todo_db",
+		User:     "user",
+		Password: "pass",
+	}
+	pool, err := NewPool(ctx, cfg)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	tm := NewSnapshotTxManager(pool)
+
+	err = tm.RunInTx(ctx, func(tx pgx.Tx) error {
+		var isolation string
+		if err := tx.QueryRow(ctx, "SHOW transaction_isolation").Scan(&isolation); err != nil {
+			return err
+		}
+		assert.Equal(t, "repeatable read", isolation)
+		_, err := tx.Exec(ctx, "CREATE TEMP TABLE snapshot_test (id int)")
+		return err
+	})
+
+	assert.Error(t, err)
+}
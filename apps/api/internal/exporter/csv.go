@@ -0,0 +1,104 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 1行目に列名、2行目以降に1件ずつ書き出すCSV（RFC 4180）
+type csvWriter struct {
+	w       *csv.Writer
+	columns []Column
+	record  []string
+	started bool
+}
+
+func newCSVWriter(w io.Writer, columns []Column) *csvWriter {
+	return &csvWriter{
+		w:       csv.NewWriter(w),
+		columns: columns,
+		record:  make([]string, len(columns)),
+	}
+}
+
+func (c *csvWriter) Write(item *Item) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	for i, col := range c.columns {
+		c.record[i] = csvValue(item, col)
+	}
+	return c.w.Write(c.record)
+}
+
+// 0件でも列名の行は書き出す
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.started {
+		return nil
+	}
+	c.started = true
+	for i, col := range c.columns {
+		c.record[i] = string(col)
+	}
+	return c.w.Write(c.record)
+}
+
+func csvValue(item *Item, col Column) string {
+	switch col {
+	case ColumnID:
+		return strconv.FormatInt(item.ID, 10)
+	case ColumnClientID:
+		return item.ClientID
+	case ColumnTitle:
+		return csvText(item.Title)
+	case ColumnDescription:
+		if item.Description == nil {
+			return ""
+		}
+		return csvText(*item.Description)
+	case ColumnCompleted:
+		return strconv.FormatBool(item.Completed)
+	case ColumnStatus:
+		if item.Status == nil {
+			return ""
+		}
+		return csvText(*item.Status)
+	case ColumnDueAt:
+		return csvTime(item.DueAt)
+	case ColumnCreatedAt:
+		return csvTime(&item.CreatedAt)
+	case ColumnUpdatedAt:
+		return csvTime(&item.UpdatedAt)
+	case ColumnDeletedAt:
+		return csvTime(item.DeletedAt)
+	case ColumnVersion:
+		return strconv.FormatInt(int64(item.Version), 10)
+	}
+	return ""
+}
+
+func csvTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// 表計算ソフトが数式として解釈しないように、数式の開始文字で始まるテキストの先頭に ' を付ける
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,150 @@
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+var (
+	ErrUnknownFormat    = errors.New("unknown export format")
+	ErrUnknownColumn    = errors.New("unknown export column")
+	ErrDuplicateColumn  = errors.New("duplicate export column")
+	ErrNoColumnSelected = errors.New("no export column selected")
+)
+
+// 出力形式
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+)
+
+// 出力する列
+type Column string
+
+const (
+	ColumnID          Column = "id"
+	ColumnClientID    Column = "client_id"
+	ColumnTitle       Column = "title"
+	ColumnDescription Column = "description"
+	ColumnCompleted   Column = "completed"
+	ColumnStatus      Column = "status"
+	ColumnDueAt       Column = "due_at"
+	ColumnCreatedAt   Column = "created_at"
+	ColumnUpdatedAt   Column = "updated_at"
+	ColumnDeletedAt   Column = "deleted_at"
+	ColumnVersion     Column = "version"
+)
+
+// 列を指定しない場合の列（削除済みも出力する場合は deleted_at を加える）
+var defaultColumns = []Column{
+	ColumnID,
+	ColumnClientID,
+	ColumnTitle,
+	ColumnDescription,
+	ColumnCompleted,
+	ColumnStatus,
+	ColumnDueAt,
+	ColumnCreatedAt,
+	ColumnUpdatedAt,
+	ColumnVersion,
+}
+
+func DefaultColumns(includeDeleted bool) []Column {
+	columns := append([]Column{}, defaultColumns...)
+	if includeDeleted {
+		columns = append(columns, ColumnDeletedAt)
+	}
+	return columns
+}
+
+// 列名を検証して出力順の列にする
+func ParseColumns(names []string) ([]Column, error) {
+	if len(names) == 0 {
+		return nil, ErrNoColumnSelected
+	}
+	columns := make([]Column, 0, len(names))
+	seen := make(map[Column]bool, len(names))
+	for _, name := range names {
+		c := Column(name)
+		if !c.valid() {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownColumn, name)
+		}
+		if seen[c] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateColumn, name)
+		}
+		seen[c] = true
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
+
+func (c Column) valid() bool {
+	if c == ColumnDeletedAt {
+		return true
+	}
+	for _, d := range defaultColumns {
+		if c == d {
+			return true
+		}
+	}
+	return false
+}
+
+// 出力する1件分のTodo
+type Item struct {
+	ID          int64
+	ClientID    string
+	Title       string
+	Description *string
+	Completed   bool
+	Status      *string
+	DueAt       *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+	Version     int32
+}
+
+// 出力形式ごとのライターが実装するインターフェース
+// Write で1件ずつ書き出し、最後に Close で終端を書き出す（Close は下位の io.Writer を閉じない）
+type Writer interface {
+	Write(item *Item) error
+	Close() error
+}
+
+// 出力形式に対応するライターを作る
+func NewWriter(format Format, w io.Writer, columns []Column) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, columns), nil
+	case FormatJSON:
+		return newJSONWriter(w, columns, false), nil
+	case FormatNDJSON:
+		return newJSONWriter(w, columns, true), nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+}
+
+func (f Format) Valid() bool {
+	return f == FormatCSV || f == FormatJSON || f == FormatNDJSON
+}
+
+// 出力形式のContent-Typeとファイルの拡張子
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	}
+	return "application/json"
+}
+
+func (f Format) Extension() string {
+	return string(f)
+}
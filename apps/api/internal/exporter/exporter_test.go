@@ -0,0 +1,174 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testItems() []Item {
+	created := time.Date(2026, 10, 20, 9, 30, 0, 0, time.UTC)
+	due := time.Date(2026, 10, 30, 10, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	description := "line1\nline2, \"quoted\""
+	status := "doing"
+	return []Item{
+		{
+			ID:        1,
+			ClientID:  "0b7c6f5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f",
+			Title:     "Buy milk",
+			CreatedAt: created,
+			UpdatedAt: created,
+			Version:   1,
+		},
+		{
+			ID:          2,
+			ClientID:    "5f0e8d7c-6b5a-4938-8271-6a5b4c3d2e1f",
+			Title:       "=SUM(A1:A2)",
+			Description: &description,
+			Completed:   true,
+			Status:      &status,
+			DueAt:       &due,
+			CreatedAt:   created,
+			UpdatedAt:   created.Add(time.Hour),
+			Version:     3,
+		},
+	}
+}
+
+func writeAll(t *testing.T, format Format, columns []Column, items []Item) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(format, &buf, columns)
+	require.NoError(t, err)
+	for i := range items {
+		require.NoError(t, w.Write(&items[i]))
+	}
+	require.NoError(t, w.Close())
+	return buf.String()
+}
+
+func TestNewWriter(t *testing.T) {
+	t.Run("異常系: 未知の形式", func(t *testing.T) {
+		_, err := NewWriter("xml", &bytes.Buffer{}, DefaultColumns(false))
+
+		assert.ErrorIs(t, err, ErrUnknownFormat)
+	})
+}
+
+func TestCSVWriter(t *testing.T) {
+	t.Run("正常系: 列名の行に続けて1件ずつ書き出す", func(t *testing.T) {
+		got := writeAll(t, FormatCSV, []Column{ColumnID, ColumnTitle, ColumnDescription, ColumnCompleted, ColumnStatus, ColumnDueAt}, testItems())
+
+		want := "id,title,description,completed,status,due_at\n" +
+			"1,Buy milk,,false,,\n" +
+			"2,'=SUM(A1:A2),\"line1\nline2, \"\"quoted\"\"\",true,doing,2026-10-30T01:00:00Z\n"
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("正常系: 0件でも列名の行を書き出す", func(t *testing.T) {
+		got := writeAll(t, FormatCSV, []Column{ColumnID, ColumnTitle}, nil)
+
+		assert.Equal(t, "id,title\n", got)
+	})
+}
+
+func TestCSVText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "正常系: 通常のテキスト", in: "Buy milk", want: "Buy milk"},
+		{name: "正常系: 空", in: "", want: ""},
+		{name: "正常系: =で始まる", in: "=1+1", want: "'=1+1"},
+		{name: "正常系: +で始まる", in: "+81", want: "'+81"},
+		{name: "正常系: -で始まる", in: "-1", want: "'-1"},
+		{name: "正常系: @で始まる", in: "@SUM(1)", want: "'@SUM(1)"},
+		{name: "正常系: 途中の=はそのまま", in: "a=b", want: "a=b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, csvText(tt.in))
+		})
+	}
+}
+
+func TestJSONWriter(t *testing.T) {
+	t.Run("正常系: 列の順にキーを並べた配列を書き出す", func(t *testing.T) {
+		got := writeAll(t, FormatJSON, []Column{ColumnTitle, ColumnID, ColumnDueAt}, testItems())
+
+		want := "[\n" +
+			`{"title":"Buy milk","id":1,"due_at":null}` + ",\n" +
+			`{"title":"=SUM(A1:A2)","id":2,"due_at":"2026-10-30T01:00:00Z"}` + "\n]\n"
+		assert.Equal(t, want, got)
+		var decoded []map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(got), &decoded))
+	})
+
+	t.Run("正常系: 0件は空の配列", func(t *testing.T) {
+		got := writeAll(t, FormatJSON, DefaultColumns(false), nil)
+
+		assert.Equal(t, "[]\n", got)
+	})
+
+	t.Run("正常系: 削除済みを含む場合はdeleted_atを出力する", func(t *testing.T) {
+		items := testItems()[:1]
+		deleted := time.Date(2026, 10, 22, 0, 0, 0, 0, time.UTC)
+		items[0].DeletedAt = &deleted
+
+		got := writeAll(t, FormatJSON, DefaultColumns(true), items)
+
+		var decoded []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(got), &decoded))
+		require.Len(t, decoded, 1)
+		assert.Equal(t, "2026-10-22T00:00:00Z", decoded[0]["deleted_at"])
+		assert.Len(t, decoded[0], len(defaultColumns)+1)
+	})
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	t.Run("正常系: 1行に1件書き出す", func(t *testing.T) {
+		got := writeAll(t, FormatNDJSON, []Column{ColumnID, ColumnCompleted, ColumnStatus}, testItems())
+
+		want := `{"id":1,"completed":false,"status":null}` + "\n" +
+			`{"id":2,"completed":true,"status":"doing"}` + "\n"
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("正常系: 0件は空", func(t *testing.T) {
+		got := writeAll(t, FormatNDJSON, DefaultColumns(false), nil)
+
+		assert.Empty(t, got)
+	})
+}
+
+func TestParseColumns(t *testing.T) {
+	t.Run("正常系: 指定した順に並べる", func(t *testing.T) {
+		columns, err := ParseColumns([]string{"title", "id", "deleted_at"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []Column{ColumnTitle, ColumnID, ColumnDeletedAt}, columns)
+	})
+
+	t.Run("異常系: 未知の列", func(t *testing.T) {
+		_, err := ParseColumns([]string{"title", "user_id"})
+
+		assert.ErrorIs(t, err, ErrUnknownColumn)
+	})
+
+	t.Run("異常系: 重複した列", func(t *testing.T) {
+		_, err := ParseColumns([]string{"title", "title"})
+
+		assert.ErrorIs(t, err, ErrDuplicateColumn)
+	})
+
+	t.Run("異常系: 列が空", func(t *testing.T) {
+		_, err := ParseColumns(nil)
+
+		assert.ErrorIs(t, err, ErrNoColumnSelected)
+	})
+}
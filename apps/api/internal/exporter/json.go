@@ -0,0 +1,102 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// 列の順にキーを並べたJSONオブジェクトを書き出す
+// ndjson が true なら1行に1件、false なら全体を1つの配列にする
+type jsonWriter struct {
+	w       io.Writer
+	columns []Column
+	ndjson  bool
+	count   int
+	buf     bytes.Buffer
+}
+
+func newJSONWriter(w io.Writer, columns []Column, ndjson bool) *jsonWriter {
+	return &jsonWriter{w: w, columns: columns, ndjson: ndjson}
+}
+
+func (j *jsonWriter) Write(item *Item) error {
+	j.buf.Reset()
+	if !j.ndjson {
+		if j.count == 0 {
+			j.buf.WriteString("[\n")
+		} else {
+			j.buf.WriteString(",\n")
+		}
+	}
+	j.buf.WriteByte('{')
+	for i, col := range j.columns {
+		if i > 0 {
+			j.buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(string(col))
+		j.buf.Write(key)
+		j.buf.WriteByte(':')
+		value, err := json.Marshal(jsonValue(item, col))
+		if err != nil {
+			return err
+		}
+		j.buf.Write(value)
+	}
+	j.buf.WriteByte('}')
+	if j.ndjson {
+		j.buf.WriteByte('\n')
+	}
+	j.count++
+	_, err := j.w.Write(j.buf.Bytes())
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	if j.ndjson {
+		return nil
+	}
+	end := "\n]\n"
+	if j.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(j.w, end)
+	return err
+}
+
+func jsonValue(item *Item, col Column) any {
+	switch col {
+	case ColumnID:
+		return item.ID
+	case ColumnClientID:
+		return item.ClientID
+	case ColumnTitle:
+		return item.Title
+	case ColumnDescription:
+		return item.Description
+	case ColumnCompleted:
+		return item.Completed
+	case ColumnStatus:
+		return item.Status
+	case ColumnDueAt:
+		return jsonTime(item.DueAt)
+	case ColumnCreatedAt:
+		return jsonTime(&item.CreatedAt)
+	case ColumnUpdatedAt:
+		return jsonTime(&item.UpdatedAt)
+	case ColumnDeletedAt:
+		return jsonTime(item.DeletedAt)
+	case ColumnVersion:
+		return item.Version
+	}
+	return nil
+}
+
+func jsonTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
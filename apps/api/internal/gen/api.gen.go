@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -20,910 +21,6941 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for BatchFailedItemCode.
+const (
+	BatchFailedItemCodeBlocked    BatchFailedItemCode = "blocked"
+	BatchFailedItemCodeInvalidId  BatchFailedItemCode = "invalid_id"
+	BatchFailedItemCodeNotFound   BatchFailedItemCode = "not_found"
+	BatchFailedItemCodeRolledBack BatchFailedItemCode = "rolled_back"
+)
+
+// Defines values for BatchUpdateChangeFields.
+const (
+	BatchUpdateChangeFieldsCompleted   BatchUpdateChangeFields = "completed"
+	BatchUpdateChangeFieldsDescription BatchUpdateChangeFields = "description"
+	BatchUpdateChangeFieldsTitle       BatchUpdateChangeFields = "title"
+)
+
+// Defines values for BatchWarningCode.
+const (
+	AlreadyCompleted BatchWarningCode = "already_completed"
+	DuplicateId      BatchWarningCode = "duplicate_id"
+)
+
+// Defines values for CreateJobRequestType.
+const (
+	CompleteTodos CreateJobRequestType = "complete_todos"
+	DeleteTodos   CreateJobRequestType = "delete_todos"
+)
+
+// Defines values for CreateReminderRequestChannel.
+const (
+	CreateReminderRequestChannelEmail   CreateReminderRequestChannel = "email"
+	CreateReminderRequestChannelInApp   CreateReminderRequestChannel = "in_app"
+	CreateReminderRequestChannelWebhook CreateReminderRequestChannel = "webhook"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeAnchorNotFound                ErrorCode = "anchor_not_found"
+	ErrorCodeAuthenticationFailed          ErrorCode = "authentication_failed"
+	ErrorCodeBadRequest                    ErrorCode = "bad_request"
+	ErrorCodeBlockerNotFound               ErrorCode = "blocker_not_found"
+	ErrorCodeCalendarFeedNotFound          ErrorCode = "calendar_feed_not_found"
+	ErrorCodeChannelUnavailable            ErrorCode = "channel_unavailable"
+	ErrorCodeConflict                      ErrorCode = "conflict"
+	ErrorCodeDefaultProjectNotFound        ErrorCode = "default_project_not_found"
+	ErrorCodeDependencyCycle               ErrorCode = "dependency_cycle"
+	ErrorCodeDependencyNotFound            ErrorCode = "dependency_not_found"
+	ErrorCodeDownloadLinkExpired           ErrorCode = "download_link_expired"
+	ErrorCodeEmptyPatch                    ErrorCode = "empty_patch"
+	ErrorCodeFirstStatusWipLimit           ErrorCode = "first_status_wip_limit"
+	ErrorCodeForbidden                     ErrorCode = "forbidden"
+	ErrorCodeIdempotencyKeyInFlight        ErrorCode = "idempotency_key_in_flight"
+	ErrorCodeIdempotencyKeyReused          ErrorCode = "idempotency_key_reused"
+	ErrorCodeIdempotencyKeyTooLong         ErrorCode = "idempotency_key_too_long"
+	ErrorCodeIfMatchRequired               ErrorCode = "if_match_required"
+	ErrorCodeInternalError                 ErrorCode = "internal_error"
+	ErrorCodeInvalidColumns                ErrorCode = "invalid_columns"
+	ErrorCodeInvalidCursor                 ErrorCode = "invalid_cursor"
+	ErrorCodeInvalidDownloadLink           ErrorCode = "invalid_download_link"
+	ErrorCodeInvalidExport                 ErrorCode = "invalid_export"
+	ErrorCodeInvalidFilter                 ErrorCode = "invalid_filter"
+	ErrorCodeInvalidFormat                 ErrorCode = "invalid_format"
+	ErrorCodeInvalidId                     ErrorCode = "invalid_id"
+	ErrorCodeInvalidIfMatch                ErrorCode = "invalid_if_match"
+	ErrorCodeInvalidImportFile             ErrorCode = "invalid_import_file"
+	ErrorCodeInvalidNotificationPreference ErrorCode = "invalid_notification_preference"
+	ErrorCodeInvalidPositionAnchor         ErrorCode = "invalid_position_anchor"
+	ErrorCodeInvalidReminder               ErrorCode = "invalid_reminder"
+	ErrorCodeInvalidRequestBody            ErrorCode = "invalid_request_body"
+	ErrorCodeInvalidStatus                 ErrorCode = "invalid_status"
+	ErrorCodeInvalidStatusSet              ErrorCode = "invalid_status_set"
+	ErrorCodeInvalidSyncToken              ErrorCode = "invalid_sync_token"
+	ErrorCodeInvalidTimezone               ErrorCode = "invalid_timezone"
+	ErrorCodeInvalidTokenName              ErrorCode = "invalid_token_name"
+	ErrorCodeInvalidUserSettings           ErrorCode = "invalid_user_settings"
+	ErrorCodeJobFinished                   ErrorCode = "job_finished"
+	ErrorCodeJobNotFound                   ErrorCode = "job_not_found"
+	ErrorCodeMethodNotAllowed              ErrorCode = "method_not_allowed"
+	ErrorCodeNotFound                      ErrorCode = "not_found"
+	ErrorCodeNotificationNotFound          ErrorCode = "notification_not_found"
+	ErrorCodePersonalAccessTokenNotFound   ErrorCode = "personal_access_token_not_found"
+	ErrorCodeReminderNotFound              ErrorCode = "reminder_not_found"
+	ErrorCodeRequestTooLarge               ErrorCode = "request_too_large"
+	ErrorCodeSelfDependency                ErrorCode = "self_dependency"
+	ErrorCodeServiceUnavailable            ErrorCode = "service_unavailable"
+	ErrorCodeStatusNameConflict            ErrorCode = "status_name_conflict"
+	ErrorCodeStatusNotFound                ErrorCode = "status_not_found"
+	ErrorCodeTitleEmpty                    ErrorCode = "title_empty"
+	ErrorCodeTitleRequired                 ErrorCode = "title_required"
+	ErrorCodeTodoBlocked                   ErrorCode = "todo_blocked"
+	ErrorCodeTodoNotFound                  ErrorCode = "todo_not_found"
+	ErrorCodeTooManyIds                    ErrorCode = "too_many_ids"
+	ErrorCodeTooManyImportItems            ErrorCode = "too_many_import_items"
+	ErrorCodeTooManyItems                  ErrorCode = "too_many_items"
+	ErrorCodeTooManyOperations             ErrorCode = "too_many_operations"
+	ErrorCodeTooManyRequests               ErrorCode = "too_many_requests"
+	ErrorCodeUnauthorized                  ErrorCode = "unauthorized"
+	ErrorCodeUnknownImportSource           ErrorCode = "unknown_import_source"
+	ErrorCodeUnknownJobType                ErrorCode = "unknown_job_type"
+	ErrorCodeUnknownStatus                 ErrorCode = "unknown_status"
+	ErrorCodeUnsupportedContentType        ErrorCode = "unsupported_content_type"
+	ErrorCodeUserNotFound                  ErrorCode = "user_not_found"
+	ErrorCodeValidationFailed              ErrorCode = "validation_failed"
+	ErrorCodeWipLimitExceeded              ErrorCode = "wip_limit_exceeded"
+)
+
+// Defines values for FieldErrorIn.
+const (
+	FieldErrorInBody   FieldErrorIn = "body"
+	FieldErrorInHeader FieldErrorIn = "header"
+	FieldErrorInPath   FieldErrorIn = "path"
+	FieldErrorInQuery  FieldErrorIn = "query"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusCanceled  JobStatus = "canceled"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+)
+
+// Defines values for NotificationType.
+const (
+	NotificationTypeAdmin      NotificationType = "admin"
+	NotificationTypeReminder   NotificationType = "reminder"
+	NotificationTypeSharedList NotificationType = "shared_list"
+)
+
+// Defines values for NotificationPreferenceType.
+const (
+	NotificationPreferenceTypeAdmin      NotificationPreferenceType = "admin"
+	NotificationPreferenceTypeReminder   NotificationPreferenceType = "reminder"
+	NotificationPreferenceTypeSharedList NotificationPreferenceType = "shared_list"
+)
+
+// Defines values for QuickAddPreviewPriority.
+const (
+	QuickAddPreviewPriorityHigh   QuickAddPreviewPriority = "high"
+	QuickAddPreviewPriorityLow    QuickAddPreviewPriority = "low"
+	QuickAddPreviewPriorityMedium QuickAddPreviewPriority = "medium"
+)
+
+// Defines values for ReminderChannel.
+const (
+	ReminderChannelEmail   ReminderChannel = "email"
+	ReminderChannelInApp   ReminderChannel = "in_app"
+	ReminderChannelWebhook ReminderChannel = "webhook"
+)
+
+// Defines values for ReminderStatus.
+const (
+	ReminderStatusCanceled ReminderStatus = "canceled"
+	ReminderStatusFailed   ReminderStatus = "failed"
+	ReminderStatusPending  ReminderStatus = "pending"
+	ReminderStatusSent     ReminderStatus = "sent"
+)
+
+// Defines values for SyncConflictField.
+const (
+	SyncConflictFieldCompleted   SyncConflictField = "completed"
+	SyncConflictFieldDeleted     SyncConflictField = "deleted"
+	SyncConflictFieldDescription SyncConflictField = "description"
+	SyncConflictFieldTitle       SyncConflictField = "title"
+)
+
+// Defines values for SyncConflictResolution.
+const (
+	Client SyncConflictResolution = "client"
+	Server SyncConflictResolution = "server"
+)
+
+// Defines values for SyncOperationOp.
+const (
+	Delete SyncOperationOp = "delete"
+	Upsert SyncOperationOp = "upsert"
+)
+
+// Defines values for SyncOperationResultStatus.
+const (
+	Created   SyncOperationResultStatus = "created"
+	Deleted   SyncOperationResultStatus = "deleted"
+	Rejected  SyncOperationResultStatus = "rejected"
+	Unchanged SyncOperationResultStatus = "unchanged"
+	Updated   SyncOperationResultStatus = "updated"
+)
+
+// Defines values for TodoPriority.
+const (
+	TodoPriorityHigh   TodoPriority = "high"
+	TodoPriorityLow    TodoPriority = "low"
+	TodoPriorityMedium TodoPriority = "medium"
+)
+
+// Defines values for UpdateUserSettingsRequestDefaultSort.
+const (
+	UpdateUserSettingsRequestDefaultSortCreatedAt UpdateUserSettingsRequestDefaultSort = "created_at"
+	UpdateUserSettingsRequestDefaultSortManual    UpdateUserSettingsRequestDefaultSort = "manual"
+)
+
+// Defines values for UserSettingsDefaultSort.
+const (
+	UserSettingsDefaultSortCreatedAt UserSettingsDefaultSort = "created_at"
+	UserSettingsDefaultSortManual    UserSettingsDefaultSort = "manual"
+)
+
+// Defines values for ListTodosParamsSort.
+const (
+	ListTodosParamsSortCreatedAt ListTodosParamsSort = "created_at"
+	ListTodosParamsSortManual    ListTodosParamsSort = "manual"
+)
+
+// Defines values for ExportTodosParamsFormat.
+const (
+	Csv    ExportTodosParamsFormat = "csv"
+	Json   ExportTodosParamsFormat = "json"
+	Ndjson ExportTodosParamsFormat = "ndjson"
+)
+
+// Defines values for ExportTodosParamsColumns.
+const (
+	ExportTodosParamsColumnsClientId    ExportTodosParamsColumns = "client_id"
+	ExportTodosParamsColumnsCompleted   ExportTodosParamsColumns = "completed"
+	ExportTodosParamsColumnsCreatedAt   ExportTodosParamsColumns = "created_at"
+	ExportTodosParamsColumnsDeletedAt   ExportTodosParamsColumns = "deleted_at"
+	ExportTodosParamsColumnsDescription ExportTodosParamsColumns = "description"
+	ExportTodosParamsColumnsDueAt       ExportTodosParamsColumns = "due_at"
+	ExportTodosParamsColumnsId          ExportTodosParamsColumns = "id"
+	ExportTodosParamsColumnsStatus      ExportTodosParamsColumns = "status"
+	ExportTodosParamsColumnsTitle       ExportTodosParamsColumns = "title"
+	ExportTodosParamsColumnsUpdatedAt   ExportTodosParamsColumns = "updated_at"
+	ExportTodosParamsColumnsVersion     ExportTodosParamsColumns = "version"
+)
+
+// Defines values for ImportExternalTodosParamsSource.
+const (
+	Todoist ImportExternalTodosParamsSource = "todoist"
+	Trello  ImportExternalTodosParamsSource = "trello"
+)
+
+// AddTodoBlockerRequest defines model for AddTodoBlockerRequest.
+type AddTodoBlockerRequest struct {
+	// BlockerId Todo that must be finished first
+	BlockerId int64 `json:"blocker_id"`
+}
+
 // BatchCompleteResponse defines model for BatchCompleteResponse.
 type BatchCompleteResponse struct {
 	Failed    []BatchFailedItem `json:"failed"`
 	Succeeded []Todo            `json:"succeeded"`
+	Warnings  []BatchWarning    `json:"warnings"`
+}
+
+// BatchCreateFailedItem defines model for BatchCreateFailedItem.
+type BatchCreateFailedItem struct {
+	Error string `json:"error"`
+
+	// Index Position of the item in the request
+	Index int `json:"index"`
+}
+
+// BatchCreateRequest defines model for BatchCreateRequest.
+type BatchCreateRequest struct {
+	// Items The maximum number of items is configured on the server (100 by default)
+	Items []CreateTodoRequest `json:"items"`
+}
+
+// BatchCreateResponse defines model for BatchCreateResponse.
+type BatchCreateResponse struct {
+	Failed    []BatchCreateFailedItem `json:"failed"`
+	Succeeded []BatchCreatedItem      `json:"succeeded"`
+}
+
+// BatchCreatedItem defines model for BatchCreatedItem.
+type BatchCreatedItem struct {
+	// Index Position of the item in the request
+	Index int  `json:"index"`
+	Todo  Todo `json:"todo"`
 }
 
 // BatchDeleteResponse defines model for BatchDeleteResponse.
 type BatchDeleteResponse struct {
 	Failed    []BatchFailedItem `json:"failed"`
 	Succeeded []int64           `json:"succeeded"`
+	Warnings  []BatchWarning    `json:"warnings"`
 }
 
 // BatchFailedItem defines model for BatchFailedItem.
 type BatchFailedItem struct {
-	Error string `json:"error"`
-	Id    int64  `json:"id"`
+	// Code Machine-readable reason. not_found is also reported for todos owned by other users. rolled_back means the todo was valid but nothing was applied because another todo in an atomic batch failed. blocked means the todo still has open blockers; retry with force=true to complete it anyway
+	Code  BatchFailedItemCode `json:"code"`
+	Error string              `json:"error"`
+	Id    int64               `json:"id"`
 }
 
+// BatchFailedItemCode Machine-readable reason. not_found is also reported for todos owned by other users. rolled_back means the todo was valid but nothing was applied because another todo in an atomic batch failed. blocked means the todo still has open blockers; retry with force=true to complete it anyway
+type BatchFailedItemCode string
+
 // BatchTodoRequest defines model for BatchTodoRequest.
 type BatchTodoRequest struct {
+	// Ids Todo IDs. Duplicates are processed once and reported in warnings. The maximum number of IDs is configured on the server (100 by default)
 	Ids []int64 `json:"ids"`
 }
 
-// CreateTodoRequest defines model for CreateTodoRequest.
-type CreateTodoRequest struct {
-	Description *string `json:"description,omitempty"`
-	Title       string  `json:"title"`
+// BatchUpdateChange defines model for BatchUpdateChange.
+type BatchUpdateChange struct {
+	Fields []BatchUpdateChangeFields `json:"fields"`
+	Id     int64                     `json:"id"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Message string `json:"message"`
+// BatchUpdateChangeFields defines model for BatchUpdateChange.Fields.
+type BatchUpdateChangeFields string
+
+// BatchUpdateRequest defines model for BatchUpdateRequest.
+type BatchUpdateRequest struct {
+	// Ids Todo IDs. Duplicates are processed once and reported in warnings. The maximum number of IDs is configured on the server (100 by default)
+	Ids   []int64           `json:"ids"`
+	Patch UpdateTodoRequest `json:"patch"`
 }
 
-// HealthResponse defines model for HealthResponse.
-type HealthResponse struct {
-	Status string `json:"status"`
+// BatchUpdateResponse defines model for BatchUpdateResponse.
+type BatchUpdateResponse struct {
+	// Changes Fields whose value changes for each todo
+	Changes []BatchUpdateChange `json:"changes"`
+	DryRun  bool                `json:"dry_run"`
+	Failed  []BatchFailedItem   `json:"failed"`
+
+	// Succeeded Updated todos. In dry-run mode, the todos as they would be after the update
+	Succeeded []Todo         `json:"succeeded"`
+	Warnings  []BatchWarning `json:"warnings"`
 }
 
-// InfoResponse defines model for InfoResponse.
-type InfoResponse struct {
-	Name    string `json:"name"`
-	Version string `json:"version"`
+// BatchWarning An adjustment made to the request that did not cause the item to fail
+type BatchWarning struct {
+	// Code already_completed means the todo was already completed; it is reported in succeeded unchanged
+	Code    BatchWarningCode `json:"code"`
+	Id      int64            `json:"id"`
+	Message string           `json:"message"`
 }
 
-// Todo defines model for Todo.
-type Todo struct {
-	Completed   bool      `json:"completed"`
-	CreatedAt   time.Time `json:"created_at"`
-	Description *string   `json:"description,omitempty"`
-	Id          int64     `json:"id"`
-	Title       string    `json:"title"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	UserId      int64     `json:"user_id"`
+// BatchWarningCode already_completed means the todo was already completed; it is reported in succeeded unchanged
+type BatchWarningCode string
+
+// Board defines model for Board.
+type Board struct {
+	Columns []BoardColumn `json:"columns"`
 }
 
-// UpdateTodoRequest defines model for UpdateTodoRequest.
-type UpdateTodoRequest struct {
-	Completed   *bool   `json:"completed,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Title       *string `json:"title,omitempty"`
+// BoardColumn defines model for BoardColumn.
+type BoardColumn struct {
+	Status Status `json:"status"`
+
+	// Todos Todos in manual order
+	Todos []Todo `json:"todos"`
 }
 
-// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
-type CreateTodoJSONRequestBody = CreateTodoRequest
+// BulkTodoFilter Selects the todos a bulk job operates on. Omitted criteria match every todo
+type BulkTodoFilter struct {
+	// Completed Only todos with this completion state
+	Completed *bool `json:"completed,omitempty"`
 
-// BatchCompleteTodosJSONRequestBody defines body for BatchCompleteTodos for application/json ContentType.
-type BatchCompleteTodosJSONRequestBody = BatchTodoRequest
+	// CreatedBefore Only todos created before this time
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+	Ids           *[]int64   `json:"ids,omitempty"`
 
-// BatchDeleteTodosJSONRequestBody defines body for BatchDeleteTodos for application/json ContentType.
-type BatchDeleteTodosJSONRequestBody = BatchTodoRequest
+	// ProjectId Only todos in this project
+	ProjectId *int64 `json:"project_id,omitempty"`
+}
 
-// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
-type UpdateTodoJSONRequestBody = UpdateTodoRequest
+// CalendarFeed defines model for CalendarFeed.
+type CalendarFeed struct {
+	// Token Secret token contained in the URL. It is only returned when issued
+	Token string `json:"token"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// API information
-	// (GET /)
-	GetInfo(ctx echo.Context) error
-	// Health check
-	// (GET /health)
-	GetHealth(ctx echo.Context) error
-	// List all todos
-	// (GET /todos)
-	ListTodos(ctx echo.Context) error
-	// Create a new todo
-	// (POST /todos)
-	CreateTodo(ctx echo.Context) error
-	// Batch complete todos
-	// (POST /todos/batch/complete)
-	BatchCompleteTodos(ctx echo.Context) error
-	// Batch delete todos
-	// (POST /todos/batch/delete)
-	BatchDeleteTodos(ctx echo.Context) error
-	// Delete a todo
-	// (DELETE /todos/{id})
-	DeleteTodo(ctx echo.Context, id int) error
-	// Get a todo by ID
-	// (GET /todos/{id})
-	GetTodo(ctx echo.Context, id int) error
-	// Update a todo
-	// (PUT /todos/{id})
-	UpdateTodo(ctx echo.Context, id int) error
+	// Url Secret feed URL to subscribe to from a calendar app. Anyone with this URL can read the todos
+	Url string `json:"url"`
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// CreateJobRequest defines model for CreateJobRequest.
+type CreateJobRequest struct {
+	// Filter Selects the todos a bulk job operates on. Omitted criteria match every todo
+	Filter BulkTodoFilter `json:"filter"`
+
+	// Force complete_todos only. Complete todos even if they still have open blockers. Otherwise such todos are skipped and counted in result.skipped
+	Force *bool                `json:"force,omitempty"`
+	Type  CreateJobRequestType `json:"type"`
 }
 
-// GetInfo converts echo context to params.
-func (w *ServerInterfaceWrapper) GetInfo(ctx echo.Context) error {
-	var err error
+// CreateJobRequestType defines model for CreateJobRequest.Type.
+type CreateJobRequestType string
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetInfo(ctx)
-	return err
+// CreatePersonalAccessTokenRequest defines model for CreatePersonalAccessTokenRequest.
+type CreatePersonalAccessTokenRequest struct {
+	// Name Label to tell tokens apart, such as the device or app that uses it
+	Name string `json:"name"`
 }
 
-// GetHealth converts echo context to params.
-func (w *ServerInterfaceWrapper) GetHealth(ctx echo.Context) error {
-	var err error
+// CreateReminderRequest Exactly one of remind_at and offset_minutes is required
+type CreateReminderRequest struct {
+	Channel CreateReminderRequestChannel `json:"channel"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetHealth(ctx)
-	return err
+	// OffsetMinutes Minutes relative to the due date (negative = before)
+	OffsetMinutes *int32     `json:"offset_minutes,omitempty"`
+	RemindAt      *time.Time `json:"remind_at,omitempty"`
 }
 
-// ListTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) ListTodos(ctx echo.Context) error {
-	var err error
+// CreateReminderRequestChannel defines model for CreateReminderRequest.Channel.
+type CreateReminderRequestChannel string
 
-	ctx.Set(CookieAuthScopes, []string{})
+// CreateStatusRequest defines model for CreateStatusRequest.
+type CreateStatusRequest struct {
+	// IsDone Make this the done status, replacing the current one
+	IsDone *bool  `json:"is_done,omitempty"`
+	Name   string `json:"name"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListTodos(ctx)
-	return err
+	// WipLimit 0 or omitted means unlimited. Not allowed on the done status or the first open status
+	WipLimit *int32 `json:"wip_limit,omitempty"`
 }
 
-// CreateTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateTodo(ctx echo.Context) error {
-	var err error
+// CreateTodoRequest defines model for CreateTodoRequest.
+type CreateTodoRequest struct {
+	Description *string    `json:"description,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Title       string     `json:"title"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// CreatedPersonalAccessToken defines model for CreatedPersonalAccessToken.
+type CreatedPersonalAccessToken struct {
+	PersonalAccessToken PersonalAccessToken `json:"personal_access_token"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateTodo(ctx)
-	return err
+	// Token Secret token. Use it as the password of HTTP Basic authentication for CalDAV. It is only returned when issued
+	Token string `json:"token"`
 }
 
-// BatchCompleteTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) BatchCompleteTodos(ctx echo.Context) error {
-	var err error
+// ErrorCode Stable machine-readable error code. Clients should branch on this instead of the title
+type ErrorCode string
 
-	ctx.Set(CookieAuthScopes, []string{})
+// FieldError A problem with a single request field
+type FieldError struct {
+	// Field Parameter name, or JSON Pointer (RFC 6901) into the request body such as /items/0/title
+	Field string       `json:"field"`
+	In    FieldErrorIn `json:"in"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.BatchCompleteTodos(ctx)
-	return err
+	// Message Untranslated description of what is wrong with the field
+	Message string `json:"message"`
 }
 
-// BatchDeleteTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) BatchDeleteTodos(ctx echo.Context) error {
-	var err error
+// FieldErrorIn defines model for FieldError.In.
+type FieldErrorIn string
 
-	ctx.Set(CookieAuthScopes, []string{})
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	Status string `json:"status"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.BatchDeleteTodos(ctx)
-	return err
+// ImportLineError defines model for ImportLineError.
+type ImportLineError struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
 }
 
-// DeleteTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+// ImportPreviewItem defines model for ImportPreviewItem.
+type ImportPreviewItem struct {
+	Completed   bool       `json:"completed"`
+	Description *string    `json:"description,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Line        int        `json:"line"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+	// Metadata Format-specific data that does not map onto a todo field, such as todo.txt priority or unmapped CSV columns. It is not saved
+	Metadata *map[string]string `json:"metadata,omitempty"`
+	Title    string             `json:"title"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// ImportResponse defines model for ImportResponse.
+type ImportResponse struct {
+	// Errors Line-numbered errors. Only the first 100 are reported
+	Errors []ImportLineError `json:"errors"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTodo(ctx, id)
-	return err
+	// Failed Number of lines that were skipped because of errors
+	Failed   int   `json:"failed"`
+	Imported int64 `json:"imported"`
+
+	// Items Parsed todos that would be imported. Only returned in preview mode, and only the first 1000
+	Items *[]ImportPreviewItem `json:"items,omitempty"`
+
+	// Skipped Number of todos that were skipped as duplicates (dedupe)
+	Skipped int `json:"skipped"`
 }
 
-// GetTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+// InfoResponse defines model for InfoResponse.
+type InfoResponse struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// Job defines model for Job.
+type Job struct {
+	CancelRequested bool       `json:"cancel_requested"`
+	CreatedAt       time.Time  `json:"created_at"`
+	Error           *string    `json:"error,omitempty"`
+	FinishedAt      *time.Time `json:"finished_at,omitempty"`
+	Id              int64      `json:"id"`
+
+	// Processed Number of items processed so far
+	Processed int32 `json:"processed"`
+
+	// Result Job-specific result, set when the job has finished
+	Result    *map[string]interface{} `json:"result,omitempty"`
+	StartedAt *time.Time              `json:"started_at,omitempty"`
+	Status    JobStatus               `json:"status"`
+
+	// Total Number of items the job is expected to process
+	Total int32  `json:"total"`
+	Type  string `json:"type"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// JobStatus defines model for Job.Status.
+type JobStatus string
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTodo(ctx, id)
-	return err
+// MarkAllNotificationsReadResponse defines model for MarkAllNotificationsReadResponse.
+type MarkAllNotificationsReadResponse struct {
+	// Marked Number of notifications marked as read
+	Marked int64 `json:"marked"`
 }
 
-// UpdateTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int
+// MoveTodoRequest At least one of before_id or after_id is required. When both are given, the todo is placed between them
+type MoveTodoRequest struct {
+	// AfterId Place the todo immediately after this todo
+	AfterId *int64 `json:"after_id,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+	// BeforeId Place the todo immediately before this todo
+	BeforeId *int64 `json:"before_id,omitempty"`
+}
 
-	ctx.Set(CookieAuthScopes, []string{})
+// Notification defines model for Notification.
+type Notification struct {
+	Body      string           `json:"body"`
+	CreatedAt time.Time        `json:"created_at"`
+	Id        int64            `json:"id"`
+	ReadAt    *time.Time       `json:"read_at,omitempty"`
+	Title     string           `json:"title"`
+	TodoId    *int64           `json:"todo_id,omitempty"`
+	Type      NotificationType `json:"type"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateTodo(ctx, id)
-	return err
+// NotificationType defines model for Notification.Type.
+type NotificationType string
+
+// NotificationPage defines model for NotificationPage.
+type NotificationPage struct {
+	// NextCursor Cursor for the next page. Omitted on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Notifications Notifications, newest first
+	Notifications []Notification `json:"notifications"`
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// NotificationPreference defines model for NotificationPreference.
+type NotificationPreference struct {
+	// Enabled Whether notifications of this type are created. admin notifications cannot be disabled
+	Enabled bool                       `json:"enabled"`
+	Type    NotificationPreferenceType `json:"type"`
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+// NotificationPreferenceType defines model for NotificationPreference.Type.
+type NotificationPreferenceType string
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	Preferences []NotificationPreference `json:"preferences"`
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// NotificationUnreadCount defines model for NotificationUnreadCount.
+type NotificationUnreadCount struct {
+	Count int64 `json:"count"`
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+// PersonalAccessToken defines model for PersonalAccessToken.
+type PersonalAccessToken struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
 
-	router.GET(baseURL+"/", wrapper.GetInfo)
-	router.GET(baseURL+"/health", wrapper.GetHealth)
-	router.GET(baseURL+"/todos", wrapper.ListTodos)
-	router.POST(baseURL+"/todos", wrapper.CreateTodo)
-	router.POST(baseURL+"/todos/batch/complete", wrapper.BatchCompleteTodos)
-	router.POST(baseURL+"/todos/batch/delete", wrapper.BatchDeleteTodos)
-	router.DELETE(baseURL+"/todos/:id", wrapper.DeleteTodo)
-	router.GET(baseURL+"/todos/:id", wrapper.GetTodo)
-	router.PUT(baseURL+"/todos/:id", wrapper.UpdateTodo)
+	// LastUsedAt Omitted if the token has never been used
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+}
 
+// PersonalAccessTokens defines model for PersonalAccessTokens.
+type PersonalAccessTokens struct {
+	Tokens []PersonalAccessToken `json:"tokens"`
 }
 
-type GetInfoRequestObject struct {
+// Problem Error response in the RFC 7807 Problem Details format (application/problem+json)
+type Problem struct {
+	// Code Stable machine-readable error code. Clients should branch on this instead of the title
+	Code ErrorCode `json:"code"`
+
+	// Detail Untranslated explanation specific to this occurrence. Omitted when there is none
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Field-level errors. Only present for validation failures
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance Path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId ID of the request, also returned in the X-Request-ID header. Include it when reporting the problem
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short human-readable summary in the language chosen by Accept-Language, or by the locale user setting when the header is absent. English and Japanese are available
+	Title string `json:"title"`
+
+	// Type URI identifying the problem type, urn:go-todo:problem:{code}
+	Type string `json:"type"`
 }
 
-type GetInfoResponseObject interface {
-	VisitGetInfoResponse(w http.ResponseWriter) error
+// Project defines model for Project.
+type Project struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-type GetInfo200JSONResponse InfoResponse
+// QuickAddPreview defines model for QuickAddPreview.
+type QuickAddPreview struct {
+	// AllDay True when only a date was given. due_at is then midnight in the requested time zone
+	AllDay bool `json:"all_day"`
 
-func (response GetInfo200JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Ambiguities Expressions that could be read in more than one way, with the reading that was chosen
+	Ambiguities []string                 `json:"ambiguities"`
+	DueAt       *time.Time               `json:"due_at,omitempty"`
+	Priority    *QuickAddPreviewPriority `json:"priority,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Project Project name. The project is created on save if it does not exist
+	Project *string `json:"project,omitempty"`
 
-type GetHealthRequestObject struct {
+	// Recurrence iCalendar RRULE
+	Recurrence *string  `json:"recurrence,omitempty"`
+	Tags       []string `json:"tags"`
+
+	// Title Text left after removing the recognized expressions
+	Title string `json:"title"`
 }
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
+// QuickAddPreviewPriority defines model for QuickAddPreview.Priority.
+type QuickAddPreviewPriority string
+
+// QuickAddRequest defines model for QuickAddRequest.
+type QuickAddRequest struct {
+	// DayFirst Read ambiguous numeric dates such as 3/4 as day/month instead of month/day. Defaults to the order used in the region of the locale user setting
+	DayFirst    *bool   `json:"day_first,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Text Natural-language todo such as "Pay rent tomorrow 9am #home !high"
+	Text string `json:"text"`
+
+	// Timezone IANA time zone used to interpret dates and times (e.g. Asia/Tokyo). Defaults to the timezone user setting
+	Timezone *string `json:"timezone,omitempty"`
 }
 
-type GetHealth200JSONResponse HealthResponse
+// Reminder defines model for Reminder.
+type Reminder struct {
+	Attempts  int32           `json:"attempts"`
+	Channel   ReminderChannel `json:"channel"`
+	CreatedAt time.Time       `json:"created_at"`
+	FiredAt   *time.Time      `json:"fired_at,omitempty"`
+	Id        int64           `json:"id"`
+	LastError *string         `json:"last_error,omitempty"`
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// OffsetMinutes Delivery time relative to the todo's due date. Negative values fire before the due date
+	OffsetMinutes *int32 `json:"offset_minutes,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// RemindAt Absolute delivery time
+	RemindAt *time.Time `json:"remind_at,omitempty"`
+
+	// ScheduledAt Computed delivery time. Omitted when the reminder is relative and the todo has no due date
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+
+	// Status canceled means the todo was completed or deleted before delivery
+	Status ReminderStatus `json:"status"`
+	TodoId int64          `json:"todo_id"`
 }
 
-type ListTodosRequestObject struct {
+// ReminderChannel defines model for Reminder.Channel.
+type ReminderChannel string
+
+// ReminderStatus canceled means the todo was completed or deleted before delivery
+type ReminderStatus string
+
+// SetTodoDueRequest defines model for SetTodoDueRequest.
+type SetTodoDueRequest struct {
+	// DueAt New due date. null or omitted clears it
+	DueAt *time.Time `json:"due_at"`
 }
 
-type ListTodosResponseObject interface {
-	VisitListTodosResponse(w http.ResponseWriter) error
+// SetTodoStatusRequest defines model for SetTodoStatusRequest.
+type SetTodoStatusRequest struct {
+	StatusId int64 `json:"status_id"`
 }
 
-type ListTodos200JSONResponse []Todo
+// Status defines model for Status.
+type Status struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
 
-func (response ListTodos200JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// IsDone Whether todos in this status are completed. Exactly one status per user is the done status
+	IsDone bool   `json:"is_done"`
+	Name   string `json:"name"`
 
-	return json.NewEncoder(w).Encode(response)
+	// SortOrder Column order on the board (ascending, ties broken by id)
+	SortOrder int32     `json:"sort_order"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// WipLimit Maximum number of todos that can be moved into this status. Omitted when unlimited. Never set on the done status or the first open status, which todos enter implicitly when created, imported, reopened or when their status is deleted
+	WipLimit *int32 `json:"wip_limit,omitempty"`
 }
 
-type ListTodos401JSONResponse ErrorResponse
+// SyncChange defines model for SyncChange.
+type SyncChange struct {
+	ChangeSeq int64 `json:"change_seq"`
+	Deleted   bool  `json:"deleted"`
+	Todo      Todo  `json:"todo"`
+}
 
-func (response ListTodos401JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// SyncConflict defines model for SyncConflict.
+type SyncConflict struct {
+	ClientId    openapi_types.UUID `json:"client_id"`
+	ClientValue interface{}        `json:"client_value,omitempty"`
+	Field       SyncConflictField  `json:"field"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Resolution Which side's value was kept
+	Resolution  SyncConflictResolution `json:"resolution"`
+	ServerValue interface{}            `json:"server_value,omitempty"`
 }
 
-type ListTodos500JSONResponse ErrorResponse
+// SyncConflictField defines model for SyncConflict.Field.
+type SyncConflictField string
 
-func (response ListTodos500JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// SyncConflictResolution Which side's value was kept
+type SyncConflictResolution string
 
-	return json.NewEncoder(w).Encode(response)
+// SyncOperation defines model for SyncOperation.
+type SyncOperation struct {
+	ClientId  openapi_types.UUID `json:"client_id"`
+	Completed *bool              `json:"completed,omitempty"`
+
+	// Description null clears the description. Omit to leave it unchanged
+	Description json.RawMessage `json:"description"`
+
+	// Force Complete the todo even if it still has open blockers. Otherwise such an operation is rejected
+	Force *bool           `json:"force,omitempty"`
+	Op    SyncOperationOp `json:"op"`
+	Title *string         `json:"title,omitempty"`
+
+	// UpdatedAt When the change was made on the client. Used for per-field last-writer-wins
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-type CreateTodoRequestObject struct {
-	Body *CreateTodoJSONRequestBody
+// SyncOperationOp defines model for SyncOperation.Op.
+type SyncOperationOp string
+
+// SyncOperationResult defines model for SyncOperationResult.
+type SyncOperationResult struct {
+	ClientId openapi_types.UUID        `json:"client_id"`
+	Error    *string                   `json:"error,omitempty"`
+	Id       *int64                    `json:"id,omitempty"`
+	Status   SyncOperationResultStatus `json:"status"`
 }
 
-type CreateTodoResponseObject interface {
-	VisitCreateTodoResponse(w http.ResponseWriter) error
+// SyncOperationResultStatus defines model for SyncOperationResult.Status.
+type SyncOperationResultStatus string
+
+// SyncRequest defines model for SyncRequest.
+type SyncRequest struct {
+	Operations []SyncOperation `json:"operations"`
+
+	// SyncToken Token returned by the previous sync. Omit for the initial sync
+	SyncToken *string `json:"sync_token,omitempty"`
 }
 
-type CreateTodo201JSONResponse Todo
+// SyncResponse defines model for SyncResponse.
+type SyncResponse struct {
+	// Changes Server changes since the given sync token, including tombstones of deleted todos. At most 1000 per response
+	Changes   []SyncChange   `json:"changes"`
+	Conflicts []SyncConflict `json:"conflicts"`
 
-func (response CreateTodo201JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// HasMore Whether more changes remain. Sync again with sync_token to fetch the next page
+	HasMore bool                  `json:"has_more"`
+	Results []SyncOperationResult `json:"results"`
 
-	return json.NewEncoder(w).Encode(response)
+	// SyncToken Token to send with the next sync. When has_more is true, it continues from the last returned change
+	SyncToken string `json:"sync_token"`
 }
 
-type CreateTodo400JSONResponse ErrorResponse
+// Todo defines model for Todo.
+type Todo struct {
+	// Blockers Todos that must be finished before this one. Only included by getTodo
+	Blockers *[]TodoRef `json:"blockers,omitempty"`
+
+	// ClientId Client-generated identifier used by offline sync
+	ClientId  openapi_types.UUID `json:"client_id"`
+	Completed bool               `json:"completed"`
+	CreatedAt time.Time          `json:"created_at"`
 
-func (response CreateTodo400JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Dependents Todos blocked by this one. Only included by getTodo
+	Dependents  *[]TodoRef `json:"dependents,omitempty"`
+	Description *string    `json:"description,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// DueAt Due date. Reminders with offset_minutes are scheduled relative to it
+	DueAt *time.Time `json:"due_at,omitempty"`
+	Id    int64      `json:"id"`
+
+	// Position Rank key for manual ordering. Todos sort by byte-wise comparison of this key (ties broken by id). Keys may be reassigned by the server without changing the order
+	Position string `json:"position"`
+
+	// Priority Priority. Omitted when not set
+	Priority *TodoPriority `json:"priority,omitempty"`
+
+	// ProjectId Project the todo belongs to. Omitted when the todo has no project
+	ProjectId *int64 `json:"project_id,omitempty"`
+
+	// Recurrence iCalendar RRULE describing how the todo repeats (e.g. FREQ=WEEKLY;BYDAY=MO). Omitted when the todo does not repeat
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// StatusId Explicitly assigned status. When omitted, the todo belongs to the done status if completed and to the first open status otherwise
+	StatusId  *int64    `json:"status_id,omitempty"`
+	Tags      []string  `json:"tags"`
+	Title     string    `json:"title"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserId    int64     `json:"user_id"`
+
+	// Version Version number for optimistic concurrency control, incremented on every update
+	Version int32 `json:"version"`
 }
 
-type CreateTodo401JSONResponse ErrorResponse
+// TodoPriority Priority. Omitted when not set
+type TodoPriority string
 
-func (response CreateTodo401JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// TodoChangesResponse defines model for TodoChangesResponse.
+type TodoChangesResponse struct {
+	// DeletedIds IDs of todos deleted since the token
+	DeletedIds []int64 `json:"deleted_ids"`
 
-	return json.NewEncoder(w).Encode(response)
+	// HasMore Whether more changes remain. Request again with next_token to fetch the next page
+	HasMore bool `json:"has_more"`
+
+	// NextToken Token to pass as since on the next request. When has_more is true, it continues from the last returned change
+	NextToken string `json:"next_token"`
+
+	// Todos Todos created or updated since the token
+	Todos []Todo `json:"todos"`
 }
 
-type CreateTodo500JSONResponse ErrorResponse
+// TodoDependencies defines model for TodoDependencies.
+type TodoDependencies struct {
+	// Blockers Todos blocking this todo. A blocker is open while it is not completed
+	Blockers []TodoRef `json:"blockers"`
 
-func (response CreateTodo500JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Dependents Todos blocked by this todo
+	Dependents []TodoRef `json:"dependents"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// TodoRef defines model for TodoRef.
+type TodoRef struct {
+	Completed bool   `json:"completed"`
+	Id        int64  `json:"id"`
+	Title     string `json:"title"`
 }
 
-type BatchCompleteTodosRequestObject struct {
-	Body *BatchCompleteTodosJSONRequestBody
+// UpdateStatusRequest defines model for UpdateStatusRequest.
+type UpdateStatusRequest struct {
+	// IsDone Only true is accepted for a status that is not already done; the previous done status becomes an open status
+	IsDone    *bool   `json:"is_done,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	SortOrder *int32  `json:"sort_order,omitempty"`
+
+	// WipLimit New WIP limit. 0 removes the limit. Not allowed on the done status or on a status that is, or is reordered to be, the first open status
+	WipLimit *int32 `json:"wip_limit,omitempty"`
 }
 
-type BatchCompleteTodosResponseObject interface {
-	VisitBatchCompleteTodosResponse(w http.ResponseWriter) error
+// UpdateTodoRequest defines model for UpdateTodoRequest.
+type UpdateTodoRequest struct {
+	Completed   *bool   `json:"completed,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Title       *string `json:"title,omitempty"`
 }
 
-type BatchCompleteTodos200JSONResponse BatchCompleteResponse
+// UpdateUserSettingsRequest Fields to change. Omitted fields keep their current value
+type UpdateUserSettingsRequest struct {
+	// DefaultProjectId Project of todos created without a project. 0 clears the default project
+	DefaultProjectId *int64                                `json:"default_project_id,omitempty"`
+	DefaultSort      *UpdateUserSettingsRequestDefaultSort `json:"default_sort,omitempty"`
 
-func (response BatchCompleteTodos200JSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Locale BCP 47 language tag (e.g. en-US, ja)
+	Locale *string `json:"locale,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Notifications Notification types to update. Types not listed keep their current preference
+	Notifications *[]NotificationPreference `json:"notifications,omitempty"`
+
+	// Timezone IANA time zone (e.g. Asia/Tokyo)
+	Timezone  *string `json:"timezone,omitempty"`
+	WeekStart *int    `json:"week_start,omitempty"`
 }
 
-type BatchCompleteTodos400JSONResponse ErrorResponse
+// UpdateUserSettingsRequestDefaultSort defines model for UpdateUserSettingsRequest.DefaultSort.
+type UpdateUserSettingsRequestDefaultSort string
 
-func (response BatchCompleteTodos400JSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// UserSettings defines model for UserSettings.
+type UserSettings struct {
+	// DefaultProjectId Project of todos created without a project. Omitted when not set
+	DefaultProjectId *int64 `json:"default_project_id,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// DefaultSort Sort order of listTodos when the sort parameter is omitted
+	DefaultSort UserSettingsDefaultSort `json:"default_sort"`
+
+	// Locale BCP 47 language tag (e.g. en-US, ja)
+	Locale string `json:"locale"`
+
+	// Notifications Preference of every notification type, as returned by getNotificationPreferences
+	Notifications []NotificationPreference `json:"notifications"`
+
+	// Timezone IANA time zone (e.g. Asia/Tokyo). Dates without a time and relative dates such as "tomorrow" are interpreted in this time zone
+	Timezone string `json:"timezone"`
+
+	// WeekStart First day of the week. 0 is Sunday and 6 is Saturday
+	WeekStart int `json:"week_start"`
 }
 
-type BatchCompleteTodos401JSONResponse ErrorResponse
+// UserSettingsDefaultSort Sort order of listTodos when the sort parameter is omitted
+type UserSettingsDefaultSort string
 
-func (response BatchCompleteTodos401JSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// DownloadAccountExportParams defines parameters for DownloadAccountExport.
+type DownloadAccountExportParams struct {
+	// Expires Expiry of the link as a Unix timestamp
+	Expires int `form:"expires" json:"expires"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Signature Signature of the link
+	Signature string `form:"signature" json:"signature"`
 }
 
-type BatchCompleteTodos500JSONResponse ErrorResponse
+// CreateJobParams defines parameters for CreateJob.
+type CreateJobParams struct {
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
 
-func (response BatchCompleteTodos500JSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ListNotificationsParams defines parameters for ListNotifications.
+type ListNotificationsParams struct {
+	// Unread When true, only unread notifications are returned
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Limit Page size
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor returned by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-type BatchDeleteTodosRequestObject struct {
-	Body *BatchDeleteTodosJSONRequestBody
+// MarkAllNotificationsReadParams defines parameters for MarkAllNotificationsRead.
+type MarkAllNotificationsReadParams struct {
+	// UpToId Only mark notifications with an ID up to this one, so that notifications arriving after the list was fetched stay unread
+	UpToId *int `form:"up_to_id,omitempty" json:"up_to_id,omitempty"`
 }
 
-type BatchDeleteTodosResponseObject interface {
-	VisitBatchDeleteTodosResponse(w http.ResponseWriter) error
+// SyncTodosParams defines parameters for SyncTodos.
+type SyncTodosParams struct {
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
 }
 
-type BatchDeleteTodos200JSONResponse BatchDeleteResponse
+// ListTodosParams defines parameters for ListTodos.
+type ListTodosParams struct {
+	// Sort Sort order. created_at lists the newest first; manual follows the order set with POST /todos/{id}/move. Defaults to the default_sort user setting
+	Sort *ListTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-func (response BatchDeleteTodos200JSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Actionable When true, only todos without open blockers are returned
+	Actionable *bool `form:"actionable,omitempty" json:"actionable,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// IfNoneMatch Weak ETag of a previously fetched list
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
 }
 
-type BatchDeleteTodos400JSONResponse ErrorResponse
+// ListTodosParamsSort defines parameters for ListTodos.
+type ListTodosParamsSort string
 
-func (response BatchDeleteTodos400JSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateTodoParams defines parameters for CreateTodo.
+type CreateTodoParams struct {
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// BatchCompleteTodosParams defines parameters for BatchCompleteTodos.
+type BatchCompleteTodosParams struct {
+	// Atomic If true, nothing is applied when any of the todos fails
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty"`
+
+	// Force Complete todos even if they still have open blockers
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
 }
 
-type BatchDeleteTodos401JSONResponse ErrorResponse
+// BatchCreateTodosParams defines parameters for BatchCreateTodos.
+type BatchCreateTodosParams struct {
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
 
-func (response BatchDeleteTodos401JSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// BatchDeleteTodosParams defines parameters for BatchDeleteTodos.
+type BatchDeleteTodosParams struct {
+	// Atomic If true, nothing is applied when any of the todos fails
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
 }
 
-type BatchDeleteTodos500JSONResponse ErrorResponse
+// BatchUpdateTodosParams defines parameters for BatchUpdateTodos.
+type BatchUpdateTodosParams struct {
+	// Atomic If true, nothing is applied when any of the todos fails
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty"`
 
-func (response BatchDeleteTodos500JSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// DryRun If true, report what would change without updating anything
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Force Complete todos even if they still have open blockers
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
 }
 
-type DeleteTodoRequestObject struct {
-	Id int `json:"id"`
+// ListTodoChangesParams defines parameters for ListTodoChanges.
+type ListTodoChangesParams struct {
+	// Since Token returned by a previous call (or by POST /sync). Omit to fetch everything
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
 }
 
-type DeleteTodoResponseObject interface {
-	VisitDeleteTodoResponse(w http.ResponseWriter) error
+// ExportTodosParams defines parameters for ExportTodos.
+type ExportTodosParams struct {
+	// Format Output format
+	Format *ExportTodosParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// IncludeDeleted If true, soft-deleted todos are exported too
+	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Columns Comma-separated list of columns in output order. Defaults to every column except deleted_at, which is added when include_deleted is set
+	Columns *[]ExportTodosParamsColumns `form:"columns,omitempty" json:"columns,omitempty"`
 }
 
-type DeleteTodo204Response struct {
+// ExportTodosParamsFormat defines parameters for ExportTodos.
+type ExportTodosParamsFormat string
+
+// ExportTodosParamsColumns defines parameters for ExportTodos.
+type ExportTodosParamsColumns string
+
+// ImportTodosParams defines parameters for ImportTodos.
+type ImportTodosParams struct {
+	// Strict If true, abort the whole import on the first invalid line
+	Strict *bool `form:"strict,omitempty" json:"strict,omitempty"`
+
+	// Preview If true, return the parsed todos without saving anything
+	Preview *bool `form:"preview,omitempty" json:"preview,omitempty"`
+
+	// Dedupe If true, skip todos whose title matches an existing todo or an earlier todo in the same file
+	Dedupe *bool `form:"dedupe,omitempty" json:"dedupe,omitempty"`
+
+	// CsvTitle CSV header of the title column (case-insensitive). Defaults to title
+	CsvTitle *string `form:"csv_title,omitempty" json:"csv_title,omitempty"`
+
+	// CsvDescription CSV header of the description column (case-insensitive). Defaults to description
+	CsvDescription *string `form:"csv_description,omitempty" json:"csv_description,omitempty"`
+
+	// CsvCompleted CSV header of the completed column (case-insensitive). Defaults to completed
+	CsvCompleted *string `form:"csv_completed,omitempty" json:"csv_completed,omitempty"`
+
+	// CsvDueAt CSV header of the due_at column (case-insensitive). Defaults to due_at
+	CsvDueAt *string `form:"csv_due_at,omitempty" json:"csv_due_at,omitempty"`
 }
 
-func (response DeleteTodo204Response) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// ImportExternalTodosJSONBody defines parameters for ImportExternalTodos.
+type ImportExternalTodosJSONBody = interface{}
+
+// ImportExternalTodosParams defines parameters for ImportExternalTodos.
+type ImportExternalTodosParams struct {
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
 }
 
-type DeleteTodo400JSONResponse ErrorResponse
+// ImportExternalTodosParamsSource defines parameters for ImportExternalTodos.
+type ImportExternalTodosParamsSource string
 
-func (response DeleteTodo400JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// QuickAddTodoParams defines parameters for QuickAddTodo.
+type QuickAddTodoParams struct {
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// DeleteTodoParams defines parameters for DeleteTodo.
+type DeleteTodoParams struct {
+	// IfMatch ETag of the todo being modified, as returned by getTodo, createTodo or updateTodo. A comma-separated list matches any of the listed ETags, and * matches any version. Requests without it fail with 428
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type DeleteTodo401JSONResponse ErrorResponse
+// UpdateTodoParams defines parameters for UpdateTodo.
+type UpdateTodoParams struct {
+	// Force Complete todos even if they still have open blockers
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
 
-func (response DeleteTodo401JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// IfMatch ETag of the todo being modified, as returned by getTodo, createTodo or updateTodo. A comma-separated list matches any of the listed ETags, and * matches any version. Requests without it fail with 428
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// SetTodoDueParams defines parameters for SetTodoDue.
+type SetTodoDueParams struct {
+	// IfMatch ETag of the todo being modified, as returned by getTodo, createTodo or updateTodo. A comma-separated list matches any of the listed ETags, and * matches any version. Requests without it fail with 428
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type DeleteTodo404JSONResponse ErrorResponse
+// SetTodoStatusParams defines parameters for SetTodoStatus.
+type SetTodoStatusParams struct {
+	// Force Complete todos even if they still have open blockers
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
 
-func (response DeleteTodo404JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// IfMatch ETag of the todo being modified, as returned by getTodo, createTodo or updateTodo. A comma-separated list matches any of the listed ETags, and * matches any version. Requests without it fail with 428
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// ExportAccountDataParams defines parameters for ExportAccountData.
+type ExportAccountDataParams struct {
+	// IdempotencyKey Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
 }
 
-type DeleteTodo500JSONResponse ErrorResponse
+// CreateJobJSONRequestBody defines body for CreateJob for application/json ContentType.
+type CreateJobJSONRequestBody = CreateJobRequest
 
-func (response DeleteTodo500JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// UpdateNotificationPreferencesJSONRequestBody defines body for UpdateNotificationPreferences for application/json ContentType.
+type UpdateNotificationPreferencesJSONRequestBody = NotificationPreferences
 
-	return json.NewEncoder(w).Encode(response)
+// CreateStatusJSONRequestBody defines body for CreateStatus for application/json ContentType.
+type CreateStatusJSONRequestBody = CreateStatusRequest
+
+// UpdateStatusJSONRequestBody defines body for UpdateStatus for application/json ContentType.
+type UpdateStatusJSONRequestBody = UpdateStatusRequest
+
+// SyncTodosJSONRequestBody defines body for SyncTodos for application/json ContentType.
+type SyncTodosJSONRequestBody = SyncRequest
+
+// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
+type CreateTodoJSONRequestBody = CreateTodoRequest
+
+// BatchCompleteTodosJSONRequestBody defines body for BatchCompleteTodos for application/json ContentType.
+type BatchCompleteTodosJSONRequestBody = BatchTodoRequest
+
+// BatchCreateTodosJSONRequestBody defines body for BatchCreateTodos for application/json ContentType.
+type BatchCreateTodosJSONRequestBody = BatchCreateRequest
+
+// BatchDeleteTodosJSONRequestBody defines body for BatchDeleteTodos for application/json ContentType.
+type BatchDeleteTodosJSONRequestBody = BatchTodoRequest
+
+// BatchUpdateTodosJSONRequestBody defines body for BatchUpdateTodos for application/json ContentType.
+type BatchUpdateTodosJSONRequestBody = BatchUpdateRequest
+
+// ImportExternalTodosJSONRequestBody defines body for ImportExternalTodos for application/json ContentType.
+type ImportExternalTodosJSONRequestBody = ImportExternalTodosJSONBody
+
+// QuickAddTodoJSONRequestBody defines body for QuickAddTodo for application/json ContentType.
+type QuickAddTodoJSONRequestBody = QuickAddRequest
+
+// PreviewQuickAddTodoJSONRequestBody defines body for PreviewQuickAddTodo for application/json ContentType.
+type PreviewQuickAddTodoJSONRequestBody = QuickAddRequest
+
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = UpdateTodoRequest
+
+// AddTodoBlockerJSONRequestBody defines body for AddTodoBlocker for application/json ContentType.
+type AddTodoBlockerJSONRequestBody = AddTodoBlockerRequest
+
+// SetTodoDueJSONRequestBody defines body for SetTodoDue for application/json ContentType.
+type SetTodoDueJSONRequestBody = SetTodoDueRequest
+
+// MoveTodoJSONRequestBody defines body for MoveTodo for application/json ContentType.
+type MoveTodoJSONRequestBody = MoveTodoRequest
+
+// CreateTodoReminderJSONRequestBody defines body for CreateTodoReminder for application/json ContentType.
+type CreateTodoReminderJSONRequestBody = CreateReminderRequest
+
+// SetTodoStatusJSONRequestBody defines body for SetTodoStatus for application/json ContentType.
+type SetTodoStatusJSONRequestBody = SetTodoStatusRequest
+
+// UpdateUserSettingsJSONRequestBody defines body for UpdateUserSettings for application/json ContentType.
+type UpdateUserSettingsJSONRequestBody = UpdateUserSettingsRequest
+
+// CreatePersonalAccessTokenJSONRequestBody defines body for CreatePersonalAccessToken for application/json ContentType.
+type CreatePersonalAccessTokenJSONRequestBody = CreatePersonalAccessTokenRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// API information
+	// (GET /)
+	GetInfo(ctx echo.Context) error
+	// Get the board
+	// (GET /board)
+	GetBoard(ctx echo.Context) error
+	// Get the calendar feed
+	// (GET /calendar/{token})
+	GetCalendarFeed(ctx echo.Context, token string) error
+	// Download an account data export
+	// (GET /exports/{id})
+	DownloadAccountExport(ctx echo.Context, id int, params DownloadAccountExportParams) error
+	// Health check
+	// (GET /health)
+	GetHealth(ctx echo.Context) error
+	// Start a background job
+	// (POST /jobs)
+	CreateJob(ctx echo.Context, params CreateJobParams) error
+	// Get a job
+	// (GET /jobs/{id})
+	GetJob(ctx echo.Context, id int) error
+	// Cancel a job
+	// (POST /jobs/{id}/cancel)
+	CancelJob(ctx echo.Context, id int) error
+	// List notifications
+	// (GET /notifications)
+	ListNotifications(ctx echo.Context, params ListNotificationsParams) error
+	// Get notification preferences
+	// (GET /notifications/preferences)
+	GetNotificationPreferences(ctx echo.Context) error
+	// Update notification preferences
+	// (PUT /notifications/preferences)
+	UpdateNotificationPreferences(ctx echo.Context) error
+	// Mark all notifications as read
+	// (POST /notifications/read-all)
+	MarkAllNotificationsRead(ctx echo.Context, params MarkAllNotificationsReadParams) error
+	// Count unread notifications
+	// (GET /notifications/unread-count)
+	GetUnreadNotificationCount(ctx echo.Context) error
+	// Mark a notification as read
+	// (POST /notifications/{id}/read)
+	MarkNotificationRead(ctx echo.Context, id int) error
+	// List projects
+	// (GET /projects)
+	ListProjects(ctx echo.Context) error
+	// Delete a reminder
+	// (DELETE /reminders/{id})
+	DeleteReminder(ctx echo.Context, id int) error
+	// List statuses
+	// (GET /statuses)
+	ListStatuses(ctx echo.Context) error
+	// Create a status
+	// (POST /statuses)
+	CreateStatus(ctx echo.Context) error
+	// Delete a status
+	// (DELETE /statuses/{id})
+	DeleteStatus(ctx echo.Context, id int) error
+	// Update a status
+	// (PUT /statuses/{id})
+	UpdateStatus(ctx echo.Context, id int) error
+	// Synchronize todos
+	// (POST /sync)
+	SyncTodos(ctx echo.Context, params SyncTodosParams) error
+	// List all todos
+	// (GET /todos)
+	ListTodos(ctx echo.Context, params ListTodosParams) error
+	// Create a new todo
+	// (POST /todos)
+	CreateTodo(ctx echo.Context, params CreateTodoParams) error
+	// Batch complete todos
+	// (POST /todos/batch/complete)
+	BatchCompleteTodos(ctx echo.Context, params BatchCompleteTodosParams) error
+	// Batch create todos
+	// (POST /todos/batch/create)
+	BatchCreateTodos(ctx echo.Context, params BatchCreateTodosParams) error
+	// Batch delete todos
+	// (POST /todos/batch/delete)
+	BatchDeleteTodos(ctx echo.Context, params BatchDeleteTodosParams) error
+	// Batch update todos
+	// (POST /todos/batch/update)
+	BatchUpdateTodos(ctx echo.Context, params BatchUpdateTodosParams) error
+	// List todo changes
+	// (GET /todos/changes)
+	ListTodoChanges(ctx echo.Context, params ListTodoChangesParams) error
+	// Export todos
+	// (GET /todos/export)
+	ExportTodos(ctx echo.Context, params ExportTodosParams) error
+	// Import todos
+	// (POST /todos/import)
+	ImportTodos(ctx echo.Context, params ImportTodosParams) error
+	// Import todos from another task manager
+	// (POST /todos/import/{source})
+	ImportExternalTodos(ctx echo.Context, source ImportExternalTodosParamsSource, params ImportExternalTodosParams) error
+	// Quick-add a todo
+	// (POST /todos/quick-add)
+	QuickAddTodo(ctx echo.Context, params QuickAddTodoParams) error
+	// Preview a quick-add
+	// (POST /todos/quick-add/preview)
+	PreviewQuickAddTodo(ctx echo.Context) error
+	// Delete a todo
+	// (DELETE /todos/{id})
+	DeleteTodo(ctx echo.Context, id int, params DeleteTodoParams) error
+	// Get a todo by ID
+	// (GET /todos/{id})
+	GetTodo(ctx echo.Context, id int) error
+	// Update a todo
+	// (PUT /todos/{id})
+	UpdateTodo(ctx echo.Context, id int, params UpdateTodoParams) error
+	// Add a blocker
+	// (POST /todos/{id}/blockers)
+	AddTodoBlocker(ctx echo.Context, id int) error
+	// Remove a blocker
+	// (DELETE /todos/{id}/blockers/{blocker_id})
+	RemoveTodoBlocker(ctx echo.Context, id int, blockerId int) error
+	// Set the due date of a todo
+	// (PUT /todos/{id}/due)
+	SetTodoDue(ctx echo.Context, id int, params SetTodoDueParams) error
+	// Move a todo
+	// (POST /todos/{id}/move)
+	MoveTodo(ctx echo.Context, id int) error
+	// List reminders of a todo
+	// (GET /todos/{id}/reminders)
+	ListTodoReminders(ctx echo.Context, id int) error
+	// Create a reminder
+	// (POST /todos/{id}/reminders)
+	CreateTodoReminder(ctx echo.Context, id int) error
+	// Change the status of a todo
+	// (PUT /todos/{id}/status)
+	SetTodoStatus(ctx echo.Context, id int, params SetTodoStatusParams) error
+	// Revoke the calendar feed URL
+	// (DELETE /users/me/calendar-feed)
+	DeleteCalendarFeed(ctx echo.Context) error
+	// Issue a calendar feed URL
+	// (POST /users/me/calendar-feed)
+	CreateCalendarFeed(ctx echo.Context) error
+	// Export all account data
+	// (POST /users/me/export)
+	ExportAccountData(ctx echo.Context, params ExportAccountDataParams) error
+	// Get user settings
+	// (GET /users/me/settings)
+	GetUserSettings(ctx echo.Context) error
+	// Update user settings
+	// (PATCH /users/me/settings)
+	UpdateUserSettings(ctx echo.Context) error
+	// List personal access tokens
+	// (GET /users/me/tokens)
+	ListPersonalAccessTokens(ctx echo.Context) error
+	// Issue a personal access token
+	// (POST /users/me/tokens)
+	CreatePersonalAccessToken(ctx echo.Context) error
+	// Revoke a personal access token
+	// (DELETE /users/me/tokens/{id})
+	DeletePersonalAccessToken(ctx echo.Context, id int) error
 }
 
-type GetTodoRequestObject struct {
-	Id int `json:"id"`
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
 }
 
-type GetTodoResponseObject interface {
-	VisitGetTodoResponse(w http.ResponseWriter) error
+// GetInfo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInfo(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInfo(ctx)
+	return err
 }
 
-type GetTodo200JSONResponse Todo
+// GetBoard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBoard(ctx echo.Context) error {
+	var err error
 
-func (response GetTodo200JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(CookieAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBoard(ctx)
+	return err
+}
+
+// GetCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendarFeed(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendarFeed(ctx, token)
+	return err
+}
+
+// DownloadAccountExport converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadAccountExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadAccountExportParams
+	// ------------- Required query parameter "expires" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "expires", ctx.QueryParams(), &params.Expires)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter expires: %s", err))
+	}
+
+	// ------------- Required query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "signature", ctx.QueryParams(), &params.Signature)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter signature: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DownloadAccountExport(ctx, id, params)
+	return err
+}
+
+// GetHealth converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealth(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealth(ctx)
+	return err
+}
+
+// CreateJob converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateJob(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateJobParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateJob(ctx, params)
+	return err
+}
+
+// GetJob converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJob(ctx, id)
+	return err
+}
+
+// CancelJob converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelJob(ctx, id)
+	return err
+}
+
+// ListNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNotifications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNotificationsParams
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", ctx.QueryParams(), &params.Unread)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unread: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNotifications(ctx, params)
+	return err
+}
+
+// GetNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotificationPreferences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNotificationPreferences(ctx)
+	return err
+}
+
+// UpdateNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNotificationPreferences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateNotificationPreferences(ctx)
+	return err
+}
+
+// MarkAllNotificationsRead converts echo context to params.
+func (w *ServerInterfaceWrapper) MarkAllNotificationsRead(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MarkAllNotificationsReadParams
+	// ------------- Optional query parameter "up_to_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "up_to_id", ctx.QueryParams(), &params.UpToId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter up_to_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MarkAllNotificationsRead(ctx, params)
+	return err
+}
+
+// GetUnreadNotificationCount converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUnreadNotificationCount(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUnreadNotificationCount(ctx)
+	return err
+}
+
+// MarkNotificationRead converts echo context to params.
+func (w *ServerInterfaceWrapper) MarkNotificationRead(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MarkNotificationRead(ctx, id)
+	return err
+}
+
+// ListProjects converts echo context to params.
+func (w *ServerInterfaceWrapper) ListProjects(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListProjects(ctx)
+	return err
+}
+
+// DeleteReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteReminder(ctx, id)
+	return err
+}
+
+// ListStatuses converts echo context to params.
+func (w *ServerInterfaceWrapper) ListStatuses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListStatuses(ctx)
+	return err
+}
+
+// CreateStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateStatus(ctx)
+	return err
+}
+
+// DeleteStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteStatus(ctx, id)
+	return err
+}
+
+// UpdateStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateStatus(ctx, id)
+	return err
+}
+
+// SyncTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) SyncTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SyncTodosParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SyncTodos(ctx, params)
+	return err
+}
+
+// ListTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodosParams
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "actionable" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actionable", ctx.QueryParams(), &params.Actionable)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actionable: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTodos(ctx, params)
+	return err
+}
+
+// CreateTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTodo(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTodo(ctx, params)
+	return err
+}
+
+// BatchCompleteTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchCompleteTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchCompleteTodosParams
+	// ------------- Optional query parameter "atomic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "atomic", ctx.QueryParams(), &params.Atomic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter atomic: %s", err))
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchCompleteTodos(ctx, params)
+	return err
+}
+
+// BatchCreateTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchCreateTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchCreateTodosParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchCreateTodos(ctx, params)
+	return err
+}
+
+// BatchDeleteTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchDeleteTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchDeleteTodosParams
+	// ------------- Optional query parameter "atomic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "atomic", ctx.QueryParams(), &params.Atomic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter atomic: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchDeleteTodos(ctx, params)
+	return err
+}
+
+// BatchUpdateTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchUpdateTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchUpdateTodosParams
+	// ------------- Optional query parameter "atomic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "atomic", ctx.QueryParams(), &params.Atomic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter atomic: %s", err))
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchUpdateTodos(ctx, params)
+	return err
+}
+
+// ListTodoChanges converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTodoChanges(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodoChangesParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTodoChanges(ctx, params)
+	return err
+}
+
+// ExportTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTodosParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
+	// ------------- Optional query parameter "columns" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "columns", ctx.QueryParams(), &params.Columns)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columns: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportTodos(ctx, params)
+	return err
+}
+
+// ImportTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTodosParams
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// ------------- Optional query parameter "preview" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "preview", ctx.QueryParams(), &params.Preview)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter preview: %s", err))
+	}
+
+	// ------------- Optional query parameter "dedupe" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dedupe", ctx.QueryParams(), &params.Dedupe)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dedupe: %s", err))
+	}
+
+	// ------------- Optional query parameter "csv_title" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "csv_title", ctx.QueryParams(), &params.CsvTitle)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter csv_title: %s", err))
+	}
+
+	// ------------- Optional query parameter "csv_description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "csv_description", ctx.QueryParams(), &params.CsvDescription)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter csv_description: %s", err))
+	}
+
+	// ------------- Optional query parameter "csv_completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "csv_completed", ctx.QueryParams(), &params.CsvCompleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter csv_completed: %s", err))
+	}
+
+	// ------------- Optional query parameter "csv_due_at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "csv_due_at", ctx.QueryParams(), &params.CsvDueAt)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter csv_due_at: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportTodos(ctx, params)
+	return err
+}
+
+// ImportExternalTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportExternalTodos(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "source" -------------
+	var source ImportExternalTodosParamsSource
+
+	err = runtime.BindStyledParameterWithOptions("simple", "source", ctx.Param("source"), &source, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter source: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportExternalTodosParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportExternalTodos(ctx, source, params)
+	return err
+}
+
+// QuickAddTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) QuickAddTodo(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QuickAddTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.QuickAddTodo(ctx, params)
+	return err
+}
+
+// PreviewQuickAddTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewQuickAddTodo(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewQuickAddTodo(ctx)
+	return err
+}
+
+// DeleteTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodo(ctx, id, params)
+	return err
+}
+
+// GetTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodo(ctx, id)
+	return err
+}
+
+// UpdateTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTodoParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTodo(ctx, id, params)
+	return err
+}
+
+// AddTodoBlocker converts echo context to params.
+func (w *ServerInterfaceWrapper) AddTodoBlocker(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddTodoBlocker(ctx, id)
+	return err
+}
+
+// RemoveTodoBlocker converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveTodoBlocker(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "blocker_id" -------------
+	var blockerId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blocker_id", ctx.Param("blocker_id"), &blockerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blocker_id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveTodoBlocker(ctx, id, blockerId)
+	return err
+}
+
+// SetTodoDue converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTodoDue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTodoDueParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetTodoDue(ctx, id, params)
+	return err
+}
+
+// MoveTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) MoveTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MoveTodo(ctx, id)
+	return err
+}
+
+// ListTodoReminders converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTodoReminders(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTodoReminders(ctx, id)
+	return err
+}
+
+// CreateTodoReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTodoReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTodoReminder(ctx, id)
+	return err
+}
+
+// SetTodoStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTodoStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTodoStatusParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetTodoStatus(ctx, id, params)
+	return err
+}
+
+// DeleteCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCalendarFeed(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCalendarFeed(ctx)
+	return err
+}
+
+// CreateCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCalendarFeed(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCalendarFeed(ctx)
+	return err
+}
+
+// ExportAccountData converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportAccountData(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportAccountDataParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportAccountData(ctx, params)
+	return err
+}
+
+// GetUserSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserSettings(ctx)
+	return err
+}
+
+// UpdateUserSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateUserSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateUserSettings(ctx)
+	return err
+}
+
+// ListPersonalAccessTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPersonalAccessTokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPersonalAccessTokens(ctx)
+	return err
+}
+
+// CreatePersonalAccessToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePersonalAccessToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePersonalAccessToken(ctx)
+	return err
+}
+
+// DeletePersonalAccessToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePersonalAccessToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePersonalAccessToken(ctx, id)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/", wrapper.GetInfo)
+	router.GET(baseURL+"/board", wrapper.GetBoard)
+	router.GET(baseURL+"/calendar/:token", wrapper.GetCalendarFeed)
+	router.GET(baseURL+"/exports/:id", wrapper.DownloadAccountExport)
+	router.GET(baseURL+"/health", wrapper.GetHealth)
+	router.POST(baseURL+"/jobs", wrapper.CreateJob)
+	router.GET(baseURL+"/jobs/:id", wrapper.GetJob)
+	router.POST(baseURL+"/jobs/:id/cancel", wrapper.CancelJob)
+	router.GET(baseURL+"/notifications", wrapper.ListNotifications)
+	router.GET(baseURL+"/notifications/preferences", wrapper.GetNotificationPreferences)
+	router.PUT(baseURL+"/notifications/preferences", wrapper.UpdateNotificationPreferences)
+	router.POST(baseURL+"/notifications/read-all", wrapper.MarkAllNotificationsRead)
+	router.GET(baseURL+"/notifications/unread-count", wrapper.GetUnreadNotificationCount)
+	router.POST(baseURL+"/notifications/:id/read", wrapper.MarkNotificationRead)
+	router.GET(baseURL+"/projects", wrapper.ListProjects)
+	router.DELETE(baseURL+"/reminders/:id", wrapper.DeleteReminder)
+	router.GET(baseURL+"/statuses", wrapper.ListStatuses)
+	router.POST(baseURL+"/statuses", wrapper.CreateStatus)
+	router.DELETE(baseURL+"/statuses/:id", wrapper.DeleteStatus)
+	router.PUT(baseURL+"/statuses/:id", wrapper.UpdateStatus)
+	router.POST(baseURL+"/sync", wrapper.SyncTodos)
+	router.GET(baseURL+"/todos", wrapper.ListTodos)
+	router.POST(baseURL+"/todos", wrapper.CreateTodo)
+	router.POST(baseURL+"/todos/batch/complete", wrapper.BatchCompleteTodos)
+	router.POST(baseURL+"/todos/batch/create", wrapper.BatchCreateTodos)
+	router.POST(baseURL+"/todos/batch/delete", wrapper.BatchDeleteTodos)
+	router.POST(baseURL+"/todos/batch/update", wrapper.BatchUpdateTodos)
+	router.GET(baseURL+"/todos/changes", wrapper.ListTodoChanges)
+	router.GET(baseURL+"/todos/export", wrapper.ExportTodos)
+	router.POST(baseURL+"/todos/import", wrapper.ImportTodos)
+	router.POST(baseURL+"/todos/import/:source", wrapper.ImportExternalTodos)
+	router.POST(baseURL+"/todos/quick-add", wrapper.QuickAddTodo)
+	router.POST(baseURL+"/todos/quick-add/preview", wrapper.PreviewQuickAddTodo)
+	router.DELETE(baseURL+"/todos/:id", wrapper.DeleteTodo)
+	router.GET(baseURL+"/todos/:id", wrapper.GetTodo)
+	router.PUT(baseURL+"/todos/:id", wrapper.UpdateTodo)
+	router.POST(baseURL+"/todos/:id/blockers", wrapper.AddTodoBlocker)
+	router.DELETE(baseURL+"/todos/:id/blockers/:blocker_id", wrapper.RemoveTodoBlocker)
+	router.PUT(baseURL+"/todos/:id/due", wrapper.SetTodoDue)
+	router.POST(baseURL+"/todos/:id/move", wrapper.MoveTodo)
+	router.GET(baseURL+"/todos/:id/reminders", wrapper.ListTodoReminders)
+	router.POST(baseURL+"/todos/:id/reminders", wrapper.CreateTodoReminder)
+	router.PUT(baseURL+"/todos/:id/status", wrapper.SetTodoStatus)
+	router.DELETE(baseURL+"/users/me/calendar-feed", wrapper.DeleteCalendarFeed)
+	router.POST(baseURL+"/users/me/calendar-feed", wrapper.CreateCalendarFeed)
+	router.POST(baseURL+"/users/me/export", wrapper.ExportAccountData)
+	router.GET(baseURL+"/users/me/settings", wrapper.GetUserSettings)
+	router.PATCH(baseURL+"/users/me/settings", wrapper.UpdateUserSettings)
+	router.GET(baseURL+"/users/me/tokens", wrapper.ListPersonalAccessTokens)
+	router.POST(baseURL+"/users/me/tokens", wrapper.CreatePersonalAccessToken)
+	router.DELETE(baseURL+"/users/me/tokens/:id", wrapper.DeletePersonalAccessToken)
+
+}
+
+type GetInfoRequestObject struct {
+}
+
+type GetInfoResponseObject interface {
+	VisitGetInfoResponse(w http.ResponseWriter) error
+}
+
+type GetInfo200JSONResponse InfoResponse
+
+func (response GetInfo200JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBoardRequestObject struct {
+}
+
+type GetBoardResponseObject interface {
+	VisitGetBoardResponse(w http.ResponseWriter) error
+}
+
+type GetBoard200JSONResponse Board
+
+func (response GetBoard200JSONResponse) VisitGetBoardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBoard401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetBoard401ApplicationProblemPlusJSONResponse) VisitGetBoardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBoard500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetBoard500ApplicationProblemPlusJSONResponse) VisitGetBoardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarFeedRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetCalendarFeedResponseObject interface {
+	VisitGetCalendarFeedResponse(w http.ResponseWriter) error
+}
+
+type GetCalendarFeed200TextcalendarResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCalendarFeed200TextcalendarResponse) VisitGetCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/calendar")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCalendarFeed404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetCalendarFeed404ApplicationProblemPlusJSONResponse) VisitGetCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarFeed500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetCalendarFeed500ApplicationProblemPlusJSONResponse) VisitGetCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadAccountExportRequestObject struct {
+	Id     int `json:"id"`
+	Params DownloadAccountExportParams
+}
+
+type DownloadAccountExportResponseObject interface {
+	VisitDownloadAccountExportResponse(w http.ResponseWriter) error
+}
+
+type DownloadAccountExport200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type DownloadAccountExport200ApplicationzipResponse struct {
+	Body          io.Reader
+	Headers       DownloadAccountExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadAccountExport200ApplicationzipResponse) VisitDownloadAccountExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadAccountExport400ApplicationProblemPlusJSONResponse Problem
+
+func (response DownloadAccountExport400ApplicationProblemPlusJSONResponse) VisitDownloadAccountExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadAccountExport403ApplicationProblemPlusJSONResponse Problem
+
+func (response DownloadAccountExport403ApplicationProblemPlusJSONResponse) VisitDownloadAccountExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadAccountExport410ApplicationProblemPlusJSONResponse Problem
+
+func (response DownloadAccountExport410ApplicationProblemPlusJSONResponse) VisitDownloadAccountExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadAccountExport500ApplicationProblemPlusJSONResponse Problem
+
+func (response DownloadAccountExport500ApplicationProblemPlusJSONResponse) VisitDownloadAccountExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse HealthResponse
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJobRequestObject struct {
+	Params CreateJobParams
+	Body   *CreateJobJSONRequestBody
+}
+
+type CreateJobResponseObject interface {
+	VisitCreateJobResponse(w http.ResponseWriter) error
+}
+
+type CreateJob202JSONResponse Job
+
+func (response CreateJob202JSONResponse) VisitCreateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJob400ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateJob400ApplicationProblemPlusJSONResponse) VisitCreateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJob401ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateJob401ApplicationProblemPlusJSONResponse) VisitCreateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJob409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateJob409ApplicationProblemPlusJSONResponse) VisitCreateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJob500ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateJob500ApplicationProblemPlusJSONResponse) VisitCreateJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobRequestObject struct {
+	Id int `json:"id"`
+}
+
+type GetJobResponseObject interface {
+	VisitGetJobResponse(w http.ResponseWriter) error
+}
+
+type GetJob200JSONResponse Job
+
+func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetJob400ApplicationProblemPlusJSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetJob401ApplicationProblemPlusJSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetJob404ApplicationProblemPlusJSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetJob500ApplicationProblemPlusJSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJobRequestObject struct {
+	Id int `json:"id"`
+}
+
+type CancelJobResponseObject interface {
+	VisitCancelJobResponse(w http.ResponseWriter) error
+}
+
+type CancelJob202JSONResponse Job
+
+func (response CancelJob202JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob400ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelJob400ApplicationProblemPlusJSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob401ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelJob401ApplicationProblemPlusJSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob404ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelJob404ApplicationProblemPlusJSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob409ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelJob409ApplicationProblemPlusJSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob500ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelJob500ApplicationProblemPlusJSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotificationsRequestObject struct {
+	Params ListNotificationsParams
+}
+
+type ListNotificationsResponseObject interface {
+	VisitListNotificationsResponse(w http.ResponseWriter) error
+}
+
+type ListNotifications200JSONResponse NotificationPage
+
+func (response ListNotifications200JSONResponse) VisitListNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotifications400ApplicationProblemPlusJSONResponse Problem
+
+func (response ListNotifications400ApplicationProblemPlusJSONResponse) VisitListNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotifications401ApplicationProblemPlusJSONResponse Problem
+
+func (response ListNotifications401ApplicationProblemPlusJSONResponse) VisitListNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNotifications500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListNotifications500ApplicationProblemPlusJSONResponse) VisitListNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationPreferencesRequestObject struct {
+}
+
+type GetNotificationPreferencesResponseObject interface {
+	VisitGetNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response GetNotificationPreferences200JSONResponse) VisitGetNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationPreferences401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetNotificationPreferences401ApplicationProblemPlusJSONResponse) VisitGetNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationPreferences500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetNotificationPreferences500ApplicationProblemPlusJSONResponse) VisitGetNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationPreferencesRequestObject struct {
+	Body *UpdateNotificationPreferencesJSONRequestBody
+}
+
+type UpdateNotificationPreferencesResponseObject interface {
+	VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type UpdateNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response UpdateNotificationPreferences200JSONResponse) VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationPreferences400ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateNotificationPreferences400ApplicationProblemPlusJSONResponse) VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationPreferences401ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateNotificationPreferences401ApplicationProblemPlusJSONResponse) VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationPreferences500ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateNotificationPreferences500ApplicationProblemPlusJSONResponse) VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkAllNotificationsReadRequestObject struct {
+	Params MarkAllNotificationsReadParams
+}
+
+type MarkAllNotificationsReadResponseObject interface {
+	VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error
+}
+
+type MarkAllNotificationsRead200JSONResponse MarkAllNotificationsReadResponse
+
+func (response MarkAllNotificationsRead200JSONResponse) VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkAllNotificationsRead400ApplicationProblemPlusJSONResponse Problem
+
+func (response MarkAllNotificationsRead400ApplicationProblemPlusJSONResponse) VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkAllNotificationsRead401ApplicationProblemPlusJSONResponse Problem
+
+func (response MarkAllNotificationsRead401ApplicationProblemPlusJSONResponse) VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkAllNotificationsRead500ApplicationProblemPlusJSONResponse Problem
+
+func (response MarkAllNotificationsRead500ApplicationProblemPlusJSONResponse) VisitMarkAllNotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUnreadNotificationCountRequestObject struct {
+}
+
+type GetUnreadNotificationCountResponseObject interface {
+	VisitGetUnreadNotificationCountResponse(w http.ResponseWriter) error
+}
+
+type GetUnreadNotificationCount200JSONResponse NotificationUnreadCount
+
+func (response GetUnreadNotificationCount200JSONResponse) VisitGetUnreadNotificationCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUnreadNotificationCount401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetUnreadNotificationCount401ApplicationProblemPlusJSONResponse) VisitGetUnreadNotificationCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUnreadNotificationCount500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetUnreadNotificationCount500ApplicationProblemPlusJSONResponse) VisitGetUnreadNotificationCountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkNotificationReadRequestObject struct {
+	Id int `json:"id"`
+}
+
+type MarkNotificationReadResponseObject interface {
+	VisitMarkNotificationReadResponse(w http.ResponseWriter) error
+}
+
+type MarkNotificationRead200JSONResponse Notification
+
+func (response MarkNotificationRead200JSONResponse) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkNotificationRead400ApplicationProblemPlusJSONResponse Problem
+
+func (response MarkNotificationRead400ApplicationProblemPlusJSONResponse) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkNotificationRead401ApplicationProblemPlusJSONResponse Problem
+
+func (response MarkNotificationRead401ApplicationProblemPlusJSONResponse) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkNotificationRead404ApplicationProblemPlusJSONResponse Problem
+
+func (response MarkNotificationRead404ApplicationProblemPlusJSONResponse) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkNotificationRead500ApplicationProblemPlusJSONResponse Problem
+
+func (response MarkNotificationRead500ApplicationProblemPlusJSONResponse) VisitMarkNotificationReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectsRequestObject struct {
+}
+
+type ListProjectsResponseObject interface {
+	VisitListProjectsResponse(w http.ResponseWriter) error
+}
+
+type ListProjects200JSONResponse []Project
+
+func (response ListProjects200JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjects401ApplicationProblemPlusJSONResponse Problem
+
+func (response ListProjects401ApplicationProblemPlusJSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjects500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListProjects500ApplicationProblemPlusJSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReminderRequestObject struct {
+	Id int `json:"id"`
+}
+
+type DeleteReminderResponseObject interface {
+	VisitDeleteReminderResponse(w http.ResponseWriter) error
+}
+
+type DeleteReminder204Response struct {
+}
+
+func (response DeleteReminder204Response) VisitDeleteReminderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteReminder400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteReminder400ApplicationProblemPlusJSONResponse) VisitDeleteReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReminder401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteReminder401ApplicationProblemPlusJSONResponse) VisitDeleteReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReminder404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteReminder404ApplicationProblemPlusJSONResponse) VisitDeleteReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReminder500ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteReminder500ApplicationProblemPlusJSONResponse) VisitDeleteReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStatusesRequestObject struct {
+}
+
+type ListStatusesResponseObject interface {
+	VisitListStatusesResponse(w http.ResponseWriter) error
+}
+
+type ListStatuses200JSONResponse []Status
+
+func (response ListStatuses200JSONResponse) VisitListStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStatuses401ApplicationProblemPlusJSONResponse Problem
+
+func (response ListStatuses401ApplicationProblemPlusJSONResponse) VisitListStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStatuses500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListStatuses500ApplicationProblemPlusJSONResponse) VisitListStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStatusRequestObject struct {
+	Body *CreateStatusJSONRequestBody
+}
+
+type CreateStatusResponseObject interface {
+	VisitCreateStatusResponse(w http.ResponseWriter) error
+}
+
+type CreateStatus201JSONResponse Status
+
+func (response CreateStatus201JSONResponse) VisitCreateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStatus400ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateStatus400ApplicationProblemPlusJSONResponse) VisitCreateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStatus401ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateStatus401ApplicationProblemPlusJSONResponse) VisitCreateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStatus409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateStatus409ApplicationProblemPlusJSONResponse) VisitCreateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStatus422ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateStatus422ApplicationProblemPlusJSONResponse) VisitCreateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateStatus500ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateStatus500ApplicationProblemPlusJSONResponse) VisitCreateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStatusRequestObject struct {
+	Id int `json:"id"`
+}
+
+type DeleteStatusResponseObject interface {
+	VisitDeleteStatusResponse(w http.ResponseWriter) error
+}
+
+type DeleteStatus204Response struct {
+}
+
+func (response DeleteStatus204Response) VisitDeleteStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteStatus400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteStatus400ApplicationProblemPlusJSONResponse) VisitDeleteStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStatus401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteStatus401ApplicationProblemPlusJSONResponse) VisitDeleteStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStatus404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteStatus404ApplicationProblemPlusJSONResponse) VisitDeleteStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStatus409ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteStatus409ApplicationProblemPlusJSONResponse) VisitDeleteStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStatus422ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteStatus422ApplicationProblemPlusJSONResponse) VisitDeleteStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStatus500ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteStatus500ApplicationProblemPlusJSONResponse) VisitDeleteStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatusRequestObject struct {
+	Id   int `json:"id"`
+	Body *UpdateStatusJSONRequestBody
+}
+
+type UpdateStatusResponseObject interface {
+	VisitUpdateStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateStatus200JSONResponse Status
+
+func (response UpdateStatus200JSONResponse) VisitUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatus400ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateStatus400ApplicationProblemPlusJSONResponse) VisitUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatus401ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateStatus401ApplicationProblemPlusJSONResponse) VisitUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatus404ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateStatus404ApplicationProblemPlusJSONResponse) VisitUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatus409ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateStatus409ApplicationProblemPlusJSONResponse) VisitUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatus422ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateStatus422ApplicationProblemPlusJSONResponse) VisitUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatus500ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateStatus500ApplicationProblemPlusJSONResponse) VisitUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncTodosRequestObject struct {
+	Params SyncTodosParams
+	Body   *SyncTodosJSONRequestBody
+}
+
+type SyncTodosResponseObject interface {
+	VisitSyncTodosResponse(w http.ResponseWriter) error
+}
+
+type SyncTodos200JSONResponse SyncResponse
+
+func (response SyncTodos200JSONResponse) VisitSyncTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response SyncTodos400ApplicationProblemPlusJSONResponse) VisitSyncTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response SyncTodos401ApplicationProblemPlusJSONResponse) VisitSyncTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncTodos409ApplicationProblemPlusJSONResponse Problem
+
+func (response SyncTodos409ApplicationProblemPlusJSONResponse) VisitSyncTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response SyncTodos500ApplicationProblemPlusJSONResponse) VisitSyncTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodosRequestObject struct {
+	Params ListTodosParams
+}
+
+type ListTodosResponseObject interface {
+	VisitListTodosResponse(w http.ResponseWriter) error
+}
+
+type ListTodos200ResponseHeaders struct {
+	ETag string
+}
+
+type ListTodos200JSONResponse struct {
+	Body    []Todo
+	Headers ListTodos200ResponseHeaders
+}
+
+func (response ListTodos200JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTodos304ResponseHeaders struct {
+	ETag string
+}
+
+type ListTodos304Response struct {
+	Headers ListTodos304ResponseHeaders
+}
+
+func (response ListTodos304Response) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type ListTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodos400ApplicationProblemPlusJSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodos401ApplicationProblemPlusJSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodos500ApplicationProblemPlusJSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodoRequestObject struct {
+	Params CreateTodoParams
+	Body   *CreateTodoJSONRequestBody
+}
+
+type CreateTodoResponseObject interface {
+	VisitCreateTodoResponse(w http.ResponseWriter) error
+}
+
+type CreateTodo201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateTodo201JSONResponse struct {
+	Body    Todo
+	Headers CreateTodo201ResponseHeaders
+}
+
+func (response CreateTodo201JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTodo400ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTodo400ApplicationProblemPlusJSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodo401ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTodo401ApplicationProblemPlusJSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodo409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTodo409ApplicationProblemPlusJSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodo500ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTodo500ApplicationProblemPlusJSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCompleteTodosRequestObject struct {
+	Params BatchCompleteTodosParams
+	Body   *BatchCompleteTodosJSONRequestBody
+}
+
+type BatchCompleteTodosResponseObject interface {
+	VisitBatchCompleteTodosResponse(w http.ResponseWriter) error
+}
+
+type BatchCompleteTodos200JSONResponse BatchCompleteResponse
+
+func (response BatchCompleteTodos200JSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCompleteTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchCompleteTodos400ApplicationProblemPlusJSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCompleteTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchCompleteTodos401ApplicationProblemPlusJSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCompleteTodos409ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchCompleteTodos409ApplicationProblemPlusJSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCompleteTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchCompleteTodos500ApplicationProblemPlusJSONResponse) VisitBatchCompleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateTodosRequestObject struct {
+	Params BatchCreateTodosParams
+	Body   *BatchCreateTodosJSONRequestBody
+}
+
+type BatchCreateTodosResponseObject interface {
+	VisitBatchCreateTodosResponse(w http.ResponseWriter) error
+}
+
+type BatchCreateTodos200JSONResponse BatchCreateResponse
+
+func (response BatchCreateTodos200JSONResponse) VisitBatchCreateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchCreateTodos400ApplicationProblemPlusJSONResponse) VisitBatchCreateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchCreateTodos401ApplicationProblemPlusJSONResponse) VisitBatchCreateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateTodos409ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchCreateTodos409ApplicationProblemPlusJSONResponse) VisitBatchCreateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchCreateTodos500ApplicationProblemPlusJSONResponse) VisitBatchCreateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchDeleteTodosRequestObject struct {
+	Params BatchDeleteTodosParams
+	Body   *BatchDeleteTodosJSONRequestBody
+}
+
+type BatchDeleteTodosResponseObject interface {
+	VisitBatchDeleteTodosResponse(w http.ResponseWriter) error
+}
+
+type BatchDeleteTodos200JSONResponse BatchDeleteResponse
+
+func (response BatchDeleteTodos200JSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchDeleteTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchDeleteTodos400ApplicationProblemPlusJSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchDeleteTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchDeleteTodos401ApplicationProblemPlusJSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchDeleteTodos409ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchDeleteTodos409ApplicationProblemPlusJSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchDeleteTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchDeleteTodos500ApplicationProblemPlusJSONResponse) VisitBatchDeleteTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateTodosRequestObject struct {
+	Params BatchUpdateTodosParams
+	Body   *BatchUpdateTodosJSONRequestBody
+}
+
+type BatchUpdateTodosResponseObject interface {
+	VisitBatchUpdateTodosResponse(w http.ResponseWriter) error
+}
+
+type BatchUpdateTodos200JSONResponse BatchUpdateResponse
+
+func (response BatchUpdateTodos200JSONResponse) VisitBatchUpdateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchUpdateTodos400ApplicationProblemPlusJSONResponse) VisitBatchUpdateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchUpdateTodos401ApplicationProblemPlusJSONResponse) VisitBatchUpdateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateTodos409ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchUpdateTodos409ApplicationProblemPlusJSONResponse) VisitBatchUpdateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response BatchUpdateTodos500ApplicationProblemPlusJSONResponse) VisitBatchUpdateTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoChangesRequestObject struct {
+	Params ListTodoChangesParams
+}
+
+type ListTodoChangesResponseObject interface {
+	VisitListTodoChangesResponse(w http.ResponseWriter) error
+}
+
+type ListTodoChanges200JSONResponse TodoChangesResponse
+
+func (response ListTodoChanges200JSONResponse) VisitListTodoChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoChanges400ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodoChanges400ApplicationProblemPlusJSONResponse) VisitListTodoChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoChanges401ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodoChanges401ApplicationProblemPlusJSONResponse) VisitListTodoChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoChanges500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodoChanges500ApplicationProblemPlusJSONResponse) VisitListTodoChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTodosRequestObject struct {
+	Params ExportTodosParams
+}
+
+type ExportTodosResponseObject interface {
+	VisitExportTodosResponse(w http.ResponseWriter) error
+}
+
+type ExportTodos200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportTodos200JSONResponse struct {
+	Body    openapi_types.File
+	Headers ExportTodos200ResponseHeaders
+}
+
+func (response ExportTodos200JSONResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExportTodos200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       ExportTodos200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportTodos200ApplicationxNdjsonResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTodos200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       ExportTodos200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportTodos200TextcsvResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response ExportTodos400ApplicationProblemPlusJSONResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response ExportTodos401ApplicationProblemPlusJSONResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response ExportTodos500ApplicationProblemPlusJSONResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodosRequestObject struct {
+	Params      ImportTodosParams
+	ContentType string
+	Body        io.Reader
+}
+
+type ImportTodosResponseObject interface {
+	VisitImportTodosResponse(w http.ResponseWriter) error
+}
+
+type ImportTodos200JSONResponse ImportResponse
+
+func (response ImportTodos200JSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportTodos400ApplicationProblemPlusJSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportTodos401ApplicationProblemPlusJSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodos415ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportTodos415ApplicationProblemPlusJSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodos422JSONResponse ImportResponse
+
+func (response ImportTodos422JSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportTodos500ApplicationProblemPlusJSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportExternalTodosRequestObject struct {
+	Source ImportExternalTodosParamsSource `json:"source"`
+	Params ImportExternalTodosParams
+	Body   *ImportExternalTodosJSONRequestBody
+}
+
+type ImportExternalTodosResponseObject interface {
+	VisitImportExternalTodosResponse(w http.ResponseWriter) error
+}
+
+type ImportExternalTodos202JSONResponse Job
+
+func (response ImportExternalTodos202JSONResponse) VisitImportExternalTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportExternalTodos400ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportExternalTodos400ApplicationProblemPlusJSONResponse) VisitImportExternalTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportExternalTodos401ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportExternalTodos401ApplicationProblemPlusJSONResponse) VisitImportExternalTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportExternalTodos409ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportExternalTodos409ApplicationProblemPlusJSONResponse) VisitImportExternalTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportExternalTodos413ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportExternalTodos413ApplicationProblemPlusJSONResponse) VisitImportExternalTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportExternalTodos500ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportExternalTodos500ApplicationProblemPlusJSONResponse) VisitImportExternalTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuickAddTodoRequestObject struct {
+	Params QuickAddTodoParams
+	Body   *QuickAddTodoJSONRequestBody
+}
+
+type QuickAddTodoResponseObject interface {
+	VisitQuickAddTodoResponse(w http.ResponseWriter) error
+}
+
+type QuickAddTodo201ResponseHeaders struct {
+	ETag string
+}
+
+type QuickAddTodo201JSONResponse struct {
+	Body    Todo
+	Headers QuickAddTodo201ResponseHeaders
+}
+
+func (response QuickAddTodo201JSONResponse) VisitQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type QuickAddTodo400ApplicationProblemPlusJSONResponse Problem
+
+func (response QuickAddTodo400ApplicationProblemPlusJSONResponse) VisitQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuickAddTodo401ApplicationProblemPlusJSONResponse Problem
+
+func (response QuickAddTodo401ApplicationProblemPlusJSONResponse) VisitQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuickAddTodo409ApplicationProblemPlusJSONResponse Problem
+
+func (response QuickAddTodo409ApplicationProblemPlusJSONResponse) VisitQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QuickAddTodo500ApplicationProblemPlusJSONResponse Problem
+
+func (response QuickAddTodo500ApplicationProblemPlusJSONResponse) VisitQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewQuickAddTodoRequestObject struct {
+	Body *PreviewQuickAddTodoJSONRequestBody
+}
+
+type PreviewQuickAddTodoResponseObject interface {
+	VisitPreviewQuickAddTodoResponse(w http.ResponseWriter) error
+}
+
+type PreviewQuickAddTodo200JSONResponse QuickAddPreview
+
+func (response PreviewQuickAddTodo200JSONResponse) VisitPreviewQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewQuickAddTodo400ApplicationProblemPlusJSONResponse Problem
+
+func (response PreviewQuickAddTodo400ApplicationProblemPlusJSONResponse) VisitPreviewQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewQuickAddTodo401ApplicationProblemPlusJSONResponse Problem
+
+func (response PreviewQuickAddTodo401ApplicationProblemPlusJSONResponse) VisitPreviewQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewQuickAddTodo500ApplicationProblemPlusJSONResponse Problem
+
+func (response PreviewQuickAddTodo500ApplicationProblemPlusJSONResponse) VisitPreviewQuickAddTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodoRequestObject struct {
+	Id     int `json:"id"`
+	Params DeleteTodoParams
+}
+
+type DeleteTodoResponseObject interface {
+	VisitDeleteTodoResponse(w http.ResponseWriter) error
+}
+
+type DeleteTodo204Response struct {
+}
+
+func (response DeleteTodo204Response) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTodo400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTodo400ApplicationProblemPlusJSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTodo401ApplicationProblemPlusJSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTodo404ApplicationProblemPlusJSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo412ResponseHeaders struct {
+	ETag string
+}
+
+type DeleteTodo412JSONResponse struct {
+	Body    Todo
+	Headers DeleteTodo412ResponseHeaders
+}
+
+func (response DeleteTodo412JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteTodo428ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTodo428ApplicationProblemPlusJSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo500ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteTodo500ApplicationProblemPlusJSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoRequestObject struct {
+	Id int `json:"id"`
+}
+
+type GetTodoResponseObject interface {
+	VisitGetTodoResponse(w http.ResponseWriter) error
+}
+
+type GetTodo200ResponseHeaders struct {
+	ETag string
+}
+
+type GetTodo200JSONResponse struct {
+	Body    Todo
+	Headers GetTodo200ResponseHeaders
+}
+
+func (response GetTodo200JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTodo400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTodo400ApplicationProblemPlusJSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodo401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTodo401ApplicationProblemPlusJSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodo404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTodo404ApplicationProblemPlusJSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodo500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTodo500ApplicationProblemPlusJSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodoRequestObject struct {
+	Id     int `json:"id"`
+	Params UpdateTodoParams
+	Body   *UpdateTodoJSONRequestBody
+}
+
+type UpdateTodoResponseObject interface {
+	VisitUpdateTodoResponse(w http.ResponseWriter) error
+}
+
+type UpdateTodo200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateTodo200JSONResponse struct {
+	Body    Todo
+	Headers UpdateTodo200ResponseHeaders
+}
+
+func (response UpdateTodo200JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTodo400ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTodo400ApplicationProblemPlusJSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodo401ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTodo401ApplicationProblemPlusJSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodo404ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTodo404ApplicationProblemPlusJSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodo409ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTodo409ApplicationProblemPlusJSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodo412ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateTodo412JSONResponse struct {
+	Body    Todo
+	Headers UpdateTodo412ResponseHeaders
+}
+
+func (response UpdateTodo412JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTodo428ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTodo428ApplicationProblemPlusJSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodo500ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateTodo500ApplicationProblemPlusJSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTodoBlockerRequestObject struct {
+	Id   int `json:"id"`
+	Body *AddTodoBlockerJSONRequestBody
+}
+
+type AddTodoBlockerResponseObject interface {
+	VisitAddTodoBlockerResponse(w http.ResponseWriter) error
+}
+
+type AddTodoBlocker200JSONResponse TodoDependencies
+
+func (response AddTodoBlocker200JSONResponse) VisitAddTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTodoBlocker400ApplicationProblemPlusJSONResponse Problem
+
+func (response AddTodoBlocker400ApplicationProblemPlusJSONResponse) VisitAddTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTodoBlocker401ApplicationProblemPlusJSONResponse Problem
+
+func (response AddTodoBlocker401ApplicationProblemPlusJSONResponse) VisitAddTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTodoBlocker404ApplicationProblemPlusJSONResponse Problem
+
+func (response AddTodoBlocker404ApplicationProblemPlusJSONResponse) VisitAddTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTodoBlocker409ApplicationProblemPlusJSONResponse Problem
+
+func (response AddTodoBlocker409ApplicationProblemPlusJSONResponse) VisitAddTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTodoBlocker500ApplicationProblemPlusJSONResponse Problem
+
+func (response AddTodoBlocker500ApplicationProblemPlusJSONResponse) VisitAddTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTodoBlockerRequestObject struct {
+	Id        int `json:"id"`
+	BlockerId int `json:"blocker_id"`
+}
+
+type RemoveTodoBlockerResponseObject interface {
+	VisitRemoveTodoBlockerResponse(w http.ResponseWriter) error
+}
+
+type RemoveTodoBlocker204Response struct {
+}
+
+func (response RemoveTodoBlocker204Response) VisitRemoveTodoBlockerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveTodoBlocker400ApplicationProblemPlusJSONResponse Problem
+
+func (response RemoveTodoBlocker400ApplicationProblemPlusJSONResponse) VisitRemoveTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTodoBlocker401ApplicationProblemPlusJSONResponse Problem
+
+func (response RemoveTodoBlocker401ApplicationProblemPlusJSONResponse) VisitRemoveTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTodoBlocker404ApplicationProblemPlusJSONResponse Problem
+
+func (response RemoveTodoBlocker404ApplicationProblemPlusJSONResponse) VisitRemoveTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTodoBlocker500ApplicationProblemPlusJSONResponse Problem
+
+func (response RemoveTodoBlocker500ApplicationProblemPlusJSONResponse) VisitRemoveTodoBlockerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoDueRequestObject struct {
+	Id     int `json:"id"`
+	Params SetTodoDueParams
+	Body   *SetTodoDueJSONRequestBody
+}
+
+type SetTodoDueResponseObject interface {
+	VisitSetTodoDueResponse(w http.ResponseWriter) error
+}
+
+type SetTodoDue200ResponseHeaders struct {
+	ETag string
+}
+
+type SetTodoDue200JSONResponse struct {
+	Body    Todo
+	Headers SetTodoDue200ResponseHeaders
+}
+
+func (response SetTodoDue200JSONResponse) VisitSetTodoDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTodoDue400ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoDue400ApplicationProblemPlusJSONResponse) VisitSetTodoDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoDue401ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoDue401ApplicationProblemPlusJSONResponse) VisitSetTodoDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoDue404ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoDue404ApplicationProblemPlusJSONResponse) VisitSetTodoDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoDue412ResponseHeaders struct {
+	ETag string
+}
+
+type SetTodoDue412JSONResponse struct {
+	Body    Todo
+	Headers SetTodoDue412ResponseHeaders
+}
+
+func (response SetTodoDue412JSONResponse) VisitSetTodoDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTodoDue428ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoDue428ApplicationProblemPlusJSONResponse) VisitSetTodoDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoDue500ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoDue500ApplicationProblemPlusJSONResponse) VisitSetTodoDueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveTodoRequestObject struct {
+	Id   int `json:"id"`
+	Body *MoveTodoJSONRequestBody
+}
+
+type MoveTodoResponseObject interface {
+	VisitMoveTodoResponse(w http.ResponseWriter) error
+}
+
+type MoveTodo200ResponseHeaders struct {
+	ETag string
+}
+
+type MoveTodo200JSONResponse struct {
+	Body    Todo
+	Headers MoveTodo200ResponseHeaders
+}
+
+func (response MoveTodo200JSONResponse) VisitMoveTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MoveTodo400ApplicationProblemPlusJSONResponse Problem
+
+func (response MoveTodo400ApplicationProblemPlusJSONResponse) VisitMoveTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveTodo401ApplicationProblemPlusJSONResponse Problem
+
+func (response MoveTodo401ApplicationProblemPlusJSONResponse) VisitMoveTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveTodo404ApplicationProblemPlusJSONResponse Problem
+
+func (response MoveTodo404ApplicationProblemPlusJSONResponse) VisitMoveTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveTodo500ApplicationProblemPlusJSONResponse Problem
+
+func (response MoveTodo500ApplicationProblemPlusJSONResponse) VisitMoveTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoRemindersRequestObject struct {
+	Id int `json:"id"`
+}
+
+type ListTodoRemindersResponseObject interface {
+	VisitListTodoRemindersResponse(w http.ResponseWriter) error
+}
+
+type ListTodoReminders200JSONResponse []Reminder
+
+func (response ListTodoReminders200JSONResponse) VisitListTodoRemindersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoReminders400ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodoReminders400ApplicationProblemPlusJSONResponse) VisitListTodoRemindersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoReminders401ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodoReminders401ApplicationProblemPlusJSONResponse) VisitListTodoRemindersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoReminders404ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodoReminders404ApplicationProblemPlusJSONResponse) VisitListTodoRemindersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodoReminders500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListTodoReminders500ApplicationProblemPlusJSONResponse) VisitListTodoRemindersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodoReminderRequestObject struct {
+	Id   int `json:"id"`
+	Body *CreateTodoReminderJSONRequestBody
+}
+
+type CreateTodoReminderResponseObject interface {
+	VisitCreateTodoReminderResponse(w http.ResponseWriter) error
+}
+
+type CreateTodoReminder201JSONResponse Reminder
+
+func (response CreateTodoReminder201JSONResponse) VisitCreateTodoReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodoReminder400ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTodoReminder400ApplicationProblemPlusJSONResponse) VisitCreateTodoReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodoReminder401ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTodoReminder401ApplicationProblemPlusJSONResponse) VisitCreateTodoReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodoReminder404ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTodoReminder404ApplicationProblemPlusJSONResponse) VisitCreateTodoReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodoReminder500ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTodoReminder500ApplicationProblemPlusJSONResponse) VisitCreateTodoReminderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoStatusRequestObject struct {
+	Id     int `json:"id"`
+	Params SetTodoStatusParams
+	Body   *SetTodoStatusJSONRequestBody
+}
+
+type SetTodoStatusResponseObject interface {
+	VisitSetTodoStatusResponse(w http.ResponseWriter) error
+}
+
+type SetTodoStatus200ResponseHeaders struct {
+	ETag string
+}
+
+type SetTodoStatus200JSONResponse struct {
+	Body    Todo
+	Headers SetTodoStatus200ResponseHeaders
+}
+
+func (response SetTodoStatus200JSONResponse) VisitSetTodoStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTodoStatus400ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoStatus400ApplicationProblemPlusJSONResponse) VisitSetTodoStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoStatus401ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoStatus401ApplicationProblemPlusJSONResponse) VisitSetTodoStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoStatus404ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoStatus404ApplicationProblemPlusJSONResponse) VisitSetTodoStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoStatus409ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoStatus409ApplicationProblemPlusJSONResponse) VisitSetTodoStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoStatus412ResponseHeaders struct {
+	ETag string
+}
+
+type SetTodoStatus412JSONResponse struct {
+	Body    Todo
+	Headers SetTodoStatus412ResponseHeaders
+}
+
+func (response SetTodoStatus412JSONResponse) VisitSetTodoStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTodoStatus428ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoStatus428ApplicationProblemPlusJSONResponse) VisitSetTodoStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTodoStatus500ApplicationProblemPlusJSONResponse Problem
+
+func (response SetTodoStatus500ApplicationProblemPlusJSONResponse) VisitSetTodoStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCalendarFeedRequestObject struct {
+}
+
+type DeleteCalendarFeedResponseObject interface {
+	VisitDeleteCalendarFeedResponse(w http.ResponseWriter) error
+}
+
+type DeleteCalendarFeed204Response struct {
+}
+
+func (response DeleteCalendarFeed204Response) VisitDeleteCalendarFeedResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCalendarFeed401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteCalendarFeed401ApplicationProblemPlusJSONResponse) VisitDeleteCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCalendarFeed404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteCalendarFeed404ApplicationProblemPlusJSONResponse) VisitDeleteCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCalendarFeed500ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteCalendarFeed500ApplicationProblemPlusJSONResponse) VisitDeleteCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalendarFeedRequestObject struct {
+}
+
+type CreateCalendarFeedResponseObject interface {
+	VisitCreateCalendarFeedResponse(w http.ResponseWriter) error
+}
+
+type CreateCalendarFeed201JSONResponse CalendarFeed
+
+func (response CreateCalendarFeed201JSONResponse) VisitCreateCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalendarFeed401ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateCalendarFeed401ApplicationProblemPlusJSONResponse) VisitCreateCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCalendarFeed500ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateCalendarFeed500ApplicationProblemPlusJSONResponse) VisitCreateCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportAccountDataRequestObject struct {
+	Params ExportAccountDataParams
+}
+
+type ExportAccountDataResponseObject interface {
+	VisitExportAccountDataResponse(w http.ResponseWriter) error
+}
+
+type ExportAccountData202JSONResponse Job
+
+func (response ExportAccountData202JSONResponse) VisitExportAccountDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportAccountData400ApplicationProblemPlusJSONResponse Problem
+
+func (response ExportAccountData400ApplicationProblemPlusJSONResponse) VisitExportAccountDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportAccountData401ApplicationProblemPlusJSONResponse Problem
+
+func (response ExportAccountData401ApplicationProblemPlusJSONResponse) VisitExportAccountDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportAccountData409ApplicationProblemPlusJSONResponse Problem
+
+func (response ExportAccountData409ApplicationProblemPlusJSONResponse) VisitExportAccountDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportAccountData500ApplicationProblemPlusJSONResponse Problem
+
+func (response ExportAccountData500ApplicationProblemPlusJSONResponse) VisitExportAccountDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSettingsRequestObject struct {
+}
+
+type GetUserSettingsResponseObject interface {
+	VisitGetUserSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetUserSettings200JSONResponse UserSettings
+
+func (response GetUserSettings200JSONResponse) VisitGetUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSettings401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetUserSettings401ApplicationProblemPlusJSONResponse) VisitGetUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSettings500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetUserSettings500ApplicationProblemPlusJSONResponse) VisitGetUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserSettingsRequestObject struct {
+	Body *UpdateUserSettingsJSONRequestBody
+}
+
+type UpdateUserSettingsResponseObject interface {
+	VisitUpdateUserSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateUserSettings200JSONResponse UserSettings
+
+func (response UpdateUserSettings200JSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserSettings400ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateUserSettings400ApplicationProblemPlusJSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserSettings401ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateUserSettings401ApplicationProblemPlusJSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserSettings500ApplicationProblemPlusJSONResponse Problem
+
+func (response UpdateUserSettings500ApplicationProblemPlusJSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalAccessTokensRequestObject struct {
+}
+
+type ListPersonalAccessTokensResponseObject interface {
+	VisitListPersonalAccessTokensResponse(w http.ResponseWriter) error
+}
+
+type ListPersonalAccessTokens200JSONResponse PersonalAccessTokens
+
+func (response ListPersonalAccessTokens200JSONResponse) VisitListPersonalAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalAccessTokens401ApplicationProblemPlusJSONResponse Problem
+
+func (response ListPersonalAccessTokens401ApplicationProblemPlusJSONResponse) VisitListPersonalAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalAccessTokens500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListPersonalAccessTokens500ApplicationProblemPlusJSONResponse) VisitListPersonalAccessTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessTokenRequestObject struct {
+	Body *CreatePersonalAccessTokenJSONRequestBody
+}
+
+type CreatePersonalAccessTokenResponseObject interface {
+	VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type CreatePersonalAccessToken201JSONResponse CreatedPersonalAccessToken
+
+func (response CreatePersonalAccessToken201JSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse Problem
+
+func (response CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessToken401ApplicationProblemPlusJSONResponse Problem
+
+func (response CreatePersonalAccessToken401ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessToken500ApplicationProblemPlusJSONResponse Problem
+
+func (response CreatePersonalAccessToken500ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessTokenRequestObject struct {
+	Id int `json:"id"`
+}
+
+type DeletePersonalAccessTokenResponseObject interface {
+	VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type DeletePersonalAccessToken204Response struct {
+}
+
+func (response DeletePersonalAccessToken204Response) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePersonalAccessToken400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeletePersonalAccessToken400ApplicationProblemPlusJSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessToken401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeletePersonalAccessToken401ApplicationProblemPlusJSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessToken404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeletePersonalAccessToken404ApplicationProblemPlusJSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalAccessToken500ApplicationProblemPlusJSONResponse Problem
+
+func (response DeletePersonalAccessToken500ApplicationProblemPlusJSONResponse) VisitDeletePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// API information
+	// (GET /)
+	GetInfo(ctx context.Context, request GetInfoRequestObject) (GetInfoResponseObject, error)
+	// Get the board
+	// (GET /board)
+	GetBoard(ctx context.Context, request GetBoardRequestObject) (GetBoardResponseObject, error)
+	// Get the calendar feed
+	// (GET /calendar/{token})
+	GetCalendarFeed(ctx context.Context, request GetCalendarFeedRequestObject) (GetCalendarFeedResponseObject, error)
+	// Download an account data export
+	// (GET /exports/{id})
+	DownloadAccountExport(ctx context.Context, request DownloadAccountExportRequestObject) (DownloadAccountExportResponseObject, error)
+	// Health check
+	// (GET /health)
+	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Start a background job
+	// (POST /jobs)
+	CreateJob(ctx context.Context, request CreateJobRequestObject) (CreateJobResponseObject, error)
+	// Get a job
+	// (GET /jobs/{id})
+	GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error)
+	// Cancel a job
+	// (POST /jobs/{id}/cancel)
+	CancelJob(ctx context.Context, request CancelJobRequestObject) (CancelJobResponseObject, error)
+	// List notifications
+	// (GET /notifications)
+	ListNotifications(ctx context.Context, request ListNotificationsRequestObject) (ListNotificationsResponseObject, error)
+	// Get notification preferences
+	// (GET /notifications/preferences)
+	GetNotificationPreferences(ctx context.Context, request GetNotificationPreferencesRequestObject) (GetNotificationPreferencesResponseObject, error)
+	// Update notification preferences
+	// (PUT /notifications/preferences)
+	UpdateNotificationPreferences(ctx context.Context, request UpdateNotificationPreferencesRequestObject) (UpdateNotificationPreferencesResponseObject, error)
+	// Mark all notifications as read
+	// (POST /notifications/read-all)
+	MarkAllNotificationsRead(ctx context.Context, request MarkAllNotificationsReadRequestObject) (MarkAllNotificationsReadResponseObject, error)
+	// Count unread notifications
+	// (GET /notifications/unread-count)
+	GetUnreadNotificationCount(ctx context.Context, request GetUnreadNotificationCountRequestObject) (GetUnreadNotificationCountResponseObject, error)
+	// Mark a notification as read
+	// (POST /notifications/{id}/read)
+	MarkNotificationRead(ctx context.Context, request MarkNotificationReadRequestObject) (MarkNotificationReadResponseObject, error)
+	// List projects
+	// (GET /projects)
+	ListProjects(ctx context.Context, request ListProjectsRequestObject) (ListProjectsResponseObject, error)
+	// Delete a reminder
+	// (DELETE /reminders/{id})
+	DeleteReminder(ctx context.Context, request DeleteReminderRequestObject) (DeleteReminderResponseObject, error)
+	// List statuses
+	// (GET /statuses)
+	ListStatuses(ctx context.Context, request ListStatusesRequestObject) (ListStatusesResponseObject, error)
+	// Create a status
+	// (POST /statuses)
+	CreateStatus(ctx context.Context, request CreateStatusRequestObject) (CreateStatusResponseObject, error)
+	// Delete a status
+	// (DELETE /statuses/{id})
+	DeleteStatus(ctx context.Context, request DeleteStatusRequestObject) (DeleteStatusResponseObject, error)
+	// Update a status
+	// (PUT /statuses/{id})
+	UpdateStatus(ctx context.Context, request UpdateStatusRequestObject) (UpdateStatusResponseObject, error)
+	// Synchronize todos
+	// (POST /sync)
+	SyncTodos(ctx context.Context, request SyncTodosRequestObject) (SyncTodosResponseObject, error)
+	// List all todos
+	// (GET /todos)
+	ListTodos(ctx context.Context, request ListTodosRequestObject) (ListTodosResponseObject, error)
+	// Create a new todo
+	// (POST /todos)
+	CreateTodo(ctx context.Context, request CreateTodoRequestObject) (CreateTodoResponseObject, error)
+	// Batch complete todos
+	// (POST /todos/batch/complete)
+	BatchCompleteTodos(ctx context.Context, request BatchCompleteTodosRequestObject) (BatchCompleteTodosResponseObject, error)
+	// Batch create todos
+	// (POST /todos/batch/create)
+	BatchCreateTodos(ctx context.Context, request BatchCreateTodosRequestObject) (BatchCreateTodosResponseObject, error)
+	// Batch delete todos
+	// (POST /todos/batch/delete)
+	BatchDeleteTodos(ctx context.Context, request BatchDeleteTodosRequestObject) (BatchDeleteTodosResponseObject, error)
+	// Batch update todos
+	// (POST /todos/batch/update)
+	BatchUpdateTodos(ctx context.Context, request BatchUpdateTodosRequestObject) (BatchUpdateTodosResponseObject, error)
+	// List todo changes
+	// (GET /todos/changes)
+	ListTodoChanges(ctx context.Context, request ListTodoChangesRequestObject) (ListTodoChangesResponseObject, error)
+	// Export todos
+	// (GET /todos/export)
+	ExportTodos(ctx context.Context, request ExportTodosRequestObject) (ExportTodosResponseObject, error)
+	// Import todos
+	// (POST /todos/import)
+	ImportTodos(ctx context.Context, request ImportTodosRequestObject) (ImportTodosResponseObject, error)
+	// Import todos from another task manager
+	// (POST /todos/import/{source})
+	ImportExternalTodos(ctx context.Context, request ImportExternalTodosRequestObject) (ImportExternalTodosResponseObject, error)
+	// Quick-add a todo
+	// (POST /todos/quick-add)
+	QuickAddTodo(ctx context.Context, request QuickAddTodoRequestObject) (QuickAddTodoResponseObject, error)
+	// Preview a quick-add
+	// (POST /todos/quick-add/preview)
+	PreviewQuickAddTodo(ctx context.Context, request PreviewQuickAddTodoRequestObject) (PreviewQuickAddTodoResponseObject, error)
+	// Delete a todo
+	// (DELETE /todos/{id})
+	DeleteTodo(ctx context.Context, request DeleteTodoRequestObject) (DeleteTodoResponseObject, error)
+	// Get a todo by ID
+	// (GET /todos/{id})
+	GetTodo(ctx context.Context, request GetTodoRequestObject) (GetTodoResponseObject, error)
+	// Update a todo
+	// (PUT /todos/{id})
+	UpdateTodo(ctx context.Context, request UpdateTodoRequestObject) (UpdateTodoResponseObject, error)
+	// Add a blocker
+	// (POST /todos/{id}/blockers)
+	AddTodoBlocker(ctx context.Context, request AddTodoBlockerRequestObject) (AddTodoBlockerResponseObject, error)
+	// Remove a blocker
+	// (DELETE /todos/{id}/blockers/{blocker_id})
+	RemoveTodoBlocker(ctx context.Context, request RemoveTodoBlockerRequestObject) (RemoveTodoBlockerResponseObject, error)
+	// Set the due date of a todo
+	// (PUT /todos/{id}/due)
+	SetTodoDue(ctx context.Context, request SetTodoDueRequestObject) (SetTodoDueResponseObject, error)
+	// Move a todo
+	// (POST /todos/{id}/move)
+	MoveTodo(ctx context.Context, request MoveTodoRequestObject) (MoveTodoResponseObject, error)
+	// List reminders of a todo
+	// (GET /todos/{id}/reminders)
+	ListTodoReminders(ctx context.Context, request ListTodoRemindersRequestObject) (ListTodoRemindersResponseObject, error)
+	// Create a reminder
+	// (POST /todos/{id}/reminders)
+	CreateTodoReminder(ctx context.Context, request CreateTodoReminderRequestObject) (CreateTodoReminderResponseObject, error)
+	// Change the status of a todo
+	// (PUT /todos/{id}/status)
+	SetTodoStatus(ctx context.Context, request SetTodoStatusRequestObject) (SetTodoStatusResponseObject, error)
+	// Revoke the calendar feed URL
+	// (DELETE /users/me/calendar-feed)
+	DeleteCalendarFeed(ctx context.Context, request DeleteCalendarFeedRequestObject) (DeleteCalendarFeedResponseObject, error)
+	// Issue a calendar feed URL
+	// (POST /users/me/calendar-feed)
+	CreateCalendarFeed(ctx context.Context, request CreateCalendarFeedRequestObject) (CreateCalendarFeedResponseObject, error)
+	// Export all account data
+	// (POST /users/me/export)
+	ExportAccountData(ctx context.Context, request ExportAccountDataRequestObject) (ExportAccountDataResponseObject, error)
+	// Get user settings
+	// (GET /users/me/settings)
+	GetUserSettings(ctx context.Context, request GetUserSettingsRequestObject) (GetUserSettingsResponseObject, error)
+	// Update user settings
+	// (PATCH /users/me/settings)
+	UpdateUserSettings(ctx context.Context, request UpdateUserSettingsRequestObject) (UpdateUserSettingsResponseObject, error)
+	// List personal access tokens
+	// (GET /users/me/tokens)
+	ListPersonalAccessTokens(ctx context.Context, request ListPersonalAccessTokensRequestObject) (ListPersonalAccessTokensResponseObject, error)
+	// Issue a personal access token
+	// (POST /users/me/tokens)
+	CreatePersonalAccessToken(ctx context.Context, request CreatePersonalAccessTokenRequestObject) (CreatePersonalAccessTokenResponseObject, error)
+	// Revoke a personal access token
+	// (DELETE /users/me/tokens/{id})
+	DeletePersonalAccessToken(ctx context.Context, request DeletePersonalAccessTokenRequestObject) (DeletePersonalAccessTokenResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetInfo operation middleware
+func (sh *strictHandler) GetInfo(ctx echo.Context) error {
+	var request GetInfoRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInfo(ctx.Request().Context(), request.(GetInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInfo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetInfoResponseObject); ok {
+		return validResponse.VisitGetInfoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBoard operation middleware
+func (sh *strictHandler) GetBoard(ctx echo.Context) error {
+	var request GetBoardRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBoard(ctx.Request().Context(), request.(GetBoardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBoard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBoardResponseObject); ok {
+		return validResponse.VisitGetBoardResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCalendarFeed operation middleware
+func (sh *strictHandler) GetCalendarFeed(ctx echo.Context, token string) error {
+	var request GetCalendarFeedRequestObject
+
+	request.Token = token
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalendarFeed(ctx.Request().Context(), request.(GetCalendarFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalendarFeed")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCalendarFeedResponseObject); ok {
+		return validResponse.VisitGetCalendarFeedResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DownloadAccountExport operation middleware
+func (sh *strictHandler) DownloadAccountExport(ctx echo.Context, id int, params DownloadAccountExportParams) error {
+	var request DownloadAccountExportRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadAccountExport(ctx.Request().Context(), request.(DownloadAccountExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadAccountExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DownloadAccountExportResponseObject); ok {
+		return validResponse.VisitDownloadAccountExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(ctx echo.Context) error {
+	var request GetHealthRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx.Request().Context(), request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		return validResponse.VisitGetHealthResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateJob operation middleware
+func (sh *strictHandler) CreateJob(ctx echo.Context, params CreateJobParams) error {
+	var request CreateJobRequestObject
+
+	request.Params = params
+
+	var body CreateJobJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateJob(ctx.Request().Context(), request.(CreateJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateJob")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateJobResponseObject); ok {
+		return validResponse.VisitCreateJobResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetJob operation middleware
+func (sh *strictHandler) GetJob(ctx echo.Context, id int) error {
+	var request GetJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJob(ctx.Request().Context(), request.(GetJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJob")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetJobResponseObject); ok {
+		return validResponse.VisitGetJobResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelJob operation middleware
+func (sh *strictHandler) CancelJob(ctx echo.Context, id int) error {
+	var request CancelJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelJob(ctx.Request().Context(), request.(CancelJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelJob")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelJobResponseObject); ok {
+		return validResponse.VisitCancelJobResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListNotifications operation middleware
+func (sh *strictHandler) ListNotifications(ctx echo.Context, params ListNotificationsParams) error {
+	var request ListNotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListNotifications(ctx.Request().Context(), request.(ListNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListNotifications")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListNotificationsResponseObject); ok {
+		return validResponse.VisitListNotificationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetNotificationPreferences operation middleware
+func (sh *strictHandler) GetNotificationPreferences(ctx echo.Context) error {
+	var request GetNotificationPreferencesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNotificationPreferences(ctx.Request().Context(), request.(GetNotificationPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNotificationPreferences")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNotificationPreferencesResponseObject); ok {
+		return validResponse.VisitGetNotificationPreferencesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateNotificationPreferences operation middleware
+func (sh *strictHandler) UpdateNotificationPreferences(ctx echo.Context) error {
+	var request UpdateNotificationPreferencesRequestObject
+
+	var body UpdateNotificationPreferencesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateNotificationPreferences(ctx.Request().Context(), request.(UpdateNotificationPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateNotificationPreferences")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateNotificationPreferencesResponseObject); ok {
+		return validResponse.VisitUpdateNotificationPreferencesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MarkAllNotificationsRead operation middleware
+func (sh *strictHandler) MarkAllNotificationsRead(ctx echo.Context, params MarkAllNotificationsReadParams) error {
+	var request MarkAllNotificationsReadRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MarkAllNotificationsRead(ctx.Request().Context(), request.(MarkAllNotificationsReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MarkAllNotificationsRead")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MarkAllNotificationsReadResponseObject); ok {
+		return validResponse.VisitMarkAllNotificationsReadResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUnreadNotificationCount operation middleware
+func (sh *strictHandler) GetUnreadNotificationCount(ctx echo.Context) error {
+	var request GetUnreadNotificationCountRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUnreadNotificationCount(ctx.Request().Context(), request.(GetUnreadNotificationCountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUnreadNotificationCount")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUnreadNotificationCountResponseObject); ok {
+		return validResponse.VisitGetUnreadNotificationCountResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MarkNotificationRead operation middleware
+func (sh *strictHandler) MarkNotificationRead(ctx echo.Context, id int) error {
+	var request MarkNotificationReadRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MarkNotificationRead(ctx.Request().Context(), request.(MarkNotificationReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MarkNotificationRead")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MarkNotificationReadResponseObject); ok {
+		return validResponse.VisitMarkNotificationReadResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListProjects operation middleware
+func (sh *strictHandler) ListProjects(ctx echo.Context) error {
+	var request ListProjectsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProjects(ctx.Request().Context(), request.(ListProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProjects")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
+		return validResponse.VisitListProjectsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteReminder operation middleware
+func (sh *strictHandler) DeleteReminder(ctx echo.Context, id int) error {
+	var request DeleteReminderRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteReminder(ctx.Request().Context(), request.(DeleteReminderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteReminder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteReminderResponseObject); ok {
+		return validResponse.VisitDeleteReminderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListStatuses operation middleware
+func (sh *strictHandler) ListStatuses(ctx echo.Context) error {
+	var request ListStatusesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStatuses(ctx.Request().Context(), request.(ListStatusesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStatuses")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListStatusesResponseObject); ok {
+		return validResponse.VisitListStatusesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateStatus operation middleware
+func (sh *strictHandler) CreateStatus(ctx echo.Context) error {
+	var request CreateStatusRequestObject
+
+	var body CreateStatusJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateStatus(ctx.Request().Context(), request.(CreateStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateStatusResponseObject); ok {
+		return validResponse.VisitCreateStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteStatus operation middleware
+func (sh *strictHandler) DeleteStatus(ctx echo.Context, id int) error {
+	var request DeleteStatusRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteStatus(ctx.Request().Context(), request.(DeleteStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteStatusResponseObject); ok {
+		return validResponse.VisitDeleteStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateStatus operation middleware
+func (sh *strictHandler) UpdateStatus(ctx echo.Context, id int) error {
+	var request UpdateStatusRequestObject
+
+	request.Id = id
+
+	var body UpdateStatusJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateStatus(ctx.Request().Context(), request.(UpdateStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateStatusResponseObject); ok {
+		return validResponse.VisitUpdateStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SyncTodos operation middleware
+func (sh *strictHandler) SyncTodos(ctx echo.Context, params SyncTodosParams) error {
+	var request SyncTodosRequestObject
+
+	request.Params = params
+
+	var body SyncTodosJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SyncTodos(ctx.Request().Context(), request.(SyncTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SyncTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SyncTodosResponseObject); ok {
+		return validResponse.VisitSyncTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListTodos operation middleware
+func (sh *strictHandler) ListTodos(ctx echo.Context, params ListTodosParams) error {
+	var request ListTodosRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTodos(ctx.Request().Context(), request.(ListTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListTodosResponseObject); ok {
+		return validResponse.VisitListTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateTodo operation middleware
+func (sh *strictHandler) CreateTodo(ctx echo.Context, params CreateTodoParams) error {
+	var request CreateTodoRequestObject
+
+	request.Params = params
+
+	var body CreateTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTodo(ctx.Request().Context(), request.(CreateTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateTodoResponseObject); ok {
+		return validResponse.VisitCreateTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BatchCompleteTodos operation middleware
+func (sh *strictHandler) BatchCompleteTodos(ctx echo.Context, params BatchCompleteTodosParams) error {
+	var request BatchCompleteTodosRequestObject
+
+	request.Params = params
+
+	var body BatchCompleteTodosJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchCompleteTodos(ctx.Request().Context(), request.(BatchCompleteTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchCompleteTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BatchCompleteTodosResponseObject); ok {
+		return validResponse.VisitBatchCompleteTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BatchCreateTodos operation middleware
+func (sh *strictHandler) BatchCreateTodos(ctx echo.Context, params BatchCreateTodosParams) error {
+	var request BatchCreateTodosRequestObject
+
+	request.Params = params
+
+	var body BatchCreateTodosJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchCreateTodos(ctx.Request().Context(), request.(BatchCreateTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchCreateTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BatchCreateTodosResponseObject); ok {
+		return validResponse.VisitBatchCreateTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BatchDeleteTodos operation middleware
+func (sh *strictHandler) BatchDeleteTodos(ctx echo.Context, params BatchDeleteTodosParams) error {
+	var request BatchDeleteTodosRequestObject
+
+	request.Params = params
+
+	var body BatchDeleteTodosJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchDeleteTodos(ctx.Request().Context(), request.(BatchDeleteTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchDeleteTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BatchDeleteTodosResponseObject); ok {
+		return validResponse.VisitBatchDeleteTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BatchUpdateTodos operation middleware
+func (sh *strictHandler) BatchUpdateTodos(ctx echo.Context, params BatchUpdateTodosParams) error {
+	var request BatchUpdateTodosRequestObject
+
+	request.Params = params
+
+	var body BatchUpdateTodosJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchUpdateTodos(ctx.Request().Context(), request.(BatchUpdateTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchUpdateTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BatchUpdateTodosResponseObject); ok {
+		return validResponse.VisitBatchUpdateTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListTodoChanges operation middleware
+func (sh *strictHandler) ListTodoChanges(ctx echo.Context, params ListTodoChangesParams) error {
+	var request ListTodoChangesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTodoChanges(ctx.Request().Context(), request.(ListTodoChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTodoChanges")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListTodoChangesResponseObject); ok {
+		return validResponse.VisitListTodoChangesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ExportTodos operation middleware
+func (sh *strictHandler) ExportTodos(ctx echo.Context, params ExportTodosParams) error {
+	var request ExportTodosRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportTodos(ctx.Request().Context(), request.(ExportTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExportTodosResponseObject); ok {
+		return validResponse.VisitExportTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ImportTodos operation middleware
+func (sh *strictHandler) ImportTodos(ctx echo.Context, params ImportTodosParams) error {
+	var request ImportTodosRequestObject
+
+	request.Params = params
+	request.ContentType = ctx.Request().Header.Get("Content-Type")
+
+	request.Body = ctx.Request().Body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportTodos(ctx.Request().Context(), request.(ImportTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ImportTodosResponseObject); ok {
+		return validResponse.VisitImportTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ImportExternalTodos operation middleware
+func (sh *strictHandler) ImportExternalTodos(ctx echo.Context, source ImportExternalTodosParamsSource, params ImportExternalTodosParams) error {
+	var request ImportExternalTodosRequestObject
+
+	request.Source = source
+	request.Params = params
+
+	var body ImportExternalTodosJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportExternalTodos(ctx.Request().Context(), request.(ImportExternalTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportExternalTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ImportExternalTodosResponseObject); ok {
+		return validResponse.VisitImportExternalTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// QuickAddTodo operation middleware
+func (sh *strictHandler) QuickAddTodo(ctx echo.Context, params QuickAddTodoParams) error {
+	var request QuickAddTodoRequestObject
+
+	request.Params = params
+
+	var body QuickAddTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.QuickAddTodo(ctx.Request().Context(), request.(QuickAddTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QuickAddTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(QuickAddTodoResponseObject); ok {
+		return validResponse.VisitQuickAddTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PreviewQuickAddTodo operation middleware
+func (sh *strictHandler) PreviewQuickAddTodo(ctx echo.Context) error {
+	var request PreviewQuickAddTodoRequestObject
+
+	var body PreviewQuickAddTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewQuickAddTodo(ctx.Request().Context(), request.(PreviewQuickAddTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewQuickAddTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PreviewQuickAddTodoResponseObject); ok {
+		return validResponse.VisitPreviewQuickAddTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTodo operation middleware
+func (sh *strictHandler) DeleteTodo(ctx echo.Context, id int, params DeleteTodoParams) error {
+	var request DeleteTodoRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTodo(ctx.Request().Context(), request.(DeleteTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTodoResponseObject); ok {
+		return validResponse.VisitDeleteTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTodo operation middleware
+func (sh *strictHandler) GetTodo(ctx echo.Context, id int) error {
+	var request GetTodoRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodo(ctx.Request().Context(), request.(GetTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTodoResponseObject); ok {
+		return validResponse.VisitGetTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateTodo operation middleware
+func (sh *strictHandler) UpdateTodo(ctx echo.Context, id int, params UpdateTodoParams) error {
+	var request UpdateTodoRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTodo(ctx.Request().Context(), request.(UpdateTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateTodoResponseObject); ok {
+		return validResponse.VisitUpdateTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetTodo400JSONResponse ErrorResponse
+// AddTodoBlocker operation middleware
+func (sh *strictHandler) AddTodoBlocker(ctx echo.Context, id int) error {
+	var request AddTodoBlockerRequestObject
 
-func (response GetTodo400JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body AddTodoBlockerJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type GetTodo401JSONResponse ErrorResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddTodoBlocker(ctx.Request().Context(), request.(AddTodoBlockerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddTodoBlocker")
+	}
 
-func (response GetTodo401JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddTodoBlockerResponseObject); ok {
+		return validResponse.VisitAddTodoBlockerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetTodo404JSONResponse ErrorResponse
-
-func (response GetTodo404JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// RemoveTodoBlocker operation middleware
+func (sh *strictHandler) RemoveTodoBlocker(ctx echo.Context, id int, blockerId int) error {
+	var request RemoveTodoBlockerRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.BlockerId = blockerId
 
-type GetTodo500JSONResponse ErrorResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveTodoBlocker(ctx.Request().Context(), request.(RemoveTodoBlockerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveTodoBlocker")
+	}
 
-func (response GetTodo500JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RemoveTodoBlockerResponseObject); ok {
+		return validResponse.VisitRemoveTodoBlockerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UpdateTodoRequestObject struct {
-	Id   int `json:"id"`
-	Body *UpdateTodoJSONRequestBody
-}
+// SetTodoDue operation middleware
+func (sh *strictHandler) SetTodoDue(ctx echo.Context, id int, params SetTodoDueParams) error {
+	var request SetTodoDueRequestObject
 
-type UpdateTodoResponseObject interface {
-	VisitUpdateTodoResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Params = params
 
-type UpdateTodo200JSONResponse Todo
+	var body SetTodoDueJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response UpdateTodo200JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTodoDue(ctx.Request().Context(), request.(SetTodoDueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTodoDue")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetTodoDueResponseObject); ok {
+		return validResponse.VisitSetTodoDueResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UpdateTodo400JSONResponse ErrorResponse
+// MoveTodo operation middleware
+func (sh *strictHandler) MoveTodo(ctx echo.Context, id int) error {
+	var request MoveTodoRequestObject
 
-func (response UpdateTodo400JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body MoveTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type UpdateTodo401JSONResponse ErrorResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveTodo(ctx.Request().Context(), request.(MoveTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveTodo")
+	}
 
-func (response UpdateTodo401JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MoveTodoResponseObject); ok {
+		return validResponse.VisitMoveTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UpdateTodo404JSONResponse ErrorResponse
+// ListTodoReminders operation middleware
+func (sh *strictHandler) ListTodoReminders(ctx echo.Context, id int) error {
+	var request ListTodoRemindersRequestObject
 
-func (response UpdateTodo404JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTodoReminders(ctx.Request().Context(), request.(ListTodoRemindersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTodoReminders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListTodoRemindersResponseObject); ok {
+		return validResponse.VisitListTodoRemindersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type UpdateTodo500JSONResponse ErrorResponse
+// CreateTodoReminder operation middleware
+func (sh *strictHandler) CreateTodoReminder(ctx echo.Context, id int) error {
+	var request CreateTodoReminderRequestObject
 
-func (response UpdateTodo500JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateTodoReminderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// API information
-	// (GET /)
-	GetInfo(ctx context.Context, request GetInfoRequestObject) (GetInfoResponseObject, error)
-	// Health check
-	// (GET /health)
-	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
-	// List all todos
-	// (GET /todos)
-	ListTodos(ctx context.Context, request ListTodosRequestObject) (ListTodosResponseObject, error)
-	// Create a new todo
-	// (POST /todos)
-	CreateTodo(ctx context.Context, request CreateTodoRequestObject) (CreateTodoResponseObject, error)
-	// Batch complete todos
-	// (POST /todos/batch/complete)
-	BatchCompleteTodos(ctx context.Context, request BatchCompleteTodosRequestObject) (BatchCompleteTodosResponseObject, error)
-	// Batch delete todos
-	// (POST /todos/batch/delete)
-	BatchDeleteTodos(ctx context.Context, request BatchDeleteTodosRequestObject) (BatchDeleteTodosResponseObject, error)
-	// Delete a todo
-	// (DELETE /todos/{id})
-	DeleteTodo(ctx context.Context, request DeleteTodoRequestObject) (DeleteTodoResponseObject, error)
-	// Get a todo by ID
-	// (GET /todos/{id})
-	GetTodo(ctx context.Context, request GetTodoRequestObject) (GetTodoResponseObject, error)
-	// Update a todo
-	// (PUT /todos/{id})
-	UpdateTodo(ctx context.Context, request UpdateTodoRequestObject) (UpdateTodoResponseObject, error)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTodoReminder(ctx.Request().Context(), request.(CreateTodoReminderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTodoReminder")
+	}
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	response, err := handler(ctx, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateTodoReminderResponseObject); ok {
+		return validResponse.VisitCreateTodoReminderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// SetTodoStatus operation middleware
+func (sh *strictHandler) SetTodoStatus(ctx echo.Context, id int, params SetTodoStatusParams) error {
+	var request SetTodoStatusRequestObject
 
-// GetInfo operation middleware
-func (sh *strictHandler) GetInfo(ctx echo.Context) error {
-	var request GetInfoRequestObject
+	request.Id = id
+	request.Params = params
+
+	var body SetTodoStatusJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetInfo(ctx.Request().Context(), request.(GetInfoRequestObject))
+		return sh.ssi.SetTodoStatus(ctx.Request().Context(), request.(SetTodoStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetInfo")
+		handler = middleware(handler, "SetTodoStatus")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetInfoResponseObject); ok {
-		return validResponse.VisitGetInfoResponse(ctx.Response())
+	} else if validResponse, ok := response.(SetTodoStatusResponseObject); ok {
+		return validResponse.VisitSetTodoStatusResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(ctx echo.Context) error {
-	var request GetHealthRequestObject
+// DeleteCalendarFeed operation middleware
+func (sh *strictHandler) DeleteCalendarFeed(ctx echo.Context) error {
+	var request DeleteCalendarFeedRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx.Request().Context(), request.(GetHealthRequestObject))
+		return sh.ssi.DeleteCalendarFeed(ctx.Request().Context(), request.(DeleteCalendarFeedRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "DeleteCalendarFeed")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		return validResponse.VisitGetHealthResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteCalendarFeedResponseObject); ok {
+		return validResponse.VisitDeleteCalendarFeedResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListTodos operation middleware
-func (sh *strictHandler) ListTodos(ctx echo.Context) error {
-	var request ListTodosRequestObject
+// CreateCalendarFeed operation middleware
+func (sh *strictHandler) CreateCalendarFeed(ctx echo.Context) error {
+	var request CreateCalendarFeedRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTodos(ctx.Request().Context(), request.(ListTodosRequestObject))
+		return sh.ssi.CreateCalendarFeed(ctx.Request().Context(), request.(CreateCalendarFeedRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTodos")
+		handler = middleware(handler, "CreateCalendarFeed")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListTodosResponseObject); ok {
-		return validResponse.VisitListTodosResponse(ctx.Response())
+	} else if validResponse, ok := response.(CreateCalendarFeedResponseObject); ok {
+		return validResponse.VisitCreateCalendarFeedResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CreateTodo operation middleware
-func (sh *strictHandler) CreateTodo(ctx echo.Context) error {
-	var request CreateTodoRequestObject
+// ExportAccountData operation middleware
+func (sh *strictHandler) ExportAccountData(ctx echo.Context, params ExportAccountDataParams) error {
+	var request ExportAccountDataRequestObject
 
-	var body CreateTodoJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTodo(ctx.Request().Context(), request.(CreateTodoRequestObject))
+		return sh.ssi.ExportAccountData(ctx.Request().Context(), request.(ExportAccountDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTodo")
+		handler = middleware(handler, "ExportAccountData")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CreateTodoResponseObject); ok {
-		return validResponse.VisitCreateTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(ExportAccountDataResponseObject); ok {
+		return validResponse.VisitExportAccountDataResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// BatchCompleteTodos operation middleware
-func (sh *strictHandler) BatchCompleteTodos(ctx echo.Context) error {
-	var request BatchCompleteTodosRequestObject
-
-	var body BatchCompleteTodosJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+// GetUserSettings operation middleware
+func (sh *strictHandler) GetUserSettings(ctx echo.Context) error {
+	var request GetUserSettingsRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.BatchCompleteTodos(ctx.Request().Context(), request.(BatchCompleteTodosRequestObject))
+		return sh.ssi.GetUserSettings(ctx.Request().Context(), request.(GetUserSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BatchCompleteTodos")
+		handler = middleware(handler, "GetUserSettings")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(BatchCompleteTodosResponseObject); ok {
-		return validResponse.VisitBatchCompleteTodosResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetUserSettingsResponseObject); ok {
+		return validResponse.VisitGetUserSettingsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// BatchDeleteTodos operation middleware
-func (sh *strictHandler) BatchDeleteTodos(ctx echo.Context) error {
-	var request BatchDeleteTodosRequestObject
+// UpdateUserSettings operation middleware
+func (sh *strictHandler) UpdateUserSettings(ctx echo.Context) error {
+	var request UpdateUserSettingsRequestObject
 
-	var body BatchDeleteTodosJSONRequestBody
+	var body UpdateUserSettingsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.BatchDeleteTodos(ctx.Request().Context(), request.(BatchDeleteTodosRequestObject))
+		return sh.ssi.UpdateUserSettings(ctx.Request().Context(), request.(UpdateUserSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BatchDeleteTodos")
+		handler = middleware(handler, "UpdateUserSettings")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(BatchDeleteTodosResponseObject); ok {
-		return validResponse.VisitBatchDeleteTodosResponse(ctx.Response())
+	} else if validResponse, ok := response.(UpdateUserSettingsResponseObject); ok {
+		return validResponse.VisitUpdateUserSettingsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTodo operation middleware
-func (sh *strictHandler) DeleteTodo(ctx echo.Context, id int) error {
-	var request DeleteTodoRequestObject
-
-	request.Id = id
+// ListPersonalAccessTokens operation middleware
+func (sh *strictHandler) ListPersonalAccessTokens(ctx echo.Context) error {
+	var request ListPersonalAccessTokensRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTodo(ctx.Request().Context(), request.(DeleteTodoRequestObject))
+		return sh.ssi.ListPersonalAccessTokens(ctx.Request().Context(), request.(ListPersonalAccessTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTodo")
+		handler = middleware(handler, "ListPersonalAccessTokens")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTodoResponseObject); ok {
-		return validResponse.VisitDeleteTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(ListPersonalAccessTokensResponseObject); ok {
+		return validResponse.VisitListPersonalAccessTokensResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTodo operation middleware
-func (sh *strictHandler) GetTodo(ctx echo.Context, id int) error {
-	var request GetTodoRequestObject
+// CreatePersonalAccessToken operation middleware
+func (sh *strictHandler) CreatePersonalAccessToken(ctx echo.Context) error {
+	var request CreatePersonalAccessTokenRequestObject
 
-	request.Id = id
+	var body CreatePersonalAccessTokenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTodo(ctx.Request().Context(), request.(GetTodoRequestObject))
+		return sh.ssi.CreatePersonalAccessToken(ctx.Request().Context(), request.(CreatePersonalAccessTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTodo")
+		handler = middleware(handler, "CreatePersonalAccessToken")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTodoResponseObject); ok {
-		return validResponse.VisitGetTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(CreatePersonalAccessTokenResponseObject); ok {
+		return validResponse.VisitCreatePersonalAccessTokenResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UpdateTodo operation middleware
-func (sh *strictHandler) UpdateTodo(ctx echo.Context, id int) error {
-	var request UpdateTodoRequestObject
+// DeletePersonalAccessToken operation middleware
+func (sh *strictHandler) DeletePersonalAccessToken(ctx echo.Context, id int) error {
+	var request DeletePersonalAccessTokenRequestObject
 
 	request.Id = id
 
-	var body UpdateTodoJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateTodo(ctx.Request().Context(), request.(UpdateTodoRequestObject))
+		return sh.ssi.DeletePersonalAccessToken(ctx.Request().Context(), request.(DeletePersonalAccessTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateTodo")
+		handler = middleware(handler, "DeletePersonalAccessToken")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UpdateTodoResponseObject); ok {
-		return validResponse.VisitUpdateTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeletePersonalAccessTokenResponseObject); ok {
+		return validResponse.VisitDeletePersonalAccessTokenResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -933,28 +6965,223 @@ func (sh *strictHandler) UpdateTodo(ctx echo.Context, id int) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYTXPbNhD9Kxi0R9aiG7cH3myndTVx2zROTh5NBiJWImISYIClE9Wj/95ZQLREEbLs",
-	"seLaGV08kgXsvn14b/Fxw3NT1UaDRsezG+7yAirhP54IzItTU9UlILwDVxvtgH6oranBogI/bCJUCZI+",
-	"KYTK/+tHCxOe8R8Gy9iDReCBj/q7nzNEqPg84TirgWdcWCtm9N01eQ4gHxD0vZGmH2mecAufG2Up0uVK",
-	"2KQFPbqdYsafIEeK4QG+hudR9MTYSiDPuNL46xG/naY0whTsTmtewderF6w1lj4spjq0Sk9pqpL3wrmG",
-	"SxGgEHQjHlrUd/C5AYd9QEq6hxJVia/DMPwwTRNeKd1+3UIi5YqhPLUgEO6EKcHlVtWojI6yhwpLiPyy",
-	"hiAMi2H4jTjcrNMKnBPTe2RoB8Zy/AGixGJzEocCG7c9x2JcLMVQT8zmBFpUEKXvGqyLU7uW2kdYjo9B",
-	"8D2klzpf9D+5kmNsTAlC06TcS0B+FNgRoRQIP6HyOXugt2nino7aLJ6EN7V8MKzGgf34CDcHNMkKY8uY",
-	"HaI68GIr8cH/fKevtizLI2y3hoY6M+SNVTi7oGbepjdXCo4bLHwT0jxb/IsnC7FyB85LbdlbavUGqLnQ",
-	"EuuJ6bUHfsycorIYlc6O3w7ZuFElsi8KC3ZmmNCSvTUOpxYu/jm/pTzj7fgVhWc8PTg8SKlYU4MWteIZ",
-	"f3WQHrziCa8FFr6OAf2ZAvaxnAF6BISUxKCMZkrnZSOVnjKq0cNp0/ks1g8byjCdLM1JJ8HVPt/PaRro",
-	"0wjaZxV1XarcTxx8cmG5wq65bU/ttAzPareCv9+E1WuqStgZ0dsth/gTU0f6nYIGK0o+ogmDwre7jcyc",
-	"FpBfMTVhWECgyDHbaE0CitAQmue3JGKtPd+HijCF5VTKRh7QSOPuFIgoS+ZHsYmxng/RYAEaqRCQjOzf",
-	"4+RcOXzvQz+Sk8ecDKMUJfwoPdzZsnR35kjKD5rYMlb9C5KS/7JDTWxNPtQIVouSObDXYFk4i622O55d",
-	"dhvd5Wg+WhURLeRSAisyCt9H84TXxsUc5PcCJpiGL35y6HCkn9qaayVBrhm1q6DluYuHfQgcnhg52xl7",
-	"/YPdvLvloW1g3pPv7rQTVNtftQBMBqk+oVpOhGS2pWJvkwfZpKf2iFNu2+1gTDefQXu88QefqIf+FPaK",
-	"VU2Jig4MoQkLx1YPX13TdG7zy/67e/P07m738k662/y9Z4uoqDEv2C1LK9zt7fVy7BVWsV27jXvRusMk",
-	"3O2vCzNBFgat2SzurPBg9N37au1dbO+q79pVC/1v99SNkvPgodZVXURBNkyE0954xoavezZaOsjfUK2o",
-	"AME6D7UbzV93fYTeQ4W/itP1dnkR988PXZskK0z3njZGPQ8d9Qv6y7DTxVo+tayH+lqUShIB/7eqjwI1",
-	"T5Pcr7o2yCam0S/SVB0XRO9Lm2/azCk9XWxBZCCFLmaiM8Dn6KD0m9+M2vv73oh7I241YnBUZy+KvF00",
-	"ES+Gp2kmNIOvyqHS07u2tOVD9jMw5O5PpP13+ic+kj7LZsCMbY+dbEx075vDi2oOrcU3vtX4YBQ9ZuRz",
-	"k4uSSbiG0tQVaFwg4QlvbMkzXiDW2WBQ0rjCOMyO0jTlBGGRqL/9+0d5BlrWRml0yz7QvtfPk2g/qYQW",
-	"U/AgIpNDPfPR/L8AAAD//4NaTvwDIgAA",
+	"H4sIAAAAAAAC/+y9aXMbR5Iw/FfK2Ddipd0mSMny7Iwc84ES5Vn6Ei3K9js7dCAK3QmgzEZVu6qaFOzQ",
+	"f38iM6v6ALoB8BCPMT7MWER315GVlffxxyA188Jo0N4NXv4xcOkM5pL+eZhl701mXuUmPQf7Dn4rwXl8",
+	"UFhTgPUK6LUxPx+pDP/KwKVWFV4ZPXg5wO+Fn0kv5qXzYgxiorRyM8jERFnnB8lgYuxc+sHLgdL+Ly8G",
+	"ycAvCuA/YQp28PFjMrDwW6ksZIOX/2pO90v1shn/CqkffEwGr6RPZ6/NvMjBwztwhdEOVhc9kSoHWrDy",
+	"MKef/j8Lk8HLwX/s1wDZD9DYp1G/om+OPcwHH6uZpbVygX+7Mk0BsisMisDpGulSWq301F1tdT/zV6sD",
+	"LsGvXmcSodCYsh+kFqSHBghWQArWGov/CAM4b8N6lM7gwypynBin8J/CTISfgcC9CqXp3zZg20Z04LGT",
+	"MPuG5fficAXmJfSdgZjLD2pezoUu52OwuFZ6WSgnUqMnalpayIThZTuwF2DFk2cHB2K8EBlMZJn7p4Nk",
+	"u4PkVSJexJV+TAZzpY/542cbjpbn2AiD27wTK2hxKzejMXTPqJuRegMYepD4VlE1GXi84luRgR60pgF6",
+	"t3IED4PKbaThj4TIrSNvqclgFTG+k+lMadizIDM5zkFYkM7oodDGjyam1BkSCpk7IywUxnrkfMYKPFcn",
+	"zKWGDAmF8TOwonRg3VBYk+eQjcYyPRdzkNoRmuEX4lI6cSFzlYlx6XGOmdJT+lUWRa5wMEhl6UBIzWPS",
+	"Z0oLqYX0Zq5SMcadCobKUDA7zZYncl7luZhJJ0wBOrxl3ZfCgrcLcan8DPeRwt+9LfEbkQaeK5QXUi8u",
+	"5WKQDECXczyPChoD5Aa0A2TgyaCx10ESeHvz7tZMZA17ybbCweUbhtPToW5kHk2CvEoxMtcj9xwfuaE4",
+	"KotcpdKDE9KCKKxJwTniGCmeUlbjhdIiouhQdLOe46PrM54tLukVWE225hr9WGTSw+uZ1NMuoqQgz9r3",
+	"PqKJVz6HQdICZjKImNWNF8uE5QbYEFa2YV9/dkxIBgUCYxO9ZmC1ZJkOHIqDbYR5H4tLCcs6AP8VHaa4",
+	"nBkHSDNLEOFdIsAg0xmRum1ls1XM7kC+zC5GttQNMjU2Jgep8eEn5sbt7fNKM2Y0Q3GsRWYXe7bUYm4y",
+	"SCpK74Qksr8Ql6bMkX8IOfFg6YWSBtkWQg9Rn0kqBKkPpxfX4pQrwDzUQma/ls7PQXsxlxlxvIbwxzpu",
+	"pjLkyYI5cCUnekPcdpAso26nRCFzCzJbjCqq1yUDhJcqppt9iWxXuRYJqSAjSs1QyBosOYvkiBnxyrSd",
+	"xHZL4poM5uCcZOq/NMgaNhw/6jwgI23WJZXl5VxfAbVwmNf00UbMimP3LieMs7Io56UvNy7llN8KWkIP",
+	"63B4kHOpS5kLYzOwN7uMy3eHlxBX0LnRMj/Hsb5SuQe7ushTyCH1rklPxLjMz8WvZiwQKMTwUCJ+O1ce",
+	"MTO1yoNVUsxJEIULsItIiZcPN+LiyrRvdb4I85Es6mfEDOl91NNwZzBIOqhwyurfaAwTY2HtyOFVwa/y",
+	"HF7NoWm1QgK5F37suC7uqnrSXH6IjPfg4OBgEye2Bg+q0/bW2AhpqsqJ8PqWVrcVXHgtc9CZtF8BdFxF",
+	"b85BdyFIasELeoriipdKM31ClPnx3bdDcUyky+B6LfjS4vPLGWihnCuJaK1AtrR571QTgAwHRsLryjG+",
+	"MSaCPbFmLqRIwzZQZxqKQ70wGhpYhF+mUgukhzVaN2FWWrW6pqWrhQtMAky67hVbIb42415xclLduLUE",
+	"rX0/P9Iy0w68jpdpFFRPnS+GIhpKA57ABQJ9wvJAVAEvoK0DDsVbPwN7qRwgi5nFa29BuHNVFJCRMJua",
+	"UgdGZMGVuR+Gp523kn+pNYH2akklaPz5yybo09MkgrAf/idgndEyP0xRFn+Pp9V7HlrOO8D6rRxDTtIA",
+	"5DljOSrj0vqEocPilcjgQqUgDGEdiwulAyeUH9Cl/xb01M/o1tOdr/7etFVaVv8O38Fc6axlvm9v4M0H",
+	"mfp8IfASmImw9P5IejpEM5k48KO50iWScRIwwtRJhyCuIW8eIsxZ7FF6JIsCxTIYz4w57xQt2lN1WFrC",
+	"Gizk0quLSgLLShBIhMUTDVN+8vdAsZ8uEbrPnzOsUZUavHzx+fMAa/57L/ywSpMrmLRo+BrKvyxIBND0",
+	"nxLLAv2apRtlRnean84jX0JQ4BkyT0+EhSKXKZqH8FFaWgva4yl33r+I3A1M/GITIiaDS1WMcjVXHWh1",
+	"gKhuAstnCbbU9C5anb43Xsg8N5e13tpYPH6KP5GLiIlPJamsnmc8v4ONSv6Gq7LWzNPaXYcRKivhCgiS",
+	"BFPHyz/Wg3iZrNFH/TvIOujZ6laK8NJI0lujinGv4zNdI39MtmH6Q/GjY6sgY2khnbs0FomL+N/370/E",
+	"K+lUKmTpZ6A9qiQowKGO/lrmR4c/XUM+WAYbLTLp2XgXON9Ya+zrTv3s1JOdd75s+CUbokhNBkPxOlcI",
+	"POFmrFNbqdMZI7pCacx5lC2CLyEavSLVHMtsVPsUSo2AMVb9zhqusWOVZbSdplF1Dn5mshH+FO4VqVV6",
+	"kiuS98KAI2/MKJd2SjhpzGgu9SLO5ohUe7AIItrOIBmgOUmlMCq1vJAqx60OkgFZcOmgRpXOHe26caqx",
+	"yRbL5t7qj8mIpH/8Kfxz1OAsMC/8YlSEN9qYUU9YOrCjJhBUBvPCeNDpYnQOC96s0dOORxZKB13fKD2a",
+	"5Go680Erak1AR9VcKP9Ay20ClC1b9Z8kvycDxAKztOQAkCI4mEb8TmULb7+dQQE6o7U2f3aQT0b1s/aL",
+	"6SLNIW4mGtiToPq1hin1uTaXelTR2ri4nh9GDnxjJDmHUQPjKt4wgg+1gQYpevy8eqM58kKno3hfKwCy",
+	"HqmMdrRMVxZs5cD5PGg/CvJehV5zfD6aqBwa+wq/OlPatPk2fMDfWwfGb8Zziy8Gyl7/EC0E9Ry/mvHy",
+	"YoIQmgzwWRPe+HcMhxhEKQO68SM+DAYtDfnSjdTGq0m8Il0jpKV1xjZ+aH1RWJiABU2AiQrSaAKQtQbr",
+	"pKCd04Unct4ERWYudW5kNsqVRn9P6288h3CtqkHUHH5nkSX+RJfegffBvBfs26OoCddr6RIyySj8JnqS",
+	"lox8qB6Pc5izLiiFU3qa1yY+8g6siL3866rDWFo5Bw9WIAgSlGi+Pn37vTgxRGHFk3dfvRZ/+dvBs6dC",
+	"6SVbIhLOSnfYJyTcP9iPbKIjuqIpcweq+1sJdsHWdSShM5BZSxEKnyeDD3v45d6FtLhQh0PUQDrWr3i4",
+	"5k8/hKGbv53wNM2f/jdM2TYGLhmptbdSu5ysLI1HyBovUUVSTlxao6d8JCwT8ilssCrq6M7ZYFb8X5C5",
+	"n/W7F2pT3voJw3tdUxwTMflWaagQrz1HrnTTUHodKyoNsX6nvIwTCxcKLvtc3A1726qOcNsi8Lp9e5lJ",
+	"L/GpzDLijDI/aS12dfq2+4eWsOcKSJHKoYoog5XegCMz/VwWwuDlk2xYJ3xpaO0mM0P/wYvCKmOVX+Al",
+	"LvVckonj9elPIpD/KKDimE5eNAXSGvyVvL/VQcbL3mWNXz7SfuQlGa5DlUZc3GNXImQsuKJVh+yFldqF",
+	"/kNpofIobGt4Xkb3DodQ7Qhrr+v7yruJYHB8XJfQMCzF6AYzCcvutKEy+4ZtfRU9wV8n0rroQAtLiR6y",
+	"OEEAWqWUKC0KvmDBy0Y2lBW4HlwNls072+UEZNisA2dzD01wSiey2iv9JIOsLMhqsslbPq9wopLGa+te",
+	"OJlOjNUT04+v0QixcrUvwLpuqtOl4dfvdy3hazNenTmVOoU8qi59BDC6Dq5C5PrDVqLod6XhtnbAVeEF",
+	"6/CCIxmrV4VDT6XtsrKsTsBG3X4K7W0Jy0T5azOuKTIPkAgHnrV5vCPoNcKoo4ZcvHKCzkt71WOo+XgU",
+	"lFBBYhHIllrzvzp9yYwbfdEnxst8M4jj1pQT8KGAlD3zEfLbATyayLfwpwYNpOHew1U2kSJZRfkWfnfd",
+	"nO+kPT/M8+8beoN7BzLrv9Bzac/XY2BTCXGC30eyZEFm1wgODxN2rt5cLFv4luR/L3KQzkdLONuQRyoj",
+	"o/3EU8x50wQ+FD8j2o4NagsWxFRdgK4jK/BVNMES2/KXwBg+X9Eg4tAdHAi/bow3n0OmpId8UcVoKBdd",
+	"p1vQhGpHV5qq5f3ccq4u72ETbzqyCFDR6CKT1yG6W1NJRLPrmW1Xn6B9ZeuJl/1dDf3ezaQFVInJ/iez",
+	"udKbHV7Nax+Fx6AKbrjVzWM5kV0Bexo++Gg+WEGc1/Q7x7TOQOC7opBTqP39wb6fS8dPusDaIgMd9KL5",
+	"OBEaLlkh5wySrUSp5hAb4yLay9kItdp2sgI70Gif6bhwP8+A4nPbBNBMwj1bFEA0JRzeUBAeLL2dSo06",
+	"xxhEphzPs41v9aa4FtAsbm178LhV+BTth1c+ynrwjYfanGrTmn/USBleowu7S00OP1+VO/GHXXNv5bf5",
+	"pJQQryca1+LwS7Ek4S6r4LLABZKcpgGDTccAWgSD+nbr6hH1u6hakOk3kLEOCLqeGJXtMa3P6bUOzcIU",
+	"nWtk+2KHD558RzbIUTE2Bk2E//PXg/8R4TtxBF6qnOJX59KLJxTzzwi7H2yX//2rM/ppb6jhus3WPi8y",
+	"puBUGyx28KHIpZYccBXFejJmKidMyj7ntMEKophvge0lul9l6ovo3cvhAvK20aKw4EB7YkK1Z4qCLksL",
+	"blsW0TAOd8WVa+el7oqsQeNndOa14kHJVsExROF4lqKI9ppW9xU4REdal7x2fLQ0YxKTTGpTBD79//eC",
+	"vLt3fCTYCozBwGleZuSTpRNhE08MFaiXukaLaq+G3Lj8UIRYzg6RJ4pOS/7UmbFezMq51LUz1ZXzubSL",
+	"uItc6mkppyDSmXEoci8EXsnC730bnpCFfcx2ltygA4OyaUTwFNQKJsOAknLGiDRD8UZPc+VmZKv5WhZS",
+	"g2Pe23Sv9KQdrFyPd8dCZaC9miyW4Ek8PRGl1S+nZs+bzLwMT17+gTD7eAXc6GbIUe6r1D46ih5CRP+8",
+	"WxbTa97hMPMbBNj08InWyF1w+KFU6flhlgUL2yo8ZJ6PMrnoiM21JTBSkXlPcggShmaTFjgUbAwXHJmj",
+	"xVxlGr3KS0mDSB3UHMTvfXE5cj5W01J1RqEM3nxAyudIFmSCEw2UFLuIkcOsuUlNSu2lXCS1JwXfYRxF",
+	"s6B04XI1qeXGZJurmvyjKb0pjubmklwXmSqR6MzUdNZpailqpF2iv/yAPG2cLhNeRejHGF5kURjKqNAk",
+	"U/sA4INqpm42iW9kX6sTqhgIK969+/HbN12fe7mU77ARlD3k8T0qVDlMfFD5LczNRSQtFlIz1ep35sUR",
+	"FwbbhRElFXaH5baxbd196Y+SkosRq2UrG0FLkeAJTOkwkwks+2XAVT6Xz/df4H8yudifG+1nzVgZ+mE/",
+	"k4uhOGKnr4sxgBQZTwJofb+mjXTdDo7Qeds2ebg8fOjY2ffSl1bmexWX4gzKsKWzwYlcCAq+82ZurDWX",
+	"4m9yLv5jZuYgPkN0PxsMNobaVa7wVVHg8PvDmoowFLwR5GcuLPgAYmRv+JYTT2A4HYpDp+T+e3O+ME9X",
+	"ARpn6wFZH14heLrwJgahdhBY72Fe+JXo+B4b6E2DTK/D3ybKXvGLqyld/R6CTSGxR5Arzp7A018OjEUs",
+	"/E9XxccOxfcxPpay4dDGbqG27tWRtNva/xtBsUuW1LEzeelBZM0Fbq0bojyelXmPKooh6yXHCzQGX9Ux",
+	"RDRzsMk2wIZuQbRykgJrOve9pTOhvbToKejK2KrTuYwVHMxeJZbEnQySDu+EA+2v4I7Y3gjZaUEM39c3",
+	"rSFOVnd1ozJ+Ch5N7Udlf7JsLTksEVO4bKCsLvO8GUic5iBtCJrvPCv8gET24H9aJVh9i90Qgh1i1q4H",
+	"2/rjTmhV6HSHwnhvSHk0TLYzh4JyRybJiMlD0cwdCG8UzIitWI1JXxt4vnrJjPUjYuxdNAADLwLbD/bl",
+	"sZE2E0+kS/nqJALhKMaWbFXjhVDZ0+0o29XVkbWR8N+t5G433PGYaDQGMTcXJLtE4wkDbImoNePnyezm",
+	"wF8lej4RlzNV5esAxaGpOZqQFB4izRFwLqlCHBJhAcdgwhWJq7JxMuUiNdsGuGv0tsZ519h5NXXudKHT",
+	"vqoDnAA7cvDblhck7qozEuDadV2CA60GWWNdvVuKQbWrm6I482WiVJaqM2cuvE3cf/Dyj49JHby4bQGG",
+	"euW/dOpMxPeD9LxMVhD1nMrgP11Ix0e2eA6Fb7A9XmIIO+8KWPwYH9XbWDayVzCpIwAbC+sD8tsYYnxj",
+	"KF8piK71J3GvyOPoVtdPmRagbJcD6bG+ldW9nu9hmCcanfhHNBEP38nL70K0YOPpHkbx7JmCwzn2Co5W",
+	"5UH7UwvrLMIo8cREQuX7KsmsZBGijSKeAYtsv1KcRCfXMEUTa8vCgfUVcnZLRr2O2za5X2GGTF8Z0oSy",
+	"lP4fyC6jBmW4cF2fAuweYR35O/curfJg9y6VdltKl2uw2RTbkcAKld9VITo3QegbF97pir4JZL3eUIso",
+	"NjG7woNfrgKrNfG4CKJeMa+RabCtk6hNPVop3F8cHKwaehopDh0p/+egayN+MGhTTCEaTfDTQAiiw11p",
+	"5ZXM6dFGbGrsrh8yV660csoVZ8Jz4ZQOoSRkCqWVsccwEYo8D2S+MvOx80YDOb2jQhSqlRx6MTchVpIk",
+	"yuga29aV05AEOkxtMUvlamdcseGOEWfSjebGrhGn8WkFIgtzqfRQ4LBCTqXSbJatUYPS1cGns3ZURScx",
+	"5EC6ayJsoBEfr4On3ggHOqtNyrRORlKinBEspAwgX0KGkBrtlSYDhDXzOjakwnqG0kZkbqUK1VVeqqNo",
+	"nnMNpC68fx+kuc66pr2FObrLmjajpYyG4KFkvOcbPWV98yqFPN7BpBOPm3R8iSXTo70paDxnyKJXSkU7",
+	"KRadm0xypSGSjptKNtdRV2Oemu+FcqxPN17cDUy3T3JYssVVNoto7AyVSZZS6KlMQjRwtWx2/UaNG0QB",
+	"h7TCDnO81OfiHBbESJoFZpSeDgXD3hnrEbrjhYc9ktQQltIqF03rytEYT1ZV7aH4BhYoLC2CP8o5NW3w",
+	"tFCmDEFkSs93Pro1oha41n207APiJ0sqM6VkQFPDuKK3qTtYkp/V4u4YMM3UCW867JBNU+MVCrBcyQUV",
+	"NIUxQnBmLut5LRQgfbT5f/XuzQ9///nNm2++/eeXr/55dPjPv3/39mnfkisfGY/RbwjthNGbD5VhoTr6",
+	"aNUg3hBMekkXFFdMGmrSMKCSDdd02zi4kibi6nYwvoGf7hbc2CGHeevr3MiDaEP7J34QbUx4p03h1Vw5",
+	"r1LkuAGTFvhvb01OopiFOejgHOUyTFXBt2tZclazluoN9lty6m0lLRG+ol3hlPo4N8t5rl9wDcLlqLM+",
+	"I9ZRrExy4c2GCBvli5uUur2mcBh0lKZ8SJG4V5YP66/WCHKFdFQKkLce1FsaN4QqfBKRbkPts8p5bwNm",
+	"rj2aG5dDa9caykIafwN8jbPsw8ajmHmvusJdN8mU9JzZYIi2H4pDEb5CkBOpu5ypHELBP6o32LhvN5d/",
+	"riqR+dsQvLqL/LtBa0F9MMcRr5rMun2k/naJmz0EsGvJXJrzuiV/OE3TlnQDJUXBBduTjDzQz2SFG7FS",
+	"JA72Zdua0GSwY0jNnKIElurt3FapoLY7ZwsvzBqPCvoIfz4+EfR4KA44KAbYZhp+3FxhyOgViFEUIdke",
+	"aaUcSDGGpFvWuEY1oh5cWFt56IZZ2VsXGupZ248O7Gmot9CbOxWK7XoTKH0tU3JNZXEOUATHUSxFxQb8",
+	"ZIVZt+s5rJO/K74d+UTUJmQUtBE5WvZ0GvxqYnhcEWJwhwmTRRjWoDo1CQ4+Wt3Fq9cn4sX/1LGtXk6D",
+	"mA5678fTRPwqn94wV4ZiTulYmH0OxXv6AQlDrij8sONgWrVAbjUj4wphTCtBSp1eV4DzEaWDBoLEd+8v",
+	"17iHDSzvkiBvFyl7dNRrIOOSJdZYH73iEzphZtmVYoefiaKqTYICBS+l6YN7mHhd4xXujdUVvYzrCeeO",
+	"1ubzKfi+XKQHhNtDcUQxejWq0MtSNyxE7UDJs0EMJjwbkE2pCveLMZDK1TNuvjvL5BxZXSYXMYASX0Za",
+	"qpw4LTU+wLX9hf6WvrQcRXqFC9gOR62q/AScaq1uCemTjdl55CNOS6v84hQPMbJRc67gsPRUuV5xSVT8",
+	"KYYgvBw4DqCtoSUL9Q2gdEr5HxPTVTTIYQgFkMVMHJ4ci3Gpcs/q2j8MgenEOD+1cPrDt5WIGPoCHJ4c",
+	"N9Tfl4OD4bPhAfs3QctCDV4OPh8eDD8PhXxoH/v4f1PoOLV/gKcVKM2EhByplcMF90jLqbXtyh10nPHn",
+	"WJ6BbeWkStN8zw8OGHxUaQv/2Uw7Ql9y3TZsYz2LZvkHguqSbPsNnx5nfyB429uJpoCX/xqwZTsf/IIf",
+	"7I9jdfBewDBNnlpTFkwZqpgYPKlQyQVvTiUgsg1yBUZciPwTAokn6IFOMnhx8GzNVM00sO2njIlpHZP+",
+	"2Kw/+DEZfHFwcJfTH4d6hNFezM7o5hUfvPxX+3L/65ePvzRxiE4/Bog1MIgPGiPdCYVi0bP9P0jX/9iL",
+	"TbXplQpNmwnqGXUvhZ/evz16K0B7q8AlosgxbE789OanN9+/F9K3a9aaSd2IgpWQmQw+jsO66mHTat4o",
+	"pl2X0F4uKRkImagI3AoWt0p5J4NKJHAEzi4bEdfaxIWwgLA0AhHUUG0skNNoM6lpPcem1EixrIT8svFa",
+	"YYh5dVRtDFserPf+vLjb+0OFAQXld16Yc9IpQx7pQ7xLnVcnbWJ84wrF38MV4kKKbv8PlfVfn6NQ848G",
+	"/r/jEyFtOkMBp7AmK1NGMR7pMKWM6SPpZd99UFON8gdsfRk4QSjOSaGWFuQcMjZdOm+snDKjrKzBM2N9",
+	"VXODzW6xciFkK7crbjCs/k2sLrn2jvFb4vioSw3ouFsqW3ux1ktfHZMrW8l6WIsRCZkUP2r1gXNGvJwX",
+	"vSuLtQbD0riMo9vm4q9Z02l1so1l9cxYYcEtE5vmbfxdFe1LWMFirLSk1WxDgGIpRprxNU+1d6Rcv6P2",
+	"tJxOOVNwonJgMS4G/0QsHKzb50ciendMabhJHWYpW0EIsWDa+/ldLuN9i0I06v75dEbLeXZw18uhy4U8",
+	"nm9JFoO1meDRk2ixpaIKDTrzGBhGRdylFpLpH1derGvsBtYRngbOMaMynL084/UM0vNYfIJ0Aifqql0r",
+	"wg0X9fyUMvpS2dBtVBn+RKS4lV495lczprUWpsvK+kMJJcT+OtWuybzNcXMnJs/FP968FzQQ8WGOSrVm",
+	"asG5YFHgSKhlwFUdSTbxqpXYolKr30rASBD0WpLgW8eEOSRYGCRCDQkC2/bGUvRLVfCi4tgW9uADpKWv",
+	"c1xjKXQi/Uw+a9p/XNfv3kNdvcUFaw/F8y++SLo5AI3+KlSfuhXkWGnt8vHjx2XG9HEFOZ/f2vx4hB0Y",
+	"eRh8RYO75wevZFad4/2rsC8O/nanVLSNoRRDzuXnY6HpTE3IrOjrYh6BLRirpgopcHjAAisG1Ctd3erH",
+	"qZafemnR0omtX9EsozOskNigjEQLa7K4XquImkq06nRQPNLUV6db4R5bUMCvzfhuRPVfPiEP6yETUUu+",
+	"J4HxQdCHO7UQIDKhWMpV6x+tiU1ucX33OXm5X8J5Tc/RVcZJnDgmEUPpzoWMMh/+SsHn3hSNbqXRf5nO",
+	"Sn0+FD8be94KfxCqyjdbknxo1od97z+5eMBAyGnEKq5kRwrulxTcsajydai+HG9NVYX5UVKlipj0EqYV",
+	"l3OvbKH0niwKofsLkSaUBV+Q8TB6cFcozbfKtfzRbhPF4QREirOkQlMlFaZsryOU6Wend4+JjL9raUfB",
+	"qzp4OZG5g9VIr1WbHJaHFU79Dj2TxGY+HXM8P2i4h5+1+u4928ZE2ahB258eFyJgu5ZWdb+5LXvg1RB9",
+	"pcjuQxO8Anx2/sWrkxm80+0L2SA27d87qM7+UgXctdpNsU0MTAzyqts9cJFWzIZT09JCRgQjlu/t0ID6",
+	"I2bu5oI0pty5wW9TRm/hStE62T6ETQZF2YGRHBsaHDNcxxCR7hoBhm3s43HXIeDt2+vW4t4ms929XoGD",
+	"+/Bkc5wdxZvjP6s6NsulyHeX9OqXNFyra9zTVcZiQWZ7Mm9p2+3L1tfQY5NQSikI2GpjSQ5lc6ZGt19Z",
+	"1IWgNSTChfCWZcHVKipmWSvxSDXIRErZTZw0GMXebT3QZTHyoYzZGpfzp5T3NrZKeWjyH0GwjgzeXd5r",
+	"XF48dIoFW0LyqpvN9neX8X2v6jQwhY7r+w/w3KegiWbcs+CO2FSzTcJOUrs1uwW5zbs0/SvhEBlccYz1",
+	"DKB5ntsQ/+b7j98J0m4Ls/OG3LsJtIVej9wtwgyhLc1txw1CplC/RYCMDr4ude5imJxshUlyJU7NUWPd",
+	"Ie040kmc7oY3bbumKjxZRw7wjoHcokWqqM80Ylr1EyNZLJBcu9ZD/bwVPnFEv7+r+0at5RDxvfviDi+6",
+	"kiJFCLbckfM7JecVLjxyUs43AF3Q9SVYR8Cr5JKNBJzrFsf3kVinjerGVWn++o0n3mQmEZmhIseZ0fC0",
+	"2SpOGB1S1UvX7YA6jWu7C2rPk+2I/acl9q4+0o70pqQn2uIwy+oSCAHrQoYS6Cp/IuZNdUWLnsZCCJ8u",
+	"jrJdJ2Mrm+yzW1tCxN6OcAm+bbtgyruNUDiM6NqOL+bk1hC1QD19iOS8eP78zvMNwvJiJ6buKmUYlUiB",
+	"FnUdk0dqq6BrUFGR/vTK+GeHrLnS1IQ4basQzVAcexdyLLHUS2/9N07valZ6iR0/ctl+s+myqAoPd4m9",
+	"FY1bK/TyWzuRdyfyBky4zziuBiFq9Cu/X4rI4QiBLKZm3kcal8gi1VOlGx9rO/E3j1yNWEcue1zu7wBJ",
+	"RxKLUwkTS24TaCqAcZh7jIOn4q7nVCxuqQTWULxu1lxtPKG2ctRkqdm2iHIOuZpMcPMt1fXs8eU/UPJ5",
+	"++JqV1m3Ow4h6BdX336zk1T/dGzge5SKYxH0KqcotrIgUszNRLRZLo6nTZMo3xPn6FipbBI63c1CHnXo",
+	"xxaS9EKn/RkUh0VB/WjHmOSMvCBWeefSunXaaEyN8qXVcdmrLRzq5g0r5B3bCLwPFVN3+aI3odqNhiR3",
+	"zTCaHT8emvuzxj0iXcZgnfpFA4N3uaS7XFLOJV3odGYNdkIWsYhzRT2RXDLlrGpN9wZZ15WcYomNVX9q",
+	"p019K0JYl2ccirrKIoW8cX3SZj7Jl7Erw8RgGVvXaHTsIBSYO3l7+l7wtjjcAzWl1W6+zfp5yx19O+up",
+	"cKmG+mSvVhryY7Ipi6XWI0zp233BtklmkSmOGzrz3ySh5WeQ5+LNezllpSkmkuSLKvowV2tYyWTve6Nh",
+	"7ztktZ80teQmJc43VqHB/Xf0b9Be+QUV7wx+gHgoyJgtONA+1uRbX3vm827rlBdzk6mJguxul7MLsHzc",
+	"rq6KRDdoPP/d7+iqrNQaLkNL5ihuFtZcqIyKplZlJtmITO9NDVRkFCknNtRuF5FOhJqIkF3MZXy7HGWh",
+	"O9FOTr6xP7BZK/2OvYGho2uvL/DfmY7tSrjsxO5e518kqx0kuZK798kisR8Nyv0WDIqanJe5V0UOdV3V",
+	"RtvxSJ1ZWAztSKqaeGUsi8X3q6LGsasZOSKkrf3Fjc5SOBxMGq11g5GEe2HjibkyTQGy6uR1HGZUD3Mp",
+	"bWdpslcIgdgsdyuN4XgSxGZt/Awpt3KCsCIWjyeFeFL10HJiIlXu+uRmb+YqvaHMXDf7pflip18/g0XV",
+	"6/cC2kJ9z3q4nfANl7NjmuurSSPOXZlnHtzu/BFn1pmaXrHBMl6Y+lbuHBc7ZvcAmB3jZ9qiftvwO+KR",
+	"ayoe0fNlfqc0qRTeSu3Y2jEUUZMkY0AwkwS+FGNcLIK8EQvaw4EqOXpnur8V6kbwvFf6Glawo6476vqo",
+	"qSsTw21pax1C2E1bT83Eh+C+JQJ7XR2im6ByVM+jFeh3NP7hS9AxA2tH4XcU/jFT+ECNt6XwoR/1hmiX",
+	"ii4VNIc3S9S+m2rXrTcfI9WulsRKgLisw2tj2FRwrxIMcdFSL2j1PcvK7GJkS70zD/3pmBvfhPtkb3EF",
+	"O/a2Y2+Pmb2VoSzeRvYWIi23aFkYTDlJ1QHf2LozFcVpyioPAGPlQlw/sGLjrUzP66Ph/e3Vkft4DjiG",
+	"lX5GFbikrjs+uaE49GJunBfPDg4ORNoYuHJbFmAr4tgbGhWWtF2nuWZ91zooB5uQ5eKJsfgzBz5haNdT",
+	"7qaLTJ9idrgq5jo+R0C7t0qwDWg82MDLqkHdLkLlOhEqFDiSVhjfTwRCU6Q+GnBKnekaMYn9JV6kE69P",
+	"f0qEFF+fvv1eUOwXhfHDZa407GVAIfOQ0XO2ftQSjROXVnkPWlzOVA4NMZZvusyogJ/UDJJGtT6OvR8v",
+	"PAg5NjaEMaZGa0hD/9lKWgoV/TmlPFsIbexc5vlihWhwL7ytBPO3pS9KL0LGUK8YyQ875MgBIU+j97W7",
+	"GCTxR53RP7YJcKykcWcmfi+S5xqGfNL0k+lZJnfqhVGdjHpDMXwu9xwg9HyIY8RDaDS6NQy7dpkJCnbi",
+	"wsL8qoAPKRTRjIahnwliSTojHSjLoga0tPw6Ggo+FLnJoFp7197Dqlp7rmIe49lQyhdnMXBtR26l3L7F",
+	"yaAWDJNBlUqRlcBBq60I1sBR+Y96g42WzF19z+dKH/Panq32AHd+kUekG9yYkWzuMZi0Rviwp7PrjcJ9",
+	"Vd3FjXscvqkxne/vn6Hf4cNSCB4f22Sk2UJsVvPIMbvtQcfzeiBu6CoJjZjjMTojaUpnxqEBYhFz5Pew",
+	"bvXLM911m8QTo0Fwd3kSdymfio0GSIIS0VhE0gwn0plgyvM0OdN0xYpcKs0lfIb+g3+aCPoZi+kicosn",
+	"HjvdELVmP2/d8H9P/Ev8gt3+dcZ/ffjlbPD0TBvLY6TuQjyRgu+bsOYyZA6wII1bwFlpA9ZcPh2e6Sjv",
+	"4X6YT7lzVRTLUU+lzsFRo1yV+r4w1+P51ky7YpYkMtDFvpyZHASfbjuvTzXW2CfQ08Juz55GGXG4gkJa",
+	"V7HxaE5z8mILYxppLXB5W4vCc4nrQNRlxONOpnh0moue4MLokFFI0wKkzRVw2nZskEwmLrwSPQvPICuL",
+	"G1vdTn+KeBitorTeIFI8SaWDPaUdaOQCF/B0KWdF+d71pe5iFJ/384AtVtR4vu262oJG3+rab91ojTUp",
+	"2XKFTeGnb33Nd24GQSJtWwMvimC9cIsvbFDM+4yg/7X/X9eQXu7O0MkUcp3Sz2/cq2mTKzpjalVlo0Nl",
+	"LdT7RxwgqSwSQylKqvbf4DoPwSL67Iu7nd6VReCVTXFic9b8J0Ig4qtAdVZk6ahXjNQtTiqeBG4+Nxk8",
+	"fZxCY1PW20Jo3P/DmdKm8HFjf2VdCSKTaFAJ7blZokQnIFhBktpcajkFu7n18pdnGmkmdi4M7UhZvgqJ",
+	"n+V8zISVp45SR8L/qeSyeKLkTqPePtX7Y5gYC8mZju+y/EhiqgXpYsY/i1aQZyESfhYKQFCtebCFtJ5l",
+	"BeWw1fbwTOMSKPOKmgI6IQXm2+LD2oaEZlmZnpeFePLHWVVo9myQiLNBLseQh3/Tovif2nhwZ4OPT5m2",
+	"vHtz+p7GrCRgBJmFPDeNmblaZuNMhiIWLxZP3vPb9I57GssMxcXQ7nkt8RHiDMrBVUSlykB7SkoMzcWU",
+	"FYw24viIjGAM72jIImkqIIecomhfdZkPsUxZybcIXI/I/OYD34StROf3DZzj4yusycoUskYX+e6KObyN",
+	"tVVzoqUlHPggGTD8tzKB7Tytf3SUMImEA5mmdPVpMXa1aIh4InXQMpMgwLMdF8mIFO/DLVy9J093DcZj",
+	"byJG8iCtkJWZLoniKyl1PI8gwfLrOx/uJ/Hhvnj2+V0XDAqni1NKpV1dM4SNyY9ezOkXQNYKQL+VKj3f",
+	"k1m2MQ1B0jxhGnQvT3NggdFMhJa+tDIXudTTUk4ByXpqplh1I0PQ48E3+pFiLYqMh6osAC/PdIa8kCUR",
+	"Ncdy1mcDb+bGosHqb3IeZAP4gNKWyuSCf1BafC4yuQiyQwap+IL/+fzg+V/2nj3fO/hCPPvry4ODswEa",
+	"2/4DoZGI/z6JSdqFVcYqj0zoyWczNZ0l4rM5ZKqcJ+Kz3Fwien/22WeJoP89Gw4/+/zpmWY7GPetS3mx",
+	"7ByZG12tjX95Li4BzuP6pMoXZwM0sh0t7ZfEDJK0LIScQgTPVGGkFL4ifjcooI8XVaZ5I/kcX6DnoYIH",
+	"7pUNggQyXMLZQDgvUbI0uvkpPhvREwTkUBxGyYhpZCW4kCWJjKTsK/nyTNfqXv3J1gnyK3LPD4iOh1m2",
+	"y4m/OSuOsNxlxN+n00c8icp1fYG5iB7bPSmnmL3mhbRIVp/uhI5d4Bjx9x8icw7MdztOvh8dDL0c/URa",
+	"hwy9+oT5eEUmL+UCNZLfGsR4jY+jTcFPePIlQv5g6NvBrU8fNtyFAie1k2iwI0J/Amd1wIXm1Vp7Z7ct",
+	"eM/y1HjBRZC7KtFvIzDRPb6TMsorFqBYSK2SDceAVCSW+Uq491kd2zkF8hcnQdB8HxyXZZWXgTJq2hXI",
+	"VPs9q7SL0G0a1+ASErf/q/VaiOhBoZFuS+3QxYriUuX0t3jx/K9ryr1dp9LbrjT/w6nJTCjWrsj87Pkn",
+	"F4ZPLKRGZxTvRKgGmXhCl2WuHOHo0zsWlJ//9S6h3tp/JDbiSbxP0XeoHMKj4kuPuLh/jxiXrKk7Gm0t",
+	"kQco77r4wD/APywm8KlD5R9CAcsdgb5HAv34yADf55Ywt0oJOjt8xBLwy9Fc3TJhnb+6kwkftEz4wHJk",
+	"P2n7k3usk/CnZxd4UZpBWzv2cS+Nt4jMxWvs2reYfRYLpit0d/+O16IZuom0R+rFpVzs9JOdfvJJOsxs",
+	"NDNTL4GK8/SamI8gzSnJeCYbHkHlArpzAm90VjPvzjI2LNcSTpgGP5NCmz1TDMVXUuXBrffi4G+BN4oM",
+	"CtAZ+g9ioYvotE4Xab6afBws0694ggemM90+A25v9x6Z8FE8JwXuweU2NxkkhwmxWIpQQ3RU3kE++ZOy",
+	"TmMZEGDvnYtuuuyPkgJzw+1xRZC2pMD7f4R/jTa4EkKrTNmEXsjRJQ/rMkFs00v++kGSzBUVKqxQ+Cst",
+	"oQbjrvnuvycZO6oR/5Gbkaq7vDW5yEqulNZlWjoFTw1jc5CxxCUIFAeH4iQUZrCQS8zbEhbmSmdgXUgg",
+	"ja2pqm9WWwGy1eaohJ0p6uG7Jz9BB8Pq/He2n12D3Z0zd2csefTGEmSYTT7JyYDbWU6Qca8Jta+btocO",
+	"j7EYSj1HzYy9EbUBxQ3FWx0KnnJ4fbS4WAglnL6kBy4mGFGoH5eIsoDgUDKn+7rMwr8Lov+/vZkkbvSB",
+	"cSpc1p8oXlobIXU6MzYJ/60peUh+wx9dzI64tEZPuVTV0x2ne4z09DtWZrYjoJUG0qiN111Y8l315r9N",
+	"SMxWzV7jvrds+LozQuzu6zUqWdaWgC7pRxtMlec9rOu+eprOICsxAboaMOQ0s1OousNVbSMq5e4oM6Qq",
+	"SBm2glkjVCkL5ZkMcnUBKIAazUlvZfFle9V1CZ1G3dy6MGMqdQo5ZEs+p4Poc0pnUmvIYwZxavRETcsw",
+	"Y72sNV1fq5v67y5YxSZEvN17Skir6WJ/Utp9Op8C+qMdScsLqXKqnhOwbEcpH3UXVFvf9D4SuSTnhPqk",
+	"fUbbhsyEemB0pfNXoe7WsEHhuKqJo5pxAj7I1OcLrs7KtSbsFChzzlMpTQ1hpD5/e/ggTDcj86ikrvjK",
+	"O/Hz8YmgosJ91uDTWHx1ZxDexSbeb2xiCyN3Jur70vqJ64VCLUwcdgzvrmMrKrIdiTmZW+aGEn6V9maZ",
+	"OyzVhO+LbNzZ33f291uVqmorecDDLhWUH0EUrUoH1u3PYT+VOehM2r0JQNaOmOlKrH0dXv8KIBus8IAt",
+	"4jv+TCTseyMieAWCl2jBGFBOcK6ERxtucWHOGd/au/vx3bcNhIvP1pg7jhEKQlLEhIPUosz9ennIoTjU",
+	"i6q3Tb4IsMNHwnlTOHFp7HlX7QVWN9Zj7O3p0615NunUu0ICV6vsFRBlS3xrEbi6dczaUqZUb5TrRMr0",
+	"3FEvmUx6uaaVDMkAUvzf8YmQNp2hD9JMuOImVjF0L890YQ3+M2m0puH2HyhDtDqgGA0uEVyLK9bhTESk",
+	"2kkdp6jAJWe6qS7j5aBqMCm+WIB1BsEt0xSc4xZFTjypKpbQRXNPqxKnv5pxIlrjSd0wwMyU88Yuhme6",
+	"o3Drl7GZOlaxCpByZZoCZJARSGcmz1zVjmJU2jzBCmjKgsOS2DiVU7/D8Ey/b7StoNutnODmW4nQAJkT",
+	"2gjHldPou1RqMSafLYIvXwijUwjVXVH3C9P0dM05TKmO65H08s9VWutPU+py13xwV0Oqp38KkmPJBICo",
+	"fIOPhJ+X2UioIrihD+GsKje4pgfZUJzGd4jhUHVqDbgj7oWWseWIiyrHGoEXMi87iNk/wP/owMYRB5/Q",
+	"LtOaZ433dCfcXDkLnuQJV5/hKjIii/LprDcVvq6MWSEgs3Hq0YL43hi+Kz1+BYs+VeJzc6J7sjBui8n3",
+	"Upm5qkmW1I0IDEq3SJ6xNCn7b5PKQrhURTTUdG5JdCgIMLFJpdbGo9yUKWpIsdNIbpAhuvnetpgIy+Jr",
+	"Q3VOgvh+SNL7e/7gE16Fzvl2xP1Wg0O6VbJWcBf9sNlSQi9SSAi3d3StSx36XVRKEi8sqTqjvZb50eFP",
+	"1adPXkmn0qaAgh9JL/YzebFfKzQ8qWRvaCGduzQ2e9pjbunAp8GnjKLomO+eAip4PVkXAB5UiMWuBePt",
+	"GKM6b3XXpe5gAR2lLrus7d2Xaa2t4qRrVfcVzrnL93xI/lREhEef6km+h6tcPhoe5+u6Ld+iYC0yuIDc",
+	"FHPQvo5SLG0+eDmYeV+83N8nAXxmnH/54uDggNoVh5lWzQAarMwF6KwwSntX3y22H2LsWmdoD/enoEV0",
+	"fMzx36ufvp1MqFq1W+h0Zo1WvzMb7xgCX+kY4ZVMz6cWcYIMth0form348NvpB7LGKBA2ib3nOqaOnof",
+	"V0eJcYg0gNJ7sijauksKiDddozZf6xp6yZnUMULlNPiYbEdIO0+GZebVEUIHiI5vooW/46sfmyoFAaVp",
+	"qIqNWzrGDK8NPv7y8f8NAPrcIM+rYAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// アカウントのデータ一式のエクスポートのHTTPハンドラー
+type AccountExportHandler struct {
+	service    *service.AccountExportService
+	jobService *service.JobService
+}
+
+// 新しいAccountExportHandlerを作成
+func NewAccountExportHandler(service *service.AccountExportService, jobService *service.JobService) *AccountExportHandler {
+	return &AccountExportHandler{
+		service:    service,
+		jobService: jobService,
+	}
+}
+
+// ExportAccountData - エクスポートジョブを登録
+func (h *AccountExportHandler) ExportAccountData(ctx context.Context, request gen.ExportAccountDataRequestObject) (gen.ExportAccountDataResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ExportAccountData401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	job, err := h.jobService.Enqueue(ctx, userID, service.JobTypeExportAccountData, struct{}{})
+	if err != nil {
+		log.Printf("Failed to create account export job (user_id=%d): %v", userID, err)
+		return gen.ExportAccountData500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.ExportAccountData202JSONResponse(mapper.JobToResponse(job)), nil
+}
+
+// DownloadAccountExport - 署名付きリンクのZIPを返す（セッション不要、一度だけ）
+func (h *AccountExportHandler) DownloadAccountExport(ctx context.Context, request gen.DownloadAccountExportRequestObject) (gen.DownloadAccountExportResponseObject, error) {
+	id := int64(request.Id)
+	download, err := h.service.Download(ctx, id, int64(request.Params.Expires), request.Params.Signature)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrAccountExportLinkInvalid):
+			return gen.DownloadAccountExport403ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusForbidden, gen.ErrorCodeInvalidDownloadLink)), nil
+		case errors.Is(err, service.ErrAccountExportGone):
+			return gen.DownloadAccountExport410ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusGone, gen.ErrorCodeDownloadLinkExpired)), nil
+		}
+		log.Printf("Failed to download account export (export_id=%d): %v", id, err)
+		return gen.DownloadAccountExport500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return downloadAccountExportResponse{
+		exportID: id,
+		DownloadAccountExport200ApplicationzipResponse: gen.DownloadAccountExport200ApplicationzipResponse{
+			Body:          download.Body,
+			ContentLength: download.Size,
+			Headers: gen.DownloadAccountExport200ResponseHeaders{
+				ContentDisposition: fmt.Sprintf(`attachment; filename="todo-export-%d.zip"`, id),
+			},
+		},
+	}, nil
+}
+
+// ZIPのチャンクをDBから読み出しながら返す
+type downloadAccountExportResponse struct {
+	gen.DownloadAccountExport200ApplicationzipResponse
+	exportID int64
+}
+
+func (r downloadAccountExportResponse) VisitDownloadAccountExportResponse(w http.ResponseWriter) error {
+	err := r.DownloadAccountExport200ApplicationzipResponse.VisitDownloadAccountExportResponse(w)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, context.Canceled) {
+		log.Printf("Failed to download account export (export_id=%d): %v", r.exportID, err)
+	}
+	// 200と途中までのボディを送った後のため、接続を切ってクライアントに不完全な応答だと分かるようにする
+	panic(http.ErrAbortHandler)
+}
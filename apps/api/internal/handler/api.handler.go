@@ -9,13 +9,37 @@ import (
 // APIHandler は StrictServerInterface を実装する
 // TodoHandlerと他のハンドラーを統合したもの
 type APIHandler struct {
-	todoHandler *TodoHandler
+	todoHandler   *TodoHandler
+	syncHandler   *SyncHandler
+	jobHandler    *JobHandler
+	statusHandler *StatusHandler
+	notifyHandler *NotificationHandler
+	calHandler    *CalendarHandler
+	tokenHandler  *PersonalAccessTokenHandler
+	exportHandler *ExportHandler
+	importHandler *ImportHandler
+	projHandler   *ProjectHandler
+	acctHandler   *AccountExportHandler
+	quickHandler  *QuickAddHandler
+	settHandler   *UserSettingsHandler
 }
 
 // NewAPIHandler は新しいAPIHandlerを作成
-func NewAPIHandler(todoHandler *TodoHandler) *APIHandler {
+func NewAPIHandler(todoHandler *TodoHandler, syncHandler *SyncHandler, jobHandler *JobHandler, statusHandler *StatusHandler, notifyHandler *NotificationHandler, calHandler *CalendarHandler, tokenHandler *PersonalAccessTokenHandler, exportHandler *ExportHandler, importHandler *ImportHandler, projHandler *ProjectHandler, acctHandler *AccountExportHandler, quickHandler *QuickAddHandler, settHandler *UserSettingsHandler) *APIHandler {
 	return &APIHandler{
-		todoHandler: todoHandler,
+		todoHandler:   todoHandler,
+		syncHandler:   syncHandler,
+		jobHandler:    jobHandler,
+		statusHandler: statusHandler,
+		notifyHandler: notifyHandler,
+		calHandler:    calHandler,
+		tokenHandler:  tokenHandler,
+		exportHandler: exportHandler,
+		importHandler: importHandler,
+		projHandler:   projHandler,
+		acctHandler:   acctHandler,
+		quickHandler:  quickHandler,
+		settHandler:   settHandler,
 	}
 }
 
@@ -39,6 +63,11 @@ func (h *APIHandler) ListTodos(ctx context.Context, request gen.ListTodosRequest
 	return h.todoHandler.ListTodos(ctx, request)
 }
 
+// ListTodoChanges - TodoHandlerに委譲
+func (h *APIHandler) ListTodoChanges(ctx context.Context, request gen.ListTodoChangesRequestObject) (gen.ListTodoChangesResponseObject, error) {
+	return h.todoHandler.ListTodoChanges(ctx, request)
+}
+
 // GetTodo - TodoHandlerに委譲
 func (h *APIHandler) GetTodo(ctx context.Context, request gen.GetTodoRequestObject) (gen.GetTodoResponseObject, error) {
 	return h.todoHandler.GetTodo(ctx, request)
@@ -49,6 +78,26 @@ func (h *APIHandler) CreateTodo(ctx context.Context, request gen.CreateTodoReque
 	return h.todoHandler.CreateTodo(ctx, request)
 }
 
+// SetTodoDue - TodoHandlerに委譲
+func (h *APIHandler) SetTodoDue(ctx context.Context, request gen.SetTodoDueRequestObject) (gen.SetTodoDueResponseObject, error) {
+	return h.todoHandler.SetTodoDue(ctx, request)
+}
+
+// AddTodoBlocker - TodoHandlerに委譲
+func (h *APIHandler) AddTodoBlocker(ctx context.Context, request gen.AddTodoBlockerRequestObject) (gen.AddTodoBlockerResponseObject, error) {
+	return h.todoHandler.AddTodoBlocker(ctx, request)
+}
+
+// RemoveTodoBlocker - TodoHandlerに委譲
+func (h *APIHandler) RemoveTodoBlocker(ctx context.Context, request gen.RemoveTodoBlockerRequestObject) (gen.RemoveTodoBlockerResponseObject, error) {
+	return h.todoHandler.RemoveTodoBlocker(ctx, request)
+}
+
+// MoveTodo - TodoHandlerに委譲
+func (h *APIHandler) MoveTodo(ctx context.Context, request gen.MoveTodoRequestObject) (gen.MoveTodoResponseObject, error) {
+	return h.todoHandler.MoveTodo(ctx, request)
+}
+
 // UpdateTodo - TodoHandlerに委譲
 func (h *APIHandler) UpdateTodo(ctx context.Context, request gen.UpdateTodoRequestObject) (gen.UpdateTodoResponseObject, error) {
 	return h.todoHandler.UpdateTodo(ctx, request)
@@ -64,10 +113,195 @@ func (h *APIHandler) BatchCompleteTodos(ctx context.Context, request gen.BatchCo
 	return h.todoHandler.BatchCompleteTodos(ctx, request)
 }
 
+// BatchCreateTodos - TodoHandlerに委譲
+func (h *APIHandler) BatchCreateTodos(ctx context.Context, request gen.BatchCreateTodosRequestObject) (gen.BatchCreateTodosResponseObject, error) {
+	return h.todoHandler.BatchCreateTodos(ctx, request)
+}
+
+// ImportTodos - TodoHandlerに委譲
+func (h *APIHandler) ImportTodos(ctx context.Context, request gen.ImportTodosRequestObject) (gen.ImportTodosResponseObject, error) {
+	return h.todoHandler.ImportTodos(ctx, request)
+}
+
+// BatchUpdateTodos - TodoHandlerに委譲
+func (h *APIHandler) BatchUpdateTodos(ctx context.Context, request gen.BatchUpdateTodosRequestObject) (gen.BatchUpdateTodosResponseObject, error) {
+	return h.todoHandler.BatchUpdateTodos(ctx, request)
+}
+
 // BatchDeleteTodos - TodoHandlerに委譲
 func (h *APIHandler) BatchDeleteTodos(ctx context.Context, request gen.BatchDeleteTodosRequestObject) (gen.BatchDeleteTodosResponseObject, error) {
 	return h.todoHandler.BatchDeleteTodos(ctx, request)
 }
 
+// SyncTodos - SyncHandlerに委譲
+func (h *APIHandler) SyncTodos(ctx context.Context, request gen.SyncTodosRequestObject) (gen.SyncTodosResponseObject, error) {
+	return h.syncHandler.SyncTodos(ctx, request)
+}
+
+// CreateJob - JobHandlerに委譲
+func (h *APIHandler) CreateJob(ctx context.Context, request gen.CreateJobRequestObject) (gen.CreateJobResponseObject, error) {
+	return h.jobHandler.CreateJob(ctx, request)
+}
+
+// GetJob - JobHandlerに委譲
+func (h *APIHandler) GetJob(ctx context.Context, request gen.GetJobRequestObject) (gen.GetJobResponseObject, error) {
+	return h.jobHandler.GetJob(ctx, request)
+}
+
+// CancelJob - JobHandlerに委譲
+func (h *APIHandler) CancelJob(ctx context.Context, request gen.CancelJobRequestObject) (gen.CancelJobResponseObject, error) {
+	return h.jobHandler.CancelJob(ctx, request)
+}
+
+// ListStatuses - StatusHandlerに委譲
+func (h *APIHandler) ListStatuses(ctx context.Context, request gen.ListStatusesRequestObject) (gen.ListStatusesResponseObject, error) {
+	return h.statusHandler.ListStatuses(ctx, request)
+}
+
+// CreateStatus - StatusHandlerに委譲
+func (h *APIHandler) CreateStatus(ctx context.Context, request gen.CreateStatusRequestObject) (gen.CreateStatusResponseObject, error) {
+	return h.statusHandler.CreateStatus(ctx, request)
+}
+
+// UpdateStatus - StatusHandlerに委譲
+func (h *APIHandler) UpdateStatus(ctx context.Context, request gen.UpdateStatusRequestObject) (gen.UpdateStatusResponseObject, error) {
+	return h.statusHandler.UpdateStatus(ctx, request)
+}
+
+// DeleteStatus - StatusHandlerに委譲
+func (h *APIHandler) DeleteStatus(ctx context.Context, request gen.DeleteStatusRequestObject) (gen.DeleteStatusResponseObject, error) {
+	return h.statusHandler.DeleteStatus(ctx, request)
+}
+
+// SetTodoStatus - StatusHandlerに委譲
+func (h *APIHandler) SetTodoStatus(ctx context.Context, request gen.SetTodoStatusRequestObject) (gen.SetTodoStatusResponseObject, error) {
+	return h.statusHandler.SetTodoStatus(ctx, request)
+}
+
+// GetBoard - StatusHandlerに委譲
+func (h *APIHandler) GetBoard(ctx context.Context, request gen.GetBoardRequestObject) (gen.GetBoardResponseObject, error) {
+	return h.statusHandler.GetBoard(ctx, request)
+}
+
+// ListTodoReminders - NotificationHandlerに委譲
+func (h *APIHandler) ListTodoReminders(ctx context.Context, request gen.ListTodoRemindersRequestObject) (gen.ListTodoRemindersResponseObject, error) {
+	return h.notifyHandler.ListTodoReminders(ctx, request)
+}
+
+// CreateTodoReminder - NotificationHandlerに委譲
+func (h *APIHandler) CreateTodoReminder(ctx context.Context, request gen.CreateTodoReminderRequestObject) (gen.CreateTodoReminderResponseObject, error) {
+	return h.notifyHandler.CreateTodoReminder(ctx, request)
+}
+
+// DeleteReminder - NotificationHandlerに委譲
+func (h *APIHandler) DeleteReminder(ctx context.Context, request gen.DeleteReminderRequestObject) (gen.DeleteReminderResponseObject, error) {
+	return h.notifyHandler.DeleteReminder(ctx, request)
+}
+
+// ListNotifications - NotificationHandlerに委譲
+func (h *APIHandler) ListNotifications(ctx context.Context, request gen.ListNotificationsRequestObject) (gen.ListNotificationsResponseObject, error) {
+	return h.notifyHandler.ListNotifications(ctx, request)
+}
+
+// MarkNotificationRead - NotificationHandlerに委譲
+func (h *APIHandler) MarkNotificationRead(ctx context.Context, request gen.MarkNotificationReadRequestObject) (gen.MarkNotificationReadResponseObject, error) {
+	return h.notifyHandler.MarkNotificationRead(ctx, request)
+}
+
+// GetUnreadNotificationCount - NotificationHandlerに委譲
+func (h *APIHandler) GetUnreadNotificationCount(ctx context.Context, request gen.GetUnreadNotificationCountRequestObject) (gen.GetUnreadNotificationCountResponseObject, error) {
+	return h.notifyHandler.GetUnreadNotificationCount(ctx, request)
+}
+
+// MarkAllNotificationsRead - NotificationHandlerに委譲
+func (h *APIHandler) MarkAllNotificationsRead(ctx context.Context, request gen.MarkAllNotificationsReadRequestObject) (gen.MarkAllNotificationsReadResponseObject, error) {
+	return h.notifyHandler.MarkAllNotificationsRead(ctx, request)
+}
+
+// GetNotificationPreferences - NotificationHandlerに委譲
+func (h *APIHandler) GetNotificationPreferences(ctx context.Context, request gen.GetNotificationPreferencesRequestObject) (gen.GetNotificationPreferencesResponseObject, error) {
+	return h.notifyHandler.GetNotificationPreferences(ctx, request)
+}
+
+// UpdateNotificationPreferences - NotificationHandlerに委譲
+func (h *APIHandler) UpdateNotificationPreferences(ctx context.Context, request gen.UpdateNotificationPreferencesRequestObject) (gen.UpdateNotificationPreferencesResponseObject, error) {
+	return h.notifyHandler.UpdateNotificationPreferences(ctx, request)
+}
+
+// CreateCalendarFeed - CalendarHandlerに委譲
+func (h *APIHandler) CreateCalendarFeed(ctx context.Context, request gen.CreateCalendarFeedRequestObject) (gen.CreateCalendarFeedResponseObject, error) {
+	return h.calHandler.CreateCalendarFeed(ctx, request)
+}
+
+// DeleteCalendarFeed - CalendarHandlerに委譲
+func (h *APIHandler) DeleteCalendarFeed(ctx context.Context, request gen.DeleteCalendarFeedRequestObject) (gen.DeleteCalendarFeedResponseObject, error) {
+	return h.calHandler.DeleteCalendarFeed(ctx, request)
+}
+
+// GetCalendarFeed - CalendarHandlerに委譲
+func (h *APIHandler) GetCalendarFeed(ctx context.Context, request gen.GetCalendarFeedRequestObject) (gen.GetCalendarFeedResponseObject, error) {
+	return h.calHandler.GetCalendarFeed(ctx, request)
+}
+
+// ListPersonalAccessTokens - PersonalAccessTokenHandlerに委譲
+func (h *APIHandler) ListPersonalAccessTokens(ctx context.Context, request gen.ListPersonalAccessTokensRequestObject) (gen.ListPersonalAccessTokensResponseObject, error) {
+	return h.tokenHandler.ListPersonalAccessTokens(ctx, request)
+}
+
+// CreatePersonalAccessToken - PersonalAccessTokenHandlerに委譲
+func (h *APIHandler) CreatePersonalAccessToken(ctx context.Context, request gen.CreatePersonalAccessTokenRequestObject) (gen.CreatePersonalAccessTokenResponseObject, error) {
+	return h.tokenHandler.CreatePersonalAccessToken(ctx, request)
+}
+
+// DeletePersonalAccessToken - PersonalAccessTokenHandlerに委譲
+func (h *APIHandler) DeletePersonalAccessToken(ctx context.Context, request gen.DeletePersonalAccessTokenRequestObject) (gen.DeletePersonalAccessTokenResponseObject, error) {
+	return h.tokenHandler.DeletePersonalAccessToken(ctx, request)
+}
+
+// ExportTodos - ExportHandlerに委譲
+func (h *APIHandler) ExportTodos(ctx context.Context, request gen.ExportTodosRequestObject) (gen.ExportTodosResponseObject, error) {
+	return h.exportHandler.ExportTodos(ctx, request)
+}
+
+// ImportExternalTodos - ImportHandlerに委譲
+func (h *APIHandler) ImportExternalTodos(ctx context.Context, request gen.ImportExternalTodosRequestObject) (gen.ImportExternalTodosResponseObject, error) {
+	return h.importHandler.ImportExternalTodos(ctx, request)
+}
+
+// ListProjects - ProjectHandlerに委譲
+func (h *APIHandler) ListProjects(ctx context.Context, request gen.ListProjectsRequestObject) (gen.ListProjectsResponseObject, error) {
+	return h.projHandler.ListProjects(ctx, request)
+}
+
+// ExportAccountData - AccountExportHandlerに委譲
+func (h *APIHandler) ExportAccountData(ctx context.Context, request gen.ExportAccountDataRequestObject) (gen.ExportAccountDataResponseObject, error) {
+	return h.acctHandler.ExportAccountData(ctx, request)
+}
+
+// DownloadAccountExport - AccountExportHandlerに委譲
+func (h *APIHandler) DownloadAccountExport(ctx context.Context, request gen.DownloadAccountExportRequestObject) (gen.DownloadAccountExportResponseObject, error) {
+	return h.acctHandler.DownloadAccountExport(ctx, request)
+}
+
+// QuickAddTodo - QuickAddHandlerに委譲
+func (h *APIHandler) QuickAddTodo(ctx context.Context, request gen.QuickAddTodoRequestObject) (gen.QuickAddTodoResponseObject, error) {
+	return h.quickHandler.QuickAddTodo(ctx, request)
+}
+
+// PreviewQuickAddTodo - QuickAddHandlerに委譲
+func (h *APIHandler) PreviewQuickAddTodo(ctx context.Context, request gen.PreviewQuickAddTodoRequestObject) (gen.PreviewQuickAddTodoResponseObject, error) {
+	return h.quickHandler.PreviewQuickAddTodo(ctx, request)
+}
+
+// GetUserSettings - UserSettingsHandlerに委譲
+func (h *APIHandler) GetUserSettings(ctx context.Context, request gen.GetUserSettingsRequestObject) (gen.GetUserSettingsResponseObject, error) {
+	return h.settHandler.GetUserSettings(ctx, request)
+}
+
+// UpdateUserSettings - UserSettingsHandlerに委譲
+func (h *APIHandler) UpdateUserSettings(ctx context.Context, request gen.UpdateUserSettingsRequestObject) (gen.UpdateUserSettingsResponseObject, error) {
+	return h.settHandler.UpdateUserSettings(ctx, request)
+}
+
 // コンパイル時にStrictServerInterfaceを実装していることを確認
 var _ gen.StrictServerInterface = (*APIHandler)(nil)
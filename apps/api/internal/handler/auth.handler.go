@@ -3,10 +3,13 @@ package handler
 import (
 	"log"
 	"net/http"
+	"strconv"
 
 	"go-todo/internal/auth"
 	"go-todo/internal/config"
+	"go-todo/internal/gen"
 	"go-todo/internal/mapper"
+	"go-todo/internal/problem"
 	"go-todo/internal/service"
 
 	"github.com/labstack/echo/v4"
@@ -15,13 +18,15 @@ import (
 
 type AuthHandler struct {
 	userService    *service.UserService
+	jobService     *service.JobService
 	sessionManager *auth.SessionManager
 	frontendURL    string
 }
 
-func NewAuthHandler(userService *service.UserService, sm *auth.SessionManager, frontendConfig config.FrontendConfig) *AuthHandler {
+func NewAuthHandler(userService *service.UserService, jobService *service.JobService, sm *auth.SessionManager, frontendConfig config.FrontendConfig) *AuthHandler {
 	return &AuthHandler{
 		userService:    userService,
+		jobService:     jobService,
 		sessionManager: sm,
 		frontendURL:    frontendConfig.URL,
 	}
@@ -42,19 +47,19 @@ func (h *AuthHandler) Callback(c echo.Context) error {
 	gothUser, err := gothic.CompleteUserAuth(c.Response(), c.Request())
 	if err != nil {
 		log.Printf("Authentication failed: %v", err)
-		return echo.NewHTTPError(http.StatusUnauthorized, "Authentication failed")
+		return problem.NewError(http.StatusUnauthorized, gen.ErrorCodeAuthenticationFailed)
 	}
 
 	user, err := h.userService.FindOrCreateFromOAuth(c.Request().Context(), gothUser)
 	if err != nil {
 		log.Printf("Failed to create user: %v", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user")
+		return problem.NewError(http.StatusInternalServerError, gen.ErrorCodeInternalError)
 	}
 
 	// SessionManagerを使用
 	if err := h.sessionManager.SetUserID(c.Response(), c.Request(), user.ID); err != nil {
 		log.Printf("Failed to save session: %v", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to save session")
+		return problem.NewError(http.StatusInternalServerError, gen.ErrorCodeInternalError)
 	}
 
 	// フロントエンドURLにリダイレクト
@@ -64,7 +69,7 @@ func (h *AuthHandler) Callback(c echo.Context) error {
 func (h *AuthHandler) Logout(c echo.Context) error {
 	if err := h.sessionManager.Clear(c.Response(), c.Request()); err != nil {
 		log.Printf("Failed to clear session: %v", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to clear session")
+		return problem.NewError(http.StatusInternalServerError, gen.ErrorCodeInternalError)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{"message": "Logged out"})
@@ -73,13 +78,13 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 func (h *AuthHandler) Me(c echo.Context) error {
 	userID, ok := auth.GetUserIDFromContext(c.Request().Context())
 	if !ok {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+		return problem.NewError(http.StatusUnauthorized, gen.ErrorCodeUnauthorized)
 	}
 
 	user, err := h.userService.GetByID(c.Request().Context(), userID)
 	if err != nil {
 		log.Printf("User not found (id=%d): %v", userID, err)
-		return echo.NewHTTPError(http.StatusNotFound, "User not found")
+		return problem.NewError(http.StatusNotFound, gen.ErrorCodeUserNotFound)
 	}
 
 	return c.JSON(http.StatusOK, mapper.UserToResponse(user))
@@ -88,15 +93,31 @@ func (h *AuthHandler) Me(c echo.Context) error {
 func (h *AuthHandler) DeleteUserAccount(c echo.Context) error {
 	userID, ok := auth.GetUserIDFromContext(c.Request().Context())
 	if !ok {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+		return problem.NewError(http.StatusUnauthorized, gen.ErrorCodeUnauthorized)
+	}
+
+	// ?export=true の場合はエクスポートジョブを登録して202を返し、アカウントはエクスポートを保存できてからジョブで削除する
+	// エクスポートに失敗した場合はアカウントを削除しない
+	if v := c.QueryParam("export"); v != "" {
+		export, err := strconv.ParseBool(v)
+		if err != nil {
+			return &problem.Error{
+				Status: http.StatusBadRequest,
+				Code:   gen.ErrorCodeValidationFailed,
+				Errors: []gen.FieldError{{In: gen.FieldErrorInQuery, Field: "export", Message: "must be a boolean"}},
+			}
+		}
+		if export {
+			return h.deleteUserAccountAfterExport(c, userID)
+		}
 	}
 
 	if err := h.userService.DeleteAccount(c.Request().Context(), userID); err != nil {
 		if err == service.ErrUserNotFound {
-			return echo.NewHTTPError(http.StatusNotFound, "User not found")
+			return problem.NewError(http.StatusNotFound, gen.ErrorCodeUserNotFound)
 		}
 		log.Printf("Failed to delete user account (id=%d): %v", userID, err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete account")
+		return problem.NewError(http.StatusInternalServerError, gen.ErrorCodeInternalError)
 	}
 
 	// セッションをクリア
@@ -106,3 +127,24 @@ func (h *AuthHandler) DeleteUserAccount(c echo.Context) error {
 
 	return c.NoContent(http.StatusNoContent)
 }
+
+// エクスポートしてからアカウントを削除するジョブを登録する
+// ダウンロードリンクはジョブの結果になるため、GET /jobs/{id} で取得できるようセッションは残す
+func (h *AuthHandler) deleteUserAccountAfterExport(c echo.Context, userID int64) error {
+	ctx := c.Request().Context()
+	if _, err := h.userService.GetByID(ctx, userID); err != nil {
+		if err == service.ErrUserNotFound {
+			return problem.NewError(http.StatusNotFound, gen.ErrorCodeUserNotFound)
+		}
+		log.Printf("Failed to get user before account deletion (id=%d): %v", userID, err)
+		return problem.NewError(http.StatusInternalServerError, gen.ErrorCodeInternalError)
+	}
+
+	job, err := h.jobService.Enqueue(ctx, userID, service.JobTypeExportAccountData, service.AccountExportJobParams{DeleteAccount: true})
+	if err != nil {
+		log.Printf("Failed to create account export job before deletion (id=%d): %v", userID, err)
+		return problem.NewError(http.StatusInternalServerError, gen.ErrorCodeInternalError)
+	}
+
+	return c.JSON(http.StatusAccepted, mapper.JobToResponse(job))
+}
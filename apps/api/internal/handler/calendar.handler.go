@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/service"
+)
+
+// iCalendarフィードのHTTPハンドラー
+type CalendarHandler struct {
+	service   *service.CalendarFeedService
+	publicURL string
+}
+
+// 新しいCalendarHandlerを作成（publicURL はフィードのURLの組み立てに使う）
+func NewCalendarHandler(service *service.CalendarFeedService, publicURL string) *CalendarHandler {
+	return &CalendarHandler{
+		service:   service,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+// CreateCalendarFeed - フィードのURLを発行（既存のURLは無効になる）
+func (h *CalendarHandler) CreateCalendarFeed(ctx context.Context, request gen.CreateCalendarFeedRequestObject) (gen.CreateCalendarFeedResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.CreateCalendarFeed401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	token, err := h.service.RotateToken(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to issue calendar feed token (user_id=%d): %v", userID, err)
+		return gen.CreateCalendarFeed500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.CreateCalendarFeed201JSONResponse{
+		Url:   h.publicURL + "/calendar/" + token,
+		Token: token,
+	}, nil
+}
+
+// DeleteCalendarFeed - フィードのURLを無効にする
+func (h *CalendarHandler) DeleteCalendarFeed(ctx context.Context, request gen.DeleteCalendarFeedRequestObject) (gen.DeleteCalendarFeedResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.DeleteCalendarFeed401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if err := h.service.RevokeToken(ctx, userID); err != nil {
+		if errors.Is(err, service.ErrCalendarFeedNotFound) {
+			return gen.DeleteCalendarFeed404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeCalendarFeedNotFound)), nil
+		}
+		log.Printf("Failed to revoke calendar feed token (user_id=%d): %v", userID, err)
+		return gen.DeleteCalendarFeed500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.DeleteCalendarFeed204Response{}, nil
+}
+
+// GetCalendarFeed - トークンの持ち主のTodoをiCalendar形式で取得（セッション不要）
+func (h *CalendarHandler) GetCalendarFeed(ctx context.Context, request gen.GetCalendarFeedRequestObject) (gen.GetCalendarFeedResponseObject, error) {
+	feed, err := h.service.Feed(ctx, request.Token)
+	if err != nil {
+		if errors.Is(err, service.ErrCalendarFeedNotFound) {
+			return gen.GetCalendarFeed404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeCalendarFeedNotFound)), nil
+		}
+		log.Printf("Failed to build calendar feed: %v", err)
+		return gen.GetCalendarFeed500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.GetCalendarFeed200TextcalendarResponse{
+		Body:          bytes.NewReader(feed),
+		ContentLength: int64(len(feed)),
+	}, nil
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+
+	"go-todo/internal/gen"
+	"go-todo/internal/problem"
+)
+
+// エラーコードと、リクエストの言語に合わせた title のエラーレスポンス（RFC 7807）
+func errorResponse(ctx context.Context, status int, code gen.ErrorCode, args ...any) gen.Problem {
+	return problem.New(ctx, status, code, args...)
+}
+
+// 原因のエラーの内容を detail に含めたエラーレスポンス（detail は翻訳しない）
+func errorResponseWithDetail(ctx context.Context, status int, code gen.ErrorCode, err error) gen.Problem {
+	resp := errorResponse(ctx, status, code)
+	detail := err.Error()
+	resp.Detail = &detail
+	return resp
+}
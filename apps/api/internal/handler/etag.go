@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go-todo/db/sqlc"
+)
+
+var errInvalidETag = errors.New("invalid etag")
+
+// TodoのETag（バージョン番号ベースの強いETag）
+func todoETag(t *sqlc.Todo) string {
+	return fmt.Sprintf(`"%d"`, t.Version)
+}
+
+// Todo一覧の弱いETag
+// 各TodoのIDとバージョンから算出するため、追加・更新・削除のいずれでも値が変わる
+func todoListETag(todos []sqlc.Todo) string {
+	h := sha256.New()
+	for _, t := range todos {
+		fmt.Fprintf(h, "%d:%d,", t.ID, t.Version)
+	}
+	return `W/"` + hex.EncodeToString(h.Sum(nil))[:32] + `"`
+}
+
+// If-Match ヘッダーから期待するバージョンの一覧を取り出す（RFC 9110 のカンマ区切りのETagのリスト）
+// "*" の場合は nil（バージョンを検証しない）を返す
+func parseIfMatch(value string) ([]int32, error) {
+	value = strings.TrimSpace(value)
+	if value == "*" {
+		return nil, nil
+	}
+	var versions []int32
+	for _, tag := range strings.Split(value, ",") {
+		tag = strings.TrimSpace(tag)
+		// リストの空の要素は無視する
+		if tag == "" {
+			continue
+		}
+		// If-Match は強い比較のため弱いETagは受け付けない
+		if !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) || len(tag) < 2 {
+			return nil, errInvalidETag
+		}
+		v, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 32)
+		if err != nil || v < 1 {
+			return nil, errInvalidETag
+		}
+		versions = append(versions, int32(v))
+	}
+	if len(versions) == 0 {
+		return nil, errInvalidETag
+	}
+	return versions, nil
+}
+
+// If-None-Match ヘッダーがETagに一致するか（弱い比較）
+func etagMatches(ifNoneMatch, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/exporter"
+	"go-todo/internal/gen"
+	"go-todo/internal/service"
+)
+
+// TodoのエクスポートのHTTPハンドラー
+type ExportHandler struct {
+	service *service.TodoExportService
+}
+
+// 新しいExportHandlerを作成
+func NewExportHandler(service *service.TodoExportService) *ExportHandler {
+	return &ExportHandler{service: service}
+}
+
+// ExportTodos - TodoをCSV・JSON・NDJSONで書き出す
+func (h *ExportHandler) ExportTodos(ctx context.Context, request gen.ExportTodosRequestObject) (gen.ExportTodosResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ExportTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	opts := service.ExportOptions{Format: exporter.FormatJSON}
+	if request.Params.Format != nil {
+		opts.Format = exporter.Format(*request.Params.Format)
+	}
+	if request.Params.IncludeDeleted != nil {
+		opts.IncludeDeleted = *request.Params.IncludeDeleted
+	}
+	if request.Params.Columns != nil {
+		names := make([]string, len(*request.Params.Columns))
+		for i, c := range *request.Params.Columns {
+			names[i] = string(c)
+		}
+		columns, err := exporter.ParseColumns(names)
+		if err != nil {
+			return gen.ExportTodos400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidColumns, err)), nil
+		}
+		opts.Columns = columns
+	}
+	if !opts.Format.Valid() {
+		return gen.ExportTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidFormat)), nil
+	}
+
+	return exportTodosResponse{ctx: ctx, service: h.service, userID: userID, opts: opts}, nil
+}
+
+// Todoを読み込みながらレスポンスに書き出す
+// 生成コードの application/json のレスポンスはボディ全体をエンコードしてから返すため使わない
+type exportTodosResponse struct {
+	ctx     context.Context
+	service *service.TodoExportService
+	userID  int64
+	opts    service.ExportOptions
+}
+
+func (r exportTodosResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", r.opts.Format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="todos.%s"`, r.opts.Format.Extension()))
+
+	// ステータスコードは最初の書き込みで200になる。それより前に失敗した場合はエラーレスポンスを返せる
+	tw := &writeTracker{ResponseWriter: w}
+	err := r.service.ExportTodos(r.ctx, r.userID, r.opts, tw)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, context.Canceled) {
+		log.Printf("Failed to export todos (user_id=%d): %v", r.userID, err)
+	}
+	if tw.written {
+		// 200と途中までのボディを送った後はエラーレスポンスを書けないため、接続を切ってクライアントに不完全な応答だと分かるようにする
+		// http.ErrAbortHandler は Recover ミドルウェアを素通りし、net/http もスタックトレースを出さない
+		panic(http.ErrAbortHandler)
+	}
+	w.Header().Del("Content-Disposition")
+	return err
+}
+
+// レスポンスへの書き込みが始まったかを記録する
+type writeTracker struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *writeTracker) WriteHeader(statusCode int) {
+	w.written = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *writeTracker) Write(b []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/importer"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// 他のタスク管理ツールからのインポートのHTTPハンドラー
+type ImportHandler struct {
+	service    *service.ExternalImportService
+	jobService *service.JobService
+}
+
+// 新しいImportHandlerを作成
+func NewImportHandler(service *service.ExternalImportService, jobService *service.JobService) *ImportHandler {
+	return &ImportHandler{
+		service:    service,
+		jobService: jobService,
+	}
+}
+
+// ImportExternalTodos - エクスポートを検証し、インポートジョブを登録
+func (h *ImportHandler) ImportExternalTodos(ctx context.Context, request gen.ImportExternalTodosRequestObject) (gen.ImportExternalTodosResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ImportExternalTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.ImportExternalTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+	// ボディはデコード済みのため、パーサーに渡すためにJSONに戻す
+	body, err := json.Marshal(*request.Body)
+	if err != nil {
+		return gen.ImportExternalTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	params, err := h.service.ParseExport(ctx, userID, service.ExternalImportSource(request.Source), bytes.NewReader(body))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnknownImportSource):
+			return gen.ImportExternalTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeUnknownImportSource)), nil
+		case errors.Is(err, importer.ErrInvalidExport):
+			return gen.ImportExternalTodos400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidExport, err)), nil
+		case errors.Is(err, service.ErrTooManyImportItems):
+			return gen.ImportExternalTodos413ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusRequestEntityTooLarge, gen.ErrorCodeTooManyImportItems, err)), nil
+		}
+		log.Printf("Failed to parse import (user_id=%d, source=%s): %v", userID, request.Source, err)
+		return gen.ImportExternalTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	job, err := h.jobService.Enqueue(ctx, userID, service.JobTypeImportExternalTodos, params)
+	if err != nil {
+		log.Printf("Failed to create import job (user_id=%d, source=%s): %v", userID, request.Source, err)
+		return gen.ImportExternalTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.ImportExternalTodos202JSONResponse(mapper.JobToResponse(job)), nil
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// 非同期ジョブのHTTPハンドラー
+type JobHandler struct {
+	service *service.JobService
+}
+
+// 新しいJobHandlerを作成
+func NewJobHandler(service *service.JobService) *JobHandler {
+	return &JobHandler{
+		service: service,
+	}
+}
+
+// CreateJob - Todoの一括処理ジョブを登録
+func (h *JobHandler) CreateJob(ctx context.Context, request gen.CreateJobRequestObject) (gen.CreateJobResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.CreateJob401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.CreateJob400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	// インポートなど専用のエンドポイントから登録するジョブは受け付けない
+	jobType := service.JobType(request.Body.Type)
+	if jobType != service.JobTypeCompleteTodos && jobType != service.JobTypeDeleteTodos {
+		return gen.CreateJob400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeUnknownJobType)), nil
+	}
+
+	filter := mapper.BulkTodoFilterFromRequest(request.Body.Filter)
+	if err := filter.Validate(); err != nil {
+		return gen.CreateJob400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidFilter, err)), nil
+	}
+
+	var params any = filter
+	if jobType == service.JobTypeCompleteTodos {
+		params = service.CompleteTodosJobParams{
+			BulkTodoFilter: filter,
+			Force:          request.Body.Force != nil && *request.Body.Force,
+		}
+	}
+
+	job, err := h.service.Enqueue(ctx, userID, jobType, params)
+	if err != nil {
+		if errors.Is(err, service.ErrUnknownJobType) {
+			return gen.CreateJob400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeUnknownJobType)), nil
+		}
+		log.Printf("Failed to create job (user_id=%d): %v", userID, err)
+		return gen.CreateJob500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.CreateJob202JSONResponse(mapper.JobToResponse(job)), nil
+}
+
+// GetJob - ジョブの進捗と結果を取得
+func (h *JobHandler) GetJob(ctx context.Context, request gen.GetJobRequestObject) (gen.GetJobResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.GetJob401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	job, err := h.service.GetJob(ctx, int64(request.Id), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrJobNotFound) {
+			return gen.GetJob404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeJobNotFound)), nil
+		}
+		log.Printf("Failed to get job (user_id=%d, job_id=%d): %v", userID, request.Id, err)
+		return gen.GetJob500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.GetJob200JSONResponse(mapper.JobToResponse(job)), nil
+}
+
+// CancelJob - ジョブのキャンセルを要求
+func (h *JobHandler) CancelJob(ctx context.Context, request gen.CancelJobRequestObject) (gen.CancelJobResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.CancelJob401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	job, err := h.service.CancelJob(ctx, int64(request.Id), userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrJobNotFound):
+			return gen.CancelJob404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeJobNotFound)), nil
+		case errors.Is(err, service.ErrJobAlreadyFinished):
+			return gen.CancelJob409ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusConflict, gen.ErrorCodeJobFinished)), nil
+		}
+		log.Printf("Failed to cancel job (user_id=%d, job_id=%d): %v", userID, request.Id, err)
+		return gen.CancelJob500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.CancelJob202JSONResponse(mapper.JobToResponse(job)), nil
+}
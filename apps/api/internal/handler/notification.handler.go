@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// リマインダーとアプリ内通知のHTTPハンドラー
+type NotificationHandler struct {
+	reminderService     *service.ReminderService
+	notificationService *service.NotificationService
+}
+
+// 新しいNotificationHandlerを作成
+func NewNotificationHandler(reminderService *service.ReminderService, notificationService *service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		reminderService:     reminderService,
+		notificationService: notificationService,
+	}
+}
+
+// ListTodoReminders - Todoのリマインダー一覧を取得
+func (h *NotificationHandler) ListTodoReminders(ctx context.Context, request gen.ListTodoRemindersRequestObject) (gen.ListTodoRemindersResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ListTodoReminders401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	reminders, err := h.reminderService.ListReminders(ctx, int64(request.Id), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrTodoNotFound) {
+			return gen.ListTodoReminders404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
+		}
+		log.Printf("Failed to list reminders (user_id=%d, todo_id=%d): %v", userID, request.Id, err)
+		return gen.ListTodoReminders500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.ListTodoReminders200JSONResponse(mapper.RemindersToResponse(reminders)), nil
+}
+
+// CreateTodoReminder - Todoにリマインダーを設定
+func (h *NotificationHandler) CreateTodoReminder(ctx context.Context, request gen.CreateTodoReminderRequestObject) (gen.CreateTodoReminderResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.CreateTodoReminder401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.CreateTodoReminder400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	reminder, err := h.reminderService.CreateReminder(ctx, int64(request.Id), userID, mapper.ReminderInputFromRequest(*request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidReminder):
+			return gen.CreateTodoReminder400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidReminder, err)), nil
+		case errors.Is(err, service.ErrChannelUnavailable):
+			return gen.CreateTodoReminder400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeChannelUnavailable)), nil
+		case errors.Is(err, service.ErrTodoNotFound):
+			return gen.CreateTodoReminder404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
+		}
+		log.Printf("Failed to create reminder (user_id=%d, todo_id=%d): %v", userID, request.Id, err)
+		return gen.CreateTodoReminder500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.CreateTodoReminder201JSONResponse(mapper.ReminderToResponse(reminder)), nil
+}
+
+// DeleteReminder - リマインダーを削除
+func (h *NotificationHandler) DeleteReminder(ctx context.Context, request gen.DeleteReminderRequestObject) (gen.DeleteReminderResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.DeleteReminder401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if err := h.reminderService.DeleteReminder(ctx, int64(request.Id), userID); err != nil {
+		if errors.Is(err, service.ErrReminderNotFound) {
+			return gen.DeleteReminder404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeReminderNotFound)), nil
+		}
+		log.Printf("Failed to delete reminder (user_id=%d, reminder_id=%d): %v", userID, request.Id, err)
+		return gen.DeleteReminder500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.DeleteReminder204Response{}, nil
+}
+
+// ListNotifications - アプリ内通知を新しい順にページ単位で取得
+func (h *NotificationHandler) ListNotifications(ctx context.Context, request gen.ListNotificationsRequestObject) (gen.ListNotificationsResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ListNotifications401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	unreadOnly := request.Params.Unread != nil && *request.Params.Unread
+	var cursor string
+	if request.Params.Cursor != nil {
+		cursor = *request.Params.Cursor
+	}
+	var limit int
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	page, err := h.notificationService.ListNotifications(ctx, userID, unreadOnly, cursor, limit)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidNotificationCursor) {
+			return gen.ListNotifications400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidCursor)), nil
+		}
+		log.Printf("Failed to list notifications (user_id=%d): %v", userID, err)
+		return gen.ListNotifications500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.ListNotifications200JSONResponse(mapper.NotificationPageToResponse(page)), nil
+}
+
+// GetUnreadNotificationCount - 未読の通知の件数を取得
+func (h *NotificationHandler) GetUnreadNotificationCount(ctx context.Context, request gen.GetUnreadNotificationCountRequestObject) (gen.GetUnreadNotificationCountResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.GetUnreadNotificationCount401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	count, err := h.notificationService.UnreadCount(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to count unread notifications (user_id=%d): %v", userID, err)
+		return gen.GetUnreadNotificationCount500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.GetUnreadNotificationCount200JSONResponse{Count: count}, nil
+}
+
+// MarkAllNotificationsRead - 未読の通知をすべて既読にする
+func (h *NotificationHandler) MarkAllNotificationsRead(ctx context.Context, request gen.MarkAllNotificationsReadRequestObject) (gen.MarkAllNotificationsReadResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.MarkAllNotificationsRead401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	var upToID *int64
+	if request.Params.UpToId != nil {
+		id := int64(*request.Params.UpToId)
+		upToID = &id
+	}
+
+	marked, err := h.notificationService.MarkAllRead(ctx, userID, upToID)
+	if err != nil {
+		log.Printf("Failed to mark all notifications as read (user_id=%d): %v", userID, err)
+		return gen.MarkAllNotificationsRead500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.MarkAllNotificationsRead200JSONResponse{Marked: marked}, nil
+}
+
+// GetNotificationPreferences - 種類ごとの通知の受け取り設定を取得
+func (h *NotificationHandler) GetNotificationPreferences(ctx context.Context, request gen.GetNotificationPreferencesRequestObject) (gen.GetNotificationPreferencesResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.GetNotificationPreferences401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	prefs, err := h.notificationService.GetPreferences(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to get notification preferences (user_id=%d): %v", userID, err)
+		return gen.GetNotificationPreferences500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.GetNotificationPreferences200JSONResponse(mapper.NotificationPreferencesToResponse(prefs)), nil
+}
+
+// UpdateNotificationPreferences - 通知の受け取り設定を更新
+func (h *NotificationHandler) UpdateNotificationPreferences(ctx context.Context, request gen.UpdateNotificationPreferencesRequestObject) (gen.UpdateNotificationPreferencesResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.UpdateNotificationPreferences401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.UpdateNotificationPreferences400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	prefs, err := h.notificationService.UpdatePreferences(ctx, userID, mapper.NotificationPreferencesFromRequest(*request.Body))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidNotificationPreference) {
+			return gen.UpdateNotificationPreferences400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidNotificationPreference, err)), nil
+		}
+		log.Printf("Failed to update notification preferences (user_id=%d): %v", userID, err)
+		return gen.UpdateNotificationPreferences500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.UpdateNotificationPreferences200JSONResponse(mapper.NotificationPreferencesToResponse(prefs)), nil
+}
+
+// MarkNotificationRead - 通知を既読にする
+func (h *NotificationHandler) MarkNotificationRead(ctx context.Context, request gen.MarkNotificationReadRequestObject) (gen.MarkNotificationReadResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.MarkNotificationRead401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	notification, err := h.notificationService.MarkRead(ctx, int64(request.Id), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotificationNotFound) {
+			return gen.MarkNotificationRead404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeNotificationNotFound)), nil
+		}
+		log.Printf("Failed to mark notification as read (user_id=%d, notification_id=%d): %v", userID, request.Id, err)
+		return gen.MarkNotificationRead500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.MarkNotificationRead200JSONResponse(mapper.NotificationToResponse(notification)), nil
+}
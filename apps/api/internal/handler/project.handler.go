@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// プロジェクトのHTTPハンドラー
+type ProjectHandler struct {
+	service *service.ProjectService
+}
+
+// 新しいProjectHandlerを作成
+func NewProjectHandler(service *service.ProjectService) *ProjectHandler {
+	return &ProjectHandler{
+		service: service,
+	}
+}
+
+// ListProjects - プロジェクト一覧を名前順で取得
+func (h *ProjectHandler) ListProjects(ctx context.Context, request gen.ListProjectsRequestObject) (gen.ListProjectsResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ListProjects401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	projects, err := h.service.ListProjects(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list projects (user_id=%d): %v", userID, err)
+		return gen.ListProjects500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.ListProjects200JSONResponse(mapper.ProjectsToResponse(projects)), nil
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/quickadd"
+	"go-todo/internal/service"
+)
+
+// 自然言語によるTodoのクイック追加のHTTPハンドラー
+type QuickAddHandler struct {
+	service *service.QuickAddService
+}
+
+// 新しいQuickAddHandlerを作成
+func NewQuickAddHandler(service *service.QuickAddService) *QuickAddHandler {
+	return &QuickAddHandler{service: service}
+}
+
+// QuickAddTodo - 1行のテキストを解析してTodoを作成
+func (h *QuickAddHandler) QuickAddTodo(ctx context.Context, request gen.QuickAddTodoRequestObject) (gen.QuickAddTodoResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.QuickAddTodo401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.QuickAddTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	todo, err := h.service.Create(ctx, userID, request.Body.Text, request.Body.Description, quickAddOptions(request.Body))
+	if err != nil {
+		if code, ok := quickAddErrorCode(err); ok {
+			return gen.QuickAddTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, code)), nil
+		}
+		log.Printf("Failed to quick-add todo (user_id=%d): %v", userID, err)
+		return gen.QuickAddTodo500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.QuickAddTodo201JSONResponse{
+		Body:    mapper.TodoToResponse(todo),
+		Headers: gen.QuickAddTodo201ResponseHeaders{ETag: todoETag(todo)},
+	}, nil
+}
+
+// PreviewQuickAddTodo - 1行のテキストを保存せずに解析
+func (h *QuickAddHandler) PreviewQuickAddTodo(ctx context.Context, request gen.PreviewQuickAddTodoRequestObject) (gen.PreviewQuickAddTodoResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.PreviewQuickAddTodo401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.PreviewQuickAddTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	result, err := h.service.Preview(ctx, userID, request.Body.Text, quickAddOptions(request.Body))
+	if err != nil {
+		if code, ok := quickAddErrorCode(err); ok {
+			return gen.PreviewQuickAddTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, code)), nil
+		}
+		log.Printf("Failed to preview quick-add (user_id=%d): %v", userID, err)
+		return gen.PreviewQuickAddTodo500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.PreviewQuickAddTodo200JSONResponse(mapper.QuickAddPreviewToResponse(result)), nil
+}
+
+func quickAddOptions(body *gen.QuickAddRequest) service.QuickAddOptions {
+	var opts service.QuickAddOptions
+	if body.Timezone != nil {
+		opts.Timezone = *body.Timezone
+	}
+	opts.DayFirst = body.DayFirst
+	return opts
+}
+
+// 利用者の入力に起因するエラーのエラーコードを返す
+func quickAddErrorCode(err error) (gen.ErrorCode, bool) {
+	switch {
+	case errors.Is(err, service.ErrInvalidTimezone):
+		return gen.ErrorCodeInvalidTimezone, true
+	case errors.Is(err, quickadd.ErrEmptyTitle):
+		return gen.ErrorCodeTitleRequired, true
+	}
+	return "", false
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// ステータス（カンバンの列）のHTTPハンドラー
+type StatusHandler struct {
+	service *service.StatusService
+}
+
+// 新しいStatusHandlerを作成
+func NewStatusHandler(service *service.StatusService) *StatusHandler {
+	return &StatusHandler{
+		service: service,
+	}
+}
+
+// ListStatuses - ステータス一覧を並び順で取得
+func (h *StatusHandler) ListStatuses(ctx context.Context, request gen.ListStatusesRequestObject) (gen.ListStatusesResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ListStatuses401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	statuses, err := h.service.ListStatuses(ctx, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return gen.ListStatuses401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		log.Printf("Failed to list statuses (user_id=%d): %v", userID, err)
+		return gen.ListStatuses500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.ListStatuses200JSONResponse(mapper.StatusesToResponse(statuses)), nil
+}
+
+// CreateStatus - ステータスを追加
+func (h *StatusHandler) CreateStatus(ctx context.Context, request gen.CreateStatusRequestObject) (gen.CreateStatusResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.CreateStatus401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.CreateStatus400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	isDone := request.Body.IsDone != nil && *request.Body.IsDone
+	status, err := h.service.CreateStatus(ctx, userID, request.Body.Name, request.Body.WipLimit, isDone)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidStatus):
+			return gen.CreateStatus400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidStatus)), nil
+		case errors.Is(err, service.ErrFirstStatusWIPLimit):
+			return gen.CreateStatus422ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnprocessableEntity, gen.ErrorCodeFirstStatusWipLimit)), nil
+		case errors.Is(err, service.ErrStatusNameConflict):
+			return gen.CreateStatus409ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusConflict, gen.ErrorCodeStatusNameConflict)), nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return gen.CreateStatus401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		log.Printf("Failed to create status (user_id=%d): %v", userID, err)
+		return gen.CreateStatus500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.CreateStatus201JSONResponse(mapper.StatusToResponse(status)), nil
+}
+
+// UpdateStatus - ステータスを変更
+func (h *StatusHandler) UpdateStatus(ctx context.Context, request gen.UpdateStatusRequestObject) (gen.UpdateStatusResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.UpdateStatus401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.UpdateStatus400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	status, err := h.service.UpdateStatus(ctx, int64(request.Id), userID, mapper.StatusPatchFromRequest(*request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidStatus):
+			return gen.UpdateStatus400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidStatus)), nil
+		case errors.Is(err, service.ErrStatusNotFound):
+			return gen.UpdateStatus404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeStatusNotFound)), nil
+		case errors.Is(err, service.ErrStatusNameConflict):
+			return gen.UpdateStatus409ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusConflict, gen.ErrorCodeStatusNameConflict)), nil
+		case errors.Is(err, service.ErrFirstStatusWIPLimit):
+			return gen.UpdateStatus422ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnprocessableEntity, gen.ErrorCodeFirstStatusWipLimit)), nil
+		case errors.Is(err, service.ErrStatusRequired):
+			return gen.UpdateStatus409ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusConflict, gen.ErrorCodeInvalidStatusSet)), nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return gen.UpdateStatus401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		log.Printf("Failed to update status (user_id=%d, status_id=%d): %v", userID, request.Id, err)
+		return gen.UpdateStatus500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.UpdateStatus200JSONResponse(mapper.StatusToResponse(status)), nil
+}
+
+// DeleteStatus - ステータスを削除
+func (h *StatusHandler) DeleteStatus(ctx context.Context, request gen.DeleteStatusRequestObject) (gen.DeleteStatusResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.DeleteStatus401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if err := h.service.DeleteStatus(ctx, int64(request.Id), userID); err != nil {
+		switch {
+		case errors.Is(err, service.ErrStatusNotFound):
+			return gen.DeleteStatus404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeStatusNotFound)), nil
+		case errors.Is(err, service.ErrFirstStatusWIPLimit):
+			return gen.DeleteStatus422ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnprocessableEntity, gen.ErrorCodeFirstStatusWipLimit)), nil
+		case errors.Is(err, service.ErrStatusRequired):
+			return gen.DeleteStatus409ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusConflict, gen.ErrorCodeInvalidStatusSet)), nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return gen.DeleteStatus401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		log.Printf("Failed to delete status (user_id=%d, status_id=%d): %v", userID, request.Id, err)
+		return gen.DeleteStatus500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.DeleteStatus204Response{}, nil
+}
+
+// SetTodoStatus - Todoを別のステータスへ移動
+func (h *StatusHandler) SetTodoStatus(ctx context.Context, request gen.SetTodoStatusRequestObject) (gen.SetTodoStatusResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.SetTodoStatus401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.SetTodoStatus400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	if request.Params.IfMatch == nil {
+		return gen.SetTodoStatus428ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusPreconditionRequired, gen.ErrorCodeIfMatchRequired)), nil
+	}
+	expectedVersions, err := parseIfMatch(*request.Params.IfMatch)
+	if err != nil {
+		return gen.SetTodoStatus400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidIfMatch)), nil
+	}
+
+	force := request.Params.Force != nil && *request.Params.Force
+
+	todo, err := h.service.SetTodoStatus(ctx, int64(request.Id), userID, request.Body.StatusId, expectedVersions, force)
+	if err != nil {
+		var mismatch *service.TodoVersionMismatchError
+		if errors.As(err, &mismatch) {
+			return gen.SetTodoStatus412JSONResponse{
+				Body:    mapper.TodoToResponse(mismatch.Current),
+				Headers: gen.SetTodoStatus412ResponseHeaders{ETag: todoETag(mismatch.Current)},
+			}, nil
+		}
+		var wip *service.WIPLimitExceededError
+		if errors.As(err, &wip) {
+			return gen.SetTodoStatus409ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusConflict, gen.ErrorCodeWipLimitExceeded, wip.Status.Name, *wip.Status.WipLimit)), nil
+		}
+		switch {
+		case errors.Is(err, service.ErrTodoBlocked):
+			return gen.SetTodoStatus409ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusConflict, gen.ErrorCodeTodoBlocked)), nil
+		case errors.Is(err, service.ErrStatusNotFound):
+			return gen.SetTodoStatus400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeUnknownStatus)), nil
+		case errors.Is(err, service.ErrTodoNotFound):
+			return gen.SetTodoStatus404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return gen.SetTodoStatus401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		log.Printf("Failed to set todo status (user_id=%d, todo_id=%d): %v", userID, request.Id, err)
+		return gen.SetTodoStatus500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.SetTodoStatus200JSONResponse{
+		Body:    mapper.TodoToResponse(todo),
+		Headers: gen.SetTodoStatus200ResponseHeaders{ETag: todoETag(todo)},
+	}, nil
+}
+
+// GetBoard - ステータスごとにまとめたTodoを取得
+func (h *StatusHandler) GetBoard(ctx context.Context, request gen.GetBoardRequestObject) (gen.GetBoardResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.GetBoard401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	board, err := h.service.GetBoard(ctx, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return gen.GetBoard401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		log.Printf("Failed to get board (user_id=%d): %v", userID, err)
+		return gen.GetBoard500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.GetBoard200JSONResponse(mapper.BoardToResponse(board)), nil
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// オフライン同期のHTTPハンドラー
+type SyncHandler struct {
+	service *service.SyncService
+}
+
+// 新しいSyncHandlerを作成
+func NewSyncHandler(service *service.SyncService) *SyncHandler {
+	return &SyncHandler{
+		service: service,
+	}
+}
+
+// SyncTodos - クライアントの操作を適用し、サーバー側の変更を返す
+func (h *SyncHandler) SyncTodos(ctx context.Context, request gen.SyncTodosRequestObject) (gen.SyncTodosResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.SyncTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.SyncTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	var token string
+	if request.Body.SyncToken != nil {
+		token = *request.Body.SyncToken
+	}
+
+	ops, err := mapper.SyncOperationsFromRequest(request.Body.Operations)
+	if err != nil {
+		return gen.SyncTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	result, err := h.service.Sync(ctx, userID, token, ops)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidSyncToken):
+			return gen.SyncTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidSyncToken)), nil
+		case errors.Is(err, service.ErrTooManySyncOperations):
+			return gen.SyncTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeTooManyOperations)), nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return gen.SyncTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		log.Printf("Failed to sync todos (user_id=%d): %v", userID, err)
+		return gen.SyncTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.SyncTodos200JSONResponse(mapper.SyncResultToResponse(result)), nil
+}
@@ -2,22 +2,35 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"time"
 
 	"go-todo/internal/auth"
 	"go-todo/internal/gen"
+	"go-todo/internal/importer"
+	csvimport "go-todo/internal/importer/csv"
+	"go-todo/internal/importer/markdown"
+	"go-todo/internal/importer/ndjson"
+	"go-todo/internal/importer/todotxt"
 	"go-todo/internal/mapper"
 	"go-todo/internal/service"
 )
 
 // TodoのHTTPハンドラー（StrictServerInterface実装）
 type TodoHandler struct {
-	service *service.TodoService
+	service           *service.TodoService
+	dependencyService *service.DependencyService
+	settingsService   *service.UserSettingsService
 }
 
 // 新しいTodoHandlerを作成
-func NewTodoHandler(service *service.TodoService) *TodoHandler {
+func NewTodoHandler(service *service.TodoService, dependencyService *service.DependencyService, settingsService *service.UserSettingsService) *TodoHandler {
 	return &TodoHandler{
-		service: service,
+		service:           service,
+		dependencyService: dependencyService,
+		settingsService:   settingsService,
 	}
 }
 
@@ -25,111 +38,325 @@ func NewTodoHandler(service *service.TodoService) *TodoHandler {
 func (h *TodoHandler) ListTodos(ctx context.Context, request gen.ListTodosRequestObject) (gen.ListTodosResponseObject, error) {
 	userID, ok := auth.GetUserIDFromContext(ctx)
 	if !ok {
-		return gen.ListTodos401JSONResponse{Message: "Unauthorized"}, nil
+		return gen.ListTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
 	}
 
-	todos, err := h.service.GetAllTodos(ctx, userID)
+	// 指定がなければユーザー設定の並び順
+	var sort service.TodoSort
+	if request.Params.Sort != nil {
+		sort = service.TodoSort(*request.Params.Sort)
+	} else {
+		var err error
+		sort, err = h.settingsService.DefaultSort(ctx, userID)
+		if err != nil {
+			return gen.ListTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+		}
+	}
+
+	actionable := request.Params.Actionable != nil && *request.Params.Actionable
+	todos, err := h.service.GetAllTodos(ctx, userID, sort, actionable)
 	if err != nil {
-		return gen.ListTodos500JSONResponse{Message: "Internal server error"}, nil
+		return gen.ListTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
 	}
 
-	return gen.ListTodos200JSONResponse(mapper.TodosToResponse(todos)), nil
+	etag := todoListETag(todos)
+	if request.Params.IfNoneMatch != nil && etagMatches(*request.Params.IfNoneMatch, etag) {
+		return gen.ListTodos304Response{Headers: gen.ListTodos304ResponseHeaders{ETag: etag}}, nil
+	}
+
+	return gen.ListTodos200JSONResponse{
+		Body:    mapper.TodosToResponse(todos),
+		Headers: gen.ListTodos200ResponseHeaders{ETag: etag},
+	}, nil
 }
 
-// GetTodo - IDでTodoを取得
-func (h *TodoHandler) GetTodo(ctx context.Context, request gen.GetTodoRequestObject) (gen.GetTodoResponseObject, error) {
+// ListTodoChanges - 同期トークン以降の変更を取得
+func (h *TodoHandler) ListTodoChanges(ctx context.Context, request gen.ListTodoChangesRequestObject) (gen.ListTodoChangesResponseObject, error) {
 	userID, ok := auth.GetUserIDFromContext(ctx)
 	if !ok {
-		return gen.GetTodo401JSONResponse{Message: "Unauthorized"}, nil
+		return gen.ListTodoChanges401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	var token string
+	if request.Params.Since != nil {
+		token = *request.Params.Since
+	}
+
+	changes, err := h.service.ListChanges(ctx, userID, token)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidSyncToken) {
+			return gen.ListTodoChanges400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidSyncToken)), nil
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return gen.ListTodoChanges401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		return gen.ListTodoChanges500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
 	}
 
-	if request.Id < 0 {
-		return gen.GetTodo400JSONResponse{Message: "Invalid ID"}, nil
+	return gen.ListTodoChanges200JSONResponse{
+		Todos:      mapper.TodosToResponse(changes.Todos),
+		DeletedIds: changes.DeletedIDs,
+		NextToken:  changes.NextToken,
+		HasMore:    changes.HasMore,
+	}, nil
+}
+
+// GetTodo - IDでTodoを取得
+func (h *TodoHandler) GetTodo(ctx context.Context, request gen.GetTodoRequestObject) (gen.GetTodoResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.GetTodo401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
 	}
 
 	todo, err := h.service.GetTodoByID(ctx, int64(request.Id), userID)
 	if err != nil {
 		if err == service.ErrTodoNotFound {
-			return gen.GetTodo404JSONResponse{Message: "Todo not found"}, nil
+			return gen.GetTodo404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
 		}
-		return gen.GetTodo500JSONResponse{Message: "Internal server error"}, nil
+		return gen.GetTodo500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
 	}
 
-	return gen.GetTodo200JSONResponse(mapper.TodoToResponse(todo)), nil
+	deps, err := h.dependencyService.GetDependencies(ctx, todo.ID, userID)
+	if err != nil {
+		return gen.GetTodo500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	body := mapper.TodoToResponse(todo)
+	resp := mapper.TodoDependenciesToResponse(deps)
+	body.Blockers = &resp.Blockers
+	body.Dependents = &resp.Dependents
+	return gen.GetTodo200JSONResponse{
+		Body:    body,
+		Headers: gen.GetTodo200ResponseHeaders{ETag: todoETag(todo)},
+	}, nil
 }
 
 // CreateTodo - 新しいTodoを作成
 func (h *TodoHandler) CreateTodo(ctx context.Context, request gen.CreateTodoRequestObject) (gen.CreateTodoResponseObject, error) {
 	userID, ok := auth.GetUserIDFromContext(ctx)
 	if !ok {
-		return gen.CreateTodo401JSONResponse{Message: "Unauthorized"}, nil
+		return gen.CreateTodo401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
 	}
 
 	if request.Body == nil {
-		return gen.CreateTodo400JSONResponse{Message: "Invalid request body"}, nil
-	}
-
-	if request.Body.Title == "" {
-		return gen.CreateTodo400JSONResponse{Message: "Title is required"}, nil
+		return gen.CreateTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
 	}
 
-	todo, err := h.service.CreateTodo(ctx, userID, request.Body.Title, request.Body.Description)
+	todo, err := h.service.CreateTodo(ctx, userID, request.Body.Title, request.Body.Description, request.Body.DueAt)
 	if err != nil {
-		return gen.CreateTodo500JSONResponse{Message: "Internal server error"}, nil
+		return gen.CreateTodo500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
 	}
 
-	return gen.CreateTodo201JSONResponse(mapper.TodoToResponse(todo)), nil
+	return gen.CreateTodo201JSONResponse{
+		Body:    mapper.TodoToResponse(todo),
+		Headers: gen.CreateTodo201ResponseHeaders{ETag: todoETag(todo)},
+	}, nil
 }
 
 // UpdateTodo - Todoを更新
 func (h *TodoHandler) UpdateTodo(ctx context.Context, request gen.UpdateTodoRequestObject) (gen.UpdateTodoResponseObject, error) {
 	userID, ok := auth.GetUserIDFromContext(ctx)
 	if !ok {
-		return gen.UpdateTodo401JSONResponse{Message: "Unauthorized"}, nil
+		return gen.UpdateTodo401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
 	}
 
-	if request.Id < 0 {
-		return gen.UpdateTodo400JSONResponse{Message: "Invalid ID"}, nil
+	if request.Body == nil {
+		return gen.UpdateTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
 	}
 
-	if request.Body == nil {
-		return gen.UpdateTodo400JSONResponse{Message: "Invalid request body"}, nil
+	if request.Params.IfMatch == nil {
+		return gen.UpdateTodo428ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusPreconditionRequired, gen.ErrorCodeIfMatchRequired)), nil
+	}
+	expectedVersions, err := parseIfMatch(*request.Params.IfMatch)
+	if err != nil {
+		return gen.UpdateTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidIfMatch)), nil
 	}
 
 	todo, err := h.service.UpdateTodo(
 		ctx,
 		int64(request.Id),
 		userID,
+		expectedVersions,
 		request.Body.Title,
 		request.Body.Description,
 		request.Body.Completed,
+		request.Params.Force != nil && *request.Params.Force,
 	)
 	if err != nil {
+		var mismatch *service.TodoVersionMismatchError
+		if errors.As(err, &mismatch) {
+			return gen.UpdateTodo412JSONResponse{
+				Body:    mapper.TodoToResponse(mismatch.Current),
+				Headers: gen.UpdateTodo412ResponseHeaders{ETag: todoETag(mismatch.Current)},
+			}, nil
+		}
 		if err == service.ErrTodoNotFound {
-			return gen.UpdateTodo404JSONResponse{Message: "Todo not found"}, nil
+			return gen.UpdateTodo404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
+		}
+		if errors.Is(err, service.ErrTodoBlocked) {
+			return gen.UpdateTodo409ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusConflict, gen.ErrorCodeTodoBlocked)), nil
+		}
+		return gen.UpdateTodo500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.UpdateTodo200JSONResponse{
+		Body:    mapper.TodoToResponse(todo),
+		Headers: gen.UpdateTodo200ResponseHeaders{ETag: todoETag(todo)},
+	}, nil
+}
+
+// SetTodoDue - Todoの期限を設定
+func (h *TodoHandler) SetTodoDue(ctx context.Context, request gen.SetTodoDueRequestObject) (gen.SetTodoDueResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.SetTodoDue401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.SetTodoDue400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	if request.Params.IfMatch == nil {
+		return gen.SetTodoDue428ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusPreconditionRequired, gen.ErrorCodeIfMatchRequired)), nil
+	}
+	expectedVersions, err := parseIfMatch(*request.Params.IfMatch)
+	if err != nil {
+		return gen.SetTodoDue400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidIfMatch)), nil
+	}
+
+	todo, err := h.service.SetDueAt(ctx, int64(request.Id), userID, expectedVersions, request.Body.DueAt)
+	if err != nil {
+		var mismatch *service.TodoVersionMismatchError
+		if errors.As(err, &mismatch) {
+			return gen.SetTodoDue412JSONResponse{
+				Body:    mapper.TodoToResponse(mismatch.Current),
+				Headers: gen.SetTodoDue412ResponseHeaders{ETag: todoETag(mismatch.Current)},
+			}, nil
+		}
+		if errors.Is(err, service.ErrTodoNotFound) {
+			return gen.SetTodoDue404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
+		}
+		return gen.SetTodoDue500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.SetTodoDue200JSONResponse{
+		Body:    mapper.TodoToResponse(todo),
+		Headers: gen.SetTodoDue200ResponseHeaders{ETag: todoETag(todo)},
+	}, nil
+}
+
+// AddTodoBlocker - Todoのブロッカーを追加
+func (h *TodoHandler) AddTodoBlocker(ctx context.Context, request gen.AddTodoBlockerRequestObject) (gen.AddTodoBlockerResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.AddTodoBlocker401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.AddTodoBlocker400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	deps, err := h.dependencyService.AddBlocker(ctx, int64(request.Id), request.Body.BlockerId, userID)
+	if err != nil {
+		var cycle *service.DependencyCycleError
+		if errors.As(err, &cycle) {
+			return gen.AddTodoBlocker409ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusConflict, gen.ErrorCodeDependencyCycle, cycle)), nil
+		}
+		switch {
+		case errors.Is(err, service.ErrInvalidDependency):
+			return gen.AddTodoBlocker400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeSelfDependency)), nil
+		case errors.Is(err, service.ErrTodoNotFound):
+			return gen.AddTodoBlocker404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
+		case errors.Is(err, service.ErrBlockerNotFound):
+			return gen.AddTodoBlocker404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeBlockerNotFound)), nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return gen.AddTodoBlocker401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		return gen.AddTodoBlocker500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.AddTodoBlocker200JSONResponse(mapper.TodoDependenciesToResponse(deps)), nil
+}
+
+// RemoveTodoBlocker - Todoのブロッカーを外す
+func (h *TodoHandler) RemoveTodoBlocker(ctx context.Context, request gen.RemoveTodoBlockerRequestObject) (gen.RemoveTodoBlockerResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.RemoveTodoBlocker401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	err := h.dependencyService.RemoveBlocker(ctx, int64(request.Id), int64(request.BlockerId), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrDependencyNotFound) {
+			return gen.RemoveTodoBlocker404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeDependencyNotFound)), nil
+		}
+		return gen.RemoveTodoBlocker500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.RemoveTodoBlocker204Response{}, nil
+}
+
+// MoveTodo - Todoの並び順を変更
+func (h *TodoHandler) MoveTodo(ctx context.Context, request gen.MoveTodoRequestObject) (gen.MoveTodoResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.MoveTodo401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.MoveTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	todo, err := h.service.MoveTodo(ctx, int64(request.Id), userID, request.Body.BeforeId, request.Body.AfterId)
+	if err != nil {
+		if errors.Is(err, service.ErrTodoNotFound) {
+			return gen.MoveTodo404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
+		}
+		if errors.Is(err, service.ErrMoveAnchorNotFound) {
+			return gen.MoveTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeAnchorNotFound)), nil
+		}
+		if errors.Is(err, service.ErrInvalidMove) {
+			return gen.MoveTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidPositionAnchor)), nil
 		}
-		return gen.UpdateTodo500JSONResponse{Message: "Internal server error"}, nil
+		if errors.Is(err, service.ErrUserNotFound) {
+			return gen.MoveTodo401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+		}
+		return gen.MoveTodo500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
 	}
 
-	return gen.UpdateTodo200JSONResponse(mapper.TodoToResponse(todo)), nil
+	return gen.MoveTodo200JSONResponse{
+		Body:    mapper.TodoToResponse(todo),
+		Headers: gen.MoveTodo200ResponseHeaders{ETag: todoETag(todo)},
+	}, nil
 }
 
 // DeleteTodo - Todoを削除
 func (h *TodoHandler) DeleteTodo(ctx context.Context, request gen.DeleteTodoRequestObject) (gen.DeleteTodoResponseObject, error) {
 	userID, ok := auth.GetUserIDFromContext(ctx)
 	if !ok {
-		return gen.DeleteTodo401JSONResponse{Message: "Unauthorized"}, nil
+		return gen.DeleteTodo401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
 	}
 
-	if request.Id < 0 {
-		return gen.DeleteTodo400JSONResponse{Message: "Invalid ID"}, nil
+	if request.Params.IfMatch == nil {
+		return gen.DeleteTodo428ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusPreconditionRequired, gen.ErrorCodeIfMatchRequired)), nil
+	}
+	expectedVersions, err := parseIfMatch(*request.Params.IfMatch)
+	if err != nil {
+		return gen.DeleteTodo400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidIfMatch)), nil
 	}
 
-	if err := h.service.DeleteTodo(ctx, int64(request.Id), userID); err != nil {
+	if err := h.service.DeleteTodo(ctx, int64(request.Id), userID, expectedVersions); err != nil {
+		var mismatch *service.TodoVersionMismatchError
+		if errors.As(err, &mismatch) {
+			return gen.DeleteTodo412JSONResponse{
+				Body:    mapper.TodoToResponse(mismatch.Current),
+				Headers: gen.DeleteTodo412ResponseHeaders{ETag: todoETag(mismatch.Current)},
+			}, nil
+		}
 		if err == service.ErrTodoNotFound {
-			return gen.DeleteTodo404JSONResponse{Message: "Todo not found"}, nil
+			return gen.DeleteTodo404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)), nil
 		}
-		return gen.DeleteTodo500JSONResponse{Message: "Internal server error"}, nil
+		return gen.DeleteTodo500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
 	}
 
 	return gen.DeleteTodo204Response{}, nil
@@ -139,25 +366,173 @@ func (h *TodoHandler) DeleteTodo(ctx context.Context, request gen.DeleteTodoRequ
 func (h *TodoHandler) BatchCompleteTodos(ctx context.Context, request gen.BatchCompleteTodosRequestObject) (gen.BatchCompleteTodosResponseObject, error) {
 	userID, ok := auth.GetUserIDFromContext(ctx)
 	if !ok {
-		return gen.BatchCompleteTodos401JSONResponse{Message: "Unauthorized"}, nil
+		return gen.BatchCompleteTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
 	}
 
-	if request.Body == nil || len(request.Body.Ids) == 0 {
-		return gen.BatchCompleteTodos400JSONResponse{Message: "IDs are required"}, nil
+	// 空の配列（minItems）はリクエストの検証ミドルウェアが拒否する
+	if request.Body == nil {
+		return gen.BatchCompleteTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
 	}
 
-	if len(request.Body.Ids) > 100 {
-		return gen.BatchCompleteTodos400JSONResponse{Message: "Too many IDs (max 100)"}, nil
-	}
+	atomic := request.Params.Atomic != nil && *request.Params.Atomic
+	force := request.Params.Force != nil && *request.Params.Force
 
-	result, err := h.service.BatchCompleteTodos(ctx, userID, request.Body.Ids)
+	result, err := h.service.BatchCompleteTodos(ctx, userID, request.Body.Ids, atomic, force)
 	if err != nil {
-		return gen.BatchCompleteTodos500JSONResponse{Message: "Internal server error"}, nil
+		if errors.Is(err, service.ErrTooManyBatchItems) {
+			return gen.BatchCompleteTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeTooManyIds, h.service.MaxBatchItems())), nil
+		}
+		return gen.BatchCompleteTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
 	}
 
 	return gen.BatchCompleteTodos200JSONResponse{
 		Succeeded: mapper.TodosToResponse(result.Succeeded),
 		Failed:    mapper.BatchFailedItemsToResponse(result.Failed),
+		Warnings:  mapper.BatchWarningsToResponse(result.Warnings),
+	}, nil
+}
+
+// BatchCreateTodos - Todoを一括作成
+func (h *TodoHandler) BatchCreateTodos(ctx context.Context, request gen.BatchCreateTodosRequestObject) (gen.BatchCreateTodosResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.BatchCreateTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.BatchCreateTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	result, err := h.service.BatchCreateTodos(ctx, userID, mapper.BatchCreateItemsFromRequest(request.Body.Items))
+	if err != nil {
+		if errors.Is(err, service.ErrTooManyBatchItems) {
+			return gen.BatchCreateTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeTooManyItems, h.service.MaxBatchItems())), nil
+		}
+		return gen.BatchCreateTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.BatchCreateTodos200JSONResponse(mapper.BatchCreateResultToResponse(result)), nil
+}
+
+// ImportTodos - NDJSON・todo.txt・Markdown・CSVからTodoをインポート（形式はContent-Typeで選ぶ）
+func (h *TodoHandler) ImportTodos(ctx context.Context, request gen.ImportTodosRequestObject) (gen.ImportTodosResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ImportTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.ImportTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	// 日付だけの期限はユーザー設定のタイムゾーンの0時とみなす
+	loc, err := h.settingsService.Location(ctx, userID)
+	if err != nil {
+		return gen.ImportTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	src, ok := newImportReader(request, loc)
+	if !ok {
+		return gen.ImportTodos415ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnsupportedMediaType, gen.ErrorCodeUnsupportedContentType)), nil
+	}
+
+	opts := service.ImportOptions{
+		Strict:  request.Params.Strict != nil && *request.Params.Strict,
+		Preview: request.Params.Preview != nil && *request.Params.Preview,
+		Dedupe:  request.Params.Dedupe != nil && *request.Params.Dedupe,
+	}
+
+	result, err := h.service.ImportTodos(ctx, userID, src, opts)
+	if err != nil {
+		var aborted *service.ImportAbortedError
+		if errors.As(err, &aborted) {
+			return gen.ImportTodos422JSONResponse{
+				Imported: 0,
+				Failed:   1,
+				Errors:   mapper.ImportLineErrorsToResponse([]importer.LineError{aborted.LineError}),
+			}, nil
+		}
+		if errors.Is(err, importer.ErrLineTooLong) || errors.Is(err, csvimport.ErrInvalidHeader) {
+			return gen.ImportTodos400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidImportFile, err)), nil
+		}
+		return gen.ImportTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.ImportTodos200JSONResponse(mapper.ImportResultToResponse(result)), nil
+}
+
+// Content-Typeに対応するインポート形式のReaderを作る
+func newImportReader(request gen.ImportTodosRequestObject, loc *time.Location) (importer.Reader, bool) {
+	mediaType, _, err := mime.ParseMediaType(request.ContentType)
+	if err != nil {
+		return nil, false
+	}
+	switch mediaType {
+	case "application/x-ndjson":
+		return ndjson.NewReader(request.Body, loc), true
+	case "text/plain":
+		return todotxt.NewReader(request.Body, loc), true
+	case "text/markdown":
+		return markdown.NewReader(request.Body), true
+	case "text/csv":
+		var mapping csvimport.Mapping
+		if request.Params.CsvTitle != nil {
+			mapping.Title = *request.Params.CsvTitle
+		}
+		if request.Params.CsvDescription != nil {
+			mapping.Description = *request.Params.CsvDescription
+		}
+		if request.Params.CsvCompleted != nil {
+			mapping.Completed = *request.Params.CsvCompleted
+		}
+		if request.Params.CsvDueAt != nil {
+			mapping.DueAt = *request.Params.CsvDueAt
+		}
+		return csvimport.NewReader(request.Body, mapping, loc), true
+	}
+	return nil, false
+}
+
+// BatchUpdateTodos - Todoを一括更新
+func (h *TodoHandler) BatchUpdateTodos(ctx context.Context, request gen.BatchUpdateTodosRequestObject) (gen.BatchUpdateTodosResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.BatchUpdateTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.BatchUpdateTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	patch := service.TodoPatch{
+		Title:       request.Body.Patch.Title,
+		Description: request.Body.Patch.Description,
+		Completed:   request.Body.Patch.Completed,
+	}
+	if patch.IsEmpty() {
+		return gen.BatchUpdateTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeEmptyPatch)), nil
+	}
+
+	opts := service.BatchUpdateOptions{
+		Atomic: request.Params.Atomic != nil && *request.Params.Atomic,
+		DryRun: request.Params.DryRun != nil && *request.Params.DryRun,
+		Force:  request.Params.Force != nil && *request.Params.Force,
+	}
+
+	result, err := h.service.BatchUpdateTodos(ctx, userID, request.Body.Ids, patch, opts)
+	if err != nil {
+		if errors.Is(err, service.ErrTooManyBatchItems) {
+			return gen.BatchUpdateTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeTooManyIds, h.service.MaxBatchItems())), nil
+		}
+		return gen.BatchUpdateTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.BatchUpdateTodos200JSONResponse{
+		Succeeded: mapper.TodosToResponse(result.Succeeded),
+		Failed:    mapper.BatchFailedItemsToResponse(result.Failed),
+		Warnings:  mapper.BatchWarningsToResponse(result.Warnings),
+		Changes:   mapper.BatchUpdateChangesToResponse(result.Changes),
+		DryRun:    opts.DryRun,
 	}, nil
 }
 
@@ -165,24 +540,26 @@ func (h *TodoHandler) BatchCompleteTodos(ctx context.Context, request gen.BatchC
 func (h *TodoHandler) BatchDeleteTodos(ctx context.Context, request gen.BatchDeleteTodosRequestObject) (gen.BatchDeleteTodosResponseObject, error) {
 	userID, ok := auth.GetUserIDFromContext(ctx)
 	if !ok {
-		return gen.BatchDeleteTodos401JSONResponse{Message: "Unauthorized"}, nil
+		return gen.BatchDeleteTodos401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
 	}
 
-	if request.Body == nil || len(request.Body.Ids) == 0 {
-		return gen.BatchDeleteTodos400JSONResponse{Message: "IDs are required"}, nil
+	if request.Body == nil {
+		return gen.BatchDeleteTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
 	}
 
-	if len(request.Body.Ids) > 100 {
-		return gen.BatchDeleteTodos400JSONResponse{Message: "Too many IDs (max 100)"}, nil
-	}
+	atomic := request.Params.Atomic != nil && *request.Params.Atomic
 
-	result, err := h.service.BatchDeleteTodos(ctx, userID, request.Body.Ids)
+	result, err := h.service.BatchDeleteTodos(ctx, userID, request.Body.Ids, atomic)
 	if err != nil {
-		return gen.BatchDeleteTodos500JSONResponse{Message: "Internal server error"}, nil
+		if errors.Is(err, service.ErrTooManyBatchItems) {
+			return gen.BatchDeleteTodos400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeTooManyIds, h.service.MaxBatchItems())), nil
+		}
+		return gen.BatchDeleteTodos500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
 	}
 
 	return gen.BatchDeleteTodos200JSONResponse{
 		Succeeded: result.Succeeded,
 		Failed:    mapper.BatchFailedItemsToResponse(result.Failed),
+		Warnings:  mapper.BatchWarningsToResponse(result.Warnings),
 	}, nil
 }
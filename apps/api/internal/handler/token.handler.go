@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// パーソナルアクセストークンのHTTPハンドラー
+type PersonalAccessTokenHandler struct {
+	service *service.PersonalAccessTokenService
+}
+
+// 新しいPersonalAccessTokenHandlerを作成
+func NewPersonalAccessTokenHandler(service *service.PersonalAccessTokenService) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{service: service}
+}
+
+// ListPersonalAccessTokens - トークン一覧を取得（トークンの値は含まない）
+func (h *PersonalAccessTokenHandler) ListPersonalAccessTokens(ctx context.Context, request gen.ListPersonalAccessTokensRequestObject) (gen.ListPersonalAccessTokensResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.ListPersonalAccessTokens401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	tokens, err := h.service.ListTokens(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list personal access tokens (user_id=%d): %v", userID, err)
+		return gen.ListPersonalAccessTokens500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.ListPersonalAccessTokens200JSONResponse{
+		Tokens: mapper.PersonalAccessTokensToResponse(tokens),
+	}, nil
+}
+
+// CreatePersonalAccessToken - トークンを発行
+func (h *PersonalAccessTokenHandler) CreatePersonalAccessToken(ctx context.Context, request gen.CreatePersonalAccessTokenRequestObject) (gen.CreatePersonalAccessTokenResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.CreatePersonalAccessToken401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	token, pat, err := h.service.CreateToken(ctx, userID, request.Body.Name)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidPersonalAccessTokenName) {
+			return gen.CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidTokenName)), nil
+		}
+		log.Printf("Failed to create personal access token (user_id=%d): %v", userID, err)
+		return gen.CreatePersonalAccessToken500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.CreatePersonalAccessToken201JSONResponse{
+		Token:               token,
+		PersonalAccessToken: mapper.PersonalAccessTokenToResponse(pat),
+	}, nil
+}
+
+// DeletePersonalAccessToken - トークンを無効にする
+func (h *PersonalAccessTokenHandler) DeletePersonalAccessToken(ctx context.Context, request gen.DeletePersonalAccessTokenRequestObject) (gen.DeletePersonalAccessTokenResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.DeletePersonalAccessToken401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if err := h.service.DeleteToken(ctx, int64(request.Id), userID); err != nil {
+		if errors.Is(err, service.ErrPersonalAccessTokenNotFound) {
+			return gen.DeletePersonalAccessToken404ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusNotFound, gen.ErrorCodePersonalAccessTokenNotFound)), nil
+		}
+		log.Printf("Failed to delete personal access token (user_id=%d, id=%d): %v", userID, request.Id, err)
+		return gen.DeletePersonalAccessToken500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.DeletePersonalAccessToken204Response{}, nil
+}
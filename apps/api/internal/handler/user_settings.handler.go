@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/mapper"
+	"go-todo/internal/service"
+)
+
+// ユーザー設定のHTTPハンドラー
+type UserSettingsHandler struct {
+	service *service.UserSettingsService
+}
+
+// 新しいUserSettingsHandlerを作成
+func NewUserSettingsHandler(service *service.UserSettingsService) *UserSettingsHandler {
+	return &UserSettingsHandler{service: service}
+}
+
+// GetUserSettings - ユーザー設定を取得
+func (h *UserSettingsHandler) GetUserSettings(ctx context.Context, request gen.GetUserSettingsRequestObject) (gen.GetUserSettingsResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.GetUserSettings401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	settings, err := h.service.GetSettings(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to get user settings (user_id=%d): %v", userID, err)
+		return gen.GetUserSettings500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.GetUserSettings200JSONResponse(mapper.UserSettingsToResponse(settings)), nil
+}
+
+// UpdateUserSettings - ユーザー設定を更新
+func (h *UserSettingsHandler) UpdateUserSettings(ctx context.Context, request gen.UpdateUserSettingsRequestObject) (gen.UpdateUserSettingsResponseObject, error) {
+	userID, ok := auth.GetUserIDFromContext(ctx)
+	if !ok {
+		return gen.UpdateUserSettings401ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusUnauthorized, gen.ErrorCodeUnauthorized)), nil
+	}
+
+	if request.Body == nil {
+		return gen.UpdateUserSettings400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidRequestBody)), nil
+	}
+
+	settings, err := h.service.UpdateSettings(ctx, userID, mapper.UserSettingsPatchFromRequest(request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidTimezone):
+			return gen.UpdateUserSettings400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidTimezone, err)), nil
+		case errors.Is(err, service.ErrInvalidUserSettings):
+			return gen.UpdateUserSettings400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidUserSettings, err)), nil
+		case errors.Is(err, service.ErrInvalidNotificationPreference):
+			return gen.UpdateUserSettings400ApplicationProblemPlusJSONResponse(errorResponseWithDetail(ctx, http.StatusBadRequest, gen.ErrorCodeInvalidNotificationPreference, err)), nil
+		case errors.Is(err, service.ErrProjectNotFound):
+			return gen.UpdateUserSettings400ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusBadRequest, gen.ErrorCodeDefaultProjectNotFound)), nil
+		}
+		log.Printf("Failed to update user settings (user_id=%d): %v", userID, err)
+		return gen.UpdateUserSettings500ApplicationProblemPlusJSONResponse(errorResponse(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)), nil
+	}
+
+	return gen.UpdateUserSettings200JSONResponse(mapper.UserSettingsToResponse(settings)), nil
+}
@@ -0,0 +1,83 @@
+package i18n
+
+import "go-todo/internal/gen"
+
+// 英語のエラーメッセージ
+var en = map[gen.ErrorCode]string{
+	gen.ErrorCodeBadRequest:         "Bad request",
+	gen.ErrorCodeUnauthorized:       "Unauthorized",
+	gen.ErrorCodeForbidden:          "Forbidden",
+	gen.ErrorCodeNotFound:           "Resource not found",
+	gen.ErrorCodeMethodNotAllowed:   "Method not allowed",
+	gen.ErrorCodeConflict:           "Conflict",
+	gen.ErrorCodeRequestTooLarge:    "Request is too large",
+	gen.ErrorCodeTooManyRequests:    "Too many requests",
+	gen.ErrorCodeInternalError:      "Internal server error",
+	gen.ErrorCodeServiceUnavailable: "Service unavailable",
+	gen.ErrorCodeValidationFailed:   "Request validation failed",
+	gen.ErrorCodeInvalidRequestBody: "Invalid request body",
+	gen.ErrorCodeInvalidId:          "Invalid ID",
+	gen.ErrorCodeInvalidIfMatch:     "Invalid If-Match header",
+	gen.ErrorCodeIfMatchRequired:    "If-Match header is required",
+	gen.ErrorCodeEmptyPatch:         "Patch must contain at least one field",
+
+	gen.ErrorCodeAuthenticationFailed: "Authentication failed",
+	gen.ErrorCodeUserNotFound:         "User not found",
+
+	gen.ErrorCodeIdempotencyKeyTooLong:  "Idempotency-Key is too long",
+	gen.ErrorCodeIdempotencyKeyReused:   "Idempotency-Key has already been used for a different request",
+	gen.ErrorCodeIdempotencyKeyInFlight: "A request with the same Idempotency-Key is in progress",
+
+	gen.ErrorCodeTodoNotFound:          "Todo not found",
+	gen.ErrorCodeTitleRequired:         "Title is required",
+	gen.ErrorCodeTitleEmpty:            "Title must not be empty",
+	gen.ErrorCodeTooManyIds:            "Too many IDs (max %d)",
+	gen.ErrorCodeTooManyItems:          "Too many items (max %d)",
+	gen.ErrorCodeAnchorNotFound:        "Anchor todo not found",
+	gen.ErrorCodeInvalidPositionAnchor: "Specify before_id and/or after_id of other todos, with after_id placed before before_id",
+
+	gen.ErrorCodeBlockerNotFound:    "Blocker not found",
+	gen.ErrorCodeDependencyNotFound: "Dependency not found",
+	gen.ErrorCodeSelfDependency:     "A todo cannot block itself",
+	gen.ErrorCodeDependencyCycle:    "Adding this blocker would create a cycle",
+	gen.ErrorCodeTodoBlocked:        "Todo has open blockers",
+
+	gen.ErrorCodeStatusNotFound:      "Status not found",
+	gen.ErrorCodeUnknownStatus:       "Unknown status",
+	gen.ErrorCodeInvalidStatus:       "Invalid status",
+	gen.ErrorCodeInvalidStatusSet:    "A done status and at least one open status are required",
+	gen.ErrorCodeStatusNameConflict:  "Status name already exists",
+	gen.ErrorCodeWipLimitExceeded:    "Status %q allows at most %d todos",
+	gen.ErrorCodeFirstStatusWipLimit: "The first open status cannot have a WIP limit",
+
+	gen.ErrorCodeInvalidSyncToken:  "Invalid sync token",
+	gen.ErrorCodeTooManyOperations: "Too many operations",
+
+	gen.ErrorCodeUnsupportedContentType: "Unsupported Content-Type",
+	gen.ErrorCodeInvalidImportFile:      "Invalid import file",
+	gen.ErrorCodeUnknownImportSource:    "Unknown import source",
+	gen.ErrorCodeInvalidExport:          "Invalid export file",
+	gen.ErrorCodeTooManyImportItems:     "Too many items to import",
+	gen.ErrorCodeInvalidFormat:          "Invalid format",
+	gen.ErrorCodeInvalidColumns:         "Invalid columns",
+
+	gen.ErrorCodeUnknownJobType: "Unknown job type",
+	gen.ErrorCodeInvalidFilter:  "Invalid filter",
+	gen.ErrorCodeJobNotFound:    "Job not found",
+	gen.ErrorCodeJobFinished:    "Job has already finished",
+
+	gen.ErrorCodeReminderNotFound:              "Reminder not found",
+	gen.ErrorCodeInvalidReminder:               "Invalid reminder",
+	gen.ErrorCodeChannelUnavailable:            "Channel is not available",
+	gen.ErrorCodeNotificationNotFound:          "Notification not found",
+	gen.ErrorCodeInvalidCursor:                 "Invalid cursor",
+	gen.ErrorCodeInvalidNotificationPreference: "Invalid notification preference",
+	gen.ErrorCodeCalendarFeedNotFound:          "Calendar feed not found",
+	gen.ErrorCodePersonalAccessTokenNotFound:   "Personal access token not found",
+	gen.ErrorCodeInvalidTokenName:              "Invalid token name",
+	gen.ErrorCodeInvalidDownloadLink:           "Invalid download link",
+	gen.ErrorCodeDownloadLinkExpired:           "Download link has expired or has already been used",
+	gen.ErrorCodeInvalidTimezone:               "Invalid timezone",
+	gen.ErrorCodeInvalidUserSettings:           "Invalid user settings",
+	gen.ErrorCodeDefaultProjectNotFound:        "Default project not found",
+}
@@ -0,0 +1,83 @@
+package i18n
+
+import "go-todo/internal/gen"
+
+// 日本語のエラーメッセージ
+var ja = map[gen.ErrorCode]string{
+	gen.ErrorCodeBadRequest:         "リクエストが不正です",
+	gen.ErrorCodeUnauthorized:       "ログインが必要です",
+	gen.ErrorCodeForbidden:          "アクセスが許可されていません",
+	gen.ErrorCodeNotFound:           "リソースが見つかりません",
+	gen.ErrorCodeMethodNotAllowed:   "許可されていないメソッドです",
+	gen.ErrorCodeConflict:           "リクエストが現在の状態と競合しています",
+	gen.ErrorCodeRequestTooLarge:    "リクエストが大きすぎます",
+	gen.ErrorCodeTooManyRequests:    "リクエストが多すぎます",
+	gen.ErrorCodeInternalError:      "サーバーでエラーが発生しました",
+	gen.ErrorCodeServiceUnavailable: "サービスを利用できません",
+	gen.ErrorCodeValidationFailed:   "リクエストの内容に誤りがあります",
+	gen.ErrorCodeInvalidRequestBody: "リクエストの本文が不正です",
+	gen.ErrorCodeInvalidId:          "IDが不正です",
+	gen.ErrorCodeInvalidIfMatch:     "If-Matchヘッダーが不正です",
+	gen.ErrorCodeIfMatchRequired:    "If-Matchヘッダーが必要です",
+	gen.ErrorCodeEmptyPatch:         "変更する項目を1つ以上指定してください",
+
+	gen.ErrorCodeAuthenticationFailed: "認証に失敗しました",
+	gen.ErrorCodeUserNotFound:         "ユーザーが見つかりません",
+
+	gen.ErrorCodeIdempotencyKeyTooLong:  "Idempotency-Keyが長すぎます",
+	gen.ErrorCodeIdempotencyKeyReused:   "このIdempotency-Keyは別のリクエストですでに使われています",
+	gen.ErrorCodeIdempotencyKeyInFlight: "同じIdempotency-Keyのリクエストを処理中です",
+
+	gen.ErrorCodeTodoNotFound:          "Todoが見つかりません",
+	gen.ErrorCodeTitleRequired:         "タイトルを入力してください",
+	gen.ErrorCodeTitleEmpty:            "タイトルを空にすることはできません",
+	gen.ErrorCodeTooManyIds:            "IDが多すぎます（最大%d件）",
+	gen.ErrorCodeTooManyItems:          "項目が多すぎます（最大%d件）",
+	gen.ErrorCodeAnchorNotFound:        "基準のTodoが見つかりません",
+	gen.ErrorCodeInvalidPositionAnchor: "before_id と after_id には他のTodoを指定し、after_id のTodoが before_id のTodoより前になるようにしてください",
+
+	gen.ErrorCodeBlockerNotFound:    "ブロックしているTodoが見つかりません",
+	gen.ErrorCodeDependencyNotFound: "依存関係が見つかりません",
+	gen.ErrorCodeSelfDependency:     "Todoは自分自身をブロックできません",
+	gen.ErrorCodeDependencyCycle:    "このTodoを追加すると依存関係が循環します",
+	gen.ErrorCodeTodoBlocked:        "未完了のブロックしているTodoがあります",
+
+	gen.ErrorCodeStatusNotFound:      "ステータスが見つかりません",
+	gen.ErrorCodeUnknownStatus:       "存在しないステータスです",
+	gen.ErrorCodeInvalidStatus:       "ステータスが不正です",
+	gen.ErrorCodeInvalidStatusSet:    "完了のステータスと、未完了のステータスが1つ以上必要です",
+	gen.ErrorCodeStatusNameConflict:  "同じ名前のステータスがすでにあります",
+	gen.ErrorCodeWipLimitExceeded:    "ステータス%qに入れられるTodoは%d件までです",
+	gen.ErrorCodeFirstStatusWipLimit: "先頭の未完了ステータスにはWIP制限を設定できません",
+
+	gen.ErrorCodeInvalidSyncToken:  "同期トークンが不正です",
+	gen.ErrorCodeTooManyOperations: "操作が多すぎます",
+
+	gen.ErrorCodeUnsupportedContentType: "対応していないContent-Typeです",
+	gen.ErrorCodeInvalidImportFile:      "インポートするファイルが不正です",
+	gen.ErrorCodeUnknownImportSource:    "対応していないインポート元です",
+	gen.ErrorCodeInvalidExport:          "エクスポートファイルが不正です",
+	gen.ErrorCodeTooManyImportItems:     "インポートする項目が多すぎます",
+	gen.ErrorCodeInvalidFormat:          "形式が不正です",
+	gen.ErrorCodeInvalidColumns:         "列の指定が不正です",
+
+	gen.ErrorCodeUnknownJobType: "対応していないジョブの種類です",
+	gen.ErrorCodeInvalidFilter:  "絞り込みの条件が不正です",
+	gen.ErrorCodeJobNotFound:    "ジョブが見つかりません",
+	gen.ErrorCodeJobFinished:    "ジョブはすでに終了しています",
+
+	gen.ErrorCodeReminderNotFound:              "リマインダーが見つかりません",
+	gen.ErrorCodeInvalidReminder:               "リマインダーの指定が不正です",
+	gen.ErrorCodeChannelUnavailable:            "この通知チャネルは利用できません",
+	gen.ErrorCodeNotificationNotFound:          "通知が見つかりません",
+	gen.ErrorCodeInvalidCursor:                 "カーソルが不正です",
+	gen.ErrorCodeInvalidNotificationPreference: "通知の受け取り設定が不正です",
+	gen.ErrorCodeCalendarFeedNotFound:          "カレンダーフィードが見つかりません",
+	gen.ErrorCodePersonalAccessTokenNotFound:   "パーソナルアクセストークンが見つかりません",
+	gen.ErrorCodeInvalidTokenName:              "トークンの名前が不正です",
+	gen.ErrorCodeInvalidDownloadLink:           "ダウンロードリンクが不正です",
+	gen.ErrorCodeDownloadLinkExpired:           "ダウンロードリンクの有効期限が切れているか、すでに使用されています",
+	gen.ErrorCodeInvalidTimezone:               "タイムゾーンが不正です",
+	gen.ErrorCodeInvalidUserSettings:           "ユーザー設定が不正です",
+	gen.ErrorCodeDefaultProjectNotFound:        "既定のプロジェクトが見つかりません",
+}
@@ -0,0 +1,81 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+
+	"go-todo/internal/gen"
+
+	"golang.org/x/text/language"
+)
+
+// 対応する言語。先頭が既定の言語
+var supported = []language.Tag{language.English, language.Japanese}
+
+var matcher = language.NewMatcher(supported)
+
+// 言語ごとのエラーメッセージ。書式は fmt.Sprintf に渡す
+var catalogs = map[language.Tag]map[gen.ErrorCode]string{
+	language.English:  en,
+	language.Japanese: ja,
+}
+
+type preferenceKey struct{}
+
+type preference struct {
+	acceptLanguage string
+	userLocale     func(ctx context.Context) string
+}
+
+// リクエストの言語の決め方をコンテキストに設定する
+// Accept-Language がなければ userLocale（ユーザー設定のロケール）を使う
+// userLocale はメッセージを作るときに、その時点のコンテキスト（認証済みならユーザーIDを含む）で呼ばれる
+// エラーにならないリクエストでは設定を読み込まない
+func WithPreference(ctx context.Context, acceptLanguage string, userLocale func(ctx context.Context) string) context.Context {
+	return context.WithValue(ctx, preferenceKey{}, preference{acceptLanguage: acceptLanguage, userLocale: userLocale})
+}
+
+// コンテキストの言語を返す。決められなければ英語
+func Language(ctx context.Context) language.Tag {
+	p, ok := ctx.Value(preferenceKey{}).(preference)
+	if !ok {
+		return supported[0]
+	}
+	if p.acceptLanguage != "" {
+		return Match(p.acceptLanguage)
+	}
+	if p.userLocale != nil {
+		return Match(p.userLocale(ctx))
+	}
+	return supported[0]
+}
+
+// Accept-Language の値またはBCP 47の言語タグから、対応する言語のうち最も近いものを選ぶ
+func Match(s string) language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(s)
+	if err != nil || len(tags) == 0 {
+		return supported[0]
+	}
+	_, index, _ := matcher.Match(tags...)
+	return supported[index]
+}
+
+// エラーコードのメッセージをコンテキストの言語で返す
+func Message(ctx context.Context, code gen.ErrorCode, args ...any) string {
+	return Translate(Language(ctx), code, args...)
+}
+
+// エラーコードのメッセージを指定した言語で返す。翻訳がなければ英語、英語もなければコードを返す
+func Translate(tag language.Tag, code gen.ErrorCode, args ...any) string {
+	format, ok := catalogs[tag][code]
+	if !ok {
+		format, ok = catalogs[supported[0]][code]
+	}
+	if !ok {
+		return string(code)
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
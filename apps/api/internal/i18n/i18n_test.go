@@ -0,0 +1,117 @@
+package i18n
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"go-todo/internal/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+// 書式の動詞（%d、%q など）
+var verbPattern = regexp.MustCompile(`%[a-z]`)
+
+func TestCatalogs(t *testing.T) {
+	swagger, err := gen.GetSwagger()
+	require.NoError(t, err)
+	schema := swagger.Components.Schemas["ErrorCode"]
+	require.NotNil(t, schema)
+	require.NotEmpty(t, schema.Value.Enum)
+
+	codes := make(map[gen.ErrorCode]bool, len(schema.Value.Enum))
+	for _, v := range schema.Value.Enum {
+		codes[gen.ErrorCode(v.(string))] = true
+	}
+
+	t.Run("正常系: すべてのコードがすべての言語に翻訳されている", func(t *testing.T) {
+		for _, tag := range supported {
+			catalog := catalogs[tag]
+			for code := range codes {
+				assert.NotEmpty(t, catalog[code], "%s: %s", tag, code)
+			}
+		}
+	})
+
+	t.Run("正常系: 仕様にないコードの翻訳がない", func(t *testing.T) {
+		for _, tag := range supported {
+			for code := range catalogs[tag] {
+				assert.True(t, codes[code], "%s: %s", tag, code)
+			}
+		}
+	})
+
+	t.Run("正常系: 翻訳の書式の引数が英語と一致する", func(t *testing.T) {
+		for _, tag := range supported {
+			for code, format := range catalogs[tag] {
+				assert.Equal(t, verbPattern.FindAllString(en[code], -1), verbPattern.FindAllString(format, -1), "%s: %s", tag, code)
+			}
+		}
+	})
+}
+
+func TestLanguage(t *testing.T) {
+	locale := func(s string) func(context.Context) string {
+		return func(context.Context) string { return s }
+	}
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		userLocale     func(context.Context) string
+		want           language.Tag
+	}{
+		{name: "Accept-Language", acceptLanguage: "ja-JP,ja;q=0.9,en;q=0.8", want: language.Japanese},
+		{name: "品質値の高い言語", acceptLanguage: "fr;q=0.5,ja;q=0.3,en;q=0.9", want: language.English},
+		{name: "Accept-Languageはユーザー設定より優先する", acceptLanguage: "en-US", userLocale: locale("ja"), want: language.English},
+		{name: "Accept-Languageがなければユーザー設定", userLocale: locale("ja-JP"), want: language.Japanese},
+		{name: "対応していない言語は英語", acceptLanguage: "fr-FR", want: language.English},
+		{name: "不正な値は英語", acceptLanguage: ";;;", want: language.English},
+		{name: "指定がなければ英語", want: language.English},
+	}
+	for _, tt := range tests {
+		t.Run("正常系: "+tt.name, func(t *testing.T) {
+			ctx := WithPreference(context.Background(), tt.acceptLanguage, tt.userLocale)
+
+			assert.Equal(t, tt.want, Language(ctx))
+		})
+	}
+
+	t.Run("正常系: ユーザー設定はメッセージを作るときのコンテキストで読み込む", func(t *testing.T) {
+		type userKey struct{}
+		ctx := WithPreference(context.Background(), "", func(ctx context.Context) string {
+			if ctx.Value(userKey{}) == nil {
+				return ""
+			}
+			return "ja"
+		})
+
+		assert.Equal(t, language.English, Language(ctx))
+		assert.Equal(t, language.Japanese, Language(context.WithValue(ctx, userKey{}, int64(1))))
+	})
+
+	t.Run("正常系: 設定されていなければ英語", func(t *testing.T) {
+		assert.Equal(t, language.English, Language(context.Background()))
+	})
+}
+
+func TestMessage(t *testing.T) {
+	jaCtx := WithPreference(context.Background(), "ja", nil)
+
+	t.Run("正常系: コンテキストの言語で返す", func(t *testing.T) {
+		assert.Equal(t, "Todoが見つかりません", Message(jaCtx, gen.ErrorCodeTodoNotFound))
+		assert.Equal(t, "Todo not found", Message(context.Background(), gen.ErrorCodeTodoNotFound))
+	})
+
+	t.Run("正常系: 引数を埋め込む", func(t *testing.T) {
+		assert.Equal(t, "IDが多すぎます（最大100件）", Message(jaCtx, gen.ErrorCodeTooManyIds, 100))
+		assert.Equal(t, `Status "Doing" allows at most 3 todos`, Message(context.Background(), gen.ErrorCodeWipLimitExceeded, "Doing", 3))
+	})
+
+	t.Run("異常系: 未知のコードはコードをそのまま返す", func(t *testing.T) {
+		assert.Equal(t, "no_such_code", Message(jaCtx, gen.ErrorCode("no_such_code")))
+	})
+}
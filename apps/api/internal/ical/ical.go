@@ -0,0 +1,197 @@
+// Package ical は Todo を iCalendar (RFC 5545) 形式で読み書きする
+//
+// フィードではすべてのTodoを VTODO として、期限のあるTodoはカレンダーに表示するための VEVENT としても出力する。
+// CalDAV のリソースは VTODO を1件だけ含むカレンダーとして読み書きする。
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 1行の最大長（改行を除くオクテット数）
+const maxLineOctets = 75
+
+// 書き出す1件分のTodo
+type Item struct {
+	// 同じTodoに対して常に同じ値を使う（カレンダーアプリが更新を識別するため）
+	UID          string
+	Summary      string
+	Description  string
+	Created      time.Time
+	LastModified time.Time
+	Due          *time.Time
+	Completed    bool
+	// Completed が true の場合に使う
+	CompletedAt time.Time
+	// 更新のたびに増える版数
+	Sequence int32
+}
+
+type Calendar struct {
+	ProdID string
+	Name   string
+	Items  []Item
+}
+
+// 期限の VEVENT の UID（VTODO の UID から導出する）
+func EventUID(uid string) string {
+	return uid + "-due"
+}
+
+// カレンダーを書き出す
+func Write(w io.Writer, cal Calendar) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", cal.ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		e.text("X-WR-CALNAME", cal.Name)
+	}
+	for _, item := range cal.Items {
+		writeTodo(e, item)
+	}
+	for _, item := range cal.Items {
+		if item.Due != nil {
+			writeEvent(e, item)
+		}
+	}
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// CalDAV のカレンダーオブジェクトリソースを書き出す
+// リソースには METHOD を含めず、1件の VTODO だけを含める（RFC 4791 4.1）
+func WriteObject(w io.Writer, prodID string, item Item) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", prodID)
+	writeTodo(e, item)
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+func writeTodo(e *encoder, item Item) {
+	e.line("BEGIN", "VTODO")
+	e.text("UID", item.UID)
+	// 配信用のフィードでは DTSTAMP に最終更新日時を使う（RFC 5545 3.8.7.2）
+	e.time("DTSTAMP", item.LastModified)
+	e.time("CREATED", item.Created)
+	e.time("LAST-MODIFIED", item.LastModified)
+	e.line("SEQUENCE", strconv.Itoa(int(item.Sequence)))
+	e.text("SUMMARY", item.Summary)
+	if item.Description != "" {
+		e.text("DESCRIPTION", item.Description)
+	}
+	if item.Due != nil {
+		e.time("DUE", *item.Due)
+	}
+	if item.Completed {
+		e.line("STATUS", "COMPLETED")
+		e.time("COMPLETED", item.CompletedAt)
+		e.line("PERCENT-COMPLETE", "100")
+	} else {
+		e.line("STATUS", "NEEDS-ACTION")
+	}
+	e.line("END", "VTODO")
+}
+
+func writeEvent(e *encoder, item Item) {
+	e.line("BEGIN", "VEVENT")
+	e.text("UID", EventUID(item.UID))
+	e.time("DTSTAMP", item.LastModified)
+	e.time("LAST-MODIFIED", item.LastModified)
+	e.line("SEQUENCE", strconv.Itoa(int(item.Sequence)))
+	// DTEND を省略すると開始時刻と同じ時刻に終わる予定になる
+	e.time("DTSTART", *item.Due)
+	e.text("SUMMARY", item.Summary)
+	if item.Description != "" {
+		e.text("DESCRIPTION", item.Description)
+	}
+	// 予定としては空き時間を占有しない
+	e.line("TRANSP", "TRANSPARENT")
+	e.text("RELATED-TO", item.UID)
+	e.line("END", "VEVENT")
+}
+
+// コンテンツ行を折り返して書き出す。最初のエラー以降は何もしない
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.WriteString(fold(name + ":" + value))
+}
+
+func (e *encoder) text(name, value string) {
+	e.line(name, escapeText(value))
+}
+
+func (e *encoder) time(name string, t time.Time) {
+	e.line(name, t.UTC().Format("20060102T150405Z"))
+}
+
+// TEXT 型の値をエスケープする（RFC 5545 3.3.11）
+func escapeText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			b.WriteString(`\\`)
+		case ';':
+			b.WriteString(`\;`)
+		case ',':
+			b.WriteString(`\,`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			// CRLF は LF として扱い、単独の CR は捨てる
+		default:
+			// 値に含められない制御文字は捨てる（HTAB は許可される）
+			if c < 0x20 && c != '\t' || c == 0x7f {
+				continue
+			}
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// 1行を75オクテットごとに折り返し、CRLFで終わる文字列にする（RFC 5545 3.1）
+// UTF-8の文字の途中では折り返さない
+func fold(line string) string {
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// 継続行の先頭の空白も1オクテットとして数える
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
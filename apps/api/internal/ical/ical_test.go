@@ -0,0 +1,185 @@
+package ical
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// 出力をゴールデンファイルと比較する（-update で書き換える）
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got))
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
+func TestWrite(t *testing.T) {
+	created := time.Date(2026, 10, 20, 9, 30, 0, 0, time.UTC)
+	modified := time.Date(2026, 10, 21, 18, 5, 10, 0, time.UTC)
+	jst := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name   string
+		golden string
+		cal    Calendar
+	}{
+		{
+			name:   "正常系: 空のカレンダー",
+			golden: "empty.ics",
+			cal:    Calendar{ProdID: "-//go-todo//Todo API//EN", Name: "Todos"},
+		},
+		{
+			name:   "正常系: 未完了・完了済み・期限付きのTodo",
+			golden: "todos.ics",
+			cal: Calendar{
+				ProdID: "-//go-todo//Todo API//EN",
+				Name:   "Todos",
+				Items: []Item{
+					{
+						UID:          "0b7c6f5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f",
+						Summary:      "Buy milk",
+						Created:      created,
+						LastModified: created,
+					},
+					{
+						UID:          "5f0e8d7c-6b5a-4938-8271-6a5b4c3d2e1f",
+						Summary:      "Write report",
+						Description:  "Q3 numbers",
+						Created:      created,
+						LastModified: modified,
+						Completed:    true,
+						CompletedAt:  modified,
+						Sequence:     2,
+					},
+					{
+						UID:          "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+						Summary:      "Dentist",
+						Created:      created,
+						LastModified: modified,
+						// UTCに変換して出力する
+						Due:      ptrTime(time.Date(2026, 10, 30, 10, 0, 0, 0, jst)),
+						Sequence: 1,
+					},
+				},
+			},
+		},
+		{
+			name:   "正常系: エスケープと折り返し",
+			golden: "escape_fold.ics",
+			cal: Calendar{
+				ProdID: "-//go-todo//Todo API//EN",
+				Name:   "Alice's todos; work, home",
+				Items: []Item{
+					{
+						UID:          "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f",
+						Summary:      `Plan trip: Tokyo, Kyoto; budget \ notes`,
+						Description:  "Line 1\r\nLine 2\nこの説明は長いので七十五オクテットを超えたところで文字の途中ではなく文字の境目で折り返される必要があります",
+						Created:      created,
+						LastModified: created,
+						Due:          ptrTime(time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := Write(&buf, tt.cal)
+
+			require.NoError(t, err)
+			assertGolden(t, tt.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "正常系: 特殊文字", in: `a\b;c,d`, want: `a\\b\;c\,d`},
+		{name: "正常系: 改行", in: "a\r\nb\nc", want: `a\nb\nc`},
+		{name: "正常系: コロンはエスケープしない", in: "10:00", want: "10:00"},
+		{name: "正常系: 制御文字を除く", in: "a\x00b\tc\x7f", want: "ab\tc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, escapeText(tt.in))
+		})
+	}
+}
+
+func TestFold(t *testing.T) {
+	t.Run("正常系: 75オクテット以下は折り返さない", func(t *testing.T) {
+		line := strings.Repeat("a", 75)
+
+		assert.Equal(t, line+"\r\n", fold(line))
+	})
+
+	t.Run("正常系: 継続行を含めてすべての行が75オクテット以下になる", func(t *testing.T) {
+		for _, line := range []string{
+			strings.Repeat("a", 200),
+			"SUMMARY:" + strings.Repeat("あ", 60),
+			"SUMMARY:" + strings.Repeat("é😀", 30),
+		} {
+			folded := fold(line)
+
+			require.True(t, strings.HasSuffix(folded, "\r\n"))
+			physical := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+			var unfolded strings.Builder
+			for i, l := range physical {
+				assert.LessOrEqual(t, len(l), 75)
+				if i > 0 {
+					require.True(t, strings.HasPrefix(l, " "))
+					l = l[1:]
+				}
+				assert.True(t, len(l) == 0 || utf8ValidStart(l), "line %d splits a character", i)
+				unfolded.WriteString(l)
+			}
+			assert.Equal(t, line, unfolded.String())
+		}
+	})
+}
+
+func utf8ValidStart(s string) bool {
+	return strings.ToValidUTF8(s, "�") == s
+}
+
+func TestWriteObject(t *testing.T) {
+	t.Run("正常系: METHODとVEVENTを含まない1件のVTODO", func(t *testing.T) {
+		created := time.Date(2026, 10, 20, 9, 30, 0, 0, time.UTC)
+		var buf bytes.Buffer
+
+		err := WriteObject(&buf, "-//go-todo//Todo API//EN", Item{
+			UID:          "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+			Summary:      "Dentist",
+			Created:      created,
+			LastModified: created,
+			Due:          ptrTime(time.Date(2026, 10, 30, 1, 0, 0, 0, time.UTC)),
+		})
+
+		require.NoError(t, err)
+		assertGolden(t, "object.ics", buf.Bytes())
+	})
+}
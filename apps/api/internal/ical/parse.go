@@ -0,0 +1,241 @@
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformed            = errors.New("malformed iCalendar data")
+	ErrUnsupportedComponent = errors.New("unsupported calendar component")
+)
+
+// 1件のコンテンツ行（折り返しを戻した後）
+type contentLine struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// CalDAV のリソースから VTODO を1件読み込む
+// VTIMEZONE と VTODO 内の VALARM などは読み飛ばす。
+// VEVENT などほかのコンポーネントを含む場合や、VTODO がちょうど1件でない場合は ErrUnsupportedComponent を返す
+// DATE 型の値とタイムゾーンのない時刻（floating）は loc（ユーザーのタイムゾーン）の時刻として読む
+func ParseTodo(r io.Reader, loc *time.Location) (Item, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Item{}, err
+	}
+	lines, err := unfold(string(data))
+	if err != nil {
+		return Item{}, err
+	}
+
+	var item Item
+	var stack []string
+	todos := 0
+	ended := false
+	for _, line := range lines {
+		if ended {
+			return Item{}, fmt.Errorf("%w: content after END:VCALENDAR", ErrMalformed)
+		}
+		switch line.name {
+		case "BEGIN":
+			component := strings.ToUpper(line.value)
+			switch {
+			case len(stack) == 0 && component != "VCALENDAR":
+				return Item{}, fmt.Errorf("%w: expected BEGIN:VCALENDAR", ErrMalformed)
+			case len(stack) == 1 && component == "VTODO":
+				todos++
+			case len(stack) == 1 && component != "VTIMEZONE":
+				return Item{}, fmt.Errorf("%w: %s", ErrUnsupportedComponent, component)
+			}
+			stack = append(stack, component)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(line.value) {
+				return Item{}, fmt.Errorf("%w: unexpected END:%s", ErrMalformed, line.value)
+			}
+			stack = stack[:len(stack)-1]
+			ended = len(stack) == 0
+		default:
+			if len(stack) == 0 {
+				return Item{}, fmt.Errorf("%w: expected BEGIN:VCALENDAR", ErrMalformed)
+			}
+			// VTODO 直下のプロパティだけを読む
+			if len(stack) == 2 && stack[1] == "VTODO" && todos == 1 {
+				if err := applyProperty(&item, line, loc); err != nil {
+					return Item{}, err
+				}
+			}
+		}
+	}
+	if !ended {
+		return Item{}, fmt.Errorf("%w: missing END:VCALENDAR", ErrMalformed)
+	}
+	if todos != 1 {
+		return Item{}, fmt.Errorf("%w: exactly one VTODO is required", ErrUnsupportedComponent)
+	}
+	if item.UID == "" {
+		return Item{}, fmt.Errorf("%w: VTODO has no UID", ErrMalformed)
+	}
+	return item, nil
+}
+
+func applyProperty(item *Item, line contentLine, loc *time.Location) error {
+	var err error
+	switch line.name {
+	case "UID":
+		item.UID = unescapeText(line.value)
+	case "SUMMARY":
+		item.Summary = unescapeText(line.value)
+	case "DESCRIPTION":
+		item.Description = unescapeText(line.value)
+	case "STATUS":
+		if strings.EqualFold(line.value, "COMPLETED") {
+			item.Completed = true
+		}
+	case "COMPLETED":
+		item.Completed = true
+		item.CompletedAt, err = parseDateTime(line, loc)
+	case "DUE":
+		var due time.Time
+		if due, err = parseDateTime(line, loc); err == nil {
+			item.Due = &due
+		}
+	case "CREATED":
+		item.Created, err = parseDateTime(line, loc)
+	case "LAST-MODIFIED":
+		item.LastModified, err = parseDateTime(line, loc)
+	case "SEQUENCE":
+		var seq int64
+		if seq, err = strconv.ParseInt(line.value, 10, 32); err != nil {
+			return fmt.Errorf("%w: invalid SEQUENCE %q", ErrMalformed, line.value)
+		}
+		item.Sequence = int32(seq)
+	}
+	return err
+}
+
+// DATE-TIME / DATE 型の値を読む（RFC 5545 3.3.4, 3.3.5）
+// DATE 型の値、タイムゾーンのない時刻（floating）、TZID が未知のタイムゾーンの時刻は loc の時刻として扱う
+func parseDateTime(line contentLine, loc *time.Location) (time.Time, error) {
+	value := line.value
+	if tzid := line.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			loc = l
+		}
+	}
+	var t time.Time
+	var err error
+	switch {
+	case strings.EqualFold(line.params["VALUE"], "DATE") || len(value) == len("20060102"):
+		t, err = time.ParseInLocation("20060102", value, loc)
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse("20060102T150405Z", value)
+	default:
+		t, err = time.ParseInLocation("20060102T150405", value, loc)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid %s %q", ErrMalformed, line.name, value)
+	}
+	return t, nil
+}
+
+// 折り返された行を戻し、コンテンツ行に分解する（RFC 5545 3.1）
+// CRLF のほかに LF だけの改行も受け付ける
+func unfold(data string) ([]contentLine, error) {
+	var raw []string
+	for _, l := range strings.Split(data, "\n") {
+		l = strings.TrimSuffix(l, "\r")
+		if l != "" && (l[0] == ' ' || l[0] == '\t') {
+			if len(raw) == 0 {
+				return nil, fmt.Errorf("%w: continuation line without content line", ErrMalformed)
+			}
+			raw[len(raw)-1] += l[1:]
+			continue
+		}
+		if l != "" {
+			raw = append(raw, l)
+		}
+	}
+
+	lines := make([]contentLine, 0, len(raw))
+	for _, l := range raw {
+		line, err := parseContentLine(l)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// name *(";" param) ":" value の形式の1行を分解する
+// 引用符で囲まれたパラメーター値には ";" と ":" を含められる
+func parseContentLine(l string) (contentLine, error) {
+	end := strings.IndexAny(l, ";:")
+	if end <= 0 {
+		return contentLine{}, fmt.Errorf("%w: invalid content line %q", ErrMalformed, l)
+	}
+	line := contentLine{name: strings.ToUpper(l[:end]), params: map[string]string{}}
+	rest := l[end:]
+	for rest[0] == ';' {
+		eq := strings.IndexByte(rest, '=')
+		if eq < 0 {
+			return contentLine{}, fmt.Errorf("%w: invalid parameter in %q", ErrMalformed, l)
+		}
+		name := strings.ToUpper(rest[1:eq])
+		rest = rest[eq+1:]
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			closing := strings.IndexByte(rest[1:], '"')
+			if closing < 0 {
+				return contentLine{}, fmt.Errorf("%w: unterminated quoted parameter in %q", ErrMalformed, l)
+			}
+			value = rest[1 : closing+1]
+			rest = rest[closing+2:]
+		} else {
+			sep := strings.IndexAny(rest, ";:")
+			if sep < 0 {
+				return contentLine{}, fmt.Errorf("%w: missing value in %q", ErrMalformed, l)
+			}
+			value = rest[:sep]
+			rest = rest[sep:]
+		}
+		line.params[name] = value
+		if rest == "" {
+			return contentLine{}, fmt.Errorf("%w: missing value in %q", ErrMalformed, l)
+		}
+	}
+	if rest[0] != ':' {
+		return contentLine{}, fmt.Errorf("%w: invalid content line %q", ErrMalformed, l)
+	}
+	line.value = rest[1:]
+	return line, nil
+}
+
+// TEXT 型の値のエスケープを戻す（RFC 5545 3.3.11）
+// 書き出し時と同じく、値に含められない制御文字は捨てる
+func unescapeText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) {
+			i++
+			if c = s[i]; c == 'n' || c == 'N' {
+				b.WriteByte('\n')
+				continue
+			}
+		}
+		if c < 0x20 && c != '\t' || c == 0x7f {
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,224 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// LF の改行で書いたテスト用のデータを CRLF にする
+func crlf(s string) string {
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
+
+func TestParseTodo(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		data string
+		// ユーザーのタイムゾーン。nil なら UTC
+		loc     *time.Location
+		want    Item
+		wantErr error
+	}{
+		{
+			name: "正常系: 基本的なVTODO",
+			data: crlf(`BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Client//EN
+BEGIN:VTODO
+UID:0b7c6f5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f
+DTSTAMP:20261020T093000Z
+SUMMARY:Buy milk
+SEQUENCE:3
+END:VTODO
+END:VCALENDAR
+`),
+			want: Item{UID: "0b7c6f5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f", Summary: "Buy milk", Sequence: 3},
+		},
+		{
+			name: "正常系: 完了済みでTZID付きの期限とVALARM・VTIMEZONEを含む",
+			data: crlf(`BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VTIMEZONE
+TZID:Asia/Tokyo
+BEGIN:STANDARD
+DTSTART:19700101T000000
+TZOFFSETFROM:+0900
+TZOFFSETTO:+0900
+END:STANDARD
+END:VTIMEZONE
+BEGIN:VTODO
+UID:abc
+SUMMARY:Report
+DESCRIPTION:Q3 numbers\, draft\nsecond line
+DUE;TZID=Asia/Tokyo:20261030T100000
+STATUS:COMPLETED
+COMPLETED:20261021T180510Z
+BEGIN:VALARM
+ACTION:DISPLAY
+DESCRIPTION:ignored
+TRIGGER:-PT15M
+END:VALARM
+END:VTODO
+END:VCALENDAR
+`),
+			want: Item{
+				UID:         "abc",
+				Summary:     "Report",
+				Description: "Q3 numbers, draft\nsecond line",
+				Due:         ptrTime(time.Date(2026, 10, 30, 10, 0, 0, 0, tokyo)),
+				Completed:   true,
+				CompletedAt: time.Date(2026, 10, 21, 18, 5, 10, 0, time.UTC),
+			},
+		},
+		{
+			name: "正常系: 折り返し・LFの改行・日付だけの期限",
+			data: "BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:abc\nSUMMARY:折り返さ\n れた件名\nDUE;VALUE=DATE:20261103\nEND:VTODO\nEND:VCALENDAR\n",
+			want: Item{UID: "abc", Summary: "折り返された件名", Due: ptrTime(time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC))},
+		},
+		{
+			name: "正常系: 日付だけの期限はユーザーのタイムゾーンの0時",
+			data: crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:abc\nDUE;VALUE=DATE:20261103\nEND:VTODO\nEND:VCALENDAR\n"),
+			loc:  newYork,
+			want: Item{UID: "abc", Due: ptrTime(time.Date(2026, 11, 3, 0, 0, 0, 0, newYork))},
+		},
+		{
+			name: "正常系: タイムゾーンのない時刻と未知のTZIDはユーザーのタイムゾーンで読む",
+			data: crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:abc\nDUE:20261103T090000\nCREATED;TZID=Nowhere/City:20261020T120000\nEND:VTODO\nEND:VCALENDAR\n"),
+			loc:  newYork,
+			want: Item{
+				UID:     "abc",
+				Due:     ptrTime(time.Date(2026, 11, 3, 9, 0, 0, 0, newYork)),
+				Created: time.Date(2026, 10, 20, 12, 0, 0, 0, newYork),
+			},
+		},
+		{
+			name: "正常系: UTCとTZID付きの時刻はユーザーのタイムゾーンに影響されない",
+			data: crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:abc\nDUE;TZID=Asia/Tokyo:20261103T090000\nCOMPLETED:20261021T180510Z\nEND:VTODO\nEND:VCALENDAR\n"),
+			loc:  newYork,
+			want: Item{
+				UID:         "abc",
+				Due:         ptrTime(time.Date(2026, 11, 3, 9, 0, 0, 0, tokyo)),
+				Completed:   true,
+				CompletedAt: time.Date(2026, 10, 21, 18, 5, 10, 0, time.UTC),
+			},
+		},
+		{
+			name: "正常系: 引用符付きのパラメーター値",
+			data: crlf(`BEGIN:VCALENDAR
+BEGIN:VTODO
+UID:abc
+SUMMARY;X-NOTE="a;b:c":Title
+END:VTODO
+END:VCALENDAR
+`),
+			want: Item{UID: "abc", Summary: "Title"},
+		},
+		{
+			name:    "異常系: VEVENTを含む",
+			data:    crlf("BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:abc\nEND:VEVENT\nEND:VCALENDAR\n"),
+			wantErr: ErrUnsupportedComponent,
+		},
+		{
+			name:    "異常系: VTODOが2件",
+			data:    crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:a\nEND:VTODO\nBEGIN:VTODO\nUID:b\nEND:VTODO\nEND:VCALENDAR\n"),
+			wantErr: ErrUnsupportedComponent,
+		},
+		{
+			name:    "異常系: VTODOがない",
+			data:    crlf("BEGIN:VCALENDAR\nVERSION:2.0\nEND:VCALENDAR\n"),
+			wantErr: ErrUnsupportedComponent,
+		},
+		{
+			name:    "異常系: UIDがない",
+			data:    crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nSUMMARY:x\nEND:VTODO\nEND:VCALENDAR\n"),
+			wantErr: ErrMalformed,
+		},
+		{
+			name:    "異常系: ENDが対応しない",
+			data:    crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:a\nEND:VCALENDAR\n"),
+			wantErr: ErrMalformed,
+		},
+		{
+			name:    "異常系: END:VCALENDARがない",
+			data:    crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:a\nEND:VTODO\n"),
+			wantErr: ErrMalformed,
+		},
+		{
+			name:    "異常系: 不正な日時",
+			data:    crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:a\nDUE:tomorrow\nEND:VTODO\nEND:VCALENDAR\n"),
+			wantErr: ErrMalformed,
+		},
+		{
+			name:    "異常系: コロンのない行",
+			data:    crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID\nEND:VTODO\nEND:VCALENDAR\n"),
+			wantErr: ErrMalformed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := tt.loc
+			if loc == nil {
+				loc = time.UTC
+			}
+			got, err := ParseTodo(strings.NewReader(tt.data), loc)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want.Due != nil {
+				require.NotNil(t, got.Due)
+				assert.True(t, tt.want.Due.Equal(*got.Due), "due: want %v, got %v", tt.want.Due, got.Due)
+				tt.want.Due, got.Due = nil, nil
+			}
+			assert.True(t, tt.want.Created.Equal(got.Created), "created: want %v, got %v", tt.want.Created, got.Created)
+			tt.want.Created, got.Created = time.Time{}, time.Time{}
+			assert.True(t, tt.want.CompletedAt.Equal(got.CompletedAt), "completed: want %v, got %v", tt.want.CompletedAt, got.CompletedAt)
+			tt.want.CompletedAt, got.CompletedAt = time.Time{}, time.Time{}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnescapeText(t *testing.T) {
+	t.Run("正常系: escapeTextの逆変換", func(t *testing.T) {
+		for _, s := range []string{`a\b;c,d`, "a\nb", "10:00", "日本語"} {
+			assert.Equal(t, s, unescapeText(escapeText(s)))
+		}
+	})
+
+	t.Run("正常系: 大文字のNも改行として扱う", func(t *testing.T) {
+		assert.Equal(t, "a\nb", unescapeText(`a\Nb`))
+	})
+}
+
+// 読み込めたデータは、書き出して読み直しても同じ内容になる
+func FuzzParseTodo(f *testing.F) {
+	f.Add(crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:abc\nSUMMARY:Buy milk\nEND:VTODO\nEND:VCALENDAR\n"))
+	f.Add(crlf("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:abc\nDESCRIPTION:a\\,b\\nc\nDUE;TZID=Asia/Tokyo:20261030T100000\nSTATUS:COMPLETED\nEND:VTODO\nEND:VCALENDAR\n"))
+	f.Add("BEGIN:VCALENDAR\nBEGIN:VTODO\nUID:a\nSUMMARY;X=\"q;:\":v\n w\nEND:VTODO\nEND:VCALENDAR")
+	f.Fuzz(func(t *testing.T, data string) {
+		item, err := ParseTodo(strings.NewReader(data), time.UTC)
+		if err != nil {
+			return
+		}
+		var first bytes.Buffer
+		require.NoError(t, WriteObject(&first, "-//fuzz//EN", item))
+		reparsed, err := ParseTodo(bytes.NewReader(first.Bytes()), time.UTC)
+		require.NoError(t, err, "output:\n%s", first.String())
+		var second bytes.Buffer
+		require.NoError(t, WriteObject(&second, "-//fuzz//EN", reparsed))
+		assert.Equal(t, first.String(), second.String())
+	})
+}
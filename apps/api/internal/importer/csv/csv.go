@@ -0,0 +1,210 @@
+package csv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"go-todo/internal/importer"
+)
+
+// 見出しの行が読めないか、件名の列がない
+var ErrInvalidHeader = errors.New("invalid CSV header")
+
+// Todoのフィールドと、それを読むCSVの列名の対応
+// 空のフィールドは既定の列名（title, description, completed, due_at）を使う。列名の大文字と小文字は区別しない
+type Mapping struct {
+	Title       string
+	Description string
+	Completed   string
+	DueAt       string
+}
+
+func (m Mapping) withDefaults() Mapping {
+	if m.Title == "" {
+		m.Title = "title"
+	}
+	if m.Description == "" {
+		m.Description = "description"
+	}
+	if m.Completed == "" {
+		m.Completed = "completed"
+	}
+	if m.DueAt == "" {
+		m.DueAt = "due_at"
+	}
+	return m
+}
+
+// 1行目を見出しとするCSV（RFC 4180）を読み込む
+// 対応付けていない列の値はメタデータに列名をキーとして残す
+type Reader struct {
+	r       *csv.Reader
+	mapping Mapping
+	header  []string
+	// 各列が対応するフィールド（対応しない列は空）
+	fields []string
+	// 日付だけの期限を解釈するタイムゾーン
+	loc *time.Location
+}
+
+// 日付だけの期限（YYYY-MM-DD）は loc の0時として読む
+func NewReader(r io.Reader, mapping Mapping, loc *time.Location) *Reader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	return &Reader{r: cr, mapping: mapping.withDefaults(), loc: loc}
+}
+
+// 次のTodoを返す（値が空の行は読み飛ばす）
+func (r *Reader) Next() (*importer.Item, error) {
+	if r.header == nil {
+		if err := r.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		record, err := r.r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &importer.LineError{Line: parseErr.StartLine, Message: "invalid CSV: " + parseErr.Err.Error()}
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.r.FieldPos(0)
+		if isBlank(record) {
+			continue
+		}
+		item, msg := r.parseRecord(record)
+		if msg != "" {
+			return nil, &importer.LineError{Line: line, Message: msg}
+		}
+		item.Line = line
+		return item, nil
+	}
+}
+
+func (r *Reader) readHeader() error {
+	record, err := r.r.Read()
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: empty input", ErrInvalidHeader)
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return fmt.Errorf("%w: %v", ErrInvalidHeader, parseErr.Err)
+	}
+	if err != nil {
+		return err
+	}
+	r.header = make([]string, len(record))
+	r.fields = make([]string, len(record))
+	hasTitle := false
+	for i, name := range record {
+		// Excelが先頭に付けるBOMを取り除く
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		name = strings.TrimSpace(name)
+		if !importer.ValidText(name) {
+			return fmt.Errorf("%w: invalid UTF-8 text", ErrInvalidHeader)
+		}
+		r.header[i] = name
+		switch {
+		case strings.EqualFold(name, r.mapping.Title):
+			r.fields[i] = "title"
+			hasTitle = true
+		case strings.EqualFold(name, r.mapping.Description):
+			r.fields[i] = "description"
+		case strings.EqualFold(name, r.mapping.Completed):
+			r.fields[i] = "completed"
+		case strings.EqualFold(name, r.mapping.DueAt):
+			r.fields[i] = "due_at"
+		}
+	}
+	if !hasTitle {
+		return fmt.Errorf("%w: no %q column", ErrInvalidHeader, r.mapping.Title)
+	}
+	return nil
+}
+
+// 1行を読む。不正な行の場合はエラーメッセージを返す
+func (r *Reader) parseRecord(record []string) (*importer.Item, string) {
+	item := &importer.Item{}
+	for i, value := range record {
+		if !importer.ValidText(value) {
+			return nil, "invalid UTF-8 text"
+		}
+		value = strings.TrimSpace(value)
+		if i >= len(r.fields) {
+			if value != "" {
+				return nil, "more fields than header"
+			}
+			continue
+		}
+		switch r.fields[i] {
+		case "title":
+			item.Title = value
+		case "description":
+			if value != "" {
+				v := value
+				item.Description = &v
+			}
+		case "completed":
+			completed, ok := parseCompleted(value)
+			if !ok {
+				return nil, fmt.Sprintf("invalid completed value %q", value)
+			}
+			item.Completed = completed
+		case "due_at":
+			if value == "" {
+				continue
+			}
+			due, err := importer.ParseDueAt(value, r.loc)
+			if err != nil {
+				return nil, fmt.Sprintf("invalid due date %q", value)
+			}
+			item.DueAt = &due
+		default:
+			if value != "" && r.header[i] != "" {
+				if item.Metadata == nil {
+					item.Metadata = map[string]string{}
+				}
+				item.Metadata[r.header[i]] = value
+			}
+		}
+	}
+	if item.Title == "" {
+		return nil, "title is required"
+	}
+	return item, ""
+}
+
+// 表計算ソフトが書き出す真偽値の表記を受け付ける
+func parseCompleted(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "", "false", "0", "no", "n", "todo":
+		return false, true
+	case "true", "1", "yes", "y", "x", "done":
+		return true, true
+	}
+	return false, false
+}
+
+func isBlank(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+var _ importer.Reader = (*Reader)(nil)
@@ -0,0 +1,163 @@
+package csv
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/internal/importer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 全件を読み込み、成功した行と行エラーを返す
+func readAll(t *testing.T, r *Reader) ([]importer.Item, []importer.LineError) {
+	t.Helper()
+	var items []importer.Item
+	var lineErrs []importer.LineError
+	for {
+		item, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return items, lineErrs
+		}
+		var lineErr *importer.LineError
+		if errors.As(err, &lineErr) {
+			lineErrs = append(lineErrs, *lineErr)
+			continue
+		}
+		require.NoError(t, err)
+		items = append(items, *item)
+	}
+}
+
+func ptrString(s string) *string {
+	return &s
+}
+
+func TestReader_Next(t *testing.T) {
+	t.Run("正常系: 既定の列名で読み込む", func(t *testing.T) {
+		input := "\ufeffTitle,Description,Completed,Due_At\n" +
+			"Buy milk,,false,\n" +
+			"\"Write report\",\"Q3 numbers\nand charts\",TRUE,2026-10-25\n"
+
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), Mapping{}, time.UTC))
+
+		assert.Empty(t, lineErrs)
+		due := time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, []importer.Item{
+			{Line: 2, Title: "Buy milk"},
+			{Line: 3, Title: "Write report", Description: ptrString("Q3 numbers\nand charts"), Completed: true, DueAt: &due},
+		}, items)
+	})
+
+	t.Run("正常系: 指定した列名で対応付け、対応しない列はメタデータに残す", func(t *testing.T) {
+		input := "Task,Notes,Done,Deadline,Priority,Owner\n" +
+			"Call mom,,x,2026-10-25T09:00:00+09:00,high,\n"
+
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), Mapping{
+			Title:       "task",
+			Description: "Notes",
+			Completed:   "Done",
+			DueAt:       "Deadline",
+		}, time.UTC))
+
+		assert.Empty(t, lineErrs)
+		require.Len(t, items, 1)
+		assert.Equal(t, "Call mom", items[0].Title)
+		assert.True(t, items[0].Completed)
+		assert.True(t, items[0].DueAt.Equal(time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, map[string]string{"Priority": "high"}, items[0].Metadata)
+	})
+
+	t.Run("正常系: 空の行は読み飛ばし、足りない列は空として扱う", func(t *testing.T) {
+		input := "title,completed\n\n,\nonly title\n"
+
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), Mapping{}, time.UTC))
+
+		assert.Empty(t, lineErrs)
+		assert.Equal(t, []importer.Item{{Line: 4, Title: "only title"}}, items)
+	})
+
+	t.Run("異常系: 不正な行は行番号付きのエラーを返して読み込みを継続する", func(t *testing.T) {
+		input := "title,completed,due_at\n" +
+			"ok,,\n" +
+			",true,\n" +
+			"a,maybe,\n" +
+			"b,,tomorrow\n" +
+			"c,,,extra\n" +
+			"\xff,,\n" +
+			"d \"quoted\" e,,\n" +
+			"ok2,,\n"
+
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), Mapping{}, time.UTC))
+
+		require.Len(t, items, 2)
+		assert.Equal(t, 9, items[1].Line)
+		assert.Equal(t, []importer.LineError{
+			{Line: 3, Message: "title is required"},
+			{Line: 4, Message: `invalid completed value "maybe"`},
+			{Line: 5, Message: `invalid due date "tomorrow"`},
+			{Line: 6, Message: "more fields than header"},
+			{Line: 7, Message: "invalid UTF-8 text"},
+			{Line: 8, Message: `invalid CSV: bare " in non-quoted-field`},
+		}, lineErrs)
+	})
+
+	t.Run("異常系: 件名の列がない場合は読み込みを継続できない", func(t *testing.T) {
+		r := NewReader(strings.NewReader("name,done\nBuy milk,\n"), Mapping{}, time.UTC)
+
+		_, err := r.Next()
+
+		assert.ErrorIs(t, err, ErrInvalidHeader)
+	})
+
+	t.Run("異常系: 空の入力は見出しがない", func(t *testing.T) {
+		r := NewReader(strings.NewReader(""), Mapping{}, time.UTC)
+
+		_, err := r.Next()
+
+		assert.ErrorIs(t, err, ErrInvalidHeader)
+	})
+}
+
+func FuzzReader(f *testing.F) {
+	f.Add("title,description,completed,due_at\nBuy milk,,false,\n\"a\nb\",\"c\",x,2026-10-25\n")
+	f.Add("Task,Extra\n\"unterminated\n")
+	f.Add("title\n\"\"\"\"\n,\n")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		r := NewReader(strings.NewReader(input), Mapping{}, time.UTC)
+		prev := 0
+		for {
+			item, err := r.Next()
+			if err != nil {
+				var lineErr *importer.LineError
+				if errors.As(err, &lineErr) {
+					continue
+				}
+				if !errors.Is(err, io.EOF) && !errors.Is(err, ErrInvalidHeader) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if item.Line <= prev {
+				t.Fatalf("line numbers must increase: %d after %d", item.Line, prev)
+			}
+			prev = item.Line
+			if item.Title == "" || !importer.ValidText(item.Title) {
+				t.Fatalf("invalid title %q", item.Title)
+			}
+			if item.Description != nil && !importer.ValidText(*item.Description) {
+				t.Fatalf("invalid description %q", *item.Description)
+			}
+			for k, v := range item.Metadata {
+				if !importer.ValidText(k) || !importer.ValidText(v) {
+					t.Fatalf("invalid metadata %q=%q", k, v)
+				}
+			}
+		}
+	})
+}
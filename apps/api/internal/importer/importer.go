@@ -0,0 +1,145 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// 1行が長すぎて読み込みを継続できない
+	ErrLineTooLong = errors.New("line too long")
+	// 他のツールのエクスポートとして読み込めない
+	ErrInvalidExport = errors.New("invalid export")
+)
+
+// インポートする1件分のTodo
+type Item struct {
+	Line        int
+	Title       string
+	Description *string
+	Completed   bool
+	DueAt       *time.Time
+	// Todoのフィールドに対応しない、形式固有の情報（todo.txtの優先度やCSVの対応付けていない列など）
+	// 保存はせず、プレビューで確認できるようにする
+	Metadata map[string]string
+}
+
+// 1行分の検証エラー（読み込みは継続できる）
+type LineError struct {
+	Line    int
+	Message string
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// インポート形式ごとのパーサーが実装するインターフェース
+// 終端では io.EOF、不正な行では *LineError を返す（続けて Next を呼べる）
+// それ以外のエラーが返った場合は読み込みを継続できない
+type Reader interface {
+	Next() (*Item, error)
+}
+
+// テキストとして保存できる値か（PostgreSQLのtextは不正なUTF-8とNUL文字を受け付けない）
+func ValidText(s string) bool {
+	return utf8.ValidString(s) && !strings.ContainsRune(s, 0)
+}
+
+// 期限の値を読む。RFC 3339の日時か、loc の0時とみなす日付（YYYY-MM-DD）を受け付ける
+// loc が nil なら UTC
+func ParseDueAt(s string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, loc); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// 他のタスク管理ツールのエクスポート（JSON）から読み込んだTodo
+// ジョブのパラメーターとして保存するため、JSONに変換できる形で持つ
+type ExternalItem struct {
+	// 元のツールでのID。同じIDを再度インポートしても重複して作成しない
+	SourceID    string     `json:"source_id"`
+	Title       string     `json:"title"`
+	Description *string    `json:"description,omitempty"`
+	Completed   bool       `json:"completed"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Project     string     `json:"project,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+}
+
+// 取り込まなかった項目とその理由
+type SkippedItem struct {
+	SourceID string `json:"source_id"`
+	Reason   string `json:"reason"`
+}
+
+// 他のツールのエクスポートを読み込んだ結果
+type ExternalExport struct {
+	Items   []ExternalItem `json:"items"`
+	Skipped []SkippedItem  `json:"skipped"`
+	// 対応するフィールドがなく取り込まなかった情報と、それを持っていた項目数
+	Unmapped map[string]int `json:"unmapped"`
+
+	seen map[string]struct{}
+}
+
+func NewExternalExport() *ExternalExport {
+	return &ExternalExport{
+		Items:    []ExternalItem{},
+		Skipped:  []SkippedItem{},
+		Unmapped: map[string]int{},
+		seen:     map[string]struct{}{},
+	}
+}
+
+func (e *ExternalExport) AddSkipped(sourceID, reason string) {
+	e.Skipped = append(e.Skipped, SkippedItem{SourceID: sourceID, Reason: reason})
+}
+
+// 件名・説明・タグを検証して項目を追加し、unmapped に挙げた情報を対応付けなかったものとして記録する
+// 保存できない場合は理由を記録して読み飛ばす。NewExternalExport で作成したものに対してだけ呼び出せる
+func (e *ExternalExport) Add(item ExternalItem, unmapped ...string) {
+	_, dup := e.seen[item.SourceID]
+	switch {
+	case item.SourceID == "":
+		e.AddSkipped("", "id is required")
+	case dup:
+		e.AddSkipped(item.SourceID, "duplicate id")
+	case strings.TrimSpace(item.Title) == "":
+		e.AddSkipped(item.SourceID, "title is required")
+	case !validExternalItem(&item):
+		e.AddSkipped(item.SourceID, "invalid text")
+	default:
+		e.seen[item.SourceID] = struct{}{}
+		e.Items = append(e.Items, item)
+		counted := make(map[string]bool, len(unmapped))
+		for _, field := range unmapped {
+			if !counted[field] {
+				counted[field] = true
+				e.Unmapped[field]++
+			}
+		}
+	}
+}
+
+func validExternalItem(item *ExternalItem) bool {
+	if !ValidText(item.SourceID) || !ValidText(item.Title) || !ValidText(item.Project) {
+		return false
+	}
+	if item.Description != nil && !ValidText(*item.Description) {
+		return false
+	}
+	for _, tag := range item.Tags {
+		if !ValidText(tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+package markdown
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"go-todo/internal/importer"
+)
+
+// 1行あたりの最大サイズ
+const maxLineSize = 1 << 20
+
+var (
+	// - [ ] や 1. [x] のようなタスクリストの項目（GitHub Flavored Markdown）
+	checkboxPattern = regexp.MustCompile(`^(\s*)(?:[-*+]|\d{1,9}[.)])\s+\[(.)\](?:\s+(.*))?$`)
+	headingPattern  = regexp.MustCompile(`^#{1,6}\s+(.*?)\s*#*\s*$`)
+)
+
+// Markdownのチェックリストを読み込む
+// チェックボックスの項目を1件のTodoとし、[x] は完了として扱う
+// 項目の直後の、項目より深く字下げされた行は説明として読み、直前の見出しはメタデータに残す
+// 入れ子の項目も1件のTodoとして読む。それ以外の行は読み飛ばす
+type Reader struct {
+	scanner *bufio.Scanner
+	line    int
+	section string
+	// 説明の行を読むために先読みした行
+	pending *string
+}
+
+func NewReader(r io.Reader) *Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &Reader{scanner: scanner}
+}
+
+// 次のTodoを返す
+func (r *Reader) Next() (*importer.Item, error) {
+	for {
+		text, ok, err := r.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, io.EOF
+		}
+		if !importer.ValidText(text) {
+			return nil, &importer.LineError{Line: r.line, Message: "invalid UTF-8 text"}
+		}
+
+		if m := headingPattern.FindStringSubmatch(text); m != nil {
+			r.section = m[1]
+			continue
+		}
+		m := checkboxPattern.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		line := r.line
+		item := &importer.Item{Line: line, Title: strings.TrimSpace(m[3])}
+		switch m[2] {
+		case " ":
+		case "x", "X":
+			item.Completed = true
+		default:
+			return nil, &importer.LineError{Line: line, Message: "invalid checkbox"}
+		}
+		if item.Title == "" {
+			return nil, &importer.LineError{Line: line, Message: "title is required"}
+		}
+		if r.section != "" {
+			item.Metadata = map[string]string{"section": r.section}
+		}
+
+		description, err := r.readDescription(indentWidth(m[1]))
+		if err != nil {
+			return nil, err
+		}
+		if description != "" {
+			item.Description = &description
+		}
+		return item, nil
+	}
+}
+
+// 項目より深く字下げされた、チェックボックスではない行を説明として読む
+// 空行は説明の途中の空行として扱い、説明の末尾の空行は取り除く
+func (r *Reader) readDescription(indent int) (string, error) {
+	var lines []string
+	for {
+		text, ok, err := r.readLine()
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			break
+		}
+		if strings.TrimSpace(text) != "" &&
+			(indentWidth(text) <= indent || checkboxPattern.MatchString(text) || !importer.ValidText(text)) {
+			r.unreadLine(text)
+			break
+		}
+		lines = append(lines, strings.TrimSpace(text))
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// 次の行を返す。入力が尽きた場合は ok が false
+func (r *Reader) readLine() (string, bool, error) {
+	if r.pending != nil {
+		text := *r.pending
+		r.pending = nil
+		return text, true, nil
+	}
+	if r.scanner.Scan() {
+		r.line++
+		return strings.TrimRight(r.scanner.Text(), "\r"), true, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return "", false, fmt.Errorf("line %d: %w", r.line+1, importer.ErrLineTooLong)
+		}
+		return "", false, err
+	}
+	return "", false, nil
+}
+
+// 先読みした行を戻す（行番号はその行のまま）
+func (r *Reader) unreadLine(text string) {
+	r.pending = &text
+}
+
+// 字下げの幅（タブは4文字として数える）
+func indentWidth(s string) int {
+	width := 0
+	for _, c := range s {
+		switch c {
+		case ' ':
+			width++
+		case '\t':
+			width += 4
+		default:
+			return width
+		}
+	}
+	return width
+}
+
+var _ importer.Reader = (*Reader)(nil)
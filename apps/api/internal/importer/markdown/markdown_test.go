@@ -0,0 +1,160 @@
+package markdown
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"go-todo/internal/importer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 全件を読み込み、成功した行と行エラーを返す
+func readAll(t *testing.T, r *Reader) ([]importer.Item, []importer.LineError) {
+	t.Helper()
+	var items []importer.Item
+	var lineErrs []importer.LineError
+	for {
+		item, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return items, lineErrs
+		}
+		var lineErr *importer.LineError
+		if errors.As(err, &lineErr) {
+			lineErrs = append(lineErrs, *lineErr)
+			continue
+		}
+		require.NoError(t, err)
+		items = append(items, *item)
+	}
+}
+
+func ptrString(s string) *string {
+	return &s
+}
+
+func TestReader_Next(t *testing.T) {
+	t.Run("正常系: チェックボックスの項目をTodoとして読み込む", func(t *testing.T) {
+		input := `# Groceries
+
+Some notes that are not tasks.
+
+- [ ] Buy milk
+* [x] Buy eggs
++ [X] Buy bread
+1. [ ] Numbered item
+`
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input)))
+
+		assert.Empty(t, lineErrs)
+		section := map[string]string{"section": "Groceries"}
+		assert.Equal(t, []importer.Item{
+			{Line: 5, Title: "Buy milk", Metadata: section},
+			{Line: 6, Title: "Buy eggs", Completed: true, Metadata: section},
+			{Line: 7, Title: "Buy bread", Completed: true, Metadata: section},
+			{Line: 8, Title: "Numbered item", Metadata: section},
+		}, items)
+	})
+
+	t.Run("正常系: 字下げされた続きの行は説明、入れ子の項目は別のTodo", func(t *testing.T) {
+		input := "- [ ] Write report\n" +
+			"  Q3 numbers\r\n" +
+			"\n" +
+			"  and charts\n" +
+			"  - [x] Collect data\n" +
+			"    from the warehouse\n" +
+			"\n" +
+			"- [ ] Send report\n" +
+			"not a description\n"
+
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input)))
+
+		assert.Empty(t, lineErrs)
+		assert.Equal(t, []importer.Item{
+			{Line: 1, Title: "Write report", Description: ptrString("Q3 numbers\n\nand charts")},
+			{Line: 5, Title: "Collect data", Completed: true, Description: ptrString("from the warehouse")},
+			{Line: 8, Title: "Send report"},
+		}, items)
+	})
+
+	t.Run("正常系: 見出しが変わるとメタデータも変わる", func(t *testing.T) {
+		input := "## Work ##\n- [ ] a\n### Home\n- [ ] b\n"
+
+		items, _ := readAll(t, NewReader(strings.NewReader(input)))
+
+		require.Len(t, items, 2)
+		assert.Equal(t, "Work", items[0].Metadata["section"])
+		assert.Equal(t, "Home", items[1].Metadata["section"])
+	})
+
+	t.Run("異常系: 不正な項目は行番号付きのエラーを返して読み込みを継続する", func(t *testing.T) {
+		input := "- [ ] ok\n- [?] unknown\n- [ ]\n- [ ]    \n- [ ] \xff\n- [x] ok2\n"
+
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input)))
+
+		require.Len(t, items, 2)
+		assert.Equal(t, 6, items[1].Line)
+		assert.Equal(t, []importer.LineError{
+			{Line: 2, Message: "invalid checkbox"},
+			{Line: 3, Message: "title is required"},
+			{Line: 4, Message: "title is required"},
+			{Line: 5, Message: "invalid UTF-8 text"},
+		}, lineErrs)
+	})
+
+	t.Run("異常系: 不正なテキストの説明の行はその行のエラーにする", func(t *testing.T) {
+		input := "- [ ] ok\n  \xff\n- [ ] ok2\n"
+
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input)))
+
+		require.Len(t, items, 2)
+		assert.Nil(t, items[0].Description)
+		assert.Equal(t, []importer.LineError{{Line: 2, Message: "invalid UTF-8 text"}}, lineErrs)
+	})
+
+	t.Run("異常系: 長すぎる行は読み込みを継続できない", func(t *testing.T) {
+		input := "- [ ] ok\n" + strings.Repeat("a", maxLineSize+1) + "\n"
+		r := NewReader(strings.NewReader(input))
+
+		_, err := r.Next()
+
+		assert.ErrorIs(t, err, importer.ErrLineTooLong)
+	})
+}
+
+func FuzzReader(f *testing.F) {
+	f.Add("# List\n- [ ] a\n  desc\n  - [x] b\n\n1. [ ] c\n")
+	f.Add("- [?] bad\n- [ ]\n* [X] done\n")
+	f.Add("\t- [ ] tab\n\t\tdesc\n")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		r := NewReader(strings.NewReader(input))
+		prev := 0
+		for {
+			item, err := r.Next()
+			if err != nil {
+				var lineErr *importer.LineError
+				if errors.As(err, &lineErr) {
+					continue
+				}
+				if !errors.Is(err, io.EOF) && !errors.Is(err, importer.ErrLineTooLong) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if item.Line <= prev {
+				t.Fatalf("line numbers must increase: %d after %d", item.Line, prev)
+			}
+			prev = item.Line
+			if strings.TrimSpace(item.Title) == "" || !importer.ValidText(item.Title) {
+				t.Fatalf("invalid title %q", item.Title)
+			}
+			if item.Description != nil && !importer.ValidText(*item.Description) {
+				t.Fatalf("invalid description %q", *item.Description)
+			}
+		}
+	})
+}
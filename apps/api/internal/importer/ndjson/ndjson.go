@@ -0,0 +1,89 @@
+package ndjson
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"go-todo/internal/importer"
+)
+
+// 1行あたりの最大サイズ
+const maxLineSize = 1 << 20
+
+// 1行に1つのJSONオブジェクトを書いたNDJSONを読み込む
+type Reader struct {
+	scanner *bufio.Scanner
+	line    int
+	// 日付だけの期限を解釈するタイムゾーン
+	loc *time.Location
+}
+
+type record struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Completed   *bool   `json:"completed"`
+	DueAt       *string `json:"due_at"`
+}
+
+// 日付だけの期限（YYYY-MM-DD）は loc の0時として読む
+func NewReader(r io.Reader, loc *time.Location) *Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &Reader{scanner: scanner, loc: loc}
+}
+
+// 次のTodoを返す（空行は読み飛ばす）
+func (r *Reader) Next() (*importer.Item, error) {
+	for r.scanner.Scan() {
+		r.line++
+		b := bytes.TrimSpace(r.scanner.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+
+		var rec record
+		if err := json.Unmarshal(b, &rec); err != nil {
+			return nil, &importer.LineError{Line: r.line, Message: "invalid JSON object"}
+		}
+		if rec.Title == nil || strings.TrimSpace(*rec.Title) == "" {
+			return nil, &importer.LineError{Line: r.line, Message: "title is required"}
+		}
+		// JSONの \u0000 はPostgreSQLのtextに保存できない
+		if !importer.ValidText(*rec.Title) || rec.Description != nil && !importer.ValidText(*rec.Description) {
+			return nil, &importer.LineError{Line: r.line, Message: "invalid text"}
+		}
+
+		item := &importer.Item{
+			Line:        r.line,
+			Title:       *rec.Title,
+			Description: rec.Description,
+		}
+		if rec.Completed != nil {
+			item.Completed = *rec.Completed
+		}
+		if rec.DueAt != nil {
+			due, err := importer.ParseDueAt(*rec.DueAt, r.loc)
+			if err != nil {
+				return nil, &importer.LineError{Line: r.line, Message: "invalid due_at"}
+			}
+			item.DueAt = &due
+		}
+		return item, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, fmt.Errorf("line %d: %w", r.line+1, importer.ErrLineTooLong)
+		}
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+var _ importer.Reader = (*Reader)(nil)
@@ -0,0 +1,166 @@
+package ndjson
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/internal/importer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 全件を読み込み、成功した行と行エラーを返す
+func readAll(t *testing.T, r *Reader) ([]importer.Item, []importer.LineError) {
+	t.Helper()
+	var items []importer.Item
+	var lineErrs []importer.LineError
+	for {
+		item, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return items, lineErrs
+		}
+		var lineErr *importer.LineError
+		if errors.As(err, &lineErr) {
+			lineErrs = append(lineErrs, *lineErr)
+			continue
+		}
+		require.NoError(t, err)
+		items = append(items, *item)
+	}
+}
+
+func TestReader_Next(t *testing.T) {
+	t.Run("正常系: 各行をTodoとして読み込む", func(t *testing.T) {
+		input := `{"title":"first"}
+{"title":"second","description":"desc","completed":true}
+`
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), time.UTC))
+
+		assert.Empty(t, lineErrs)
+		require.Len(t, items, 2)
+		assert.Equal(t, importer.Item{Line: 1, Title: "first"}, items[0])
+		assert.Equal(t, 2, items[1].Line)
+		assert.Equal(t, "second", items[1].Title)
+		assert.Equal(t, "desc", *items[1].Description)
+		assert.True(t, items[1].Completed)
+	})
+
+	t.Run("正常系: 空行は読み飛ばし、行番号は元の行に対応する", func(t *testing.T) {
+		input := "\n{\"title\":\"a\"}\r\n   \n{\"title\":\"b\"}"
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), time.UTC))
+
+		assert.Empty(t, lineErrs)
+		require.Len(t, items, 2)
+		assert.Equal(t, 2, items[0].Line)
+		assert.Equal(t, 4, items[1].Line)
+	})
+
+	t.Run("異常系: 不正な行は行番号付きのエラーを返して読み込みを継続する", func(t *testing.T) {
+		input := `{"title":"ok"}
+not json
+{"description":"no title"}
+{"title":"   "}
+[1,2]
+{"title":"ok2"}
+`
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), time.UTC))
+
+		require.Len(t, items, 2)
+		assert.Equal(t, 6, items[1].Line)
+		assert.Equal(t, []importer.LineError{
+			{Line: 2, Message: "invalid JSON object"},
+			{Line: 3, Message: "title is required"},
+			{Line: 4, Message: "title is required"},
+			{Line: 5, Message: "invalid JSON object"},
+		}, lineErrs)
+	})
+
+	t.Run("正常系: due_atは日時か日付を受け付ける", func(t *testing.T) {
+		input := `{"title":"a","due_at":"2026-10-25T09:00:00+09:00"}
+{"title":"b","due_at":"2026-10-25"}
+`
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), time.UTC))
+
+		assert.Empty(t, lineErrs)
+		require.Len(t, items, 2)
+		assert.True(t, items[0].DueAt.Equal(time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC), *items[1].DueAt)
+	})
+
+	t.Run("正常系: 日付だけのdue_atは指定したタイムゾーンの0時とみなす", func(t *testing.T) {
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		input := `{"title":"a","due_at":"2026-10-25T09:00:00Z"}
+{"title":"b","due_at":"2026-10-25"}
+`
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), tokyo))
+
+		assert.Empty(t, lineErrs)
+		require.Len(t, items, 2)
+		assert.True(t, items[0].DueAt.Equal(time.Date(2026, 10, 25, 9, 0, 0, 0, time.UTC)))
+		assert.True(t, items[1].DueAt.Equal(time.Date(2026, 10, 24, 15, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("異常系: 保存できない値は行エラーにする", func(t *testing.T) {
+		input := `{"title":"a\u0000b"}
+{"title":"ok","description":"\u0000"}
+{"title":"ok","due_at":"tomorrow"}
+`
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), time.UTC))
+
+		assert.Empty(t, items)
+		assert.Equal(t, []importer.LineError{
+			{Line: 1, Message: "invalid text"},
+			{Line: 2, Message: "invalid text"},
+			{Line: 3, Message: "invalid due_at"},
+		}, lineErrs)
+	})
+
+	t.Run("異常系: 長すぎる行はErrLineTooLongを返す", func(t *testing.T) {
+		input := `{"title":"ok"}` + "\n" + `{"title":"` + strings.Repeat("x", maxLineSize) + `"}`
+		r := NewReader(strings.NewReader(input), time.UTC)
+
+		_, err := r.Next()
+		require.NoError(t, err)
+		_, err = r.Next()
+		assert.ErrorIs(t, err, importer.ErrLineTooLong)
+		assert.Contains(t, err.Error(), "line 2")
+	})
+}
+
+func FuzzReader(f *testing.F) {
+	f.Add(`{"title":"first"}` + "\n" + `{"title":"second","description":"desc","completed":true,"due_at":"2026-10-25"}` + "\n")
+	f.Add("not json\n[1,2]\n{\"title\":\"\\u0000\"}\n")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		r := NewReader(strings.NewReader(input), time.UTC)
+		prev := 0
+		for {
+			item, err := r.Next()
+			if err != nil {
+				var lineErr *importer.LineError
+				if errors.As(err, &lineErr) {
+					continue
+				}
+				if !errors.Is(err, io.EOF) && !errors.Is(err, importer.ErrLineTooLong) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if item.Line <= prev {
+				t.Fatalf("line numbers must increase: %d after %d", item.Line, prev)
+			}
+			prev = item.Line
+			if strings.TrimSpace(item.Title) == "" || !importer.ValidText(item.Title) {
+				t.Fatalf("invalid title %q", item.Title)
+			}
+			if item.Description != nil && !importer.ValidText(*item.Description) {
+				t.Fatalf("invalid description %q", *item.Description)
+			}
+		}
+	})
+}
@@ -0,0 +1,263 @@
+package todoist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-todo/internal/importer"
+)
+
+// 日時を持つ期限の形式（タイムゾーンなし）
+const floatingDateTime = "2006-01-02T15:04:05"
+
+// Sync APIやバックアップの形式（トップレベルがオブジェクト）
+type backup struct {
+	Projects []project `json:"projects"`
+	Labels   []label   `json:"labels"`
+	Items    []item    `json:"items"`
+	// 新しいAPIではタスクの一覧が tasks になっている
+	Tasks []item `json:"tasks"`
+	Notes []note `json:"notes"`
+}
+
+type project struct {
+	ID           flexID `json:"id"`
+	Name         string `json:"name"`
+	InboxProject bool   `json:"inbox_project"`
+}
+
+type label struct {
+	ID   flexID `json:"id"`
+	Name string `json:"name"`
+}
+
+type item struct {
+	ID          flexID            `json:"id"`
+	Content     string            `json:"content"`
+	Description string            `json:"description"`
+	ProjectID   flexID            `json:"project_id"`
+	SectionID   flexID            `json:"section_id"`
+	ParentID    flexID            `json:"parent_id"`
+	Labels      []json.RawMessage `json:"labels"`
+	Priority    int               `json:"priority"`
+	Due         *due              `json:"due"`
+	Checked     flexBool          `json:"checked"`
+	IsCompleted flexBool          `json:"is_completed"`
+	IsDeleted   flexBool          `json:"is_deleted"`
+	// Sync APIは responsible_uid、REST APIは assignee_id
+	ResponsibleUID flexID `json:"responsible_uid"`
+	AssigneeID     flexID `json:"assignee_id"`
+	Duration       any    `json:"duration"`
+}
+
+type due struct {
+	Date        string `json:"date"`
+	Datetime    string `json:"datetime"`
+	Timezone    string `json:"timezone"`
+	IsRecurring bool   `json:"is_recurring"`
+}
+
+type note struct {
+	ItemID    flexID   `json:"item_id"`
+	IsDeleted flexBool `json:"is_deleted"`
+}
+
+// Todoistのエクスポート（Sync APIのレスポンス・バックアップ、またはREST APIのタスク一覧）を読み込む
+// プロジェクトはプロジェクト名、ラベルはタグに対応付ける。インボックスはプロジェクトなしとして扱う
+// 日付だけの期限とタイムゾーンのない日時は loc（nil なら UTC）で解釈する
+func Parse(r io.Reader, loc *time.Location) (*importer.ExternalExport, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var b backup
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &b.Items)
+	} else {
+		err = json.Unmarshal(data, &b)
+		b.Items = append(b.Items, b.Tasks...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", importer.ErrInvalidExport, err)
+	}
+
+	projects := make(map[flexID]project, len(b.Projects))
+	for _, p := range b.Projects {
+		projects[p.ID] = p
+	}
+	labels := make(map[flexID]string, len(b.Labels))
+	for _, l := range b.Labels {
+		labels[l.ID] = l.Name
+	}
+	comments := map[flexID]int{}
+	for _, n := range b.Notes {
+		if !n.IsDeleted {
+			comments[n.ItemID]++
+		}
+	}
+
+	out := importer.NewExternalExport()
+	for i := range b.Items {
+		it := &b.Items[i]
+		id := string(it.ID)
+		if it.IsDeleted {
+			out.AddSkipped(id, "deleted")
+			continue
+		}
+		dueAt, err := parseDue(it.Due, loc)
+		if err != nil {
+			out.AddSkipped(id, "invalid due date")
+			continue
+		}
+
+		var unmapped []string
+		ext := importer.ExternalItem{
+			SourceID:  id,
+			Title:     it.Content,
+			Completed: bool(it.Checked || it.IsCompleted),
+			DueAt:     dueAt,
+		}
+		if it.Description != "" {
+			ext.Description = &it.Description
+		}
+		if it.ProjectID != "" {
+			if p, ok := projects[it.ProjectID]; ok {
+				if !p.InboxProject {
+					ext.Project = p.Name
+				}
+			} else {
+				// REST APIのタスク一覧にはプロジェクト名が含まれない
+				unmapped = append(unmapped, "project")
+			}
+		}
+		for _, raw := range it.Labels {
+			name, ok := labelName(raw, labels)
+			if !ok {
+				unmapped = append(unmapped, "label")
+				continue
+			}
+			ext.Tags = append(ext.Tags, name)
+		}
+
+		// Todoの項目にないもの
+		if it.Priority > 1 {
+			unmapped = append(unmapped, "priority")
+		}
+		if it.Due != nil && it.Due.IsRecurring {
+			unmapped = append(unmapped, "recurrence")
+		}
+		if it.ParentID != "" {
+			unmapped = append(unmapped, "parent")
+		}
+		if it.SectionID != "" {
+			unmapped = append(unmapped, "section")
+		}
+		if it.ResponsibleUID != "" || it.AssigneeID != "" {
+			unmapped = append(unmapped, "assignee")
+		}
+		if it.Duration != nil {
+			unmapped = append(unmapped, "duration")
+		}
+		if comments[it.ID] > 0 {
+			unmapped = append(unmapped, "comments")
+		}
+		out.Add(ext, unmapped...)
+	}
+	return out, nil
+}
+
+// ラベルは名前（Sync API v9以降・REST API）か、labels のID（古いSync API）で指定される
+func labelName(raw json.RawMessage, labels map[flexID]string) (string, bool) {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name, name != ""
+	}
+	var id flexID
+	if err := json.Unmarshal(raw, &id); err != nil {
+		return "", false
+	}
+	name, ok := labels[id]
+	return name, ok && name != ""
+}
+
+// 期限を読む。日付だけの場合は loc の0時、タイムゾーンのない日時は timezone（なければ loc）の時刻として扱う
+func parseDue(d *due, loc *time.Location) (*time.Time, error) {
+	if d == nil {
+		return nil, nil
+	}
+	value := d.Datetime
+	if value == "" {
+		// 古いSync APIは date に日時を入れることがある
+		value = d.Date
+	}
+	if value == "" {
+		return nil, nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	if t, err := importer.ParseDueAt(value, loc); err == nil {
+		return &t, nil
+	}
+	if d.Timezone != "" {
+		l, err := time.LoadLocation(d.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		loc = l
+	}
+	t, err := time.ParseInLocation(floatingDateTime, value, loc)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// 文字列と数値のどちらでも表されるID（古いAPIは数値、新しいAPIは文字列）
+type flexID string
+
+func (id *flexID) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*id = ""
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*id = flexID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("invalid id: %s", b)
+	}
+	*id = flexID(n.String())
+	return nil
+}
+
+// 真偽値と0/1のどちらでも表されるフラグ
+type flexBool bool
+
+func (f *flexBool) UnmarshalJSON(b []byte) error {
+	switch strings.TrimSpace(string(b)) {
+	case "null":
+		*f = false
+		return nil
+	case "true":
+		*f = true
+		return nil
+	case "false":
+		*f = false
+		return nil
+	}
+	n, err := strconv.Atoi(string(b))
+	if err != nil {
+		return fmt.Errorf("invalid flag: %s", b)
+	}
+	*f = n != 0
+	return nil
+}
@@ -0,0 +1,146 @@
+package todoist
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/internal/importer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("正常系: Sync APIの形式を読み込み、プロジェクトとラベルを対応付ける", func(t *testing.T) {
+		input := `{
+  "projects": [
+    {"id": "100", "name": "Inbox", "inbox_project": true},
+    {"id": "200", "name": "Home"}
+  ],
+  "items": [
+    {"id": "1", "content": "Buy milk", "project_id": "100", "labels": [], "priority": 1, "checked": false},
+    {"id": "2", "content": "Pay rent", "description": "by transfer", "project_id": "200",
+     "labels": ["money", "monthly"], "priority": 4, "checked": true,
+     "due": {"date": "2026-11-01", "is_recurring": true, "string": "every month"}}
+  ],
+  "notes": [{"id": "9", "item_id": "2", "content": "paid early"}]
+}`
+		got, err := Parse(strings.NewReader(input), time.UTC)
+
+		require.NoError(t, err)
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, importer.ExternalItem{SourceID: "1", Title: "Buy milk"}, got.Items[0])
+
+		due := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, "2", got.Items[1].SourceID)
+		assert.Equal(t, "Pay rent", got.Items[1].Title)
+		assert.Equal(t, "by transfer", *got.Items[1].Description)
+		assert.True(t, got.Items[1].Completed)
+		assert.Equal(t, &due, got.Items[1].DueAt)
+		assert.Equal(t, "Home", got.Items[1].Project)
+		assert.Equal(t, []string{"money", "monthly"}, got.Items[1].Tags)
+
+		assert.Empty(t, got.Skipped)
+		assert.Equal(t, map[string]int{"priority": 1, "recurrence": 1, "comments": 1}, got.Unmapped)
+	})
+
+	t.Run("正常系: 古いSync APIの数値のIDとラベルID、0/1の完了フラグを読み込む", func(t *testing.T) {
+		input := `{
+  "projects": [{"id": 200, "name": "Work"}],
+  "labels": [{"id": 7, "name": "urgent"}],
+  "items": [{"id": 1, "content": "Report", "project_id": 200, "labels": [7, 8], "checked": 1, "is_deleted": 0}]
+}`
+		got, err := Parse(strings.NewReader(input), time.UTC)
+
+		require.NoError(t, err)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, "1", got.Items[0].SourceID)
+		assert.Equal(t, "Work", got.Items[0].Project)
+		assert.Equal(t, []string{"urgent"}, got.Items[0].Tags)
+		assert.True(t, got.Items[0].Completed)
+		assert.Equal(t, map[string]int{"label": 1}, got.Unmapped)
+	})
+
+	t.Run("正常系: REST APIのタスク一覧を読み込む", func(t *testing.T) {
+		input := `[
+  {"id": "5", "content": "Call mom", "is_completed": true, "project_id": "200", "labels": ["family"],
+   "parent_id": "4", "section_id": "3", "assignee_id": "42", "duration": {"amount": 15, "unit": "minute"},
+   "due": {"date": "2026-10-25", "datetime": "2026-10-25T09:00:00Z"}}
+]`
+		got, err := Parse(strings.NewReader(input), time.UTC)
+
+		require.NoError(t, err)
+		require.Len(t, got.Items, 1)
+		due := time.Date(2026, 10, 25, 9, 0, 0, 0, time.UTC)
+		assert.Equal(t, &due, got.Items[0].DueAt)
+		assert.True(t, got.Items[0].Completed)
+		assert.Empty(t, got.Items[0].Project)
+		assert.Equal(t, []string{"family"}, got.Items[0].Tags)
+		assert.Equal(t, map[string]int{
+			"project": 1, "parent": 1, "section": 1, "assignee": 1, "duration": 1,
+		}, got.Unmapped)
+	})
+
+	t.Run("正常系: タイムゾーンのない日時は due.timezone の時刻として扱う", func(t *testing.T) {
+		input := `{"items": [{"id": "1", "content": "Meeting",
+  "due": {"date": "2026-10-25T09:00:00", "timezone": "Asia/Tokyo"}}]}`
+		got, err := Parse(strings.NewReader(input), time.UTC)
+
+		require.NoError(t, err)
+		require.Len(t, got.Items, 1)
+		assert.True(t, time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC).Equal(*got.Items[0].DueAt))
+	})
+
+	t.Run("正常系: 日付だけの期限とdue.timezoneのない日時は指定したタイムゾーンで読む", func(t *testing.T) {
+		newYork, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+		input := `{"items": [
+  {"id": "1", "content": "All day", "due": {"date": "2026-10-25"}},
+  {"id": "2", "content": "Floating", "due": {"date": "2026-10-25T09:00:00"}}
+]}`
+		got, err := Parse(strings.NewReader(input), newYork)
+
+		require.NoError(t, err)
+		require.Len(t, got.Items, 2)
+		assert.True(t, time.Date(2026, 10, 25, 4, 0, 0, 0, time.UTC).Equal(*got.Items[0].DueAt))
+		assert.True(t, time.Date(2026, 10, 25, 13, 0, 0, 0, time.UTC).Equal(*got.Items[1].DueAt))
+	})
+
+	t.Run("正常系: 削除済み・件名なし・不正な期限・重複したIDの項目は理由を記録して読み飛ばす", func(t *testing.T) {
+		input := `{"items": [
+  {"id": "1", "content": "deleted", "is_deleted": true},
+  {"id": "2", "content": "   "},
+  {"id": "3", "content": "bad due", "due": {"date": "tomorrow"}},
+  {"id": "4", "content": "ok", "priority": 4},
+  {"id": "4", "content": "again"},
+  {"content": "no id"}
+]}`
+		got, err := Parse(strings.NewReader(input), time.UTC)
+
+		require.NoError(t, err)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, "4", got.Items[0].SourceID)
+		assert.Equal(t, []importer.SkippedItem{
+			{SourceID: "1", Reason: "deleted"},
+			{SourceID: "2", Reason: "title is required"},
+			{SourceID: "3", Reason: "invalid due date"},
+			{SourceID: "4", Reason: "duplicate id"},
+			{SourceID: "", Reason: "id is required"},
+		}, got.Skipped)
+		// 読み飛ばした項目の情報は対応付けなかったものとして数えない
+		assert.Equal(t, map[string]int{"priority": 1}, got.Unmapped)
+	})
+
+	t.Run("異常系: JSONでない場合はErrInvalidExport", func(t *testing.T) {
+		_, err := Parse(strings.NewReader("content,priority\nBuy milk,1\n"), time.UTC)
+
+		assert.ErrorIs(t, err, importer.ErrInvalidExport)
+	})
+
+	t.Run("異常系: 項目の型が異なる場合はErrInvalidExport", func(t *testing.T) {
+		_, err := Parse(strings.NewReader(`{"items": [{"id": "1", "checked": "yes"}]}`), time.UTC)
+
+		assert.ErrorIs(t, err, importer.ErrInvalidExport)
+	})
+}
@@ -0,0 +1,141 @@
+package todotxt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"go-todo/internal/importer"
+)
+
+// 1行あたりの最大サイズ
+const maxLineSize = 1 << 20
+
+var priorityPattern = regexp.MustCompile(`^\([A-Z]\)$`)
+
+// todo.txt形式（https://github.com/todotxt/todo.txt）を読み込む
+//
+//	x 2026-10-21 2026-10-01 (A) Call mom +family @phone due:2026-10-25
+//
+// 先頭の x は完了、(A) は優先度、日付は完了日と作成日を表す
+// due: タグは期限として読み、件名から取り除く。+プロジェクトと@コンテキストは件名に残す
+type Reader struct {
+	scanner *bufio.Scanner
+	line    int
+	// 日付だけの期限を解釈するタイムゾーン
+	loc *time.Location
+}
+
+// 日付だけの期限（YYYY-MM-DD）は loc の0時として読む
+func NewReader(r io.Reader, loc *time.Location) *Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &Reader{scanner: scanner, loc: loc}
+}
+
+// 次のTodoを返す（空行は読み飛ばす）
+func (r *Reader) Next() (*importer.Item, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" {
+			continue
+		}
+		if !importer.ValidText(line) {
+			return nil, &importer.LineError{Line: r.line, Message: "invalid UTF-8 text"}
+		}
+		item, msg := parseLine(line, r.loc)
+		if msg != "" {
+			return nil, &importer.LineError{Line: r.line, Message: msg}
+		}
+		item.Line = r.line
+		return item, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, fmt.Errorf("line %d: %w", r.line+1, importer.ErrLineTooLong)
+		}
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// 1行を読む。不正な行の場合はエラーメッセージを返す
+func parseLine(line string, loc *time.Location) (*importer.Item, string) {
+	item := &importer.Item{}
+	metadata := map[string]string{}
+	fields := strings.Fields(line)
+
+	if fields[0] == "x" {
+		item.Completed = true
+		fields = fields[1:]
+		// 完了したタスクは完了日と作成日の順に日付を持てる
+		if len(fields) > 0 && isDate(fields[0]) {
+			metadata["completed_on"] = fields[0]
+			fields = fields[1:]
+			if len(fields) > 0 && isDate(fields[0]) {
+				metadata["created_on"] = fields[0]
+				fields = fields[1:]
+			}
+		}
+	} else {
+		if priorityPattern.MatchString(fields[0]) {
+			metadata["priority"] = fields[0][1:2]
+			fields = fields[1:]
+		}
+		if len(fields) > 0 && isDate(fields[0]) {
+			metadata["created_on"] = fields[0]
+			fields = fields[1:]
+		}
+	}
+
+	title := make([]string, 0, len(fields))
+	var projects, contexts []string
+	for _, f := range fields {
+		switch {
+		case strings.HasPrefix(f, "due:"):
+			due, err := importer.ParseDueAt(strings.TrimPrefix(f, "due:"), loc)
+			if err != nil {
+				return nil, "invalid due date"
+			}
+			item.DueAt = &due
+			continue
+		case strings.HasPrefix(f, "pri:") && len(f) == 5:
+			// 完了時に優先度を pri: タグに移す慣習がある
+			metadata["priority"] = f[4:]
+			continue
+		case len(f) > 1 && f[0] == '+':
+			projects = append(projects, f[1:])
+		case len(f) > 1 && f[0] == '@':
+			contexts = append(contexts, f[1:])
+		}
+		title = append(title, f)
+	}
+
+	item.Title = strings.Join(title, " ")
+	if item.Title == "" {
+		return nil, "title is required"
+	}
+	if len(projects) > 0 {
+		metadata["projects"] = strings.Join(projects, ",")
+	}
+	if len(contexts) > 0 {
+		metadata["contexts"] = strings.Join(contexts, ",")
+	}
+	if len(metadata) > 0 {
+		item.Metadata = metadata
+	}
+	return item, ""
+}
+
+func isDate(s string) bool {
+	_, err := time.Parse(time.DateOnly, s)
+	return err == nil
+}
+
+var _ importer.Reader = (*Reader)(nil)
@@ -0,0 +1,156 @@
+package todotxt
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/internal/importer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 全件を読み込み、成功した行と行エラーを返す
+func readAll(t *testing.T, r *Reader) ([]importer.Item, []importer.LineError) {
+	t.Helper()
+	var items []importer.Item
+	var lineErrs []importer.LineError
+	for {
+		item, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return items, lineErrs
+		}
+		var lineErr *importer.LineError
+		if errors.As(err, &lineErr) {
+			lineErrs = append(lineErrs, *lineErr)
+			continue
+		}
+		require.NoError(t, err)
+		items = append(items, *item)
+	}
+}
+
+func TestReader_Next(t *testing.T) {
+	due := time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		line string
+		want importer.Item
+	}{
+		{
+			name: "正常系: 件名だけ",
+			line: "Buy milk",
+			want: importer.Item{Line: 1, Title: "Buy milk"},
+		},
+		{
+			name: "正常系: 優先度と作成日",
+			line: "(A) 2026-10-01 Call mom",
+			want: importer.Item{Line: 1, Title: "Call mom", Metadata: map[string]string{"priority": "A", "created_on": "2026-10-01"}},
+		},
+		{
+			name: "正常系: 完了日と作成日を持つ完了済みタスク",
+			line: "x 2026-10-21 2026-10-01 Pay rent pri:B",
+			want: importer.Item{Line: 1, Title: "Pay rent", Completed: true, Metadata: map[string]string{"completed_on": "2026-10-21", "created_on": "2026-10-01", "priority": "B"}},
+		},
+		{
+			name: "正常系: プロジェクトとコンテキストは件名に残す",
+			line: "Call mom +family +phone @home",
+			want: importer.Item{Line: 1, Title: "Call mom +family +phone @home", Metadata: map[string]string{"projects": "family,phone", "contexts": "home"}},
+		},
+		{
+			name: "正常系: due:タグは期限にして件名から取り除く",
+			line: "Submit report due:2026-10-25 +work",
+			want: importer.Item{Line: 1, Title: "Submit report +work", DueAt: &due, Metadata: map[string]string{"projects": "work"}},
+		},
+		{
+			name: "正常系: 件名の途中のxや括弧は完了や優先度として扱わない",
+			line: "Fix (A) x bug",
+			want: importer.Item{Line: 1, Title: "Fix (A) x bug"},
+		},
+		{
+			name: "正常系: 小文字の優先度は件名の一部",
+			line: "(a) lowercase",
+			want: importer.Item{Line: 1, Title: "(a) lowercase"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, lineErrs := readAll(t, NewReader(strings.NewReader(tt.line+"\n"), time.UTC))
+
+			assert.Empty(t, lineErrs)
+			require.Len(t, items, 1)
+			assert.Equal(t, tt.want, items[0])
+		})
+	}
+
+	t.Run("正常系: 空行は読み飛ばし、行番号は元の行に対応する", func(t *testing.T) {
+		items, lineErrs := readAll(t, NewReader(strings.NewReader("\na\r\n   \nb"), time.UTC))
+
+		assert.Empty(t, lineErrs)
+		require.Len(t, items, 2)
+		assert.Equal(t, 2, items[0].Line)
+		assert.Equal(t, 4, items[1].Line)
+	})
+
+	t.Run("異常系: 不正な行は行番号付きのエラーを返して読み込みを継続する", func(t *testing.T) {
+		input := "ok\n(A) 2026-10-01\nx\nbad due:tomorrow\n\xff\xfe\nok2\n"
+
+		items, lineErrs := readAll(t, NewReader(strings.NewReader(input), time.UTC))
+
+		require.Len(t, items, 2)
+		assert.Equal(t, 6, items[1].Line)
+		assert.Equal(t, []importer.LineError{
+			{Line: 2, Message: "title is required"},
+			{Line: 3, Message: "title is required"},
+			{Line: 4, Message: "invalid due date"},
+			{Line: 5, Message: "invalid UTF-8 text"},
+		}, lineErrs)
+	})
+
+	t.Run("異常系: 長すぎる行は読み込みを継続できない", func(t *testing.T) {
+		input := "ok\n" + strings.Repeat("a", maxLineSize+1) + "\n"
+		r := NewReader(strings.NewReader(input), time.UTC)
+
+		_, err := r.Next()
+		require.NoError(t, err)
+		_, err = r.Next()
+
+		assert.ErrorIs(t, err, importer.ErrLineTooLong)
+	})
+}
+
+func FuzzReader(f *testing.F) {
+	f.Add("x 2026-10-21 2026-10-01 (A) Call mom +family @phone due:2026-10-25\n")
+	f.Add("(B) Buy milk\n\n  \nx done pri:C\n")
+	f.Add("due:2026-13-01 bad\n+ @ x\n")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		r := NewReader(strings.NewReader(input), time.UTC)
+		prev := 0
+		for {
+			item, err := r.Next()
+			if err != nil {
+				var lineErr *importer.LineError
+				if errors.As(err, &lineErr) {
+					continue
+				}
+				// 入力が尽きるか、長すぎる行で終わる
+				if !errors.Is(err, io.EOF) && !errors.Is(err, importer.ErrLineTooLong) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if item.Line <= prev {
+				t.Fatalf("line numbers must increase: %d after %d", item.Line, prev)
+			}
+			prev = item.Line
+			if strings.TrimSpace(item.Title) == "" || !importer.ValidText(item.Title) {
+				t.Fatalf("invalid title %q", item.Title)
+			}
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package trello
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"go-todo/internal/importer"
+)
+
+// ボードのJSONエクスポート（メニューの「印刷とエクスポート」→「JSONとしてエクスポート」）
+type board struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Lists  []list  `json:"lists"`
+	Labels []label `json:"labels"`
+	Cards  []card  `json:"cards"`
+}
+
+type list struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Closed bool   `json:"closed"`
+}
+
+type label struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type card struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Desc         string     `json:"desc"`
+	Closed       bool       `json:"closed"`
+	IDList       string     `json:"idList"`
+	Due          *time.Time `json:"due"`
+	DueComplete  bool       `json:"dueComplete"`
+	Start        *string    `json:"start"`
+	IDLabels     []string   `json:"idLabels"`
+	Labels       []label    `json:"labels"`
+	IDChecklists []string   `json:"idChecklists"`
+	IDMembers    []string   `json:"idMembers"`
+	Badges       struct {
+		Comments    int `json:"comments"`
+		Attachments int `json:"attachments"`
+	} `json:"badges"`
+}
+
+// Trelloのボードのエクスポートを読み込む
+// ボード名をプロジェクト、ラベルをタグに対応付け、期限の完了をTodoの完了として扱う
+// アーカイブされたカードとアーカイブされたリストのカードは読み飛ばす
+func Parse(r io.Reader) (*importer.ExternalExport, error) {
+	var b board
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return nil, fmt.Errorf("%w: %v", importer.ErrInvalidExport, err)
+	}
+	if b.ID == "" || b.Cards == nil {
+		return nil, fmt.Errorf("%w: not a Trello board export", importer.ErrInvalidExport)
+	}
+
+	lists := make(map[string]list, len(b.Lists))
+	for _, l := range b.Lists {
+		lists[l.ID] = l
+	}
+	labels := make(map[string]label, len(b.Labels))
+	for _, l := range b.Labels {
+		labels[l.ID] = l
+	}
+
+	out := importer.NewExternalExport()
+	for i := range b.Cards {
+		c := &b.Cards[i]
+		if c.Closed || lists[c.IDList].Closed {
+			out.AddSkipped(c.ID, "archived")
+			continue
+		}
+
+		var unmapped []string
+		item := importer.ExternalItem{
+			SourceID:  c.ID,
+			Title:     c.Name,
+			Completed: c.DueComplete,
+			DueAt:     c.Due,
+			Project:   b.Name,
+		}
+		if c.Desc != "" {
+			item.Description = &c.Desc
+		}
+		// カードのラベルは labels に埋め込まれている。ない場合は idLabels からボードのラベルを引く
+		cardLabels := c.Labels
+		if cardLabels == nil {
+			for _, id := range c.IDLabels {
+				if l, ok := labels[id]; ok {
+					cardLabels = append(cardLabels, l)
+				}
+			}
+		}
+		for _, l := range cardLabels {
+			if name := labelName(l); name != "" {
+				item.Tags = append(item.Tags, name)
+			}
+		}
+
+		// Todoの項目にないもの
+		if c.IDList != "" {
+			unmapped = append(unmapped, "list")
+		}
+		if c.Start != nil {
+			unmapped = append(unmapped, "start")
+		}
+		if len(c.IDChecklists) > 0 {
+			unmapped = append(unmapped, "checklists")
+		}
+		if len(c.IDMembers) > 0 {
+			unmapped = append(unmapped, "members")
+		}
+		if c.Badges.Comments > 0 {
+			unmapped = append(unmapped, "comments")
+		}
+		if c.Badges.Attachments > 0 {
+			unmapped = append(unmapped, "attachments")
+		}
+		out.Add(item, unmapped...)
+	}
+	return out, nil
+}
+
+// 名前のないラベルは色をタグ名として使う
+func labelName(l label) string {
+	if l.Name != "" {
+		return l.Name
+	}
+	return l.Color
+}
@@ -0,0 +1,82 @@
+package trello
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/internal/importer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const boardJSON = `{
+  "id": "b1",
+  "name": "Launch",
+  "lists": [
+    {"id": "l1", "name": "Doing", "closed": false},
+    {"id": "l2", "name": "Old", "closed": true}
+  ],
+  "labels": [
+    {"id": "g1", "name": "", "color": "green"},
+    {"id": "r1", "name": "blocker", "color": "red"}
+  ],
+  "cards": [
+    {"id": "c1", "name": "Write copy", "desc": "landing page", "idList": "l1",
+     "due": "2026-11-02T10:00:00.000Z", "dueComplete": true,
+     "idLabels": ["g1", "r1"], "labels": [{"id": "g1", "name": "", "color": "green"}, {"id": "r1", "name": "blocker", "color": "red"}],
+     "idChecklists": ["k1"], "idMembers": ["m1"], "badges": {"comments": 2, "attachments": 0}},
+    {"id": "c2", "name": "Pick date", "idList": "l1", "idLabels": ["r1"], "start": "2026-10-20T00:00:00.000Z"},
+    {"id": "c3", "name": "Archived card", "idList": "l1", "closed": true},
+    {"id": "c4", "name": "In archived list", "idList": "l2"}
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	t.Run("正常系: カードをボード名のプロジェクトとラベルのタグで読み込む", func(t *testing.T) {
+		got, err := Parse(strings.NewReader(boardJSON))
+
+		require.NoError(t, err)
+		require.Len(t, got.Items, 2)
+
+		due := time.Date(2026, 11, 2, 10, 0, 0, 0, time.UTC)
+		assert.Equal(t, "c1", got.Items[0].SourceID)
+		assert.Equal(t, "Write copy", got.Items[0].Title)
+		assert.Equal(t, "landing page", *got.Items[0].Description)
+		assert.True(t, got.Items[0].Completed)
+		assert.True(t, due.Equal(*got.Items[0].DueAt))
+		assert.Equal(t, "Launch", got.Items[0].Project)
+		// 名前のないラベルは色をタグ名にする
+		assert.Equal(t, []string{"green", "blocker"}, got.Items[0].Tags)
+
+		// labels がない場合は idLabels からボードのラベルを引く
+		assert.Equal(t, importer.ExternalItem{
+			SourceID: "c2",
+			Title:    "Pick date",
+			Project:  "Launch",
+			Tags:     []string{"blocker"},
+		}, got.Items[1])
+
+		assert.Equal(t, []importer.SkippedItem{
+			{SourceID: "c3", Reason: "archived"},
+			{SourceID: "c4", Reason: "archived"},
+		}, got.Skipped)
+		assert.Equal(t, map[string]int{
+			"list": 2, "start": 1, "checklists": 1, "members": 1, "comments": 1,
+		}, got.Unmapped)
+	})
+
+	t.Run("異常系: ボードのエクスポートでない場合はErrInvalidExport", func(t *testing.T) {
+		for _, input := range []string{
+			`{"items": []}`,
+			`[{"id": "c1"}]`,
+			`not json`,
+			`{"id": "b1", "cards": [{"id": "c1", "due": "tomorrow"}]}`,
+		} {
+			_, err := Parse(strings.NewReader(input))
+
+			assert.ErrorIs(t, err, importer.ErrInvalidExport, input)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"encoding/json"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/gen"
+	"go-todo/internal/service"
+)
+
+func BulkTodoFilterFromRequest(f gen.BulkTodoFilter) service.BulkTodoFilter {
+	filter := service.BulkTodoFilter{
+		CreatedBefore: f.CreatedBefore,
+		Completed:     f.Completed,
+		ProjectID:     f.ProjectId,
+	}
+	if f.Ids != nil {
+		filter.IDs = *f.Ids
+	}
+	return filter
+}
+
+func JobToResponse(j *sqlc.Job) gen.Job {
+	resp := gen.Job{
+		Id:              j.ID,
+		Type:            j.Type,
+		Status:          gen.JobStatus(j.Status),
+		Total:           j.Total,
+		Processed:       j.Processed,
+		CancelRequested: j.CancelRequested,
+		Error:           j.Error,
+		CreatedAt:       j.CreatedAt,
+	}
+	if len(j.Result) > 0 {
+		var result map[string]interface{}
+		if err := json.Unmarshal(j.Result, &result); err == nil {
+			resp.Result = &result
+		}
+	}
+	if j.StartedAt.Valid {
+		resp.StartedAt = &j.StartedAt.Time
+	}
+	if j.FinishedAt.Valid {
+		resp.FinishedAt = &j.FinishedAt.Time
+	}
+	return resp
+}
@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"go-todo/db/sqlc"
+	"go-todo/internal/gen"
+)
+
+func PersonalAccessTokenToResponse(t *sqlc.PersonalAccessToken) gen.PersonalAccessToken {
+	resp := gen.PersonalAccessToken{
+		Id:        t.ID,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+	}
+	if t.LastUsedAt.Valid {
+		resp.LastUsedAt = &t.LastUsedAt.Time
+	}
+	return resp
+}
+
+func PersonalAccessTokensToResponse(tokens []sqlc.PersonalAccessToken) []gen.PersonalAccessToken {
+	result := make([]gen.PersonalAccessToken, len(tokens))
+	for i := range tokens {
+		result[i] = PersonalAccessTokenToResponse(&tokens[i])
+	}
+	return result
+}
@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"go-todo/db/sqlc"
+	"go-todo/internal/gen"
+)
+
+func ProjectToResponse(p *sqlc.Project) gen.Project {
+	return gen.Project{
+		Id:        p.ID,
+		Name:      p.Name,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+func ProjectsToResponse(projects []sqlc.Project) []gen.Project {
+	result := make([]gen.Project, len(projects))
+	for i := range projects {
+		result[i] = ProjectToResponse(&projects[i])
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package mapper
+
+import (
+	"go-todo/db/sqlc"
+	"go-todo/internal/gen"
+	"go-todo/internal/service"
+)
+
+func ReminderToResponse(r *service.ScheduledReminder) gen.Reminder {
+	resp := gen.Reminder{
+		Id:            r.ID,
+		TodoId:        r.TodoID,
+		Channel:       gen.ReminderChannel(r.Channel),
+		OffsetMinutes: r.OffsetMinutes,
+		ScheduledAt:   r.ScheduledAt,
+		Status:        gen.ReminderStatus(r.Status),
+		Attempts:      r.Attempts,
+		LastError:     r.LastError,
+		CreatedAt:     r.CreatedAt,
+	}
+	if r.RemindAt.Valid {
+		resp.RemindAt = &r.RemindAt.Time
+	}
+	if r.FiredAt.Valid {
+		resp.FiredAt = &r.FiredAt.Time
+	}
+	return resp
+}
+
+func RemindersToResponse(reminders []service.ScheduledReminder) []gen.Reminder {
+	result := make([]gen.Reminder, len(reminders))
+	for i := range reminders {
+		result[i] = ReminderToResponse(&reminders[i])
+	}
+	return result
+}
+
+func ReminderInputFromRequest(r gen.CreateReminderRequest) service.ReminderInput {
+	return service.ReminderInput{
+		Channel:       service.ReminderChannel(r.Channel),
+		RemindAt:      r.RemindAt,
+		OffsetMinutes: r.OffsetMinutes,
+	}
+}
+
+func NotificationToResponse(n *sqlc.Notification) gen.Notification {
+	resp := gen.Notification{
+		Id:        n.ID,
+		Type:      gen.NotificationType(n.Type),
+		Title:     n.Title,
+		Body:      n.Body,
+		TodoId:    n.TodoID,
+		CreatedAt: n.CreatedAt,
+	}
+	if n.ReadAt.Valid {
+		resp.ReadAt = &n.ReadAt.Time
+	}
+	return resp
+}
+
+func NotificationsToResponse(notifications []sqlc.Notification) []gen.Notification {
+	result := make([]gen.Notification, len(notifications))
+	for i := range notifications {
+		result[i] = NotificationToResponse(&notifications[i])
+	}
+	return result
+}
+
+func NotificationPageToResponse(p *service.NotificationPage) gen.NotificationPage {
+	resp := gen.NotificationPage{
+		Notifications: NotificationsToResponse(p.Notifications),
+	}
+	if p.NextCursor != "" {
+		resp.NextCursor = &p.NextCursor
+	}
+	return resp
+}
+
+func NotificationPreferencesToResponse(prefs []service.NotificationPreference) gen.NotificationPreferences {
+	result := make([]gen.NotificationPreference, len(prefs))
+	for i, p := range prefs {
+		result[i] = gen.NotificationPreference{
+			Type:    gen.NotificationPreferenceType(p.Type),
+			Enabled: p.Enabled,
+		}
+	}
+	return gen.NotificationPreferences{Preferences: result}
+}
+
+func NotificationPreferencesFromRequest(r gen.NotificationPreferences) []service.NotificationPreference {
+	result := make([]service.NotificationPreference, len(r.Preferences))
+	for i, p := range r.Preferences {
+		result[i] = service.NotificationPreference{
+			Type:    service.NotificationType(p.Type),
+			Enabled: p.Enabled,
+		}
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"go-todo/db/sqlc"
+	"go-todo/internal/gen"
+	"go-todo/internal/service"
+)
+
+func StatusToResponse(s *sqlc.Status) gen.Status {
+	return gen.Status{
+		Id:        s.ID,
+		Name:      s.Name,
+		SortOrder: s.SortOrder,
+		WipLimit:  s.WipLimit,
+		IsDone:    s.IsDone,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func StatusesToResponse(statuses []sqlc.Status) []gen.Status {
+	result := make([]gen.Status, len(statuses))
+	for i := range statuses {
+		result[i] = StatusToResponse(&statuses[i])
+	}
+	return result
+}
+
+func StatusPatchFromRequest(r gen.UpdateStatusRequest) service.StatusPatch {
+	return service.StatusPatch{
+		Name:      r.Name,
+		SortOrder: r.SortOrder,
+		WIPLimit:  r.WipLimit,
+		IsDone:    r.IsDone,
+	}
+}
+
+func BoardToResponse(b *service.Board) gen.Board {
+	columns := make([]gen.BoardColumn, len(b.Columns))
+	for i := range b.Columns {
+		columns[i] = gen.BoardColumn{
+			Status: StatusToResponse(&b.Columns[i].Status),
+			Todos:  TodosToResponse(b.Columns[i].Todos),
+		}
+	}
+	return gen.Board{Columns: columns}
+}
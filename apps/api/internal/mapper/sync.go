@@ -0,0 +1,81 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go-todo/internal/gen"
+	"go-todo/internal/service"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// description は省略（変更なし）と null（削除）を区別するため、JSONのまま受け取って変換する
+func SyncOperationsFromRequest(ops []gen.SyncOperation) ([]service.SyncOperation, error) {
+	result := make([]service.SyncOperation, len(ops))
+	for i, op := range ops {
+		result[i] = service.SyncOperation{
+			ClientID:  pgtype.UUID{Bytes: op.ClientId, Valid: op.ClientId != openapi_types.UUID{}},
+			Op:        service.SyncOpType(op.Op),
+			UpdatedAt: op.UpdatedAt,
+			Title:     op.Title,
+			Completed: op.Completed,
+			Force:     op.Force != nil && *op.Force,
+		}
+		switch {
+		case op.Description == nil:
+		case string(op.Description) == "null":
+			result[i].ClearDescription = true
+		default:
+			var description string
+			if err := json.Unmarshal(op.Description, &description); err != nil {
+				return nil, fmt.Errorf("operations[%d].description: %w", i, err)
+			}
+			result[i].Description = &description
+		}
+	}
+	return result, nil
+}
+
+func SyncResultToResponse(r *service.SyncResult) gen.SyncResponse {
+	changes := make([]gen.SyncChange, len(r.Changes))
+	for i := range r.Changes {
+		changes[i] = gen.SyncChange{
+			Todo:      TodoToResponse(&r.Changes[i]),
+			Deleted:   r.Changes[i].DeletedAt.Valid,
+			ChangeSeq: r.Changes[i].ChangeSeq,
+		}
+	}
+
+	conflicts := make([]gen.SyncConflict, len(r.Conflicts))
+	for i, c := range r.Conflicts {
+		conflicts[i] = gen.SyncConflict{
+			ClientId:    openapi_types.UUID(c.ClientID.Bytes),
+			Field:       gen.SyncConflictField(c.Field),
+			ClientValue: c.ClientValue,
+			ServerValue: c.ServerValue,
+			Resolution:  gen.SyncConflictResolution(c.Resolution),
+		}
+	}
+
+	results := make([]gen.SyncOperationResult, len(r.Results))
+	for i, res := range r.Results {
+		results[i] = gen.SyncOperationResult{
+			ClientId: openapi_types.UUID(res.ClientID.Bytes),
+			Status:   gen.SyncOperationResultStatus(res.Status),
+			Id:       res.TodoID,
+		}
+		if res.Error != "" {
+			results[i].Error = &res.Error
+		}
+	}
+
+	return gen.SyncResponse{
+		SyncToken: r.SyncToken,
+		Changes:   changes,
+		HasMore:   r.HasMore,
+		Conflicts: conflicts,
+		Results:   results,
+	}
+}
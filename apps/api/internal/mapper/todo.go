@@ -3,11 +3,15 @@ package mapper
 import (
 	"go-todo/db/sqlc"
 	"go-todo/internal/gen"
+	"go-todo/internal/importer"
+	"go-todo/internal/quickadd"
 	"go-todo/internal/service"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 func TodoToResponse(t *sqlc.Todo) gen.Todo {
-	return gen.Todo{
+	resp := gen.Todo{
 		Id:          t.ID,
 		Title:       t.Title,
 		Description: t.Description,
@@ -15,7 +19,52 @@ func TodoToResponse(t *sqlc.Todo) gen.Todo {
 		UserId:      t.UserID,
 		CreatedAt:   t.CreatedAt,
 		UpdatedAt:   t.UpdatedAt,
+		Version:     t.Version,
+		ClientId:    openapi_types.UUID(t.ClientID.Bytes),
+		Position:    t.Position,
+		StatusId:    t.StatusID,
+		ProjectId:   t.ProjectID,
+		Tags:        t.Tags,
+		Recurrence:  t.Recurrence,
+	}
+	if resp.Tags == nil {
+		resp.Tags = []string{}
+	}
+	if t.DueAt.Valid {
+		resp.DueAt = &t.DueAt.Time
+	}
+	if t.Priority != nil {
+		priority := gen.TodoPriority(*t.Priority)
+		resp.Priority = &priority
+	}
+	return resp
+}
+
+func QuickAddPreviewToResponse(r *quickadd.Result) gen.QuickAddPreview {
+	resp := gen.QuickAddPreview{
+		Title:       r.Title,
+		DueAt:       r.DueAt,
+		AllDay:      r.AllDay,
+		Tags:        r.Tags,
+		Ambiguities: r.Ambiguities,
+	}
+	if resp.Tags == nil {
+		resp.Tags = []string{}
 	}
+	if resp.Ambiguities == nil {
+		resp.Ambiguities = []string{}
+	}
+	if r.Priority != "" {
+		priority := gen.QuickAddPreviewPriority(r.Priority)
+		resp.Priority = &priority
+	}
+	if r.Project != "" {
+		resp.Project = &r.Project
+	}
+	if r.Recurrence != "" {
+		resp.Recurrence = &r.Recurrence
+	}
+	return resp
 }
 
 func TodosToResponse(todos []sqlc.Todo) []gen.Todo {
@@ -26,13 +75,135 @@ func TodosToResponse(todos []sqlc.Todo) []gen.Todo {
 	return result
 }
 
+func TodoRefsToResponse(todos []sqlc.Todo) []gen.TodoRef {
+	result := make([]gen.TodoRef, len(todos))
+	for i, t := range todos {
+		result[i] = gen.TodoRef{
+			Id:        t.ID,
+			Title:     t.Title,
+			Completed: t.Completed,
+		}
+	}
+	return result
+}
+
+func TodoDependenciesToResponse(d *service.TodoDependencies) gen.TodoDependencies {
+	return gen.TodoDependencies{
+		Blockers:   TodoRefsToResponse(d.Blockers),
+		Dependents: TodoRefsToResponse(d.Dependents),
+	}
+}
+
 func BatchFailedItemsToResponse(items []service.BatchFailedItem) []gen.BatchFailedItem {
 	result := make([]gen.BatchFailedItem, len(items))
 	for i, item := range items {
 		result[i] = gen.BatchFailedItem{
 			Id:    item.ID,
+			Code:  gen.BatchFailedItemCode(item.Code),
+			Error: item.Error,
+		}
+	}
+	return result
+}
+
+func BatchWarningsToResponse(items []service.BatchWarning) []gen.BatchWarning {
+	result := make([]gen.BatchWarning, len(items))
+	for i, item := range items {
+		result[i] = gen.BatchWarning{
+			Id:      item.ID,
+			Code:    gen.BatchWarningCode(item.Code),
+			Message: item.Message,
+		}
+	}
+	return result
+}
+
+func BatchUpdateChangesToResponse(changes []service.BatchUpdateChange) []gen.BatchUpdateChange {
+	result := make([]gen.BatchUpdateChange, len(changes))
+	for i, c := range changes {
+		fields := make([]gen.BatchUpdateChangeFields, len(c.Fields))
+		for j, f := range c.Fields {
+			fields[j] = gen.BatchUpdateChangeFields(f)
+		}
+		result[i] = gen.BatchUpdateChange{
+			Id:     c.ID,
+			Fields: fields,
+		}
+	}
+	return result
+}
+
+func BatchCreateItemsFromRequest(items []gen.CreateTodoRequest) []service.BatchCreateItem {
+	result := make([]service.BatchCreateItem, len(items))
+	for i, item := range items {
+		result[i] = service.BatchCreateItem{
+			Title:       item.Title,
+			Description: item.Description,
+		}
+	}
+	return result
+}
+
+func BatchCreateResultToResponse(r *service.BatchCreateResult) gen.BatchCreateResponse {
+	succeeded := make([]gen.BatchCreatedItem, len(r.Succeeded))
+	for i := range r.Succeeded {
+		succeeded[i] = gen.BatchCreatedItem{
+			Index: r.Succeeded[i].Index,
+			Todo:  TodoToResponse(&r.Succeeded[i].Todo),
+		}
+	}
+	failed := make([]gen.BatchCreateFailedItem, len(r.Failed))
+	for i, item := range r.Failed {
+		failed[i] = gen.BatchCreateFailedItem{
+			Index: item.Index,
 			Error: item.Error,
 		}
 	}
+	return gen.BatchCreateResponse{
+		Succeeded: succeeded,
+		Failed:    failed,
+	}
+}
+
+func ImportResultToResponse(r *service.ImportResult) gen.ImportResponse {
+	resp := gen.ImportResponse{
+		Imported: r.Imported,
+		Failed:   r.Failed,
+		Skipped:  r.Skipped,
+		Errors:   ImportLineErrorsToResponse(r.Errors),
+	}
+	if r.Items != nil {
+		items := ImportPreviewItemsToResponse(r.Items)
+		resp.Items = &items
+	}
+	return resp
+}
+
+func ImportPreviewItemsToResponse(items []importer.Item) []gen.ImportPreviewItem {
+	result := make([]gen.ImportPreviewItem, len(items))
+	for i, item := range items {
+		result[i] = gen.ImportPreviewItem{
+			Line:        item.Line,
+			Title:       item.Title,
+			Description: item.Description,
+			Completed:   item.Completed,
+			DueAt:       item.DueAt,
+		}
+		if len(item.Metadata) > 0 {
+			metadata := item.Metadata
+			result[i].Metadata = &metadata
+		}
+	}
+	return result
+}
+
+func ImportLineErrorsToResponse(errs []importer.LineError) []gen.ImportLineError {
+	result := make([]gen.ImportLineError, len(errs))
+	for i, e := range errs {
+		result[i] = gen.ImportLineError{
+			Line:    e.Line,
+			Message: e.Message,
+		}
+	}
 	return result
 }
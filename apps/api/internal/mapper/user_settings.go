@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"time"
+
+	"go-todo/internal/gen"
+	"go-todo/internal/service"
+)
+
+func UserSettingsToResponse(s *service.UserSettings) gen.UserSettings {
+	return gen.UserSettings{
+		Timezone:         s.Timezone,
+		Locale:           s.Locale,
+		WeekStart:        int(s.WeekStart),
+		DefaultSort:      gen.UserSettingsDefaultSort(s.DefaultSort),
+		DefaultProjectId: s.DefaultProjectID,
+		Notifications:    NotificationPreferencesToResponse(s.Notifications).Preferences,
+	}
+}
+
+func UserSettingsPatchFromRequest(r *gen.UpdateUserSettingsRequest) service.UserSettingsPatch {
+	patch := service.UserSettingsPatch{
+		Timezone:         r.Timezone,
+		Locale:           r.Locale,
+		DefaultProjectID: r.DefaultProjectId,
+	}
+	if r.WeekStart != nil {
+		weekStart := time.Weekday(*r.WeekStart)
+		patch.WeekStart = &weekStart
+	}
+	if r.DefaultSort != nil {
+		sort := service.TodoSort(*r.DefaultSort)
+		patch.DefaultSort = &sort
+	}
+	if r.Notifications != nil {
+		patch.Notifications = NotificationPreferencesFromRequest(gen.NotificationPreferences{Preferences: *r.Notifications})
+	}
+	return patch
+}
@@ -0,0 +1,173 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/internal/notify"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// テスト用の最小限のSMTPサーバー
+type stubServer struct {
+	addr     string
+	from     string
+	rcpt     []string
+	data     string
+	rejectTo string
+	done     chan struct{}
+}
+
+func startStubServer(t *testing.T) *stubServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	s := &stubServer{addr: ln.Addr().String(), done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(textproto.NewConn(conn))
+	}()
+	return s
+}
+
+func (s *stubServer) serve(c *textproto.Conn) {
+	_ = c.PrintfLine("220 stub ESMTP")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = c.PrintfLine("250 stub")
+		case "MAIL":
+			s.from = strings.TrimSuffix(strings.TrimPrefix(line[len("MAIL FROM:"):], "<"), ">")
+			_ = c.PrintfLine("250 OK")
+		case "RCPT":
+			to := strings.TrimSuffix(strings.TrimPrefix(line[len("RCPT TO:"):], "<"), ">")
+			if to == s.rejectTo {
+				_ = c.PrintfLine("550 no such user")
+				continue
+			}
+			s.rcpt = append(s.rcpt, to)
+			_ = c.PrintfLine("250 OK")
+		case "DATA":
+			_ = c.PrintfLine("354 go ahead")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.data = string(data)
+			_ = c.PrintfLine("250 queued")
+		case "QUIT":
+			_ = c.PrintfLine("221 bye")
+			return
+		default:
+			_ = c.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func (s *stubServer) config(t *testing.T) Config {
+	host, port, err := net.SplitHostPort(s.addr)
+	require.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return Config{Host: host, Port: p, From: "todo@example.com", Timeout: 5 * time.Second}
+}
+
+func TestSender_Send(t *testing.T) {
+	due := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	msg := notify.Message{
+		ReminderID:  42,
+		UserID:      1,
+		Email:       "alice@example.com",
+		Name:        "Alice",
+		TodoID:      7,
+		TodoTitle:   "牛乳を買う",
+		DueAt:       &due,
+		ScheduledAt: due.Add(-time.Hour),
+	}
+
+	t.Run("正常系: SMTPサーバーにメールを送信する", func(t *testing.T) {
+		server := startStubServer(t)
+
+		err := NewSender(server.config(t)).Send(context.Background(), msg)
+		require.NoError(t, err)
+		<-server.done
+
+		assert.Equal(t, "todo@example.com", server.from)
+		assert.Equal(t, []string{"alice@example.com"}, server.rcpt)
+		assert.Contains(t, server.data, "To: Alice <alice@example.com>\n")
+		assert.Contains(t, server.data, "Subject: =?utf-8?q?")
+		assert.Contains(t, server.data, "Message-ID: <reminder-42@example.com>\n")
+		assert.Contains(t, server.data, "is due at 2026-10-20T09:00:00Z.")
+	})
+
+	t.Run("異常系: 宛先が拒否された", func(t *testing.T) {
+		server := startStubServer(t)
+		server.rejectTo = "alice@example.com"
+
+		err := NewSender(server.config(t)).Send(context.Background(), msg)
+
+		assert.ErrorContains(t, err, "rcpt to")
+	})
+
+	t.Run("異常系: メールアドレスがない", func(t *testing.T) {
+		noEmail := msg
+		noEmail.Email = ""
+
+		err := NewSender(Config{Host: "127.0.0.1", Port: 1, Timeout: time.Second}).Send(context.Background(), noEmail)
+
+		assert.ErrorContains(t, err, "no email address")
+	})
+
+	t.Run("異常系: SMTPサーバーに接続できない", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := ln.Addr().(*net.TCPAddr)
+		ln.Close()
+
+		err = NewSender(Config{Host: "127.0.0.1", Port: addr.Port, Timeout: time.Second}).Send(context.Background(), msg)
+
+		assert.ErrorContains(t, err, "dial smtp")
+	})
+}
+
+func TestBuildMessage(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	msg := notify.Message{ReminderID: 3, Email: "bob@example.com", TodoTitle: "Report"}
+
+	got := string(buildMessage("noreply@todo.test", msg, now))
+
+	lines := readHeaders(t, got)
+	assert.Equal(t, "noreply@todo.test", lines.Get("From"))
+	assert.Equal(t, "bob@example.com", lines.Get("To"))
+	assert.Equal(t, "Reminder: Report", lines.Get("Subject"))
+	assert.Equal(t, "<reminder-3@todo.test>", lines.Get("Message-Id"))
+	assert.Equal(t, now.Format(time.RFC1123Z), lines.Get("Date"))
+	assert.True(t, strings.HasSuffix(got, "\r\n\r\n\"Report\" is waiting for you.\r\n"))
+}
+
+func readHeaders(t *testing.T, raw string) textproto.MIMEHeader {
+	t.Helper()
+	header, err := textproto.NewReader(bufio.NewReader(strings.NewReader(raw))).ReadMIMEHeader()
+	require.NoError(t, err)
+	return header
+}
@@ -0,0 +1,43 @@
+// Package notify はリマインダーを配信するチャネルの共通インターフェースを定義する
+//
+// チャネルごとの実装はサブパッケージ（email, webhook）に置き、アプリ内通知はサービス層で実装する。
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// 配信する1件分のリマインダー
+type Message struct {
+	// 同じリマインダーの再送を受信側で判別するためのID
+	ReminderID int64
+	UserID     int64
+	Email      string
+	Name       string
+	TodoID     int64
+	TodoTitle  string
+	DueAt      *time.Time
+	// 本来配信する予定だった時刻（停止中に過ぎたリマインダーは現在時刻より前になる）
+	ScheduledAt time.Time
+}
+
+// リマインダーの配信チャネル
+// 配信に失敗した場合はエラーを返す（スケジューラーが再試行する）
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// 通知の件名
+func (m Message) Subject() string {
+	return fmt.Sprintf("Reminder: %s", m.TodoTitle)
+}
+
+// 通知の本文
+func (m Message) Body() string {
+	if m.DueAt == nil {
+		return fmt.Sprintf("%q is waiting for you.", m.TodoTitle)
+	}
+	return fmt.Sprintf("%q is due at %s.", m.TodoTitle, m.DueAt.UTC().Format(time.RFC3339))
+}
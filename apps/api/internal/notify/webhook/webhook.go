@@ -0,0 +1,96 @@
+// Package webhook はリマインダーをJSONでWebhookに送信する
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go-todo/internal/notify"
+)
+
+// 署名ヘッダー。値は "sha256=" に本文のHMAC-SHA256を16進数で続けたもの
+const SignatureHeader = "X-Todo-Signature"
+
+type Config struct {
+	URL string
+	// 空の場合は署名しない
+	Secret  string
+	Timeout time.Duration
+}
+
+// Webhookにリマインダーを送信する notify.Sender
+type Sender struct {
+	cfg    Config
+	client *http.Client
+}
+
+func NewSender(cfg Config) *Sender {
+	return &Sender{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Webhookに送信する本文
+type payload struct {
+	Event       string     `json:"event"`
+	ReminderID  int64      `json:"reminder_id"`
+	UserID      int64      `json:"user_id"`
+	TodoID      int64      `json:"todo_id"`
+	TodoTitle   string     `json:"todo_title"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+}
+
+func (s *Sender) Send(ctx context.Context, msg notify.Message) error {
+	body, err := json.Marshal(payload{
+		Event:       "reminder",
+		ReminderID:  msg.ReminderID,
+		UserID:      msg.UserID,
+		TodoID:      msg.TodoID,
+		TodoTitle:   msg.TodoTitle,
+		DueAt:       msg.DueAt,
+		ScheduledAt: msg.ScheduledAt,
+	})
+	if err != nil {
+		return fmt.Errorf("encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// 再送時も同じ値になるため、受信側で重複を除ける
+	req.Header.Set("Idempotency-Key", "reminder-"+strconv.FormatInt(msg.ReminderID, 10))
+	if s.cfg.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(s.cfg.Secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// 本文の署名を返す（受信側での検証にも使える）
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
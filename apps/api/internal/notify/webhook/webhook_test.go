@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go-todo/internal/notify"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSender_Send(t *testing.T) {
+	scheduled := time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC)
+	msg := notify.Message{
+		ReminderID:  42,
+		UserID:      1,
+		TodoID:      7,
+		TodoTitle:   "Write report",
+		ScheduledAt: scheduled,
+	}
+
+	t.Run("正常系: 署名付きのJSONを送信する", func(t *testing.T) {
+		var got *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sender := NewSender(Config{URL: server.URL, Secret: "s3cret", Timeout: time.Second})
+		err := sender.Send(context.Background(), msg)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, got.Method)
+		assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+		assert.Equal(t, "reminder-42", got.Header.Get("Idempotency-Key"))
+		assert.Equal(t, Sign("s3cret", body), got.Header.Get(SignatureHeader))
+
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal(body, &decoded))
+		assert.Equal(t, "reminder", decoded["event"])
+		assert.Equal(t, float64(7), decoded["todo_id"])
+		assert.Equal(t, "2026-10-20T08:00:00Z", decoded["scheduled_at"])
+		assert.NotContains(t, decoded, "due_at")
+	})
+
+	t.Run("正常系: シークレットがなければ署名しない", func(t *testing.T) {
+		var signature string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			signature = r.Header.Get(SignatureHeader)
+		}))
+		defer server.Close()
+
+		err := NewSender(Config{URL: server.URL, Timeout: time.Second}).Send(context.Background(), msg)
+
+		require.NoError(t, err)
+		assert.Empty(t, signature)
+	})
+
+	t.Run("異常系: 2xx以外のレスポンス", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		err := NewSender(Config{URL: server.URL, Timeout: time.Second}).Send(context.Background(), msg)
+
+		assert.ErrorContains(t, err, "status 502")
+	})
+}
+
+func TestSign(t *testing.T) {
+	// printf '{}' | openssl dgst -sha256 -hmac key
+	assert.Equal(t, "sha256=a777724d943eb48dc69bca8a4a6d57a04db3f9ec7e1de4e581e860265bdf3032", Sign("key", []byte("{}")))
+	assert.NotEqual(t, Sign("key", []byte("{}")), Sign("other", []byte("{}")))
+}
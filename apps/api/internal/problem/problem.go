@@ -0,0 +1,138 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go-todo/internal/gen"
+	"go-todo/internal/i18n"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RFC 7807のエラーレスポンスのContent-Type
+const ContentType = "application/problem+json"
+
+// エラーコードから type のURIを作る接頭辞
+const typePrefix = "urn:go-todo:problem:"
+
+type requestKey struct{}
+
+type request struct {
+	instance  string
+	requestID string
+}
+
+// エラーレスポンスの instance と request_id に入れる値をコンテキストに設定する
+func WithRequest(ctx context.Context, instance, requestID string) context.Context {
+	return context.WithValue(ctx, requestKey{}, request{instance: instance, requestID: requestID})
+}
+
+// エラーコードからエラーレスポンスを作る。title はコンテキストの言語に翻訳する
+func New(ctx context.Context, status int, code gen.ErrorCode, args ...any) gen.Problem {
+	p := gen.Problem{
+		Type:   typePrefix + string(code),
+		Title:  i18n.Message(ctx, code, args...),
+		Status: status,
+		Code:   code,
+	}
+	if r, ok := ctx.Value(requestKey{}).(request); ok {
+		if r.instance != "" {
+			p.Instance = &r.instance
+		}
+		if r.requestID != "" {
+			p.RequestId = &r.requestID
+		}
+	}
+	return p
+}
+
+// Echoのハンドラーやミドルウェアから返すエラー
+// エラーハンドラーが FromError で application/problem+json のレスポンスにする
+type Error struct {
+	Status int
+	Code   gen.ErrorCode
+	// 翻訳しない補足（detail）
+	Detail string
+	// 項目ごとのエラー（errors）
+	Errors []gen.FieldError
+}
+
+func NewError(status int, code gen.ErrorCode) *Error {
+	return &Error{Status: status, Code: code}
+}
+
+func (e *Error) Error() string {
+	if e.Detail != "" {
+		return fmt.Sprintf("%d %s: %s", e.Status, e.Code, e.Detail)
+	}
+	return fmt.Sprintf("%d %s", e.Status, e.Code)
+}
+
+// エラーをエラーレスポンスにする
+// *Error はそのまま、*echo.HTTPError はステータスコードからエラーコードを決め、それ以外は 500 にする
+func FromError(ctx context.Context, err error) gen.Problem {
+	var pe *Error
+	if errors.As(err, &pe) {
+		p := New(ctx, pe.Status, pe.Code)
+		if pe.Detail != "" {
+			p.Detail = &pe.Detail
+		}
+		if len(pe.Errors) > 0 {
+			p.Errors = &pe.Errors
+		}
+		return p
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		p := New(ctx, he.Code, CodeForStatus(he.Code))
+		// Echoやoapi-codegenが返すエラーの理由（パラメータの形式の誤りなど）を detail に入れる
+		if m, ok := he.Message.(string); ok && m != "" && m != http.StatusText(he.Code) {
+			p.Detail = &m
+		}
+		return p
+	}
+
+	return New(ctx, http.StatusInternalServerError, gen.ErrorCodeInternalError)
+}
+
+// エラーコードが決まっていないエラーのステータスコードに対応するエラーコード
+func CodeForStatus(status int) gen.ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return gen.ErrorCodeBadRequest
+	case http.StatusUnauthorized:
+		return gen.ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return gen.ErrorCodeForbidden
+	case http.StatusNotFound:
+		return gen.ErrorCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return gen.ErrorCodeMethodNotAllowed
+	case http.StatusConflict:
+		return gen.ErrorCodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return gen.ErrorCodeRequestTooLarge
+	case http.StatusUnsupportedMediaType:
+		return gen.ErrorCodeUnsupportedContentType
+	case http.StatusTooManyRequests:
+		return gen.ErrorCodeTooManyRequests
+	case http.StatusServiceUnavailable:
+		return gen.ErrorCodeServiceUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return gen.ErrorCodeInternalError
+	}
+	return gen.ErrorCodeBadRequest
+}
+
+// エラーレスポンスを application/problem+json で書き出す
+func Write(c echo.Context, p gen.Problem) error {
+	c.Response().Header().Set(echo.HeaderContentType, ContentType)
+	c.Response().WriteHeader(p.Status)
+	return json.NewEncoder(c.Response()).Encode(p)
+}
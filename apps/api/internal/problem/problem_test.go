@@ -0,0 +1,118 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-todo/internal/gen"
+	"go-todo/internal/i18n"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("正常系: コードから type と翻訳した title を作る", func(t *testing.T) {
+		ctx := i18n.WithPreference(context.Background(), "ja", nil)
+		ctx = WithRequest(ctx, "/todos/1", "req-1")
+
+		got := New(ctx, http.StatusNotFound, gen.ErrorCodeTodoNotFound)
+
+		assert.Equal(t, "urn:go-todo:problem:todo_not_found", got.Type)
+		assert.Equal(t, "Todoが見つかりません", got.Title)
+		assert.Equal(t, http.StatusNotFound, got.Status)
+		assert.Equal(t, gen.ErrorCodeTodoNotFound, got.Code)
+		require.NotNil(t, got.Instance)
+		assert.Equal(t, "/todos/1", *got.Instance)
+		require.NotNil(t, got.RequestId)
+		assert.Equal(t, "req-1", *got.RequestId)
+	})
+
+	t.Run("正常系: リクエストの情報がなければ instance と request_id を省略する", func(t *testing.T) {
+		got := New(context.Background(), http.StatusBadRequest, gen.ErrorCodeTooManyIds, 100)
+
+		assert.Equal(t, "Too many IDs (max 100)", got.Title)
+		assert.Nil(t, got.Instance)
+		assert.Nil(t, got.RequestId)
+	})
+}
+
+func TestFromError(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: Error の detail と項目ごとのエラーを引き継ぐ", func(t *testing.T) {
+		fieldErrors := []gen.FieldError{{In: gen.FieldErrorInQuery, Field: "export", Message: "must be a boolean"}}
+		err := &Error{Status: http.StatusBadRequest, Code: gen.ErrorCodeValidationFailed, Detail: "bad export", Errors: fieldErrors}
+
+		got := FromError(ctx, err)
+
+		assert.Equal(t, http.StatusBadRequest, got.Status)
+		assert.Equal(t, gen.ErrorCodeValidationFailed, got.Code)
+		require.NotNil(t, got.Detail)
+		assert.Equal(t, "bad export", *got.Detail)
+		require.NotNil(t, got.Errors)
+		assert.Equal(t, fieldErrors, *got.Errors)
+	})
+
+	t.Run("正常系: echo.HTTPError はステータスコードからコードを決める", func(t *testing.T) {
+		tests := []struct {
+			err        error
+			wantCode   gen.ErrorCode
+			wantDetail *string
+		}{
+			{err: echo.ErrNotFound, wantCode: gen.ErrorCodeNotFound},
+			{err: echo.ErrMethodNotAllowed, wantCode: gen.ErrorCodeMethodNotAllowed},
+			{err: echo.ErrUnauthorized, wantCode: gen.ErrorCodeUnauthorized},
+			{err: echo.ErrStatusRequestEntityTooLarge, wantCode: gen.ErrorCodeRequestTooLarge},
+			{err: echo.NewHTTPError(http.StatusTeapot), wantCode: gen.ErrorCodeBadRequest},
+			{err: echo.NewHTTPError(http.StatusBadGateway), wantCode: gen.ErrorCodeInternalError},
+			{
+				err:        echo.NewHTTPError(http.StatusBadRequest, "Invalid format for parameter id"),
+				wantCode:   gen.ErrorCodeBadRequest,
+				wantDetail: ptrString("Invalid format for parameter id"),
+			},
+		}
+		for _, tt := range tests {
+			got := FromError(ctx, tt.err)
+
+			assert.Equal(t, tt.wantCode, got.Code, tt.err.Error())
+			assert.Equal(t, tt.wantDetail, got.Detail, tt.err.Error())
+		}
+	})
+
+	t.Run("異常系: それ以外のエラーは内容を出さずに500にする", func(t *testing.T) {
+		got := FromError(ctx, errors.New("connection refused"))
+
+		assert.Equal(t, http.StatusInternalServerError, got.Status)
+		assert.Equal(t, gen.ErrorCodeInternalError, got.Code)
+		assert.Nil(t, got.Detail)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("正常系: application/problem+json で書き出す", func(t *testing.T) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/todos/1", nil), rec)
+
+		err := Write(c, New(context.Background(), http.StatusNotFound, gen.ErrorCodeTodoNotFound))
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, ContentType, rec.Header().Get(echo.HeaderContentType))
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "todo_not_found", body["code"])
+		assert.Equal(t, float64(http.StatusNotFound), body["status"])
+		assert.Equal(t, "Todo not found", body["title"])
+	})
+}
+
+func ptrString(s string) *string {
+	return &s
+}
@@ -0,0 +1,772 @@
+package quickadd
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrEmptyTitle = errors.New("title is empty after parsing")
+
+type Priority string
+
+const (
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+)
+
+// 解析の基準
+type Options struct {
+	// 相対的な表現（tomorrow、in 3 days など）の基準時刻
+	Now time.Time
+	// 日付と時刻を解釈するタイムゾーン。nil なら UTC
+	Location *time.Location
+	// 3/4 のようにどちらとも読める日付を日/月の順で読む（false なら月/日）
+	DayFirst bool
+	// 週の始まりの曜日（next week、next friday の解釈に使う）。ゼロ値は日曜日
+	WeekStart time.Weekday
+}
+
+// 解析結果
+type Result struct {
+	// 解析した表現を取り除いた件名
+	Title string
+	DueAt *time.Time
+	// 時刻の指定がない期限（DueAt はその日の0時）
+	AllDay   bool
+	Tags     []string
+	Priority Priority
+	Project  string
+	// iCalendarのRRULE（例: FREQ=WEEKLY;BYDAY=MO）
+	Recurrence string
+	// 複数の意味に読める表現。オプションに従って1つに決めたもの
+	Ambiguities []string
+}
+
+var (
+	tagPattern     = regexp.MustCompile(`^#(\pL[\pL\pN_-]*)$`)
+	projectPattern = regexp.MustCompile(`^\+(\pL[\pL\pN_-]*)$`)
+	isoDatePattern = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})$`)
+	slashPattern   = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})(?:/(\d{2}|\d{4}))?$`)
+	dayPattern     = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)?$`)
+	yearPattern    = regexp.MustCompile(`^\d{4}$`)
+	clockPattern   = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm|a|p)?$`)
+	numberPattern  = regexp.MustCompile(`^\d{1,3}$`)
+)
+
+var priorities = map[string]Priority{
+	"!high": PriorityHigh, "!h": PriorityHigh, "!1": PriorityHigh, "!!!": PriorityHigh,
+	"!medium": PriorityMedium, "!med": PriorityMedium, "!m": PriorityMedium, "!2": PriorityMedium, "!!": PriorityMedium,
+	"!low": PriorityLow, "!l": PriorityLow, "!3": PriorityLow,
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// 曜日の略称のうち普通の単語と同じもの。前置詞がある場合だけ曜日として扱う（Enjoy the sun など）
+var wordLikeWeekdays = map[string]bool{"sun": true, "sat": true, "wed": true}
+
+var months = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+// RRULEの曜日
+var byDay = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// 日付（時刻とタイムゾーンを持たない）
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func (d date) at(hour, minute int, loc *time.Location) time.Time {
+	return time.Date(d.year, d.month, d.day, hour, minute, 0, 0, loc)
+}
+
+func (d date) addDays(n int) date {
+	return dateOf(time.Date(d.year, d.month, d.day+n, 0, 0, 0, 0, time.UTC))
+}
+
+// n か月後の同じ日。その月にない日（1月31日の1か月後など）は月末にする
+func (d date) addMonths(n int) date {
+	first := time.Date(d.year, d.month+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	return date{year: first.Year(), month: first.Month(), day: min(d.day, last)}
+}
+
+func (d date) weekday() time.Weekday {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC).Weekday()
+}
+
+func (d date) before(o date) bool {
+	if d.year != o.year {
+		return d.year < o.year
+	}
+	if d.month != o.month {
+		return d.month < o.month
+	}
+	return d.day < o.day
+}
+
+func dateOf(t time.Time) date {
+	return date{year: t.Year(), month: t.Month(), day: t.Day()}
+}
+
+// 存在する日付の場合だけ ok
+func makeDate(year int, month time.Month, day int) (date, bool) {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || t.Month() != month || t.Day() != day {
+		return date{}, false
+	}
+	return date{year: year, month: month, day: day}, true
+}
+
+type clock struct {
+	hour, minute int
+}
+
+// 繰り返し
+type recurrence struct {
+	freq     string // DAILY, WEEKLY, MONTHLY, YEARLY
+	interval int
+	days     []time.Weekday
+}
+
+func (r *recurrence) rrule() string {
+	var b strings.Builder
+	b.WriteString("FREQ=" + r.freq)
+	if r.interval > 1 {
+		b.WriteString(";INTERVAL=" + strconv.Itoa(r.interval))
+	}
+	if len(r.days) > 0 {
+		b.WriteString(";BYDAY=")
+		for i, d := range r.days {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(byDay[d])
+		}
+	}
+	return b.String()
+}
+
+func (r *recurrence) matches(d date) bool {
+	if len(r.days) == 0 {
+		return true
+	}
+	for _, wd := range r.days {
+		if d.weekday() == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// d 以降で最初の繰り返しの日
+func (r *recurrence) first(d date) date {
+	for !r.matches(d) {
+		d = d.addDays(1)
+	}
+	return d
+}
+
+// d の次の繰り返しの日
+func (r *recurrence) next(d date) date {
+	if len(r.days) > 0 {
+		return r.first(d.addDays(1))
+	}
+	switch r.freq {
+	case "WEEKLY":
+		return d.addDays(7 * r.interval)
+	case "MONTHLY":
+		return d.addMonths(r.interval)
+	case "YEARLY":
+		return d.addMonths(12 * r.interval)
+	default:
+		return d.addDays(r.interval)
+	}
+}
+
+type parser struct {
+	opts  Options
+	loc   *time.Location
+	now   time.Time
+	today date
+	// 元の単語と、照合用に小文字にして末尾の句読点を除いたもの
+	words []string
+	norm  []string
+	res   *Result
+
+	date    *date
+	clock   *clock
+	tonight bool
+	// in 2 hours のように時刻まで決まる期限
+	instant *time.Time
+	recur   *recurrence
+}
+
+// 自由入力の文字列から件名と、期限・タグ・優先度・プロジェクト・繰り返しを取り出す
+//
+//	Pay rent tomorrow 9am #home !high +Finance every month
+//
+// #タグ と +プロジェクト は英字で始まる単語だけを対象にする（#123 は件名に残す）
+// 期限・時刻・優先度・プロジェクト・繰り返しは最初に現れたものだけを使い、2つ目以降は件名に残す
+// 日付だけの場合はその日の0時、時刻だけの場合は次にその時刻になる日時を期限にする
+func Parse(text string, opts Options) (*Result, error) {
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	now := opts.Now.In(loc)
+	p := &parser{
+		opts:  opts,
+		loc:   loc,
+		now:   now,
+		today: dateOf(now),
+		words: strings.Fields(text),
+		res:   &Result{Tags: []string{}, Ambiguities: []string{}},
+	}
+	p.norm = make([]string, len(p.words))
+	for i, w := range p.words {
+		p.norm[i] = strings.TrimRight(strings.ToLower(w), ",.;:?")
+	}
+
+	var title []string
+	for i := 0; i < len(p.words); {
+		if n := p.match(i); n > 0 {
+			i += n
+			continue
+		}
+		title = append(title, p.words[i])
+		i++
+	}
+
+	p.resolveDue()
+	p.res.Title = strings.Join(title, " ")
+	if p.res.Title == "" {
+		return nil, ErrEmptyTitle
+	}
+	return p.res, nil
+}
+
+// i 番目の単語から始まる表現を解析し、使った単語の数を返す（0 なら件名の一部）
+func (p *parser) match(i int) int {
+	raw := strings.TrimRight(p.words[i], ",.;:?")
+
+	if m := tagPattern.FindStringSubmatch(raw); m != nil {
+		tag := m[1]
+		for _, t := range p.res.Tags {
+			if t == tag {
+				return 1
+			}
+		}
+		p.res.Tags = append(p.res.Tags, tag)
+		return 1
+	}
+	if p.res.Project == "" {
+		if m := projectPattern.FindStringSubmatch(raw); m != nil {
+			p.res.Project = m[1]
+			return 1
+		}
+	}
+	if p.res.Priority == "" {
+		if pr, ok := priorities[p.norm[i]]; ok {
+			p.res.Priority = pr
+			return 1
+		}
+	}
+	if p.recur == nil {
+		if n := p.matchRecurrence(i); n > 0 {
+			return n
+		}
+	}
+	if p.date == nil && p.instant == nil {
+		// on friday、due tomorrow、by nov 3 のように前置詞に続く日付は前置詞ごと取り除く
+		start := i
+		if p.norm[i] == "on" || p.norm[i] == "due" || p.norm[i] == "by" {
+			start = i + 1
+		}
+		if start < len(p.words) {
+			if n := p.matchDate(start, start > i); n > 0 {
+				return start - i + n
+			}
+		}
+	}
+	if p.clock == nil {
+		if n := p.matchClock(i); n > 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+func (p *parser) word(i int) string {
+	if i < len(p.norm) {
+		return p.norm[i]
+	}
+	return ""
+}
+
+// 元の表現（曖昧さの報告用）
+func (p *parser) phrase(i, n int) string {
+	return strings.TrimRight(strings.Join(p.words[i:i+n], " "), ",.;:?")
+}
+
+func (p *parser) matchRecurrence(i int) int {
+	switch p.norm[i] {
+	case "daily":
+		p.recur = &recurrence{freq: "DAILY", interval: 1}
+		return 1
+	case "weekly":
+		p.recur = &recurrence{freq: "WEEKLY", interval: 1}
+		return 1
+	case "monthly":
+		p.recur = &recurrence{freq: "MONTHLY", interval: 1}
+		return 1
+	case "yearly", "annually":
+		p.recur = &recurrence{freq: "YEARLY", interval: 1}
+		return 1
+	case "every":
+	default:
+		return 0
+	}
+
+	j := i + 1
+	interval := 1
+	switch w := p.word(j); {
+	case w == "other":
+		interval = 2
+		j++
+	case numberPattern.MatchString(w):
+		n, _ := strconv.Atoi(w)
+		if n < 1 {
+			return 0
+		}
+		interval = n
+		j++
+	}
+
+	if freq, ok := frequency(p.word(j)); ok {
+		p.recur = &recurrence{freq: freq, interval: interval}
+		return j + 1 - i
+	}
+	if interval != 1 {
+		return 0
+	}
+	if p.word(j) == "weekday" || p.word(j) == "weekdays" {
+		p.recur = &recurrence{freq: "WEEKLY", interval: 1, days: []time.Weekday{
+			time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+		}}
+		return j + 1 - i
+	}
+
+	// every mon, wed and fri
+	var days []time.Weekday
+	for {
+		wd, ok := weekdays[strings.TrimSuffix(p.word(j), "s")]
+		if !ok {
+			if p.word(j) == "and" && len(days) > 0 {
+				if wd, ok = weekdays[strings.TrimSuffix(p.word(j+1), "s")]; ok {
+					j++
+				}
+			}
+			if !ok {
+				break
+			}
+		}
+		if !containsWeekday(days, wd) {
+			days = append(days, wd)
+		}
+		j++
+	}
+	if len(days) == 0 {
+		return 0
+	}
+	p.recur = &recurrence{freq: "WEEKLY", interval: 1, days: days}
+	return j - i
+}
+
+func frequency(unit string) (string, bool) {
+	switch unit {
+	case "day", "days":
+		return "DAILY", true
+	case "week", "weeks":
+		return "WEEKLY", true
+	case "month", "months":
+		return "MONTHLY", true
+	case "year", "years":
+		return "YEARLY", true
+	}
+	return "", false
+}
+
+func containsWeekday(days []time.Weekday, wd time.Weekday) bool {
+	for _, d := range days {
+		if d == wd {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) setDate(d date) {
+	p.date = &d
+}
+
+// prefixed は前置詞（on、due、by）に続く場合
+func (p *parser) matchDate(i int, prefixed bool) int {
+	w := p.norm[i]
+	switch w {
+	case "today":
+		p.setDate(p.today)
+		return 1
+	case "tonight":
+		p.setDate(p.today)
+		p.tonight = true
+		return 1
+	case "tomorrow", "tmr", "tmrw":
+		p.setDate(p.today.addDays(1))
+		return 1
+	case "weekend":
+		p.setDate(p.onOrAfter(p.today, time.Saturday))
+		return 1
+	case "this":
+		if p.word(i+1) == "weekend" {
+			p.setDate(p.onOrAfter(p.today, time.Saturday))
+			return 2
+		}
+		if wd, ok := weekdays[p.word(i+1)]; ok {
+			p.setDate(p.onOrAfter(p.today, wd))
+			return 2
+		}
+		return 0
+	case "next":
+		return p.matchNext(i)
+	case "in":
+		return p.matchIn(i)
+	}
+
+	if wd, ok := weekdays[w]; ok && (prefixed || !wordLikeWeekdays[w]) {
+		// 曜日だけの場合は明日以降で最初のその曜日
+		p.setDate(p.onOrAfter(p.today.addDays(1), wd))
+		return 1
+	}
+	if m := isoDatePattern.FindStringSubmatch(w); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		day, _ := strconv.Atoi(m[3])
+		if d, ok := makeDate(year, time.Month(month), day); ok {
+			p.setDate(d)
+			return 1
+		}
+		return 0
+	}
+	if m := slashPattern.FindStringSubmatch(w); m != nil {
+		return p.matchSlashDate(i, m)
+	}
+	if month, ok := months[w]; ok {
+		// nov 3、november 3rd 2027
+		if m := dayPattern.FindStringSubmatch(p.word(i + 1)); m != nil {
+			day, _ := strconv.Atoi(m[1])
+			n := 2
+			year := 0
+			if yearPattern.MatchString(p.word(i + 2)) {
+				year, _ = strconv.Atoi(p.word(i + 2))
+				n = 3
+			}
+			if p.setMonthDay(year, month, day) {
+				return n
+			}
+		}
+		return 0
+	}
+	if m := dayPattern.FindStringSubmatch(w); m != nil {
+		// 3 nov、3rd of november 2027
+		j := i + 1
+		if p.word(j) == "of" {
+			j++
+		}
+		month, ok := months[p.word(j)]
+		if !ok {
+			return 0
+		}
+		day, _ := strconv.Atoi(m[1])
+		j++
+		year := 0
+		if yearPattern.MatchString(p.word(j)) {
+			year, _ = strconv.Atoi(p.word(j))
+			j++
+		}
+		if p.setMonthDay(year, month, day) {
+			return j - i
+		}
+	}
+	return 0
+}
+
+// 年を省略した日付は、今日より前なら来年の日付にする
+func (p *parser) setMonthDay(year int, month time.Month, day int) bool {
+	explicitYear := year != 0
+	if !explicitYear {
+		year = p.today.year
+	}
+	d, ok := makeDate(year, month, day)
+	if !ok && !explicitYear && month == time.February && day == 29 {
+		// 2/29 は次のうるう年
+		for y := year + 1; !ok && y <= year+8; y++ {
+			d, ok = makeDate(y, month, day)
+		}
+	}
+	if !ok {
+		return false
+	}
+	if !explicitYear && d.before(p.today) {
+		if nd, ok := makeDate(d.year+1, month, day); ok {
+			d = nd
+		}
+	}
+	p.setDate(d)
+	return true
+}
+
+// 3/4、3/4/2027、13/4
+// どちらも12以下の場合は DayFirst に従い、曖昧な表現として記録する
+func (p *parser) matchSlashDate(i int, m []string) int {
+	a, _ := strconv.Atoi(m[1])
+	b, _ := strconv.Atoi(m[2])
+	year := 0
+	if m[3] != "" {
+		year, _ = strconv.Atoi(m[3])
+		if year < 100 {
+			year += 2000
+		}
+	}
+
+	month, day := a, b
+	switch {
+	case a > 12 && b > 12:
+		return 0
+	case a > 12:
+		month, day = b, a
+	case b > 12:
+	case a != b:
+		if p.opts.DayFirst {
+			month, day = b, a
+		}
+		p.res.Ambiguities = append(p.res.Ambiguities, p.phrase(i, 1))
+	}
+	if month < 1 || day < 1 || !p.setMonthDay(year, time.Month(month), day) {
+		return 0
+	}
+	return 1
+}
+
+// next week、next month、next year、next friday
+func (p *parser) matchNext(i int) int {
+	switch p.word(i + 1) {
+	case "week":
+		p.setDate(p.weekStart(p.today).addDays(7))
+		return 2
+	case "month":
+		d, _ := makeDate(p.today.year, p.today.month+1, 1)
+		if p.today.month == time.December {
+			d, _ = makeDate(p.today.year+1, time.January, 1)
+		}
+		p.setDate(d)
+		return 2
+	case "year":
+		d, _ := makeDate(p.today.year+1, time.January, 1)
+		p.setDate(d)
+		return 2
+	}
+	wd, ok := weekdays[p.word(i+1)]
+	if !ok {
+		return 0
+	}
+	// 来週のその曜日。直近のその曜日が今週の場合は、どちらの意味にも読めるため記録する
+	nearest := p.onOrAfter(p.today.addDays(1), wd)
+	nextWeek := p.onOrAfter(p.weekStart(p.today).addDays(7), wd)
+	if nearest != nextWeek {
+		p.res.Ambiguities = append(p.res.Ambiguities, p.phrase(i, 2))
+	}
+	p.setDate(nextWeek)
+	return 2
+}
+
+// in 3 days、in a week、in 2 hours
+func (p *parser) matchIn(i int) int {
+	w := p.word(i + 1)
+	n := 0
+	switch {
+	case w == "a" || w == "an":
+		n = 1
+	case numberPattern.MatchString(w):
+		n, _ = strconv.Atoi(w)
+	default:
+		return 0
+	}
+	switch p.word(i + 2) {
+	case "minute", "minutes", "min", "mins":
+		t := p.now.Add(time.Duration(n) * time.Minute).Truncate(time.Minute)
+		p.instant = &t
+	case "hour", "hours":
+		t := p.now.Add(time.Duration(n) * time.Hour).Truncate(time.Minute)
+		p.instant = &t
+	case "day", "days":
+		p.setDate(p.today.addDays(n))
+	case "week", "weeks":
+		p.setDate(p.today.addDays(7 * n))
+	case "month", "months":
+		p.setDate(p.today.addMonths(n))
+	case "year", "years":
+		p.setDate(p.today.addMonths(12 * n))
+	default:
+		return 0
+	}
+	return 3
+}
+
+// 9am、9:30pm、9 pm、21:00、noon、at 7
+// at のない数字だけの単語は時刻として扱わない。at 7 のように午前午後がない12以下の時は24時間制で読み、曖昧な表現として記録する
+func (p *parser) matchClock(i int) int {
+	j := i
+	at := p.norm[i] == "at"
+	if at {
+		j++
+	}
+	w := p.word(j)
+	switch w {
+	case "noon":
+		p.clock = &clock{hour: 12}
+		return j + 1 - i
+	case "midnight":
+		p.clock = &clock{}
+		return j + 1 - i
+	}
+
+	m := clockPattern.FindStringSubmatch(w)
+	if m == nil {
+		return 0
+	}
+	hour, _ := strconv.Atoi(m[1])
+	minute := 0
+	if m[2] != "" {
+		minute, _ = strconv.Atoi(m[2])
+	}
+	suffix := m[3]
+	n := j + 1 - i
+	if suffix == "" {
+		switch p.word(j + 1) {
+		case "am", "a.m", "pm", "p.m":
+			suffix = p.word(j + 1)[:1]
+			n++
+		}
+	}
+	if minute > 59 {
+		return 0
+	}
+
+	switch {
+	case suffix != "":
+		if hour < 1 || hour > 12 {
+			return 0
+		}
+		hour %= 12
+		if suffix[0] == 'p' {
+			hour += 12
+		}
+	case m[2] != "":
+		if hour > 23 {
+			return 0
+		}
+	case at:
+		if hour > 23 {
+			return 0
+		}
+		if hour >= 1 && hour <= 12 {
+			p.res.Ambiguities = append(p.res.Ambiguities, p.phrase(i, n))
+		}
+	default:
+		return 0
+	}
+	p.clock = &clock{hour: hour, minute: minute}
+	return n
+}
+
+// d 以降で最初の wd の日
+func (p *parser) onOrAfter(d date, wd time.Weekday) date {
+	return d.addDays((int(wd) - int(d.weekday()) + 7) % 7)
+}
+
+// d を含む週の初日
+func (p *parser) weekStart(d date) date {
+	return d.addDays(-((int(d.weekday()) - int(p.opts.WeekStart) + 7) % 7))
+}
+
+// 取り出した日付・時刻・繰り返しから期限を決める
+func (p *parser) resolveDue() {
+	if p.recur != nil {
+		p.res.Recurrence = p.recur.rrule()
+	}
+	if p.instant != nil {
+		p.res.DueAt = p.instant
+		return
+	}
+	if p.date == nil && p.clock == nil && p.recur == nil {
+		return
+	}
+
+	d := p.today
+	explicit := p.date != nil
+	switch {
+	case explicit:
+		d = *p.date
+	case p.recur != nil:
+		d = p.recur.first(p.today)
+	}
+
+	c := p.clock
+	if c == nil && p.tonight {
+		c = &clock{hour: 20}
+	}
+	if c == nil {
+		due := d.at(0, 0, p.loc)
+		p.res.DueAt = &due
+		p.res.AllDay = true
+		return
+	}
+
+	due := d.at(c.hour, c.minute, p.loc)
+	if !explicit && !due.After(p.now) {
+		// 日付の指定がなく、今日のその時刻を過ぎている場合は次の日（繰り返しなら次の回）
+		if p.recur != nil {
+			d = p.recur.next(d)
+		} else {
+			d = d.addDays(1)
+		}
+		due = d.at(c.hour, c.minute, p.loc)
+	}
+	p.res.DueAt = &due
+}
@@ -0,0 +1,224 @@
+package quickadd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func TestParse(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	// 2026-10-21（水）10:30 JST
+	now := time.Date(2026, 10, 21, 10, 30, 0, 0, tokyo)
+	opts := Options{Now: now, Location: tokyo, WeekStart: time.Monday}
+	// 月末とうるう日（翌月・翌年に同じ日がない）
+	jan31 := Options{Now: time.Date(2027, 1, 31, 10, 30, 0, 0, tokyo), Location: tokyo}
+	feb29 := Options{Now: time.Date(2028, 2, 29, 10, 30, 0, 0, tokyo), Location: tokyo}
+
+	tests := []struct {
+		name  string
+		input string
+		opts  *Options
+		// 期限（opts のタイムゾーンでの "2006-01-02 15:04"）。空なら期限なし
+		due         string
+		allDay      bool
+		title       string
+		tags        []string
+		priority    Priority
+		project     string
+		recurrence  string
+		ambiguities []string
+	}{
+		// 基本
+		{name: "何も含まない", input: "Buy milk", title: "Buy milk"},
+		{name: "日時・タグ・優先度", input: "Pay rent tomorrow 9am #home !high", title: "Pay rent", due: "2026-10-22 09:00", tags: []string{"home"}, priority: PriorityHigh},
+		{name: "余分な空白を詰める", input: "  Pay   rent  #home ", title: "Pay rent", tags: []string{"home"}},
+		{name: "末尾の句読点", input: "Call mom tomorrow.", title: "Call mom", due: "2026-10-22 00:00", allDay: true},
+
+		// 相対的な日付
+		{name: "today", input: "Call mom today", title: "Call mom", due: "2026-10-21 00:00", allDay: true},
+		{name: "tonight は20時", input: "Call mom tonight", title: "Call mom", due: "2026-10-21 20:00"},
+		{name: "tonight と時刻", input: "Call mom tonight at 11pm", title: "Call mom", due: "2026-10-21 23:00"},
+		{name: "tmrw", input: "Laundry tmrw", title: "Laundry", due: "2026-10-22 00:00", allDay: true},
+		{name: "曜日は明日以降で最初のその曜日", input: "Report friday", title: "Report", due: "2026-10-23 00:00", allDay: true},
+		{name: "今日と同じ曜日は翌週", input: "Report on wednesday", title: "Report", due: "2026-10-28 00:00", allDay: true},
+		{name: "this は今日を含む", input: "Report this wednesday", title: "Report", due: "2026-10-21 00:00", allDay: true},
+		{name: "曜日の略称", input: "Report thu", title: "Report", due: "2026-10-22 00:00", allDay: true},
+		{name: "単語と同じ略称は前置詞が必要", input: "Enjoy the sun", title: "Enjoy the sun"},
+		{name: "前置詞のある略称", input: "Brunch on sat", title: "Brunch", due: "2026-10-24 00:00", allDay: true},
+		{name: "weekend は土曜日", input: "Clean garage this weekend", title: "Clean garage", due: "2026-10-24 00:00", allDay: true},
+		{name: "next week は来週の初日", input: "Plan next week", title: "Plan", due: "2026-10-26 00:00", allDay: true},
+		{name: "next week（週の始まりが日曜日）", input: "Plan next week", opts: &Options{Now: now, Location: tokyo, WeekStart: time.Sunday}, title: "Plan", due: "2026-10-25 00:00", allDay: true},
+		{name: "next month は来月1日", input: "Plan next month", title: "Plan", due: "2026-11-01 00:00", allDay: true},
+		{name: "next year は来年1月1日", input: "Plan next year", title: "Plan", due: "2027-01-01 00:00", allDay: true},
+		{name: "in N days", input: "Renew in 3 days", title: "Renew", due: "2026-10-24 00:00", allDay: true},
+		{name: "in a week", input: "Renew in a week", title: "Renew", due: "2026-10-28 00:00", allDay: true},
+		{name: "in N weeks", input: "Renew in 2 weeks", title: "Renew", due: "2026-11-04 00:00", allDay: true},
+		{name: "in N months", input: "Renew in 2 months", title: "Renew", due: "2026-12-21 00:00", allDay: true},
+		{name: "in N years", input: "Renew in 1 year", title: "Renew", due: "2027-10-21 00:00", allDay: true},
+		{name: "1月31日の1か月後は2月末", input: "Renew in 1 month", opts: &jan31, title: "Renew", due: "2027-02-28 00:00", allDay: true},
+		{name: "1月31日の3か月後は4月末", input: "Renew in 3 months", opts: &jan31, title: "Renew", due: "2027-04-30 00:00", allDay: true},
+		{name: "うるう日の1年後は2月28日", input: "Renew in 1 year", opts: &feb29, title: "Renew", due: "2029-02-28 00:00", allDay: true},
+		{name: "うるう日の4年後はうるう日", input: "Renew in 4 years", opts: &feb29, title: "Renew", due: "2032-02-29 00:00", allDay: true},
+		{name: "in N minutes は時刻まで決まる", input: "Check oven in 45 minutes", title: "Check oven", due: "2026-10-21 11:15"},
+		{name: "in N hours", input: "Check oven in 2 hours", title: "Check oven", due: "2026-10-21 12:30"},
+		{name: "in an hour", input: "Check oven in an hour", title: "Check oven", due: "2026-10-21 11:30"},
+		{name: "in の後が期間でない", input: "Put it in a box", title: "Put it in a box"},
+
+		// 曖昧な「next + 曜日」
+		{name: "next friday は来週の金曜日（今週の金曜日とも読める）", input: "Report next friday", title: "Report", due: "2026-10-30 00:00", allDay: true, ambiguities: []string{"next friday"}},
+		{name: "直近の火曜日が来週なら曖昧ではない", input: "Report next tuesday", title: "Report", due: "2026-10-27 00:00", allDay: true},
+		{name: "next sunday（週の始まりが月曜日）", input: "Hike next sunday", title: "Hike", due: "2026-11-01 00:00", allDay: true, ambiguities: []string{"next sunday"}},
+		{name: "next sunday（週の始まりが日曜日）", input: "Hike next sunday", opts: &Options{Now: now, Location: tokyo, WeekStart: time.Sunday}, title: "Hike", due: "2026-10-25 00:00", allDay: true},
+
+		// 絶対的な日付
+		{name: "ISO形式と24時間制の時刻", input: "Dentist 2026-11-03 14:30", title: "Dentist", due: "2026-11-03 14:30"},
+		{name: "月名と日", input: "Dentist nov 3", title: "Dentist", due: "2026-11-03 00:00", allDay: true},
+		{name: "日と月名", input: "Dentist 3 nov", title: "Dentist", due: "2026-11-03 00:00", allDay: true},
+		{name: "序数と of と年", input: "Dentist on the 3rd of November 2027", title: "Dentist on the", due: "2027-11-03 00:00", allDay: true},
+		{name: "月名・序数・年", input: "Dentist November 3rd, 2027", title: "Dentist", due: "2027-11-03 00:00", allDay: true},
+		{name: "年を省略した過ぎた日付は来年", input: "Trip jan 5", title: "Trip", due: "2027-01-05 00:00", allDay: true},
+		{name: "2/29 は次のうるう年", input: "Party feb 29", title: "Party", due: "2028-02-29 00:00", allDay: true},
+		{name: "存在しない日付は件名に残す", input: "Party feb 30", title: "Party feb 30"},
+		{name: "may は日が続く場合だけ月名", input: "We may go", title: "We may go"},
+		{name: "may と日", input: "Exam may 5", title: "Exam", due: "2027-05-05 00:00", allDay: true},
+		{name: "スラッシュ（日が12より大きい）", input: "Party 12/25", title: "Party", due: "2026-12-25 00:00", allDay: true},
+		{name: "スラッシュ（日/月と確定できる）", input: "Party 25/12", title: "Party", due: "2026-12-25 00:00", allDay: true},
+		{name: "スラッシュ（両方12以下は月/日）", input: "Meeting 3/4", title: "Meeting", due: "2027-03-04 00:00", allDay: true, ambiguities: []string{"3/4"}},
+		{name: "スラッシュ（両方12以下で DayFirst）", input: "Meeting 3/4", opts: &Options{Now: now, Location: tokyo, DayFirst: true}, title: "Meeting", due: "2027-04-03 00:00", allDay: true, ambiguities: []string{"3/4"}},
+		{name: "スラッシュ（同じ数字は曖昧ではない）", input: "Meeting 11/11", title: "Meeting", due: "2026-11-11 00:00", allDay: true},
+		{name: "スラッシュと2桁の年", input: "Meeting 11/5/27", title: "Meeting", due: "2027-11-05 00:00", allDay: true, ambiguities: []string{"11/5/27"}},
+		{name: "スラッシュ（どちらも12より大きい）", input: "Score 13/14", title: "Score 13/14"},
+
+		// 時刻
+		{name: "過ぎた時刻だけなら明日", input: "Standup 9am", title: "Standup", due: "2026-10-22 09:00"},
+		{name: "これからの時刻だけなら今日", input: "Lunch at noon", title: "Lunch", due: "2026-10-21 12:00"},
+		{name: "分と午後", input: "Call 4:45pm", title: "Call", due: "2026-10-21 16:45"},
+		{name: "午前午後が別の単語", input: "Meet at 9 pm", title: "Meet", due: "2026-10-21 21:00"},
+		{name: "12am は0時", input: "Backup 12am", title: "Backup", due: "2026-10-22 00:00"},
+		{name: "12pm は正午", input: "Backup 12pm", title: "Backup", due: "2026-10-21 12:00"},
+		{name: "24時間制", input: "Deploy at 17:45", title: "Deploy", due: "2026-10-21 17:45"},
+		{name: "at と12以下の数字は曖昧", input: "Gym at 7", title: "Gym", due: "2026-10-22 07:00", ambiguities: []string{"at 7"}},
+		{name: "at と13以上の数字", input: "Gym at 19", title: "Gym", due: "2026-10-21 19:00"},
+		{name: "at のない数字は時刻ではない", input: "Buy 3 apples", title: "Buy 3 apples"},
+		{name: "範囲外の時刻", input: "Meet at 25", title: "Meet at 25"},
+		{name: "過ぎた時刻でも日付の指定があればそのまま", input: "Standup today 9am", title: "Standup", due: "2026-10-21 09:00"},
+
+		// 前置詞
+		{name: "due に続く日付", input: "Submit report due tomorrow", title: "Submit report", due: "2026-10-22 00:00", allDay: true},
+		{name: "日付が続かない前置詞は残す", input: "Stand by me", title: "Stand by me"},
+		{name: "2つ目の日付は件名に残す", input: "Move meeting from tomorrow to friday", title: "Move meeting from to friday", due: "2026-10-22 00:00", allDay: true},
+
+		// タグ・プロジェクト・優先度
+		{name: "数字で始まる # はタグではない", input: "Fix bug #123", title: "Fix bug #123"},
+		{name: "重複したタグは1つ", input: "Reply #urgent #follow-up #urgent", title: "Reply", tags: []string{"urgent", "follow-up"}},
+		{name: "タグの大文字小文字は保つ", input: "Read #SciFi", title: "Read", tags: []string{"SciFi"}},
+		{name: "プロジェクトは最初の1つ", input: "Write +SideProject +Other", title: "Write +Other", project: "SideProject"},
+		{name: "数式の + はプロジェクトではない", input: "Check 1 +1", title: "Check 1 +1"},
+		{name: "!1 は高", input: "Fix prod !1", title: "Fix prod", priority: PriorityHigh},
+		{name: "!! は中", input: "Fix prod !!", title: "Fix prod", priority: PriorityMedium},
+		{name: "大文字の優先度", input: "Fix prod !LOW", title: "Fix prod", priority: PriorityLow},
+		{name: "感嘆符で終わる単語は優先度ではない", input: "Celebrate wow!", title: "Celebrate wow!"},
+		{name: "2つ目の優先度は件名に残す", input: "Fix !high !low", title: "Fix !low", priority: PriorityHigh},
+
+		// 繰り返し
+		{name: "every day は今日から", input: "Water plants every day", title: "Water plants", due: "2026-10-21 00:00", allDay: true, recurrence: "FREQ=DAILY"},
+		{name: "daily と時刻", input: "Take pills daily 9pm", title: "Take pills", due: "2026-10-21 21:00", recurrence: "FREQ=DAILY"},
+		{name: "daily と過ぎた時刻は明日", input: "Take pills daily 8am", title: "Take pills", due: "2026-10-22 08:00", recurrence: "FREQ=DAILY"},
+		{name: "every other week", input: "Clean every other week", title: "Clean", due: "2026-10-21 00:00", allDay: true, recurrence: "FREQ=WEEKLY;INTERVAL=2"},
+		{name: "every N months", input: "Haircut every 3 months", title: "Haircut", due: "2026-10-21 00:00", allDay: true, recurrence: "FREQ=MONTHLY;INTERVAL=3"},
+		{name: "every + 曜日は次のその曜日から", input: "Team sync every monday 10am", title: "Team sync", due: "2026-10-26 10:00", recurrence: "FREQ=WEEKLY;BYDAY=MO"},
+		{name: "複数の曜日と過ぎた時刻", input: "Gym every mon, wed and fri at 7am", title: "Gym", due: "2026-10-23 07:00", recurrence: "FREQ=WEEKLY;BYDAY=MO,WE,FR"},
+		{name: "複数形の曜日", input: "Piano every tuesdays", title: "Piano", due: "2026-10-27 00:00", allDay: true, recurrence: "FREQ=WEEKLY;BYDAY=TU"},
+		{name: "平日", input: "Standup every weekday 9:15am", title: "Standup", due: "2026-10-22 09:15", recurrence: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+		{name: "繰り返しと開始日", input: "Pay rent monthly nov 1", title: "Pay rent", due: "2026-11-01 00:00", allDay: true, recurrence: "FREQ=MONTHLY"},
+		{name: "毎月の次の回は月末に丸める", input: "Pay rent every month 9am", opts: &jan31, title: "Pay rent", due: "2027-02-28 09:00", recurrence: "FREQ=MONTHLY"},
+		{name: "yearly", input: "Renew domain yearly", title: "Renew domain", due: "2026-10-21 00:00", allDay: true, recurrence: "FREQ=YEARLY"},
+		{name: "every の後が繰り返しでない", input: "Read every book", title: "Read every book"},
+
+		// すべて
+		{name: "すべての要素", input: "Quarterly report +Work #finance !2 every 3 months on dec 1 at 9:30am", title: "Quarterly report", due: "2026-12-01 09:30", tags: []string{"finance"}, priority: PriorityMedium, project: "Work", recurrence: "FREQ=MONTHLY;INTERVAL=3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := opts
+			if tt.opts != nil {
+				o = *tt.opts
+			}
+
+			got, err := Parse(tt.input, o)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, got.Title)
+			if tt.due == "" {
+				assert.Nil(t, got.DueAt)
+			} else if assert.NotNil(t, got.DueAt) {
+				assert.Equal(t, tt.due, got.DueAt.In(o.Location).Format("2006-01-02 15:04"))
+			}
+			assert.Equal(t, tt.allDay, got.AllDay)
+			if tt.tags == nil {
+				tt.tags = []string{}
+			}
+			assert.Equal(t, tt.tags, got.Tags)
+			assert.Equal(t, tt.priority, got.Priority)
+			assert.Equal(t, tt.project, got.Project)
+			assert.Equal(t, tt.recurrence, got.Recurrence)
+			if tt.ambiguities == nil {
+				tt.ambiguities = []string{}
+			}
+			assert.Equal(t, tt.ambiguities, got.Ambiguities)
+		})
+	}
+}
+
+func TestParse_Timezone(t *testing.T) {
+	t.Run("正常系: today はタイムゾーンでの今日", func(t *testing.T) {
+		// UTCでは21日だが、東京では22日
+		now := time.Date(2026, 10, 21, 23, 30, 0, 0, time.UTC)
+
+		got, err := Parse("Call mom today", Options{Now: now, Location: mustLoadLocation(t, "Asia/Tokyo")})
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 21, 15, 0, 0, 0, time.UTC), got.DueAt.UTC())
+	})
+
+	t.Run("正常系: 夏時間の切り替わりをまたいでも現地の時刻で決まる", func(t *testing.T) {
+		ny := mustLoadLocation(t, "America/New_York")
+		// 2026-11-01 に夏時間が終わる
+		now := time.Date(2026, 10, 31, 12, 0, 0, 0, ny)
+
+		got, err := Parse("Run tomorrow 9am", Options{Now: now, Location: ny})
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 11, 1, 14, 0, 0, 0, time.UTC), got.DueAt.UTC())
+	})
+
+	t.Run("正常系: タイムゾーンの指定がなければUTC", func(t *testing.T) {
+		now := time.Date(2026, 10, 21, 23, 30, 0, 0, time.UTC)
+
+		got, err := Parse("Call mom tomorrow", Options{Now: now})
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 22, 0, 0, 0, 0, time.UTC), *got.DueAt)
+	})
+}
+
+func TestParse_EmptyTitle(t *testing.T) {
+	now := time.Date(2026, 10, 21, 10, 30, 0, 0, time.UTC)
+
+	for _, input := range []string{"", "   ", "tomorrow 9am #home !high", "+Work every day"} {
+		_, err := Parse(input, Options{Now: now})
+
+		assert.ErrorIs(t, err, ErrEmptyTitle, input)
+	}
+}
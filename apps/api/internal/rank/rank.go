@@ -0,0 +1,176 @@
+// Package rank はTodoの並び順に使う辞書順のランクキーを生成する
+//
+// キーは 0-9A-Za-z の62進数の小数部とみなし、バイト列として比較したときの大小が並び順になる。
+// 2つのキーの間には常に別のキーを作れるため、移動時に他のTodoの位置を振り直す必要がない。
+// 末尾が '0' のキーは直前にキーを作れなくなるため生成しない。
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+var (
+	ErrInvalidKey   = errors.New("invalid rank key")
+	ErrInvalidRange = errors.New("invalid rank range")
+)
+
+// a と b の間に位置するキーを返す
+// a が空の場合は先頭、b が空の場合は末尾を表す
+func Between(a, b string) (string, error) {
+	if err := validate(a); err != nil {
+		return "", err
+	}
+	if err := validate(b); err != nil {
+		return "", err
+	}
+	if b != "" && a >= b {
+		return "", ErrInvalidRange
+	}
+	switch {
+	case a != "" && b == "":
+		return after(a), nil
+	case a == "" && b != "":
+		return before(b), nil
+	}
+	return midpoint(a, b), nil
+}
+
+// last より後ろに並ぶ n 個のキーを昇順で返す
+// 末尾への一括追加で使い、キーの長さが件数に対して対数的にしか伸びないようにする
+func Append(last string, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	prefix, err := Between(last, "")
+	if err != nil {
+		return nil, err
+	}
+	if n == 1 {
+		return []string{prefix}, nil
+	}
+	// prefix は last より大きいため、prefix で始まるキーはすべて last より後ろに並ぶ
+	keys := Spread(n)
+	for i := range keys {
+		keys[i] = prefix + keys[i]
+	}
+	return keys, nil
+}
+
+// 等間隔に並んだ同じ長さの n 個のキーを昇順で返す（位置の振り直しに使う）
+func Spread(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	base := uint64(len(digits))
+	// キーの間隔が少なくとも1桁分空くように桁数を決める
+	width, space := 1, base
+	for space/uint64(n+1) < base {
+		width++
+		space *= base
+	}
+	step := space / uint64(n+1)
+
+	keys := make([]string, n)
+	for i := range keys {
+		v := step * uint64(i+1)
+		if v%base == 0 {
+			v++
+		}
+		keys[i] = encode(v, width)
+	}
+	return keys
+}
+
+func encode(v uint64, width int) string {
+	base := uint64(len(digits))
+	buf := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		buf[i] = digits[v%base]
+		v /= base
+	}
+	return string(buf)
+}
+
+func validate(key string) error {
+	if key == "" {
+		return nil
+	}
+	if key[len(key)-1] == digits[0] {
+		return ErrInvalidKey
+	}
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+// 末尾への追加は中間ではなく最初に増やせる桁を1つ増やす
+// 中間を取ると追加のたびに残りの範囲が半分になり、キーがすぐに長くなるため
+func after(a string) string {
+	for i := 0; ; i++ {
+		d := strings.IndexByte(digits, digitAt(a, i))
+		if d < len(digits)-1 {
+			return a[:min(i, len(a))] + string(digits[d+1])
+		}
+	}
+}
+
+// 先頭への追加は最初に減らせる桁を1つ減らす（末尾が '0' にならない桁に限る）
+func before(b string) string {
+	for i := 0; i < len(b); i++ {
+		if d := strings.IndexByte(digits, b[i]); d > 1 {
+			return b[:i] + string(digits[d-1])
+		}
+	}
+	return midpoint("", b)
+}
+
+// a < b を満たす検証済みのキーの中間を求める
+func midpoint(a, b string) string {
+	if b != "" {
+		// 共通の接頭辞はそのまま残し、残りの部分の中間を求める
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	lo := 0
+	if a != "" {
+		lo = strings.IndexByte(digits, a[0])
+	}
+	hi := len(digits)
+	if b != "" {
+		hi = strings.IndexByte(digits, b[0])
+	}
+	if hi-lo > 1 {
+		return string(digits[(lo+hi+1)/2])
+	}
+	// 先頭の桁が隣り合っている場合は次の桁で中間を求める
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(digits[lo]) + midpoint(suffix(a, 1), "")
+}
+
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return digits[0]
+}
+
+func suffix(key string, n int) string {
+	if n >= len(key) {
+		return ""
+	}
+	return key[n:]
+}
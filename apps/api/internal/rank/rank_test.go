@@ -0,0 +1,176 @@
+package rank
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{name: "正常系: 空のリスト", a: "", b: "", want: "V"},
+		{name: "正常系: 末尾に追加", a: "V", b: "", want: "W"},
+		{name: "正常系: 先頭に追加", a: "", b: "V", want: "U"},
+		{name: "正常系: 間に挿入", a: "A", b: "C", want: "B"},
+		{name: "正常系: 隣り合う桁の間は桁を増やす", a: "A", b: "B", want: "AV"},
+		{name: "正常系: 最大の桁の後ろ", a: "z", b: "", want: "z1"},
+		{name: "正常系: 最大の桁が続くキーの後ろ", a: "zzA", b: "", want: "zzB"},
+		{name: "正常系: 最小の桁の前", a: "", b: "1", want: "0V"},
+		{name: "正常系: 小さい桁が続くキーの前", a: "", b: "01z", want: "01y"},
+		{name: "正常系: 一方が他方の接頭辞", a: "A", b: "A1", want: "A0V"},
+		{name: "正常系: 長いキーの間は短いキーを返す", a: "AV", b: "Bk", want: "B"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.a, tt.b)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Greater(t, got, tt.a)
+			if tt.b != "" {
+				assert.Less(t, got, tt.b)
+			}
+		})
+	}
+
+	t.Run("異常系: 範囲が逆転している", func(t *testing.T) {
+		_, err := Between("B", "A")
+		assert.ErrorIs(t, err, ErrInvalidRange)
+	})
+
+	t.Run("異常系: 同じキーの間", func(t *testing.T) {
+		_, err := Between("A", "A")
+		assert.ErrorIs(t, err, ErrInvalidRange)
+	})
+
+	t.Run("異常系: 末尾が0のキー", func(t *testing.T) {
+		_, err := Between("A0", "")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+
+	t.Run("異常系: 使用できない文字を含むキー", func(t *testing.T) {
+		_, err := Between("", "A-B")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
+
+func TestBetween_RepeatedInsertions(t *testing.T) {
+	t.Run("正常系: 同じ位置への挿入を繰り返しても順序が保たれる", func(t *testing.T) {
+		lo, hi := "A", "B"
+		for i := 0; i < 200; i++ {
+			mid, err := Between(lo, hi)
+			require.NoError(t, err)
+			require.Greater(t, mid, lo)
+			require.Less(t, mid, hi)
+			hi = mid
+		}
+	})
+
+	t.Run("正常系: ランダムな位置への挿入で常に整列した状態を保つ", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		keys := []string{}
+		for i := 0; i < 1000; i++ {
+			pos := rng.Intn(len(keys) + 1)
+			var a, b string
+			if pos > 0 {
+				a = keys[pos-1]
+			}
+			if pos < len(keys) {
+				b = keys[pos]
+			}
+			key, err := Between(a, b)
+			require.NoError(t, err)
+			keys = append(keys[:pos], append([]string{key}, keys[pos:]...)...)
+		}
+		assert.True(t, sort.StringsAreSorted(keys))
+		for i := 1; i < len(keys); i++ {
+			require.NotEqual(t, keys[i-1], keys[i])
+		}
+	})
+}
+
+func TestBetween_Ends(t *testing.T) {
+	t.Run("正常系: 末尾への追加を繰り返してもキーが長くなりにくい", func(t *testing.T) {
+		key := ""
+		for i := 0; i < 1000; i++ {
+			next, err := Between(key, "")
+			require.NoError(t, err)
+			require.Greater(t, next, key)
+			key = next
+		}
+		assert.LessOrEqual(t, len(key), 20)
+	})
+
+	t.Run("正常系: 先頭への追加を繰り返してもキーが長くなりにくい", func(t *testing.T) {
+		key := "V"
+		for i := 0; i < 1000; i++ {
+			prev, err := Between("", key)
+			require.NoError(t, err)
+			require.Less(t, prev, key)
+			require.NoError(t, validate(prev))
+			key = prev
+		}
+		assert.LessOrEqual(t, len(key), 40)
+	})
+}
+
+func TestAppend(t *testing.T) {
+	t.Run("正常系: 最後のキーより後ろに昇順のキーを返す", func(t *testing.T) {
+		keys, err := Append("k", 1000)
+		require.NoError(t, err)
+		require.Len(t, keys, 1000)
+		assert.True(t, sort.StringsAreSorted(keys))
+		assert.Greater(t, keys[0], "k")
+		for _, key := range keys {
+			assert.LessOrEqual(t, len(key), 4)
+		}
+	})
+
+	t.Run("正常系: 1件の場合は Between と同じ", func(t *testing.T) {
+		keys, err := Append("V", 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"W"}, keys)
+	})
+
+	t.Run("正常系: 続けて追加しても順序が保たれる", func(t *testing.T) {
+		last := ""
+		var all []string
+		for i := 0; i < 50; i++ {
+			keys, err := Append(last, 100)
+			require.NoError(t, err)
+			all = append(all, keys...)
+			last = keys[len(keys)-1]
+		}
+		assert.True(t, sort.StringsAreSorted(all))
+		assert.LessOrEqual(t, len(last), 6)
+	})
+
+	t.Run("正常系: 0件", func(t *testing.T) {
+		keys, err := Append("V", 0)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
+
+func TestSpread(t *testing.T) {
+	for _, n := range []int{1, 2, 61, 62, 1000, 100000} {
+		keys := Spread(n)
+		require.Len(t, keys, n)
+		assert.True(t, sort.StringsAreSorted(keys), "n=%d", n)
+		for i, key := range keys {
+			require.NoError(t, validate(key), "n=%d key=%q", n, key)
+			require.Len(t, key, len(keys[0]))
+			if i > 0 {
+				// 隣り合うキーの間に新しいキーを作れる
+				_, err := Between(keys[i-1], key)
+				require.NoError(t, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/caldav"
+	"go-todo/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// CalDAVのルートのURLの接頭辞
+const CalDAVPrefix = "/dav"
+
+// CalDAVのルートを設定
+// CalDAVクライアントはセッションを持たないため、Basic認証のパスワードとしてパーソナルアクセストークンを受け取る
+func SetupCalDAVRoutes(e *echo.Echo, h *caldav.Handler, tokenService *service.PersonalAccessTokenService) {
+	basicAuth := middleware.BasicAuthWithConfig(middleware.BasicAuthConfig{
+		Realm: "go-todo CalDAV",
+		Validator: func(username, password string, c echo.Context) (bool, error) {
+			// ユーザー名は使わない（クライアントが空のユーザー名を許さないため任意の値を受け付ける）
+			userID, err := tokenService.Authenticate(c.Request().Context(), password)
+			if errors.Is(err, service.ErrPersonalAccessTokenNotFound) {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			c.SetRequest(c.Request().WithContext(auth.WithUserID(c.Request().Context(), userID)))
+			return true, nil
+		},
+	})
+
+	handler := echo.WrapHandler(h)
+	e.Match(caldav.Methods, CalDAVPrefix, handler, basicAuth)
+	e.Match(caldav.Methods, CalDAVPrefix+"/*", handler, basicAuth)
+
+	// サービス検出（RFC 6764）
+	e.Any("/.well-known/caldav", func(c echo.Context) error {
+		return c.Redirect(http.StatusMovedPermanently, CalDAVPrefix+"/")
+	})
+}
@@ -0,0 +1,190 @@
+package router
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/problem"
+	"go-todo/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerIdempotencyKey     = "Idempotency-Key"
+	headerIdempotentReplayed = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+// Idempotency-Key ヘッダー付きのPOSTリクエストを冪等にするStrictMiddlewareFunc
+// 認証ミドルウェアの内側で動作し、ユーザー単位でキーを管理する
+func createIdempotencyMiddleware(svc *service.IdempotencyService) gen.StrictMiddlewareFunc {
+	return func(f gen.StrictHandlerFunc, operationID string) gen.StrictHandlerFunc {
+		return func(ctx echo.Context, request interface{}) (interface{}, error) {
+			key := ctx.Request().Header.Get(headerIdempotencyKey)
+			if ctx.Request().Method != http.MethodPost || key == "" {
+				return f(ctx, request)
+			}
+			// ストリーミングのボディ（NDJSON等）はリクエストの指紋を計算できないため対象外
+			if contentType := ctx.Request().Header.Get(echo.HeaderContentType); contentType != "" && !strings.HasPrefix(contentType, echo.MIMEApplicationJSON) {
+				return f(ctx, request)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return nil, problem.NewError(http.StatusBadRequest, gen.ErrorCodeIdempotencyKeyTooLong)
+			}
+
+			userID, ok := auth.GetUserIDFromContext(ctx.Request().Context())
+			if !ok {
+				return f(ctx, request)
+			}
+
+			requestHash, err := fingerprint(operationID, request)
+			if err != nil {
+				return nil, problem.NewError(http.StatusInternalServerError, gen.ErrorCodeInternalError)
+			}
+
+			reqCtx := ctx.Request().Context()
+			stored, lock, err := svc.Begin(reqCtx, userID, key, requestHash)
+			switch {
+			case errors.Is(err, service.ErrIdempotencyKeyReused):
+				return nil, problem.NewError(http.StatusConflict, gen.ErrorCodeIdempotencyKeyReused)
+			case errors.Is(err, service.ErrIdempotencyKeyInFlight):
+				return nil, problem.NewError(http.StatusConflict, gen.ErrorCodeIdempotencyKeyInFlight)
+			case err != nil:
+				log.Printf("Failed to begin idempotent request: %v", err)
+				return nil, problem.NewError(http.StatusInternalServerError, gen.ErrorCodeInternalError)
+			}
+
+			// 保存済みのレスポンスを再送する
+			if stored != nil {
+				stored.Header.Set(headerIdempotentReplayed, "true")
+				return nil, writeStoredResponse(ctx.Response(), stored)
+			}
+
+			response, err := f(ctx, request)
+			if err != nil {
+				releaseIdempotencyKey(svc, ctx, *lock)
+				return nil, err
+			}
+
+			// レスポンスを一度バッファに書き出してから保存する
+			recorder := newResponseRecorder()
+			if err := visitResponse(response, operationID, recorder); err != nil {
+				releaseIdempotencyKey(svc, ctx, *lock)
+				return nil, err
+			}
+			captured := recorder.result()
+
+			// サーバーエラーは再試行できるように保存しない
+			if captured.StatusCode >= http.StatusInternalServerError {
+				releaseIdempotencyKey(svc, ctx, *lock)
+			} else if err := svc.Complete(reqCtx, *lock, *captured); errors.Is(err, service.ErrIdempotencyKeyLost) {
+				// ロックの期限切れ後に取り直したリクエストの結果を優先する
+				log.Printf("Idempotency key was taken over before saving the response (user_id=%d)", userID)
+			} else if err != nil {
+				log.Printf("Failed to save idempotent response: %v", err)
+				releaseIdempotencyKey(svc, ctx, *lock)
+			}
+
+			return nil, writeStoredResponse(ctx.Response(), captured)
+		}
+	}
+}
+
+// 操作IDとリクエスト内容（パラメータ・ボディ）からリクエストの指紋を算出
+func fingerprint(operationID string, request interface{}) (string, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+	h := sha256.New()
+	h.Write([]byte(operationID))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func releaseIdempotencyKey(svc *service.IdempotencyService, ctx echo.Context, lock service.IdempotencyLock) {
+	err := svc.Release(ctx.Request().Context(), lock)
+	switch {
+	case errors.Is(err, service.ErrIdempotencyKeyLost):
+		log.Printf("Idempotency key was taken over before release (user_id=%d)", lock.UserID)
+	case err != nil:
+		log.Printf("Failed to release idempotency key: %v", err)
+	}
+}
+
+// 生成されたレスポンス型の Visit<OperationID>Response を呼び出す
+func visitResponse(response interface{}, operationID string, w http.ResponseWriter) error {
+	if response == nil {
+		return fmt.Errorf("empty response for %s", operationID)
+	}
+	method := reflect.ValueOf(response).MethodByName("Visit" + operationID + "Response")
+	if !method.IsValid() {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	out := method.Call([]reflect.Value{reflect.ValueOf(w)})
+	if err, ok := out[0].Interface().(error); ok && err != nil {
+		return err
+	}
+	return nil
+}
+
+func writeStoredResponse(w *echo.Response, resp *service.IdempotentResponse) error {
+	for k, values := range resp.Header {
+		w.Header()[k] = values
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, err := w.Write(resp.Body)
+	return err
+}
+
+// レスポンスをメモリ上に記録するhttp.ResponseWriter
+type responseRecorder struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{header: http.Header{}}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if r.statusCode == 0 {
+		r.statusCode = statusCode
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.statusCode == 0 {
+		r.statusCode = http.StatusOK
+	}
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) result() *service.IdempotentResponse {
+	statusCode := r.statusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	return &service.IdempotentResponse{
+		StatusCode: statusCode,
+		Header:     r.header.Clone(),
+		Body:       r.body.Bytes(),
+	}
+}
@@ -1,6 +1,8 @@
 package router
 
 import (
+	"strings"
+
 	"go-todo/internal/config"
 
 	"github.com/labstack/echo/v4"
@@ -10,9 +12,15 @@ import (
 // EchoのCORSミドルウェア設定
 func CORSConfig(frontendConfig config.FrontendConfig) middleware.CORSConfig {
 	return middleware.CORSConfig{
+		// CalDAVはブラウザから使わないため、CORSの対象外にする
+		Skipper: func(c echo.Context) bool {
+			path := c.Request().URL.Path
+			return path == CalDAVPrefix || strings.HasPrefix(path, CalDAVPrefix+"/") || strings.HasPrefix(path, "/.well-known/")
+		},
 		AllowOrigins:     []string{frontendConfig.URL},
 		AllowMethods:     []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS},
-		AllowHeaders:     []string{echo.HeaderContentType, echo.HeaderAuthorization},
+		AllowHeaders:     []string{echo.HeaderContentType, echo.HeaderAuthorization, "If-Match", "If-None-Match", "Idempotency-Key"},
+		ExposeHeaders:    []string{"ETag", "Idempotent-Replayed", echo.HeaderXRequestID},
 		AllowCredentials: true,
 	}
 }
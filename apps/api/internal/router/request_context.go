@@ -0,0 +1,43 @@
+package router
+
+import (
+	"context"
+	"log"
+
+	"go-todo/internal/auth"
+	"go-todo/internal/i18n"
+	"go-todo/internal/problem"
+	"go-todo/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// エラーレスポンスを作るための情報をリクエストのコンテキストに設定するEchoミドルウェア
+// RequestIDミドルウェアの後に配置する
+//   - エラーメッセージの言語: Accept-Language を優先し、なければユーザー設定のロケール
+//   - instance と request_id: リクエストのパスと X-Request-ID
+func requestContextMiddleware(settingsService *service.UserSettingsService) echo.MiddlewareFunc {
+	// 認証ミドルウェアはこのミドルウェアより後にユーザーIDを設定するため、メッセージを作るときのコンテキストから取得する
+	userLocale := func(ctx context.Context) string {
+		userID, ok := auth.GetUserIDFromContext(ctx)
+		if !ok {
+			return ""
+		}
+		locale, err := settingsService.Locale(ctx, userID)
+		if err != nil {
+			log.Printf("Failed to load locale (user_id=%d): %v", userID, err)
+			return ""
+		}
+		return locale
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := i18n.WithPreference(req.Context(), req.Header.Get("Accept-Language"), userLocale)
+			ctx = problem.WithRequest(ctx, req.URL.Path, c.Response().Header().Get(echo.HeaderXRequestID))
+			c.SetRequest(req.WithContext(ctx))
+			return next(c)
+		}
+	}
+}
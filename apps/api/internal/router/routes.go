@@ -1,29 +1,48 @@
 package router
 
 import (
+	"fmt"
+	"log"
 	"net/http"
 
 	"go-todo/internal/auth"
 	"go-todo/internal/config"
 	"go-todo/internal/gen"
 	"go-todo/internal/handler"
+	"go-todo/internal/problem"
+	"go-todo/internal/service"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
 // Echoインスタンスにルートを設定
-func SetupRoutes(e *echo.Echo, apiHandler *handler.APIHandler, authHandler *handler.AuthHandler, sm *auth.SessionManager, frontendConfig config.FrontendConfig) {
+func SetupRoutes(e *echo.Echo, apiHandler *handler.APIHandler, authHandler *handler.AuthHandler, sm *auth.SessionManager, idempotencyService *service.IdempotencyService, settingsService *service.UserSettingsService, frontendConfig config.FrontendConfig) error {
 	// グローバルミドルウェア
 	e.Use(middleware.CORSWithConfig(CORSConfig(frontendConfig)))
+	e.Use(middleware.RequestID())
 	e.Use(middleware.Recover())
 	e.Use(middleware.Logger())
+	// エラーレスポンスの言語・instance・request_id（RequestIDミドルウェアの後に配置）
+	e.Use(requestContextMiddleware(settingsService))
+
+	// OpenAPI仕様によるリクエストの検証
+	openAPIRouter, err := newOpenAPIRouter()
+	if err != nil {
+		return fmt.Errorf("create openapi router: %w", err)
+	}
+	e.Use(requestValidatorMiddleware(openAPIRouter, func(r *http.Request) bool {
+		_, err := sm.GetUserID(r)
+		return err == nil
+	}))
 
 	// 認証ミドルウェアをstrictmiddlewareとしてラップ
 	authMiddleware := createAuthMiddleware(sm)
+	// 冪等性ミドルウェア（認証済みユーザーIDを使うため認証ミドルウェアの内側に配置）
+	idempotencyMiddleware := createIdempotencyMiddleware(idempotencyService)
 
-	// StrictハンドラーをEchoハンドラーにラップ（認証ミドルウェア付き）
-	strictHandler := gen.NewStrictHandler(apiHandler, []gen.StrictMiddlewareFunc{authMiddleware})
+	// StrictハンドラーをEchoハンドラーにラップ（後に指定したものほど外側で実行される）
+	strictHandler := gen.NewStrictHandler(apiHandler, []gen.StrictMiddlewareFunc{idempotencyMiddleware, authMiddleware})
 
 	// 生成されたルート登録関数を使用
 	gen.RegisterHandlers(e, strictHandler)
@@ -33,21 +52,22 @@ func SetupRoutes(e *echo.Echo, apiHandler *handler.APIHandler, authHandler *hand
 
 	// カスタムエラーハンドラー
 	e.HTTPErrorHandler = customHTTPErrorHandler
+	return nil
 }
 
 // 認証ミドルウェアをStrictMiddlewareFuncに変換
 func createAuthMiddleware(sm *auth.SessionManager) gen.StrictMiddlewareFunc {
 	return func(f gen.StrictHandlerFunc, operationID string) gen.StrictHandlerFunc {
 		return func(ctx echo.Context, request interface{}) (interface{}, error) {
-			// 認証不要なエンドポイントをスキップ
-			if operationID == "GetInfo" || operationID == "GetHealth" {
+			// 認証不要なエンドポイントをスキップ（カレンダーフィードはURLのトークン、エクスポートのダウンロードはURLの署名で認証する）
+			if operationID == "GetInfo" || operationID == "GetHealth" || operationID == "GetCalendarFeed" || operationID == "DownloadAccountExport" {
 				return f(ctx, request)
 			}
 
 			// セッションからユーザーIDを取得
 			userID, err := sm.GetUserID(ctx.Request())
 			if err != nil {
-				return nil, echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+				return nil, problem.NewError(http.StatusUnauthorized, gen.ErrorCodeUnauthorized)
 			}
 
 			// コンテキストにユーザーIDを設定
@@ -60,24 +80,19 @@ func createAuthMiddleware(sm *auth.SessionManager) gen.StrictMiddlewareFunc {
 }
 
 // カスタムエラーハンドラー
+// すべてのエラーを RFC 7807 の application/problem+json で返す
 func customHTTPErrorHandler(err error, c echo.Context) {
-	code := http.StatusInternalServerError
-	message := "Internal Server Error"
-
-	if he, ok := err.(*echo.HTTPError); ok {
-		code = he.Code
-		if m, ok := he.Message.(string); ok {
-			message = m
-		}
-	}
-
-	if code == http.StatusNotFound {
-		c.JSON(code, map[string]string{
-			"error": "Resource not found",
-			"path":  c.Request().URL.Path,
-		})
+	if c.Response().Committed {
 		return
 	}
 
-	c.JSON(code, map[string]string{"error": message})
+	p := problem.FromError(c.Request().Context(), err)
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(p.Status)
+	} else {
+		err = problem.Write(c, p)
+	}
+	if err != nil {
+		log.Printf("Failed to write error response: %v", err)
+	}
 }
@@ -0,0 +1,160 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"go-todo/internal/gen"
+	"go-todo/internal/problem"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/labstack/echo/v4"
+)
+
+// 埋め込みのOpenAPI仕様（gen.GetSwagger）からリクエストを照合するルーターを作る
+func newOpenAPIRouter() (routers.Router, error) {
+	swagger, err := gen.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec: %w", err)
+	}
+	// servers のURL（http://localhost:4000）とホスト名を照合しないようにする
+	swagger.Servers = nil
+	return gorillamux.NewRouter(swagger)
+}
+
+// OpenAPI仕様でリクエストのパラメータとJSONのボディを検証するEchoミドルウェア
+// 仕様に違反するリクエストは、項目ごとのエラー（errors）を含む 400 validation_failed にする
+// 仕様にないルート（認証、CalDAVなど）は検証しない
+// セッションが必要な操作で authenticated が false の場合も検証せず、認証ミドルウェアに 401 を返させる
+func requestValidatorMiddleware(router routers.Router, authenticated func(*http.Request) bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			route, pathParams, err := router.FindRoute(req)
+			if err != nil {
+				return next(c)
+			}
+			if requiresSession(route) && !authenticated(req) {
+				return next(c)
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    req,
+				PathParams: pathParams,
+				Route:      route,
+				Options: &openapi3filter.Options{
+					MultiError: true,
+					// 認証は認証ミドルウェアが行う
+					AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+					// NDJSON・CSVなどのボディはストリームのまま読むため、インポーターが1行ずつ検証する
+					ExcludeRequestBody: !isJSONContentType(req.Header.Get(echo.HeaderContentType)),
+				},
+			}
+			if err := openapi3filter.ValidateRequest(req.Context(), input); err != nil {
+				return &problem.Error{
+					Status: http.StatusBadRequest,
+					Code:   gen.ErrorCodeValidationFailed,
+					Errors: fieldErrors(err),
+				}
+			}
+			return next(c)
+		}
+	}
+}
+
+// 操作にセキュリティ要件があるか（security: [] の操作は認証不要）
+func requiresSession(route *routers.Route) bool {
+	security := route.Operation.Security
+	if security == nil {
+		security = &route.Spec.Security
+	}
+	return len(*security) > 0
+}
+
+// application/json と +json のメディアタイプか（Content-Typeがない場合も JSON とみなす）
+func isJSONContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == echo.MIMEApplicationJSON || strings.HasSuffix(mediaType, "+json")
+}
+
+// 検証エラーを項目ごとのエラーにする
+func fieldErrors(err error) []gen.FieldError {
+	// MultiError は errors.As で最初の要素だけに一致するため、型で判定して展開する
+	if multi, ok := err.(openapi3.MultiError); ok {
+		var result []gen.FieldError
+		for _, e := range multi {
+			result = append(result, fieldErrors(e)...)
+		}
+		return result
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return []gen.FieldError{{In: gen.FieldErrorInBody, Field: "", Message: err.Error()}}
+	}
+
+	in, field := gen.FieldErrorInBody, ""
+	if p := reqErr.Parameter; p != nil {
+		in, field = gen.FieldErrorIn(p.In), p.Name
+	}
+
+	// スキーマ違反は違反した値ごとに返す。ボディは値の位置を JSON Pointer で示す
+	var result []gen.FieldError
+	for _, se := range schemaErrors(reqErr.Err) {
+		f := field
+		if reqErr.Parameter == nil {
+			f = jsonPointer(se.JSONPointer())
+		}
+		result = append(result, gen.FieldError{In: in, Field: f, Message: se.Reason})
+	}
+	if len(result) > 0 {
+		return result
+	}
+
+	message := reqErr.Reason
+	if inner := reqErr.Err; inner != nil {
+		if message == "" {
+			message = inner.Error()
+		} else {
+			message += ": " + inner.Error()
+		}
+	}
+	return []gen.FieldError{{In: in, Field: field, Message: message}}
+}
+
+func schemaErrors(err error) []*openapi3.SchemaError {
+	if multi, ok := err.(openapi3.MultiError); ok {
+		var result []*openapi3.SchemaError
+		for _, e := range multi {
+			result = append(result, schemaErrors(e)...)
+		}
+		return result
+	}
+	var se *openapi3.SchemaError
+	if errors.As(err, &se) {
+		return []*openapi3.SchemaError{se}
+	}
+	return nil
+}
+
+// RFC 6901 の JSON Pointer（ルートは空文字列）
+func jsonPointer(path []string) string {
+	var b strings.Builder
+	for _, p := range path {
+		b.WriteByte('/')
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(p))
+	}
+	return b.String()
+}
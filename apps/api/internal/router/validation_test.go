@@ -0,0 +1,575 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/auth"
+	"go-todo/internal/gen"
+	"go-todo/internal/handler"
+	"go-todo/internal/mapper"
+	"go-todo/internal/problem"
+	"go-todo/internal/service"
+	"go-todo/internal/service/mocks"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// レスポンスを書き出しながら保持する
+type teeResponseWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *teeResponseWriter) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// レスポンスを OpenAPI 仕様で厳密に検証するEchoミドルウェア（テスト用）
+// ハンドラーと仕様のずれ（未定義のステータスコード・Content-Type、スキーマ違反）をテストの失敗にする
+func responseValidatorMiddleware(t *testing.T, router routers.Router) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			rec := &teeResponseWriter{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = rec
+
+			// エラーレスポンスも検証するため、ここでエラーハンドラーを呼ぶ
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			route, pathParams, err := router.FindRoute(c.Request())
+			if err != nil {
+				return nil
+			}
+			if err := validateResponse(c.Request(), route, pathParams, c.Response().Status, c.Response().Header(), rec.body.Bytes()); err != nil {
+				t.Errorf("response does not match the OpenAPI spec: %s %s: %v", c.Request().Method, c.Request().URL.Path, err)
+			}
+			return nil
+		}
+	}
+}
+
+func validateResponse(req *http.Request, route *routers.Route, pathParams map[string]string, status int, header http.Header, body []byte) error {
+	return openapi3filter.ValidateResponse(req.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+		},
+		Status: status,
+		Header: header,
+		Body:   io.NopCloser(bytes.NewReader(body)),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+			MultiError:            true,
+		},
+	})
+}
+
+type testServer struct {
+	echo         *echo.Echo
+	todoRepo     *mocks.MockTodoRepository
+	depRepo      *mocks.MockDependencyRepository
+	syncRepo     *mocks.MockSyncRepository
+	jobRepo      *mocks.MockJobRepository
+	statusRepo   *mocks.MockStatusRepository
+	reminderRepo *mocks.MockReminderRepository
+	notifyRepo   *mocks.MockNotificationRepository
+	calRepo      *mocks.MockCalendarFeedRepository
+	tokenRepo    *mocks.MockPersonalAccessTokenRepository
+	exportRepo   *mocks.MockTodoExportRepository
+	importRepo   *mocks.MockExternalImportRepository
+	projRepo     *mocks.MockProjectRepository
+	acctRepo     *mocks.MockAccountExportRepository
+	settingsRepo *mocks.MockUserSettingsRepository
+	// true の場合はセッションのないリクエストとして扱う
+	anonymous bool
+}
+
+// 本番と同じ検証とエラーハンドラーを通し、レスポンスを厳密に検証するサーバー
+// すべてのハンドラーをモックのリポジトリで組み立て、認証はユーザーID 1 で通す
+// トランザクションを使う処理はプールがないため成功させられない（mapper の出力を TestResponseMappersMatchSpec で検証する）
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	s := &testServer{
+		todoRepo:     mocks.NewMockTodoRepository(t),
+		depRepo:      mocks.NewMockDependencyRepository(t),
+		syncRepo:     mocks.NewMockSyncRepository(t),
+		jobRepo:      mocks.NewMockJobRepository(t),
+		statusRepo:   mocks.NewMockStatusRepository(t),
+		reminderRepo: mocks.NewMockReminderRepository(t),
+		notifyRepo:   mocks.NewMockNotificationRepository(t),
+		calRepo:      mocks.NewMockCalendarFeedRepository(t),
+		tokenRepo:    mocks.NewMockPersonalAccessTokenRepository(t),
+		exportRepo:   mocks.NewMockTodoExportRepository(t),
+		importRepo:   mocks.NewMockExternalImportRepository(t),
+		projRepo:     mocks.NewMockProjectRepository(t),
+		acctRepo:     mocks.NewMockAccountExportRepository(t),
+		settingsRepo: mocks.NewMockUserSettingsRepository(t),
+	}
+
+	settingsService := service.NewUserSettingsService(s.settingsRepo, nil)
+	todoService := service.NewTodoService(s.todoRepo, nil, 100)
+	notificationService := service.NewNotificationService(s.notifyRepo, nil)
+	externalImportService := service.NewExternalImportService(s.importRepo, nil)
+	accountExportService := service.NewAccountExportService(s.acctRepo, nil, nil, []byte(strings.Repeat("k", 32)), time.Hour, "http://localhost:4000")
+	jobService := service.NewJobService(s.jobRepo, time.Minute, 3)
+	jobService.RegisterHandler(service.JobTypeCompleteTodos, todoService.CompleteTodosJob)
+	jobService.RegisterHandler(service.JobTypeDeleteTodos, todoService.DeleteTodosJob)
+	jobService.RegisterHandler(service.JobTypeImportExternalTodos, externalImportService.ImportJob)
+	jobService.RegisterHandler(service.JobTypeExportAccountData, accountExportService.ExportJob)
+	reminderService := service.NewReminderService(s.reminderRepo, time.Minute, 3, time.Minute)
+	reminderService.RegisterChannel(service.ReminderChannelInApp, notificationService)
+
+	apiHandler := handler.NewAPIHandler(
+		handler.NewTodoHandler(todoService, service.NewDependencyService(s.depRepo, nil), settingsService),
+		handler.NewSyncHandler(service.NewSyncService(s.syncRepo, nil)),
+		handler.NewJobHandler(jobService),
+		handler.NewStatusHandler(service.NewStatusService(s.statusRepo, nil)),
+		handler.NewNotificationHandler(reminderService, notificationService),
+		handler.NewCalendarHandler(service.NewCalendarFeedService(s.calRepo), "http://localhost:4000"),
+		handler.NewPersonalAccessTokenHandler(service.NewPersonalAccessTokenService(s.tokenRepo)),
+		handler.NewExportHandler(service.NewTodoExportService(s.exportRepo, nil)),
+		handler.NewImportHandler(externalImportService, jobService),
+		handler.NewProjectHandler(service.NewProjectService(s.projRepo)),
+		handler.NewAccountExportHandler(accountExportService, jobService),
+		handler.NewQuickAddHandler(service.NewQuickAddService(s.todoRepo, nil)),
+		handler.NewUserSettingsHandler(settingsService),
+	)
+
+	openAPIRouter, err := newOpenAPIRouter()
+	require.NoError(t, err)
+
+	fakeAuth := func(f gen.StrictHandlerFunc, operationID string) gen.StrictHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			if s.anonymous {
+				return nil, problem.NewError(http.StatusUnauthorized, gen.ErrorCodeUnauthorized)
+			}
+			c.SetRequest(c.Request().WithContext(auth.WithUserID(c.Request().Context(), 1)))
+			return f(c, request)
+		}
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = customHTTPErrorHandler
+	e.Use(requestContextMiddleware(settingsService))
+	e.Use(responseValidatorMiddleware(t, openAPIRouter))
+	e.Use(requestValidatorMiddleware(openAPIRouter, func(*http.Request) bool { return !s.anonymous }))
+	gen.RegisterHandlers(e, gen.NewStrictHandler(apiHandler, []gen.StrictMiddlewareFunc{fakeAuth}))
+
+	s.echo = e
+	return s
+}
+
+func (s *testServer) do(method, target, body string) *httptest.ResponseRecorder {
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, r)
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	// ユーザー設定のロケールを読み込まないように言語を指定する
+	req.Header.Set("Accept-Language", "en")
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) gen.Problem {
+	t.Helper()
+	assert.Equal(t, problem.ContentType, rec.Header().Get(echo.HeaderContentType))
+	var p gen.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+	return p
+}
+
+func TestRequestValidatorMiddleware(t *testing.T) {
+	t.Run("正常系: 仕様どおりのリクエストはハンドラーに渡す", func(t *testing.T) {
+		s := newTestServer(t)
+		now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		s.todoRepo.EXPECT().
+			GetTodoByID(mock.Anything, sqlc.GetTodoByIDParams{ID: 1, UserID: 1}).
+			Return(sqlc.Todo{ID: 1, UserID: 1, Title: "Test Todo", CreatedAt: now, UpdatedAt: now, Position: "a0"}, nil)
+		s.depRepo.EXPECT().ListTodoBlockers(mock.Anything, mock.Anything).Return(nil, nil)
+		s.depRepo.EXPECT().ListTodoDependents(mock.Anything, mock.Anything).Return(nil, nil)
+
+		rec := s.do(http.MethodGet, "/todos/1", "")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("正常系: ハンドラーのエラーレスポンスも仕様に一致する", func(t *testing.T) {
+		s := newTestServer(t)
+		s.todoRepo.EXPECT().
+			GetTodoByID(mock.Anything, sqlc.GetTodoByIDParams{ID: 2, UserID: 1}).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		rec := s.do(http.MethodGet, "/todos/2", "")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, gen.ErrorCodeTodoNotFound, decodeProblem(t, rec).Code)
+	})
+
+	t.Run("正常系: 仕様にないルートは検証せずに通す", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodGet, "/unknown", "")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, gen.ErrorCodeNotFound, decodeProblem(t, rec).Code)
+	})
+
+	t.Run("異常系: パスパラメータの形式の誤りを項目ごとのエラーで返す", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodGet, "/todos/abc", "")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		p := decodeProblem(t, rec)
+		assert.Equal(t, gen.ErrorCodeValidationFailed, p.Code)
+		require.NotNil(t, p.Errors)
+		require.Len(t, *p.Errors, 1)
+		assert.Equal(t, gen.FieldErrorInPath, (*p.Errors)[0].In)
+		assert.Equal(t, "id", (*p.Errors)[0].Field)
+	})
+
+	t.Run("異常系: 必須のプロパティがないボディを拒否する", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodPost, "/todos", `{"description":"no title"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		p := decodeProblem(t, rec)
+		assert.Equal(t, gen.ErrorCodeValidationFailed, p.Code)
+		require.NotNil(t, p.Errors)
+		require.Len(t, *p.Errors, 1)
+		assert.Equal(t, gen.FieldErrorInBody, (*p.Errors)[0].In)
+		assert.Equal(t, "/title", (*p.Errors)[0].Field)
+	})
+
+	t.Run("異常系: 空の配列（minItems）を拒否する", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodPost, "/todos/batch/complete", `{"ids":[]}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		p := decodeProblem(t, rec)
+		assert.Equal(t, gen.ErrorCodeValidationFailed, p.Code)
+		require.NotNil(t, p.Errors)
+		require.Len(t, *p.Errors, 1)
+		assert.Equal(t, gen.FieldErrorInBody, (*p.Errors)[0].In)
+		assert.Equal(t, "/ids", (*p.Errors)[0].Field)
+	})
+
+	t.Run("異常系: 複数の違反をまとめて返す", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodPost, "/todos", `{"title":1,"due_at":"tomorrow"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		p := decodeProblem(t, rec)
+		require.NotNil(t, p.Errors)
+		var fields []string
+		for _, fe := range *p.Errors {
+			fields = append(fields, fe.Field)
+		}
+		assert.ElementsMatch(t, []string{"/title", "/due_at"}, fields)
+	})
+
+	t.Run("異常系: セッションがなければボディを検証せずに 401 を返す", func(t *testing.T) {
+		s := newTestServer(t)
+		s.anonymous = true
+
+		rec := s.do(http.MethodPost, "/todos", `{"description":"no title"}`)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, gen.ErrorCodeUnauthorized, decodeProblem(t, rec).Code)
+	})
+
+	t.Run("異常系: 空のタイトル（minLength）を拒否する", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodPost, "/todos", `{"title":""}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		p := decodeProblem(t, rec)
+		require.NotNil(t, p.Errors)
+		require.Len(t, *p.Errors, 1)
+		assert.Equal(t, "/title", (*p.Errors)[0].Field)
+	})
+
+	t.Run("異常系: 負のID（minimum）を拒否する", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodGet, "/todos/-1", "")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		p := decodeProblem(t, rec)
+		require.NotNil(t, p.Errors)
+		require.Len(t, *p.Errors, 1)
+		assert.Equal(t, gen.FieldErrorInPath, (*p.Errors)[0].In)
+		assert.Equal(t, "id", (*p.Errors)[0].Field)
+	})
+}
+
+// 各ハンドラーのレスポンスが仕様に一致するか（検証は responseValidatorMiddleware が行う）
+func TestHandlerResponsesMatchSpec(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	job := sqlc.Job{ID: 1, UserID: 1, Type: "delete_todos", Status: "succeeded", Result: []byte(`{"processed":2}`), Total: 2, Processed: 2, CreatedAt: now, UpdatedAt: now,
+		StartedAt: pgtype.Timestamptz{Time: now, Valid: true}, FinishedAt: pgtype.Timestamptz{Time: now, Valid: true}}
+	pending := sqlc.Job{ID: 2, UserID: 1, Type: "delete_todos", Status: "pending", CreatedAt: now, UpdatedAt: now}
+
+	t.Run("正常系: 同期トークンの誤り", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodPost, "/sync", `{"sync_token":"bad","operations":[]}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, gen.ErrorCodeInvalidSyncToken, decodeProblem(t, rec).Code)
+	})
+
+	t.Run("正常系: ジョブの登録・取得・キャンセル", func(t *testing.T) {
+		s := newTestServer(t)
+		s.jobRepo.EXPECT().CreateJob(mock.Anything, mock.Anything).Return(pending, nil)
+		s.jobRepo.EXPECT().GetJobByID(mock.Anything, sqlc.GetJobByIDParams{ID: 1, UserID: 1}).Return(job, nil)
+		s.jobRepo.EXPECT().CancelJob(mock.Anything, sqlc.CancelJobParams{ID: 1, UserID: 1}).Return(sqlc.Job{}, pgx.ErrNoRows)
+
+		assert.Equal(t, http.StatusAccepted, s.do(http.MethodPost, "/jobs", `{"type":"delete_todos","filter":{"completed":true}}`).Code)
+		assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/jobs/1", "").Code)
+		assert.Equal(t, http.StatusConflict, s.do(http.MethodPost, "/jobs/1/cancel", "").Code)
+	})
+
+	t.Run("正常系: ステータスの検証エラーとIf-Matchの省略", func(t *testing.T) {
+		s := newTestServer(t)
+
+		rec := s.do(http.MethodPost, "/statuses", `{"name":" "}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, gen.ErrorCodeInvalidStatus, decodeProblem(t, rec).Code)
+
+		rec = s.do(http.MethodPut, "/todos/1/status", `{"status_id":2}`)
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+
+	t.Run("正常系: 通知とリマインダー", func(t *testing.T) {
+		s := newTestServer(t)
+		todoID, offset := int64(1), int32(30)
+		s.notifyRepo.EXPECT().ListNotifications(mock.Anything, mock.Anything).Return([]sqlc.Notification{
+			{ID: 3, UserID: 1, Type: "reminder", Title: "Buy milk", Body: "Due soon", TodoID: &todoID, CreatedAt: now},
+		}, nil)
+		s.notifyRepo.EXPECT().CountUnreadNotifications(mock.Anything, int64(1)).Return(int64(1), nil)
+		s.notifyRepo.EXPECT().MarkAllNotificationsRead(mock.Anything, mock.Anything).Return(int64(1), nil)
+		s.notifyRepo.EXPECT().ListNotificationPreferences(mock.Anything, int64(1)).Return(nil, nil)
+		s.reminderRepo.EXPECT().GetTodoByID(mock.Anything, sqlc.GetTodoByIDParams{ID: 1, UserID: 1}).
+			Return(sqlc.Todo{ID: 1, UserID: 1, Title: "Buy milk", DueAt: pgtype.Timestamptz{Time: now, Valid: true}}, nil)
+		s.reminderRepo.EXPECT().ListRemindersByTodo(mock.Anything, mock.Anything).Return([]sqlc.Reminder{
+			{ID: 4, UserID: 1, TodoID: 1, Channel: "in_app", OffsetMinutes: &offset, Status: "pending", CreatedAt: now, UpdatedAt: now},
+		}, nil)
+		s.reminderRepo.EXPECT().DeleteReminder(mock.Anything, mock.Anything).Return(int64(0), nil)
+
+		assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/notifications", "").Code)
+		assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/notifications/unread-count", "").Code)
+		assert.Equal(t, http.StatusOK, s.do(http.MethodPost, "/notifications/read-all", "").Code)
+		assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/notifications/preferences", "").Code)
+		assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/todos/1/reminders", "").Code)
+		assert.Equal(t, http.StatusNotFound, s.do(http.MethodDelete, "/reminders/1", "").Code)
+	})
+
+	t.Run("正常系: インポートとエクスポート", func(t *testing.T) {
+		s := newTestServer(t)
+		s.importRepo.EXPECT().GetUserSettings(mock.Anything, int64(1)).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		s.jobRepo.EXPECT().CreateJob(mock.Anything, mock.Anything).Return(pending, nil)
+
+		assert.Equal(t, http.StatusAccepted, s.do(http.MethodPost, "/todos/import/todoist", `{"items":[{"id":"1","content":"Buy milk"}]}`).Code)
+		assert.Equal(t, http.StatusAccepted, s.do(http.MethodPost, "/users/me/export", "").Code)
+		assert.Equal(t, http.StatusForbidden, s.do(http.MethodGet, "/exports/1?expires=4102444800&signature=bad", "").Code)
+
+		// エクスポートの本体はスナップショットのトランザクションで読み込むため、ここでは検証エラーだけを確認する
+		assert.Equal(t, http.StatusBadRequest, s.do(http.MethodGet, "/todos/export?format=xml", "").Code)
+	})
+
+	t.Run("正常系: ユーザー設定", func(t *testing.T) {
+		s := newTestServer(t)
+		s.settingsRepo.EXPECT().GetUserSettings(mock.Anything, int64(1)).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		s.settingsRepo.EXPECT().ListNotificationPreferences(mock.Anything, int64(1)).Return(nil, nil)
+
+		assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/users/me/settings", "").Code)
+
+		rec := s.do(http.MethodPatch, "/users/me/settings", `{"timezone":"Nowhere/City"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, gen.ErrorCodeInvalidTimezone, decodeProblem(t, rec).Code)
+	})
+
+	t.Run("正常系: クイック追加", func(t *testing.T) {
+		s := newTestServer(t)
+		s.todoRepo.EXPECT().GetUserSettings(mock.Anything, int64(1)).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+
+		assert.Equal(t, http.StatusOK, s.do(http.MethodPost, "/todos/quick-add/preview", `{"text":"Pay rent tomorrow 9am monthly #home !high +Household"}`).Code)
+
+		rec := s.do(http.MethodPost, "/todos/quick-add", `{"text":"tomorrow"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, gen.ErrorCodeTitleRequired, decodeProblem(t, rec).Code)
+	})
+
+	t.Run("正常系: プロジェクト・トークン・カレンダーフィード", func(t *testing.T) {
+		s := newTestServer(t)
+		s.projRepo.EXPECT().ListProjectsByUser(mock.Anything, int64(1)).Return([]sqlc.Project{{ID: 5, UserID: 1, Name: "Home", CreatedAt: now, UpdatedAt: now}}, nil)
+		s.tokenRepo.EXPECT().ListPersonalAccessTokens(mock.Anything, int64(1)).Return([]sqlc.PersonalAccessToken{{ID: 6, UserID: 1, Name: "cli", CreatedAt: now}}, nil)
+		s.calRepo.EXPECT().UpsertCalendarFeed(mock.Anything, mock.Anything).Return(sqlc.CalendarFeed{}, nil)
+
+		assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/projects", "").Code)
+		assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/users/me/tokens", "").Code)
+		assert.Equal(t, http.StatusCreated, s.do(http.MethodPost, "/users/me/calendar-feed", "").Code)
+	})
+}
+
+// トランザクションを使う操作はテストサーバーで成功させられないため、ハンドラーが返す mapper の出力を仕様で検証する
+func TestResponseMappersMatchSpec(t *testing.T) {
+	openAPIRouter, err := newOpenAPIRouter()
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	todo := sqlc.Todo{ID: 1, UserID: 1, Title: "Buy milk", CreatedAt: now, UpdatedAt: now, Version: 2, Position: "a0", Tags: []string{"home"}}
+	wipLimit := int32(3)
+	status := sqlc.Status{ID: 2, UserID: 1, Name: "Doing", SortOrder: 1, WipLimit: &wipLimit, CreatedAt: now, UpdatedAt: now}
+	clientID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	prefs := []service.NotificationPreference{{Type: service.NotificationTypeReminder, Enabled: true}, {Type: service.NotificationTypeAdmin, Enabled: false}}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		status int
+		body   any
+	}{
+		{
+			name:   "正常系: 同期の結果",
+			method: http.MethodPost,
+			target: "/sync",
+			status: http.StatusOK,
+			body: mapper.SyncResultToResponse(&service.SyncResult{
+				SyncToken: "token",
+				Changes:   []sqlc.Todo{todo},
+				Conflicts: []service.SyncConflict{{ClientID: clientID, Field: "title", ClientValue: "a", ServerValue: "b", Resolution: service.SyncResolutionClient}},
+				Results:   []service.SyncOperationResult{{ClientID: clientID, Status: service.SyncStatusCreated, TodoID: &todo.ID}, {ClientID: clientID, Status: service.SyncStatusRejected, Error: "todo has open blockers"}},
+			}),
+		},
+		{
+			name:   "正常系: ステータスの一覧",
+			method: http.MethodGet,
+			target: "/statuses",
+			status: http.StatusOK,
+			body:   mapper.StatusesToResponse([]sqlc.Status{status}),
+		},
+		{
+			name:   "正常系: 作成したステータス",
+			method: http.MethodPost,
+			target: "/statuses",
+			status: http.StatusCreated,
+			body:   mapper.StatusToResponse(&status),
+		},
+		{
+			name:   "正常系: ボード",
+			method: http.MethodGet,
+			target: "/board",
+			status: http.StatusOK,
+			body:   mapper.BoardToResponse(&service.Board{Columns: []service.BoardColumn{{Status: status, Todos: []sqlc.Todo{todo}}}}),
+		},
+		{
+			name:   "正常系: ステータスを設定したTodo",
+			method: http.MethodPut,
+			target: "/todos/1/status",
+			status: http.StatusOK,
+			body:   mapper.TodoToResponse(&todo),
+		},
+		{
+			name:   "正常系: クイック追加したTodo",
+			method: http.MethodPost,
+			target: "/todos/quick-add",
+			status: http.StatusCreated,
+			body:   mapper.TodoToResponse(&todo),
+		},
+		{
+			name:   "正常系: 更新したユーザー設定",
+			method: http.MethodPatch,
+			target: "/users/me/settings",
+			status: http.StatusOK,
+			body: mapper.UserSettingsToResponse(&service.UserSettings{
+				Timezone: "Asia/Tokyo", Location: tokyo, Locale: "ja", WeekStart: time.Monday, DefaultSort: service.TodoSortManual, Notifications: prefs,
+			}),
+		},
+		{
+			name:   "正常系: 更新した通知の設定",
+			method: http.MethodPut,
+			target: "/notifications/preferences",
+			status: http.StatusOK,
+			body:   mapper.NotificationPreferencesToResponse(prefs),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			route, pathParams, err := openAPIRouter.FindRoute(req)
+			require.NoError(t, err)
+			body, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+			header := http.Header{echo.HeaderContentType: []string{echo.MIMEApplicationJSON}, "Etag": []string{`"2"`}}
+
+			assert.NoError(t, validateResponse(req, route, pathParams, tt.status, header, body))
+		})
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	openAPIRouter, err := newOpenAPIRouter()
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	route, pathParams, err := openAPIRouter.FindRoute(req)
+	require.NoError(t, err)
+	header := http.Header{echo.HeaderContentType: []string{echo.MIMEApplicationJSON}}
+
+	t.Run("正常系: 仕様どおりのレスポンスを受け入れる", func(t *testing.T) {
+		err := validateResponse(req, route, pathParams, http.StatusOK, header, []byte(`{"status":"ok"}`))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: スキーマに違反するレスポンスを検出する", func(t *testing.T) {
+		err := validateResponse(req, route, pathParams, http.StatusOK, header, []byte(`{"status":1}`))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 仕様にないステータスコードを検出する", func(t *testing.T) {
+		err := validateResponse(req, route, pathParams, http.StatusTeapot, header, []byte(`{"status":"ok"}`))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestJSONPointer(t *testing.T) {
+	t.Run("正常系: RFC 6901 のとおりにエスケープする", func(t *testing.T) {
+		assert.Equal(t, "", jsonPointer(nil))
+		assert.Equal(t, "/items/0/title", jsonPointer([]string{"items", "0", "title"}))
+		assert.Equal(t, "/a~1b/c~0d", jsonPointer([]string{"a/b", "c~d"}))
+	})
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go-todo/db/sqlc"
+)
+
+type AccountExportRepository interface {
+	GetUserByID(ctx context.Context, id int64) (sqlc.User, error)
+	ListTodosForExportPage(ctx context.Context, arg sqlc.ListTodosForExportPageParams) ([]sqlc.Todo, error)
+	ListProjectsByUser(ctx context.Context, userID int64) ([]sqlc.Project, error)
+	ListStatusesByUser(ctx context.Context, userID int64) ([]sqlc.Status, error)
+	ListTodoDependenciesByUser(ctx context.Context, userID int64) ([]sqlc.TodoDependency, error)
+	ListNotificationPreferences(ctx context.Context, userID int64) ([]sqlc.NotificationPreference, error)
+	GetUserSettings(ctx context.Context, userID int64) (sqlc.UserSetting, error)
+	ListPersonalAccessTokens(ctx context.Context, userID int64) ([]sqlc.PersonalAccessToken, error)
+	ListJobsByUser(ctx context.Context, userID int64) ([]sqlc.Job, error)
+	ListNotificationsByUser(ctx context.Context, userID int64) ([]sqlc.Notification, error)
+	ListRemindersByUser(ctx context.Context, userID int64) ([]sqlc.Reminder, error)
+	CreateAccountExport(ctx context.Context, arg sqlc.CreateAccountExportParams) (int64, error)
+	CreateAccountExportChunk(ctx context.Context, arg sqlc.CreateAccountExportChunkParams) error
+	FinishAccountExport(ctx context.Context, arg sqlc.FinishAccountExportParams) error
+	ClaimAccountExport(ctx context.Context, id int64) (sqlc.ClaimAccountExportRow, error)
+	GetAccountExportChunk(ctx context.Context, arg sqlc.GetAccountExportChunkParams) ([]byte, error)
+	DeleteFinishedAccountExports(ctx context.Context, before time.Time) (int64, error)
+}
+
+// sqlc.Querier が AccountExportRepository を満たすことを保証
+var _ AccountExportRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,601 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+	"go-todo/internal/takeout"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// 署名が一致しないリンク
+	ErrAccountExportLinkInvalid = errors.New("invalid account export link")
+	// 期限切れ、またはダウンロード済みのエクスポート
+	ErrAccountExportGone = errors.New("account export expired or already downloaded")
+)
+
+// ZIPを保存するときのチャンクのバイト数
+const accountExportChunkSize = 1 << 20
+
+// ダウンロード中のエクスポートを消さないよう、RunCleanup は期限切れやダウンロードからこの時間がたった行だけを削除する
+const accountExportRetention = time.Hour
+
+// エクスポートジョブのパラメーター
+type AccountExportJobParams struct {
+	// エクスポートが成功した後にアカウントを削除する（DELETE /users/me?export=true）
+	DeleteAccount bool `json:"delete_account,omitempty"`
+}
+
+// 保存したエクスポートのダウンロードリンク（エクスポートジョブの結果）
+type AccountExportLink struct {
+	ExportID    int64     `json:"export_id"`
+	DownloadURL string    `json:"download_url"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	// ZIPのバイト数
+	Size int64 `json:"size"`
+}
+
+// ダウンロードするZIP。Body はチャンクを順にDBから読み出す
+type AccountExportDownload struct {
+	Size int64
+	Body io.Reader
+}
+
+// アカウントのデータ一式（GDPRのデータポータビリティ）をZIPにまとめ、一度だけダウンロードできる署名付きリンクを発行する
+// ZIPはメモリーにまとめず、一定のサイズのチャンクに分けながらDBに保存する。期限切れとダウンロード済みの行は RunCleanup で削除する
+type AccountExportService struct {
+	repo AccountExportRepository
+	// データは読み取り専用のスナップショットで読み込み、ファイル間で食い違わないようにする
+	txManager database.TxManager
+	// チャンクはすべて1つのトランザクションで保存し、途中で失敗したエクスポートを残さない
+	storeTxManager database.TxManager
+	withTx         func(tx pgx.Tx) AccountExportRepository
+	// エクスポートの後にアカウントを削除するジョブで使う
+	deleteAccount func(ctx context.Context, userID int64) error
+	key           []byte
+	ttl           time.Duration
+	baseURL       string
+	now           func() time.Time
+	pageSize      int32
+	chunkSize     int
+}
+
+// signingKey は設定で必須（ACCOUNT_EXPORT_SIGNING_KEY）。再起動や複数台の構成でも発行済みのリンクを検証できるよう、固定の鍵を使う
+// baseURL はAPIの公開URLで、リンクは {baseURL}/exports/{id} になる
+// deleteAccount は AccountExportJobParams.DeleteAccount のジョブでエクスポートの後に呼ぶ（UserService.DeleteAccount）
+func NewAccountExportService(repo AccountExportRepository, pool *pgxpool.Pool, deleteAccount func(ctx context.Context, userID int64) error, signingKey []byte, ttl time.Duration, baseURL string) *AccountExportService {
+	return &AccountExportService{
+		repo:           repo,
+		txManager:      database.NewSnapshotTxManager(pool),
+		storeTxManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) AccountExportRepository {
+			return sqlc.New(tx)
+		},
+		deleteAccount: deleteAccount,
+		key:           signingKey,
+		ttl:           ttl,
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		now:           time.Now,
+		pageSize:      exportPageSize,
+		chunkSize:     accountExportChunkSize,
+	}
+}
+
+// エクスポートジョブ（JobHandler）
+// DeleteAccount の場合はエクスポートを保存できてからアカウントを削除する。削除に失敗した場合はジョブを失敗にする
+func (s *AccountExportService) ExportJob(ctx context.Context, userID int64, params json.RawMessage, progress JobProgressFunc) (any, error) {
+	var p AccountExportJobParams
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, fmt.Errorf("decode job params: %w", err)
+		}
+	}
+	link, err := s.Export(ctx, userID, progress)
+	if err != nil {
+		return nil, err
+	}
+	if p.DeleteAccount {
+		if err := s.deleteAccount(ctx, userID); err != nil {
+			return nil, fmt.Errorf("delete account: %w", err)
+		}
+	}
+	return link, nil
+}
+
+// ユーザーのデータ一式をZIPにまとめて保存し、ダウンロードリンクを返す
+// progress にはZIPに書き出したファイルの数を報告する。nil なら報告しない
+func (s *AccountExportService) Export(ctx context.Context, userID int64, progress JobProgressFunc) (*AccountExportLink, error) {
+	if progress == nil {
+		progress = func(int32, int32) error { return nil }
+	}
+	createdAt := s.now().UTC()
+	// リンクの期限は秒単位で署名するため、保存する期限もそろえる
+	expiresAt := createdAt.Add(s.ttl).Truncate(time.Second)
+
+	var id, size int64
+	var total int32
+	err := s.storeTxManager.RunInTx(ctx, func(storeTx pgx.Tx) error {
+		store := s.withTx(storeTx)
+		var err error
+		id, err = store.CreateAccountExport(ctx, sqlc.CreateAccountExportParams{
+			UserID:    userID,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("create account export: %w", err)
+		}
+
+		cw := &accountExportChunkWriter{ctx: ctx, repo: store, exportID: id, chunkSize: s.chunkSize}
+		zw := takeout.NewWriter(cw, createdAt)
+		err = s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+			sections := s.sections(s.withTx(tx), userID, createdAt)
+			// 最後の1つは保存
+			total = int32(len(sections) + 1)
+			if err := progress(0, total); err != nil {
+				return err
+			}
+			for i, section := range sections {
+				if err := section(ctx, zw); err != nil {
+					return err
+				}
+				if err := progress(int32(i+1), total); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("close zip: %w", err)
+		}
+		if err := cw.flush(); err != nil {
+			return err
+		}
+		size = cw.size
+		if err := store.FinishAccountExport(ctx, sqlc.FinishAccountExportParams{
+			ID:     id,
+			Size:   cw.size,
+			Chunks: cw.seq,
+		}); err != nil {
+			return fmt.Errorf("finish account export: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := progress(total, total); err != nil {
+		return nil, err
+	}
+	return &AccountExportLink{
+		ExportID:    id,
+		DownloadURL: s.downloadURL(id, expiresAt),
+		ExpiresAt:   expiresAt,
+		Size:        size,
+	}, nil
+}
+
+// 署名付きリンクのZIPを返す。一度ダウンロードすると同じリンクでは取得できない
+// Body は ctx でチャンクを読み出すため、ctx が有効なうちに読み終える
+func (s *AccountExportService) Download(ctx context.Context, id, expires int64, signature string) (*AccountExportDownload, error) {
+	if !hmac.Equal([]byte(signature), []byte(s.sign(id, expires))) {
+		return nil, ErrAccountExportLinkInvalid
+	}
+	if s.now().Unix() >= expires {
+		return nil, ErrAccountExportGone
+	}
+	claimed, err := s.repo.ClaimAccountExport(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAccountExportGone
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &AccountExportDownload{
+		Size: claimed.Size,
+		Body: &accountExportReader{ctx: ctx, repo: s.repo, exportID: id, chunks: claimed.Chunks},
+	}, nil
+}
+
+// 期限切れとダウンロード済みのエクスポートを定期的に削除する（ctxがキャンセルされるまでブロックする）
+func (s *AccountExportService) RunCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.repo.DeleteFinishedAccountExports(ctx, s.now().Add(-accountExportRetention))
+			if err != nil {
+				log.Printf("Failed to delete finished account exports: %v", err)
+				continue
+			}
+			if deleted > 0 {
+				log.Printf("Deleted %d finished account exports", deleted)
+			}
+		}
+	}
+}
+
+func (s *AccountExportService) downloadURL(id int64, expiresAt time.Time) string {
+	expires := expiresAt.Unix()
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires, 10))
+	q.Set("signature", s.sign(id, expires))
+	return fmt.Sprintf("%s/exports/%d?%s", s.baseURL, id, q.Encode())
+}
+
+// IDと期限のHMAC-SHA256
+func (s *AccountExportService) sign(id, expires int64) string {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%d.%d", id, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ZIPをチャンクに分けて保存する io.Writer
+type accountExportChunkWriter struct {
+	ctx       context.Context
+	repo      AccountExportRepository
+	exportID  int64
+	chunkSize int
+	buf       []byte
+	// 保存したチャンクの数とバイト数
+	seq  int32
+	size int64
+}
+
+func (w *accountExportChunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if w.buf == nil {
+			w.buf = make([]byte, 0, w.chunkSize)
+		}
+		n := min(len(p), w.chunkSize-len(w.buf))
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(w.buf) == w.chunkSize {
+			if err := w.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// 溜まっているデータを1つのチャンクとして保存する
+func (w *accountExportChunkWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	if err := w.repo.CreateAccountExportChunk(w.ctx, sqlc.CreateAccountExportChunkParams{
+		ExportID: w.exportID,
+		Seq:      w.seq,
+		Data:     w.buf,
+	}); err != nil {
+		return fmt.Errorf("create account export chunk %d: %w", w.seq, err)
+	}
+	w.seq++
+	w.size += int64(len(w.buf))
+	// 保存した buf はクエリの引数に渡したため使い回さない
+	w.buf = nil
+	return nil
+}
+
+// 保存したチャンクを順に読み出す io.Reader
+type accountExportReader struct {
+	ctx      context.Context
+	repo     AccountExportRepository
+	exportID int64
+	chunks   int32
+	seq      int32
+	buf      []byte
+}
+
+func (r *accountExportReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.seq >= r.chunks {
+			return 0, io.EOF
+		}
+		data, err := r.repo.GetAccountExportChunk(r.ctx, sqlc.GetAccountExportChunkParams{ExportID: r.exportID, Seq: r.seq})
+		if err != nil {
+			return 0, fmt.Errorf("get account export chunk %d: %w", r.seq, err)
+		}
+		r.buf = data
+		r.seq++
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// ZIPに書き出すファイルの順番
+func (s *AccountExportService) sections(repo AccountExportRepository, userID int64, createdAt time.Time) []func(context.Context, *takeout.Writer) error {
+	tags := map[string]int{}
+	return []func(context.Context, *takeout.Writer) error{
+		func(ctx context.Context, zw *takeout.Writer) error {
+			user, err := repo.GetUserByID(ctx, userID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			if err != nil {
+				return fmt.Errorf("get user: %w", err)
+			}
+			return zw.WriteJSON("profile.json", exportProfile{
+				ID:         user.ID,
+				Email:      user.Email,
+				Name:       user.Name,
+				AvatarURL:  user.AvatarUrl,
+				Provider:   user.Provider,
+				CreatedAt:  user.CreatedAt,
+				UpdatedAt:  user.UpdatedAt,
+				ExportedAt: createdAt,
+			})
+		},
+		func(ctx context.Context, zw *takeout.Writer) error {
+			return s.writeTodos(ctx, repo, zw, userID, tags)
+		},
+		func(_ context.Context, zw *takeout.Writer) error {
+			return zw.WriteJSON("tags.json", sortedTags(tags))
+		},
+		listSection("projects.json", userID, repo.ListProjectsByUser),
+		listSection("statuses.json", userID, repo.ListStatusesByUser),
+		listSection("dependencies.json", userID, repo.ListTodoDependenciesByUser),
+		listSection("notification_preferences.json", userID, repo.ListNotificationPreferences),
+		func(ctx context.Context, zw *takeout.Writer) error {
+			settings, err := getUserSettingsRow(ctx, repo, userID)
+			if err != nil {
+				return err
+			}
+			return zw.WriteJSON("settings.json", exportSettings{
+				Timezone:         settings.Timezone,
+				Locale:           settings.Locale,
+				WeekStart:        settings.WeekStart,
+				DefaultSort:      settings.DefaultSort,
+				DefaultProjectID: settings.DefaultProjectID,
+			})
+		},
+		func(ctx context.Context, zw *takeout.Writer) error {
+			tokens, err := repo.ListPersonalAccessTokens(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("list personal access tokens: %w", err)
+			}
+			// トークンのハッシュは含めない
+			out := make([]exportToken, len(tokens))
+			for i, t := range tokens {
+				out[i] = exportToken{ID: t.ID, Name: t.Name, CreatedAt: t.CreatedAt, LastUsedAt: timePtr(t.LastUsedAt)}
+			}
+			return zw.WriteJSON("personal_access_tokens.json", out)
+		},
+		func(ctx context.Context, zw *takeout.Writer) error {
+			jobs, err := repo.ListJobsByUser(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("list jobs: %w", err)
+			}
+			out := make([]exportJob, len(jobs))
+			for i := range jobs {
+				out[i] = toExportJob(&jobs[i])
+			}
+			return zw.WriteJSON("history/jobs.json", out)
+		},
+		listSection("history/notifications.json", userID, repo.ListNotificationsByUser),
+		listSection("history/reminders.json", userID, repo.ListRemindersByUser),
+	}
+}
+
+// 削除済みを含むすべてのTodoを1件ずつ書き出し、タグごとの件数を数える
+func (s *AccountExportService) writeTodos(ctx context.Context, repo AccountExportRepository, zw *takeout.Writer, userID int64, tags map[string]int) error {
+	statuses, err := repo.ListStatusesByUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list statuses: %w", err)
+	}
+	statusNames := make(map[int64]string, len(statuses))
+	for _, st := range statuses {
+		statusNames[st.ID] = st.Name
+	}
+	projects, err := repo.ListProjectsByUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list projects: %w", err)
+	}
+	projectNames := make(map[int64]string, len(projects))
+	for _, p := range projects {
+		projectNames[p.ID] = p.Name
+	}
+
+	arr, err := zw.CreateArray("todos.json")
+	if err != nil {
+		return err
+	}
+	err = forEachTodoForExport(ctx, repo, sqlc.ListTodosForExportPageParams{
+		UserID:         userID,
+		IncludeDeleted: true,
+		MaxRows:        s.pageSize,
+	}, func(t *sqlc.Todo) error {
+		for _, tag := range t.Tags {
+			tags[tag]++
+		}
+		return arr.Write(toExportTodo(t, statusNames, projectNames))
+	})
+	if err != nil {
+		return fmt.Errorf("export todos: %w", err)
+	}
+	return arr.Close()
+}
+
+// 一覧を取得してそのままJSONファイルにする
+func listSection[T any](name string, userID int64, list func(context.Context, int64) ([]T, error)) func(context.Context, *takeout.Writer) error {
+	return func(ctx context.Context, zw *takeout.Writer) error {
+		rows, err := list(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("list %s: %w", name, err)
+		}
+		return zw.WriteJSON(name, rows)
+	}
+}
+
+type exportProfile struct {
+	ID         int64     `json:"id"`
+	Email      string    `json:"email"`
+	Name       string    `json:"name"`
+	AvatarURL  *string   `json:"avatar_url"`
+	Provider   string    `json:"provider"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+type exportTodo struct {
+	ID             int64      `json:"id"`
+	ClientID       string     `json:"client_id"`
+	Title          string     `json:"title"`
+	Description    *string    `json:"description"`
+	Completed      bool       `json:"completed"`
+	StatusID       *int64     `json:"status_id"`
+	Status         *string    `json:"status"`
+	ProjectID      *int64     `json:"project_id"`
+	Project        *string    `json:"project"`
+	Tags           []string   `json:"tags"`
+	DueAt          *time.Time `json:"due_at"`
+	Priority       *string    `json:"priority"`
+	Recurrence     *string    `json:"recurrence"`
+	Position       string     `json:"position"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	DeletedAt      *time.Time `json:"deleted_at"`
+	Version        int32      `json:"version"`
+	ImportSource   *string    `json:"import_source"`
+	ImportSourceID *string    `json:"import_source_id"`
+}
+
+type exportSettings struct {
+	Timezone         string `json:"timezone"`
+	Locale           string `json:"locale"`
+	WeekStart        int16  `json:"week_start"`
+	DefaultSort      string `json:"default_sort"`
+	DefaultProjectID *int64 `json:"default_project_id"`
+}
+
+type exportTag struct {
+	Name string `json:"name"`
+	// 削除済みを含め、このタグが付いたTodoの件数
+	Count int `json:"count"`
+}
+
+type exportToken struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+type exportJob struct {
+	ID         int64           `json:"id"`
+	Type       string          `json:"type"`
+	Status     string          `json:"status"`
+	Params     json.RawMessage `json:"params"`
+	Result     json.RawMessage `json:"result"`
+	Error      *string         `json:"error"`
+	Total      int32           `json:"total"`
+	Processed  int32           `json:"processed"`
+	CreatedAt  time.Time       `json:"created_at"`
+	StartedAt  *time.Time      `json:"started_at"`
+	FinishedAt *time.Time      `json:"finished_at"`
+}
+
+func toExportTodo(t *sqlc.Todo, statusNames, projectNames map[int64]string) exportTodo {
+	out := exportTodo{
+		ID:             t.ID,
+		ClientID:       t.ClientID.String(),
+		Title:          t.Title,
+		Description:    t.Description,
+		Completed:      t.Completed,
+		StatusID:       t.StatusID,
+		ProjectID:      t.ProjectID,
+		Tags:           t.Tags,
+		DueAt:          timePtr(t.DueAt),
+		Priority:       t.Priority,
+		Recurrence:     t.Recurrence,
+		Position:       t.Position,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		DeletedAt:      timePtr(t.DeletedAt),
+		Version:        t.Version,
+		ImportSource:   t.ImportSource,
+		ImportSourceID: t.ImportSourceID,
+	}
+	if out.Tags == nil {
+		out.Tags = []string{}
+	}
+	if t.StatusID != nil {
+		if name, ok := statusNames[*t.StatusID]; ok {
+			out.Status = &name
+		}
+	}
+	if t.ProjectID != nil {
+		if name, ok := projectNames[*t.ProjectID]; ok {
+			out.Project = &name
+		}
+	}
+	return out
+}
+
+func toExportJob(j *sqlc.Job) exportJob {
+	out := exportJob{
+		ID:         j.ID,
+		Type:       j.Type,
+		Status:     j.Status,
+		Error:      j.Error,
+		Total:      j.Total,
+		Processed:  j.Processed,
+		CreatedAt:  j.CreatedAt,
+		StartedAt:  timePtr(j.StartedAt),
+		FinishedAt: timePtr(j.FinishedAt),
+	}
+	// params と result はJSONとして保存されている
+	if len(j.Params) > 0 {
+		out.Params = j.Params
+	}
+	if len(j.Result) > 0 {
+		out.Result = j.Result
+	}
+	return out
+}
+
+func sortedTags(counts map[string]int) []exportTag {
+	tags := make([]exportTag, 0, len(counts))
+	for name, count := range counts {
+		tags = append(tags, exportTag{Name: name, Count: count})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+	return tags
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	tm := t.Time
+	return &tm
+}
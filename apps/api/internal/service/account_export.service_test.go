@@ -0,0 +1,378 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var accountExportTestKey = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestAccountExportService(repo AccountExportRepository, now time.Time) *AccountExportService {
+	svc := NewAccountExportService(repo, nil, nil, accountExportTestKey, 24*time.Hour, "https://api.example.com")
+	svc.txManager = fakeTxManager{}
+	svc.storeTxManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) AccountExportRepository { return repo }
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func readTakeout(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[f.Name] = string(b)
+	}
+	return files
+}
+
+// ダウンロードURLから Download の引数を取り出す
+func parseDownloadURL(t *testing.T, link string) (int64, int64, string) {
+	t.Helper()
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+	id, err := strconv.ParseInt(strings.TrimPrefix(u.Path, "/exports/"), 10, 64)
+	require.NoError(t, err)
+	expires, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	return id, expires, u.Query().Get("signature")
+}
+
+// 保存するチャンクを順に連結して返す。FinishAccountExport にはその合計を期待する
+func expectAccountExportStore(repo *mocks.MockAccountExportRepository, id int64) *[]byte {
+	var stored []byte
+	var chunks int32
+	repo.EXPECT().CreateAccountExportChunk(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, arg sqlc.CreateAccountExportChunkParams) error {
+			if arg.ExportID != id || arg.Seq != chunks {
+				return errors.New("unexpected chunk")
+			}
+			stored = append(stored, arg.Data...)
+			chunks++
+			return nil
+		})
+	repo.EXPECT().FinishAccountExport(mock.Anything, mock.MatchedBy(func(arg sqlc.FinishAccountExportParams) bool {
+		return arg.ID == id && arg.Size == int64(len(stored)) && arg.Chunks == chunks
+	})).Return(nil)
+	return &stored
+}
+
+func expectEmptyAccountExportLists(repo *mocks.MockAccountExportRepository, userID int64) {
+	repo.EXPECT().ListTodoDependenciesByUser(mock.Anything, userID).Return([]sqlc.TodoDependency{}, nil)
+	repo.EXPECT().ListNotificationPreferences(mock.Anything, userID).Return([]sqlc.NotificationPreference{}, nil)
+	repo.EXPECT().GetUserSettings(mock.Anything, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+	repo.EXPECT().ListNotificationsByUser(mock.Anything, userID).Return([]sqlc.Notification{}, nil)
+	repo.EXPECT().ListRemindersByUser(mock.Anything, userID).Return([]sqlc.Reminder{}, nil)
+}
+
+func TestAccountExportService_Export(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+	now := time.Date(2026, 10, 25, 12, 0, 0, 500, time.UTC)
+
+	t.Run("正常系: 削除済みを含むデータ一式をZIPにまとめてチャンクに分けて保存し、署名付きリンクを返す", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+		svc.chunkSize = 256
+		statusID, projectID := int64(10), int64(20)
+		params, result := []byte(`{"ids":[1]}`), []byte(`{"processed":1}`)
+
+		repo.EXPECT().GetUserByID(mock.Anything, userID).Return(sqlc.User{ID: userID, Email: "alice@example.com", Name: "Alice", ProviderID: "g-1"}, nil)
+		repo.EXPECT().ListStatusesByUser(mock.Anything, userID).Return([]sqlc.Status{{ID: statusID, Name: "Doing"}}, nil)
+		repo.EXPECT().ListProjectsByUser(mock.Anything, userID).Return([]sqlc.Project{{ID: projectID, Name: "Home"}}, nil)
+		repo.EXPECT().ListTodosForExportPage(mock.Anything, sqlc.ListTodosForExportPageParams{UserID: userID, IncludeDeleted: true, MaxRows: exportPageSize}).
+			Return([]sqlc.Todo{
+				{ID: 1, Title: "Buy milk", StatusID: &statusID, ProjectID: &projectID, Tags: []string{"home", "errand"}},
+				{ID: 2, Title: "Old", Tags: []string{"home"}, DeletedAt: pgtype.Timestamptz{Time: now, Valid: true}},
+			}, nil)
+		repo.EXPECT().ListPersonalAccessTokens(mock.Anything, userID).Return([]sqlc.PersonalAccessToken{{ID: 3, Name: "laptop", TokenHash: "secret-hash"}}, nil)
+		repo.EXPECT().ListJobsByUser(mock.Anything, userID).Return([]sqlc.Job{{ID: 4, Type: "complete_todos", Params: params, Result: result}}, nil)
+		expectEmptyAccountExportLists(repo, userID)
+
+		expiresAt := time.Date(2026, 10, 26, 12, 0, 0, 0, time.UTC)
+		repo.EXPECT().CreateAccountExport(mock.Anything, mock.MatchedBy(func(arg sqlc.CreateAccountExportParams) bool {
+			return arg.UserID == userID && arg.ExpiresAt.Equal(expiresAt)
+		})).Return(int64(99), nil)
+		stored := expectAccountExportStore(repo, 99)
+
+		var reported []int32
+		got, err := svc.Export(ctx, userID, func(processed, total int32) error {
+			reported = append(reported, processed)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(99), got.ExportID)
+		assert.True(t, expiresAt.Equal(got.ExpiresAt))
+		assert.Equal(t, int64(len(*stored)), got.Size)
+		assert.Greater(t, got.Size, int64(svc.chunkSize))
+		assert.True(t, strings.HasPrefix(got.DownloadURL, "https://api.example.com/exports/99?"))
+		assert.Equal(t, int32(0), reported[0])
+		assert.Equal(t, reported[len(reported)-2]+1, reported[len(reported)-1])
+
+		files := readTakeout(t, *stored)
+		assert.ElementsMatch(t, []string{
+			"profile.json", "todos.json", "tags.json", "projects.json", "statuses.json", "dependencies.json",
+			"notification_preferences.json", "settings.json", "personal_access_tokens.json",
+			"history/jobs.json", "history/notifications.json", "history/reminders.json",
+		}, mapKeys(files))
+
+		var profile map[string]any
+		require.NoError(t, json.Unmarshal([]byte(files["profile.json"]), &profile))
+		assert.Equal(t, "alice@example.com", profile["email"])
+		assert.NotContains(t, files["profile.json"], "g-1")
+
+		var todos []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(files["todos.json"]), &todos))
+		require.Len(t, todos, 2)
+		assert.Equal(t, "Doing", todos[0]["status"])
+		assert.Equal(t, "Home", todos[0]["project"])
+		assert.NotNil(t, todos[1]["deleted_at"])
+
+		assert.JSONEq(t, `[{"name": "errand", "count": 1}, {"name": "home", "count": 2}]`, files["tags.json"])
+		assert.NotContains(t, files["personal_access_tokens.json"], "secret-hash")
+		assert.JSONEq(t, `{"timezone": "UTC", "locale": "en", "week_start": 1, "default_sort": "created_at", "default_project_id": null}`, files["settings.json"])
+		assert.JSONEq(t, `[{"id": 4, "type": "complete_todos", "status": "", "params": {"ids": [1]}, "result": {"processed": 1},
+			"error": null, "total": 0, "processed": 0, "created_at": "0001-01-01T00:00:00Z", "started_at": null, "finished_at": null}]`,
+			files["history/jobs.json"])
+		assert.Equal(t, "[]\n", files["history/reminders.json"])
+	})
+
+	t.Run("異常系: ユーザーが存在しない場合はErrUserNotFound", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+
+		repo.EXPECT().CreateAccountExport(mock.Anything, mock.Anything).Return(int64(5), nil)
+		repo.EXPECT().GetUserByID(mock.Anything, userID).Return(sqlc.User{}, pgx.ErrNoRows)
+
+		_, err := svc.Export(ctx, userID, nil)
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("異常系: キャンセルされた場合は保存を完了しない", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+
+		repo.EXPECT().CreateAccountExport(mock.Anything, mock.Anything).Return(int64(5), nil)
+		repo.EXPECT().GetUserByID(mock.Anything, userID).Return(sqlc.User{ID: userID}, nil)
+
+		_, err := svc.Export(ctx, userID, func(processed, total int32) error {
+			if processed > 0 {
+				return context.Canceled
+			}
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestAccountExportService_ExportJob(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+	now := time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC)
+
+	expectExport := func(repo *mocks.MockAccountExportRepository) {
+		repo.EXPECT().CreateAccountExport(mock.Anything, mock.Anything).Return(int64(5), nil)
+		repo.EXPECT().GetUserByID(mock.Anything, userID).Return(sqlc.User{ID: userID}, nil)
+		repo.EXPECT().ListStatusesByUser(mock.Anything, userID).Return([]sqlc.Status{}, nil)
+		repo.EXPECT().ListProjectsByUser(mock.Anything, userID).Return([]sqlc.Project{}, nil)
+		repo.EXPECT().ListTodosForExportPage(mock.Anything, mock.Anything).Return([]sqlc.Todo{}, nil)
+		repo.EXPECT().ListPersonalAccessTokens(mock.Anything, userID).Return([]sqlc.PersonalAccessToken{}, nil)
+		repo.EXPECT().ListJobsByUser(mock.Anything, userID).Return([]sqlc.Job{}, nil)
+		expectEmptyAccountExportLists(repo, userID)
+		expectAccountExportStore(repo, 5)
+	}
+	noProgress := func(int32, int32) error { return nil }
+
+	t.Run("正常系: パラメーターがなければエクスポートだけを行う", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+		svc.deleteAccount = func(context.Context, int64) error {
+			t.Fatal("deleteAccount must not be called")
+			return nil
+		}
+		expectExport(repo)
+
+		got, err := svc.ExportJob(ctx, userID, json.RawMessage(`{}`), noProgress)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), got.(*AccountExportLink).ExportID)
+	})
+
+	t.Run("正常系: delete_account の場合はエクスポートを保存してからアカウントを削除する", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+		var deleted []int64
+		svc.deleteAccount = func(_ context.Context, id int64) error {
+			// 削除より前にエクスポートの保存が完了している
+			repo.AssertCalled(t, "FinishAccountExport", mock.Anything, mock.Anything)
+			deleted = append(deleted, id)
+			return nil
+		}
+		expectExport(repo)
+
+		got, err := svc.ExportJob(ctx, userID, json.RawMessage(`{"delete_account":true}`), noProgress)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), got.(*AccountExportLink).ExportID)
+		assert.Equal(t, []int64{userID}, deleted)
+	})
+
+	t.Run("異常系: エクスポートに失敗した場合はアカウントを削除しない", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+		svc.deleteAccount = func(context.Context, int64) error {
+			t.Fatal("deleteAccount must not be called")
+			return nil
+		}
+		dbErr := errors.New("db error")
+		repo.EXPECT().CreateAccountExport(mock.Anything, mock.Anything).Return(int64(0), dbErr)
+
+		_, err := svc.ExportJob(ctx, userID, json.RawMessage(`{"delete_account":true}`), noProgress)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("異常系: アカウントの削除に失敗した場合はジョブを失敗にする", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+		svc.deleteAccount = func(context.Context, int64) error { return ErrUserNotFound }
+		expectExport(repo)
+
+		_, err := svc.ExportJob(ctx, userID, json.RawMessage(`{"delete_account":true}`), noProgress)
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestAccountExportService_Download(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour).Unix()
+
+	t.Run("正常系: 署名が正しいリンクはチャンクを順に読み出して返す", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+		id, exp, sig := parseDownloadURL(t, svc.downloadURL(7, time.Unix(expires, 0)))
+
+		repo.EXPECT().ClaimAccountExport(mock.Anything, int64(7)).Return(sqlc.ClaimAccountExportRow{Chunks: 2, Size: 6}, nil)
+		repo.EXPECT().GetAccountExportChunk(mock.Anything, sqlc.GetAccountExportChunkParams{ExportID: 7, Seq: 0}).Return([]byte("zip"), nil).Once()
+		repo.EXPECT().GetAccountExportChunk(mock.Anything, sqlc.GetAccountExportChunkParams{ExportID: 7, Seq: 1}).Return([]byte("abc"), nil).Once()
+
+		got, err := svc.Download(ctx, id, exp, sig)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), got.Size)
+		data, err := io.ReadAll(got.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "zipabc", string(data))
+	})
+
+	t.Run("異常系: チャンクを読み出せない場合は読み込みエラーになる", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+
+		repo.EXPECT().ClaimAccountExport(mock.Anything, int64(7)).Return(sqlc.ClaimAccountExportRow{Chunks: 2, Size: 6}, nil)
+		repo.EXPECT().GetAccountExportChunk(mock.Anything, sqlc.GetAccountExportChunkParams{ExportID: 7, Seq: 0}).Return([]byte("zip"), nil).Once()
+		repo.EXPECT().GetAccountExportChunk(mock.Anything, sqlc.GetAccountExportChunkParams{ExportID: 7, Seq: 1}).Return(nil, pgx.ErrNoRows).Once()
+
+		got, err := svc.Download(ctx, 7, expires, svc.sign(7, expires))
+		require.NoError(t, err)
+
+		_, err = io.ReadAll(got.Body)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("異常系: IDや期限を書き換えたリンクはErrAccountExportLinkInvalid", func(t *testing.T) {
+		svc := newTestAccountExportService(mocks.NewMockAccountExportRepository(t), now)
+		sig := svc.sign(7, expires)
+
+		for _, tc := range []struct {
+			id, expires int64
+			signature   string
+		}{
+			{8, expires, sig},
+			{7, expires + 3600, sig},
+			{7, expires, ""},
+		} {
+			_, err := svc.Download(ctx, tc.id, tc.expires, tc.signature)
+
+			assert.ErrorIs(t, err, ErrAccountExportLinkInvalid)
+		}
+	})
+
+	t.Run("異常系: 別の鍵で署名したリンクはErrAccountExportLinkInvalid", func(t *testing.T) {
+		other := NewAccountExportService(nil, nil, nil, []byte("fedcba9876543210fedcba9876543210"), time.Hour, "")
+		svc := newTestAccountExportService(mocks.NewMockAccountExportRepository(t), now)
+
+		_, err := svc.Download(ctx, 7, expires, other.sign(7, expires))
+
+		assert.ErrorIs(t, err, ErrAccountExportLinkInvalid)
+	})
+
+	t.Run("異常系: 期限切れのリンクはErrAccountExportGone", func(t *testing.T) {
+		svc := newTestAccountExportService(mocks.NewMockAccountExportRepository(t), now)
+		past := now.Add(-time.Second).Unix()
+
+		_, err := svc.Download(ctx, 7, past, svc.sign(7, past))
+
+		assert.ErrorIs(t, err, ErrAccountExportGone)
+	})
+
+	t.Run("異常系: ダウンロード済みの場合はErrAccountExportGone", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+
+		repo.EXPECT().ClaimAccountExport(mock.Anything, int64(7)).Return(sqlc.ClaimAccountExportRow{}, pgx.ErrNoRows)
+
+		_, err := svc.Download(ctx, 7, expires, svc.sign(7, expires))
+
+		assert.ErrorIs(t, err, ErrAccountExportGone)
+	})
+
+	t.Run("異常系: DBエラーはそのまま返す", func(t *testing.T) {
+		repo := mocks.NewMockAccountExportRepository(t)
+		svc := newTestAccountExportService(repo, now)
+		dbErr := errors.New("db error")
+
+		repo.EXPECT().ClaimAccountExport(mock.Anything, int64(7)).Return(sqlc.ClaimAccountExportRow{}, dbErr)
+
+		_, err := svc.Download(ctx, 7, expires, svc.sign(7, expires))
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type CalDAVRepository interface {
+	GetTodoChangeSeq(ctx context.Context, id int64) (int64, error)
+	GetTodoChangeSeqForUpdate(ctx context.Context, id int64) (int64, error)
+	ListTodosByUser(ctx context.Context, userID int64) ([]sqlc.Todo, error)
+	ListTodosByCalendarUIDs(ctx context.Context, arg sqlc.ListTodosByCalendarUIDsParams) ([]sqlc.Todo, error)
+	GetTodoByCalendarUIDForUpdate(ctx context.Context, arg sqlc.GetTodoByCalendarUIDForUpdateParams) (sqlc.Todo, error)
+	GetLastTodoPosition(ctx context.Context, userID int64) (string, error)
+	GetUserSettings(ctx context.Context, userID int64) (sqlc.UserSetting, error)
+	CreateCalendarTodo(ctx context.Context, arg sqlc.CreateCalendarTodoParams) (sqlc.Todo, error)
+	UpdateCalendarTodo(ctx context.Context, arg sqlc.UpdateCalendarTodoParams) (sqlc.Todo, error)
+	ListOpenBlockedTodoIDs(ctx context.Context, arg sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error)
+	DeleteTodo(ctx context.Context, arg sqlc.DeleteTodoParams) (int64, error)
+}
+
+// sqlc.Querier が CalDAVRepository を満たすことを保証
+var _ CalDAVRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/caldav"
+	"go-todo/internal/database"
+	"go-todo/internal/ical"
+	"go-todo/internal/rank"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CalDAVのリソースとしてTodoを読み書きする（caldav.Backend の実装）
+// リソース名はTodoのUID（calendarUID）で、CalDAVで作成したTodoはクライアントが決めたUIDを保存する
+type CalDAVService struct {
+	repo      CalDAVRepository
+	txManager database.TxManager
+	withTx    func(pgx.Tx) CalDAVRepository
+	now       func() time.Time
+}
+
+func NewCalDAVService(repo CalDAVRepository, pool *pgxpool.Pool) *CalDAVService {
+	return &CalDAVService{
+		repo:      repo,
+		txManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) CalDAVRepository {
+			return sqlc.New(tx)
+		},
+		now: time.Now,
+	}
+}
+
+// CalDAVService が caldav.Backend を満たすことを保証
+var _ caldav.Backend = (*CalDAVService)(nil)
+
+// Todoの変更シーケンス番号はいずれかのTodoが変わるたびに増えるため、CTagとして使う
+func (s *CalDAVService) CollectionTag(ctx context.Context, userID int64) (string, error) {
+	seq, err := s.repo.GetTodoChangeSeq(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrUserNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(seq, 10), nil
+}
+
+func (s *CalDAVService) ListObjects(ctx context.Context, userID int64) ([]caldav.Object, error) {
+	todos, err := s.repo.ListTodosByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return todosToObjects(todos), nil
+}
+
+func (s *CalDAVService) GetObjects(ctx context.Context, userID int64, names []string) ([]caldav.Object, error) {
+	if len(names) == 0 {
+		return []caldav.Object{}, nil
+	}
+	todos, err := s.repo.ListTodosByCalendarUIDs(ctx, sqlc.ListTodosByCalendarUIDsParams{
+		UserID: userID,
+		Uids:   names,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return todosToObjects(todos), nil
+}
+
+// リソースを作成または置き換える
+// 件名・説明・完了状態・期限を保存し、未完了のブロッカーがあるTodoは完了にできない
+// 日付だけの期限やタイムゾーンのない期限は、ユーザー設定のタイムゾーンの時刻として読む
+func (s *CalDAVService) PutObject(ctx context.Context, userID int64, name string, data io.Reader, cond caldav.PutCondition) (bool, error) {
+	settings, err := loadUserSettings(ctx, s.repo, userID)
+	if err != nil {
+		return false, err
+	}
+	item, err := ical.ParseTodo(data, settings.Location)
+	if err != nil {
+		return false, err
+	}
+	if item.UID != name {
+		return false, fmt.Errorf("%w: UID must match the resource name", caldav.ErrInvalidObject)
+	}
+	title := strings.TrimSpace(item.Summary)
+	if title == "" {
+		return false, fmt.Errorf("%w: SUMMARY is required", caldav.ErrInvalidObject)
+	}
+	var description *string
+	if item.Description != "" {
+		description = &item.Description
+	}
+
+	created := false
+	err = s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		// ユーザー行のロックで、同じUIDの同時作成と末尾の位置の計算を直列化する
+		if _, err := repo.GetTodoChangeSeqForUpdate(ctx, userID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("lock change sequence: %w", err)
+		}
+
+		existing, err := repo.GetTodoByCalendarUIDForUpdate(ctx, sqlc.GetTodoByCalendarUIDForUpdateParams{
+			UserID: userID,
+			Uid:    name,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			if cond.IfMatch != nil {
+				return caldav.ErrPreconditionFailed
+			}
+			last, err := repo.GetLastTodoPosition(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("get last position: %w", err)
+			}
+			position, err := rank.Between(last, "")
+			if err != nil {
+				return fmt.Errorf("append position: %w", err)
+			}
+			project, err := loadNewTodoProject(ctx, repo, userID)
+			if err != nil {
+				return err
+			}
+			if _, err := repo.CreateCalendarTodo(ctx, sqlc.CreateCalendarTodoParams{
+				UserID:      userID,
+				IcalUid:     &name,
+				Title:       title,
+				Description: description,
+				Completed:   item.Completed,
+				Position:    position,
+				DueAt:       timestamptz(item.Due),
+				ProjectID:   project.resolve(nil),
+			}); err != nil {
+				return fmt.Errorf("create todo: %w", err)
+			}
+			created = true
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get todo by calendar uid: %w", err)
+		}
+
+		// 削除済みのTodoはUIDが再利用されないように残っているため、同じUIDで作り直せない
+		if existing.DeletedAt.Valid {
+			if cond.IfMatch != nil {
+				return caldav.ErrPreconditionFailed
+			}
+			return fmt.Errorf("%w: the todo with this UID was deleted", caldav.ErrConflict)
+		}
+		if cond.IfNoneMatch || cond.IfMatch != nil && *cond.IfMatch != existing.Version {
+			return caldav.ErrPreconditionFailed
+		}
+		if item.Completed && !existing.Completed {
+			err := ensureTodoNotBlocked(ctx, repo, userID, existing.ID)
+			if errors.Is(err, ErrTodoBlocked) {
+				return fmt.Errorf("%w: the todo is blocked by open todos", caldav.ErrConflict)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		now := s.now()
+		params := sqlc.UpdateCalendarTodoParams{
+			UserID:               userID,
+			Title:                title,
+			Description:          description,
+			Completed:            item.Completed,
+			DueAt:                timestamptz(item.Due),
+			TitleUpdatedAt:       existing.TitleUpdatedAt,
+			DescriptionUpdatedAt: existing.DescriptionUpdatedAt,
+			CompletedUpdatedAt:   existing.CompletedUpdatedAt,
+			ID:                   existing.ID,
+		}
+		// 同期APIのフィールド単位の後勝ち判定のため、変わったフィールドの変更日時だけを進める
+		if title != existing.Title {
+			params.TitleUpdatedAt = now
+		}
+		if !equalStringPtr(description, existing.Description) {
+			params.DescriptionUpdatedAt = now
+		}
+		if item.Completed != existing.Completed {
+			params.CompletedUpdatedAt = now
+		}
+		if _, err := repo.UpdateCalendarTodo(ctx, params); err != nil {
+			return fmt.Errorf("update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return created, nil
+}
+
+func (s *CalDAVService) DeleteObject(ctx context.Context, userID int64, name string, ifMatch *int32) error {
+	todos, err := s.repo.ListTodosByCalendarUIDs(ctx, sqlc.ListTodosByCalendarUIDsParams{
+		UserID: userID,
+		Uids:   []string{name},
+	})
+	if err != nil {
+		return err
+	}
+	if len(todos) == 0 {
+		return caldav.ErrNotFound
+	}
+	var expectedVersions []int32
+	if ifMatch != nil {
+		expectedVersions = []int32{*ifMatch}
+	}
+	affected, err := s.repo.DeleteTodo(ctx, sqlc.DeleteTodoParams{
+		ID:               todos[0].ID,
+		UserID:           userID,
+		ExpectedVersions: expectedVersions,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		// 取得後に更新された場合はバージョンが一致せず、削除された場合は存在しない
+		if ifMatch != nil {
+			return caldav.ErrPreconditionFailed
+		}
+		return caldav.ErrNotFound
+	}
+	return nil
+}
+
+func todosToObjects(todos []sqlc.Todo) []caldav.Object {
+	objects := make([]caldav.Object, len(todos))
+	for i := range todos {
+		objects[i] = caldav.Object{
+			Name:    calendarUID(&todos[i]),
+			Version: todos[i].Version,
+			Item:    todoToCalendarItem(&todos[i]),
+		}
+	}
+	return objects
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/caldav"
+	"go-todo/internal/ical"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTxTestCalDAVService(repo CalDAVRepository, now time.Time) *CalDAVService {
+	svc := NewCalDAVService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) CalDAVRepository { return repo }
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+// PUT されるカレンダーオブジェクト
+func calendarObject(t *testing.T, item ical.Item) io.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, ical.WriteObject(&buf, "-//test//EN", item))
+	return &buf
+}
+
+func TestCalDAVService_PutObject(t *testing.T) {
+	userID := int64(1)
+	uid := "A1B2C3-apple-reminders"
+	now := time.Date(2026, 10, 24, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	existing := sqlc.Todo{
+		ID:                   5,
+		UserID:               userID,
+		Title:                "Buy milk",
+		Version:              3,
+		IcalUid:              &uid,
+		TitleUpdatedAt:       earlier,
+		DescriptionUpdatedAt: earlier,
+		CompletedUpdatedAt:   earlier,
+	}
+	lookup := sqlc.GetTodoByCalendarUIDForUpdateParams{UserID: userID, Uid: uid}
+
+	t.Run("正常系: 存在しないUIDは末尾に既定のプロジェクトで作成する", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+		ctx := context.Background()
+		due := time.Date(2026, 10, 30, 1, 0, 0, 0, time.UTC)
+		projectID := int64(4)
+
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(7), nil)
+		mockRepo.EXPECT().GetTodoByCalendarUIDForUpdate(ctx, lookup).Return(sqlc.Todo{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetLastTodoPosition(ctx, userID).Return("", nil)
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{UserID: userID, DefaultProjectID: &projectID}, nil)
+		var created sqlc.CreateCalendarTodoParams
+		mockRepo.EXPECT().
+			CreateCalendarTodo(ctx, mock.AnythingOfType("sqlc.CreateCalendarTodoParams")).
+			Run(func(_ context.Context, arg sqlc.CreateCalendarTodoParams) { created = arg }).
+			Return(sqlc.Todo{ID: 6}, nil)
+
+		ok, err := svc.PutObject(ctx, userID, uid, calendarObject(t, ical.Item{UID: uid, Summary: " Buy oat milk ", Description: "2L", Due: &due}), caldav.PutCondition{IfNoneMatch: true})
+
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, &uid, created.IcalUid)
+		assert.Equal(t, "Buy oat milk", created.Title)
+		assert.Equal(t, ptrString("2L"), created.Description)
+		assert.Equal(t, pgtype.Timestamptz{Time: due, Valid: true}, created.DueAt)
+		assert.NotEmpty(t, created.Position)
+		assert.Equal(t, &projectID, created.ProjectID)
+	})
+
+	t.Run("正常系: 変わったフィールドの変更日時だけを進める", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(7), nil)
+		mockRepo.EXPECT().GetTodoByCalendarUIDForUpdate(ctx, lookup).Return(existing, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{existing.ID}}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().UpdateCalendarTodo(ctx, sqlc.UpdateCalendarTodoParams{
+			UserID:               userID,
+			Title:                "Buy milk",
+			Completed:            true,
+			TitleUpdatedAt:       earlier,
+			DescriptionUpdatedAt: earlier,
+			CompletedUpdatedAt:   now,
+			ID:                   existing.ID,
+		}).Return(sqlc.Todo{}, nil)
+
+		ok, err := svc.PutObject(ctx, userID, uid, calendarObject(t, ical.Item{UID: uid, Summary: "Buy milk", Completed: true}), caldav.PutCondition{IfMatch: ptrInt32(3)})
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("異常系: UIDがリソース名と異なる場合は不正なオブジェクト", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+
+		mockRepo.EXPECT().GetUserSettings(mock.Anything, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		_, err := svc.PutObject(context.Background(), userID, uid, calendarObject(t, ical.Item{UID: "other", Summary: "x"}), caldav.PutCondition{})
+
+		assert.ErrorIs(t, err, caldav.ErrInvalidObject)
+	})
+
+	t.Run("異常系: SUMMARYが空の場合は不正なオブジェクト", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+
+		mockRepo.EXPECT().GetUserSettings(mock.Anything, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		_, err := svc.PutObject(context.Background(), userID, uid, calendarObject(t, ical.Item{UID: uid, Summary: "  "}), caldav.PutCondition{})
+
+		assert.ErrorIs(t, err, caldav.ErrInvalidObject)
+	})
+
+	t.Run("異常系: If-Matchのバージョンが一致しない場合は412", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(7), nil)
+		mockRepo.EXPECT().GetTodoByCalendarUIDForUpdate(ctx, lookup).Return(existing, nil)
+
+		_, err := svc.PutObject(ctx, userID, uid, calendarObject(t, ical.Item{UID: uid, Summary: "x"}), caldav.PutCondition{IfMatch: ptrInt32(2)})
+
+		assert.ErrorIs(t, err, caldav.ErrPreconditionFailed)
+	})
+
+	t.Run("異常系: If-None-Matchで既存のUIDを指定した場合は412", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(7), nil)
+		mockRepo.EXPECT().GetTodoByCalendarUIDForUpdate(ctx, lookup).Return(existing, nil)
+
+		_, err := svc.PutObject(ctx, userID, uid, calendarObject(t, ical.Item{UID: uid, Summary: "x"}), caldav.PutCondition{IfNoneMatch: true})
+
+		assert.ErrorIs(t, err, caldav.ErrPreconditionFailed)
+	})
+
+	t.Run("異常系: If-Matchで存在しないUIDを指定した場合は412", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(7), nil)
+		mockRepo.EXPECT().GetTodoByCalendarUIDForUpdate(ctx, lookup).Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		_, err := svc.PutObject(ctx, userID, uid, calendarObject(t, ical.Item{UID: uid, Summary: "x"}), caldav.PutCondition{IfMatch: ptrInt32(1)})
+
+		assert.ErrorIs(t, err, caldav.ErrPreconditionFailed)
+	})
+
+	t.Run("異常系: 削除済みのTodoのUIDは再利用できない", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+		ctx := context.Background()
+		deleted := existing
+		deleted.DeletedAt = pgtype.Timestamptz{Time: earlier, Valid: true}
+
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(7), nil)
+		mockRepo.EXPECT().GetTodoByCalendarUIDForUpdate(ctx, lookup).Return(deleted, nil)
+
+		_, err := svc.PutObject(ctx, userID, uid, calendarObject(t, ical.Item{UID: uid, Summary: "x"}), caldav.PutCondition{})
+
+		assert.ErrorIs(t, err, caldav.ErrConflict)
+	})
+
+	t.Run("異常系: 未完了のブロッカーがあるTodoは完了にできない", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(7), nil)
+		mockRepo.EXPECT().GetTodoByCalendarUIDForUpdate(ctx, lookup).Return(existing, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{existing.ID}}).
+			Return([]int64{existing.ID}, nil)
+
+		_, err := svc.PutObject(ctx, userID, uid, calendarObject(t, ical.Item{UID: uid, Summary: "Buy milk", Completed: true}), caldav.PutCondition{})
+
+		assert.ErrorIs(t, err, caldav.ErrConflict)
+	})
+	t.Run("正常系: 日付だけの期限はユーザーのタイムゾーンの0時として保存する", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+		ctx := context.Background()
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{UserID: userID, Timezone: "Asia/Tokyo"}, nil)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(7), nil)
+		mockRepo.EXPECT().GetTodoByCalendarUIDForUpdate(ctx, lookup).Return(sqlc.Todo{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetLastTodoPosition(ctx, userID).Return("", nil)
+		var created sqlc.CreateCalendarTodoParams
+		mockRepo.EXPECT().
+			CreateCalendarTodo(ctx, mock.AnythingOfType("sqlc.CreateCalendarTodoParams")).
+			Run(func(_ context.Context, arg sqlc.CreateCalendarTodoParams) { created = arg }).
+			Return(sqlc.Todo{ID: 6}, nil)
+		data := "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nUID:" + uid + "\r\nSUMMARY:Pay rent\r\nDUE;VALUE=DATE:20261101\r\nEND:VTODO\r\nEND:VCALENDAR\r\n"
+
+		_, err = svc.PutObject(ctx, userID, uid, strings.NewReader(data), caldav.PutCondition{})
+
+		require.NoError(t, err)
+		require.True(t, created.DueAt.Valid)
+		assert.True(t, time.Date(2026, 11, 1, 0, 0, 0, 0, tokyo).Equal(created.DueAt.Time), "due: %v", created.DueAt.Time)
+	})
+
+	t.Run("異常系: 読み込めないカレンダーデータはical.ErrMalformed", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := newTxTestCalDAVService(mockRepo, now)
+
+		mockRepo.EXPECT().GetUserSettings(mock.Anything, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+
+		_, err := svc.PutObject(context.Background(), userID, uid, strings.NewReader("BEGIN:VCALENDAR\r\n"), caldav.PutCondition{})
+
+		assert.ErrorIs(t, err, ical.ErrMalformed)
+	})
+}
+
+func TestCalDAVService_DeleteObject(t *testing.T) {
+	userID := int64(1)
+	uid := "A1B2C3-apple-reminders"
+	params := sqlc.ListTodosByCalendarUIDsParams{UserID: userID, Uids: []string{uid}}
+
+	t.Run("正常系: 期待するバージョンを指定して削除する", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := NewCalDAVService(mockRepo, nil)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().ListTodosByCalendarUIDs(ctx, params).Return([]sqlc.Todo{{ID: 5, Version: 3}}, nil)
+		mockRepo.EXPECT().
+			DeleteTodo(ctx, sqlc.DeleteTodoParams{ID: 5, UserID: userID, ExpectedVersions: []int32{3}}).
+			Return(int64(1), nil)
+
+		assert.NoError(t, svc.DeleteObject(ctx, userID, uid, ptrInt32(3)))
+	})
+
+	t.Run("異常系: 存在しないUIDは見つからない", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := NewCalDAVService(mockRepo, nil)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().ListTodosByCalendarUIDs(ctx, params).Return([]sqlc.Todo{}, nil)
+
+		assert.ErrorIs(t, svc.DeleteObject(ctx, userID, uid, nil), caldav.ErrNotFound)
+	})
+
+	t.Run("異常系: バージョンが一致しない場合は412", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := NewCalDAVService(mockRepo, nil)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().ListTodosByCalendarUIDs(ctx, params).Return([]sqlc.Todo{{ID: 5, Version: 4}}, nil)
+		mockRepo.EXPECT().
+			DeleteTodo(ctx, sqlc.DeleteTodoParams{ID: 5, UserID: userID, ExpectedVersions: []int32{3}}).
+			Return(int64(0), nil)
+
+		assert.ErrorIs(t, svc.DeleteObject(ctx, userID, uid, ptrInt32(3)), caldav.ErrPreconditionFailed)
+	})
+}
+
+func TestCalDAVService_GetObjects(t *testing.T) {
+	t.Run("正常系: 名前を指定しない場合はDBを参照しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := NewCalDAVService(mockRepo, nil)
+
+		objects, err := svc.GetObjects(context.Background(), 1, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, objects)
+	})
+
+	t.Run("正常系: ical_uidがないTodoはclient_idをリソース名にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalDAVRepository(t)
+		svc := NewCalDAVService(mockRepo, nil)
+		ctx := context.Background()
+		clientID := pgtype.UUID{Bytes: [16]byte{0x0b, 0x7c, 0x6f, 0x5e, 0x1d, 0x2a, 0x4c, 0x3b, 0x9e, 0x8f, 0x7a, 0x6b, 0x5c, 0x4d, 0x3e, 0x2f}, Valid: true}
+
+		mockRepo.EXPECT().
+			ListTodosByCalendarUIDs(ctx, sqlc.ListTodosByCalendarUIDsParams{UserID: 1, Uids: []string{clientID.String()}}).
+			Return([]sqlc.Todo{{ID: 5, Title: "Buy milk", Version: 2, ClientID: clientID}}, nil)
+
+		objects, err := svc.GetObjects(ctx, 1, []string{clientID.String()})
+
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, clientID.String(), objects[0].Name)
+		assert.Equal(t, int32(2), objects[0].Version)
+		assert.Equal(t, clientID.String(), objects[0].Item.UID)
+	})
+}
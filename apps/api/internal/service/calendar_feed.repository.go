@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type CalendarFeedRepository interface {
+	UpsertCalendarFeed(ctx context.Context, arg sqlc.UpsertCalendarFeedParams) (sqlc.CalendarFeed, error)
+	DeleteCalendarFeed(ctx context.Context, userID int64) (int64, error)
+	GetCalendarFeedUserID(ctx context.Context, tokenHash string) (int64, error)
+	ListTodosByUser(ctx context.Context, userID int64) ([]sqlc.Todo, error)
+}
+
+// sqlc.Querier が CalendarFeedRepository を満たすことを保証
+var _ CalendarFeedRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/ical"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	CalendarProdID = "-//go-todo//Todo API//EN"
+	calendarName   = "Todos"
+	// フィードのトークンのバイト数
+	calendarTokenBytes = 32
+)
+
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// ユーザーごとのiCalendarフィード
+// フィードはセッションを持たないカレンダーアプリから取得されるため、URLに含めた秘密のトークンで認証する
+type CalendarFeedService struct {
+	repo CalendarFeedRepository
+}
+
+func NewCalendarFeedService(repo CalendarFeedRepository) *CalendarFeedService {
+	return &CalendarFeedService{repo: repo}
+}
+
+// 新しいトークンを発行する。既存のトークンは無効になる
+// トークンはハッシュだけを保存するため、平文を返すのはこの時だけ
+func (s *CalendarFeedService) RotateToken(ctx context.Context, userID int64) (string, error) {
+	raw := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	if _, err := s.repo.UpsertCalendarFeed(ctx, sqlc.UpsertCalendarFeedParams{
+		UserID:    userID,
+		TokenHash: hashToken(token),
+	}); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// トークンを無効にする
+func (s *CalendarFeedService) RevokeToken(ctx context.Context, userID int64) error {
+	affected, err := s.repo.DeleteCalendarFeed(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrCalendarFeedNotFound
+	}
+	return nil
+}
+
+// トークンの持ち主のTodoをiCalendar形式で返す
+func (s *CalendarFeedService) Feed(ctx context.Context, token string) ([]byte, error) {
+	if token == "" {
+		return nil, ErrCalendarFeedNotFound
+	}
+	userID, err := s.repo.GetCalendarFeedUserID(ctx, hashToken(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	todos, err := s.repo.ListTodosByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := ical.Calendar{
+		ProdID: CalendarProdID,
+		Name:   calendarName,
+		Items:  make([]ical.Item, len(todos)),
+	}
+	for i := range todos {
+		cal.Items[i] = todoToCalendarItem(&todos[i])
+	}
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, cal); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// TodoのiCalendarのUID
+// CalDAVで作成されたTodoはクライアントが決めたUIDを、それ以外は作成時に決まり変わらない client_id を使う
+func calendarUID(t *sqlc.Todo) string {
+	if t.IcalUid != nil {
+		return *t.IcalUid
+	}
+	return t.ClientID.String()
+}
+
+func todoToCalendarItem(t *sqlc.Todo) ical.Item {
+	item := ical.Item{
+		UID:          calendarUID(t),
+		Summary:      t.Title,
+		Created:      t.CreatedAt,
+		LastModified: t.UpdatedAt,
+		Completed:    t.Completed,
+		CompletedAt:  t.CompletedUpdatedAt,
+		Sequence:     t.Version - 1,
+	}
+	if t.Description != nil {
+		item.Description = *t.Description
+	}
+	if t.DueAt.Valid {
+		due := t.DueAt.Time
+		item.Due = &due
+	}
+	return item
+}
+
+// URLやヘッダーで受け取る秘密のトークンは、SHA-256のハッシュだけを保存する
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
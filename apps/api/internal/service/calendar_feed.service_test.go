@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarFeedService_RotateToken(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: 返したトークンのハッシュだけを保存する", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalendarFeedRepository(t)
+		svc := NewCalendarFeedService(mockRepo)
+		ctx := context.Background()
+
+		var saved sqlc.UpsertCalendarFeedParams
+		mockRepo.EXPECT().
+			UpsertCalendarFeed(ctx, mock.AnythingOfType("sqlc.UpsertCalendarFeedParams")).
+			Run(func(_ context.Context, arg sqlc.UpsertCalendarFeedParams) { saved = arg }).
+			Return(sqlc.CalendarFeed{UserID: userID}, nil)
+
+		token, err := svc.RotateToken(ctx, userID)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, token)
+		assert.Equal(t, userID, saved.UserID)
+		assert.Equal(t, hashToken(token), saved.TokenHash)
+		assert.NotContains(t, saved.TokenHash, token)
+	})
+
+	t.Run("正常系: 発行するたびに異なるトークンになる", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalendarFeedRepository(t)
+		svc := NewCalendarFeedService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			UpsertCalendarFeed(ctx, mock.Anything).
+			Return(sqlc.CalendarFeed{UserID: userID}, nil).
+			Times(2)
+
+		first, err := svc.RotateToken(ctx, userID)
+		require.NoError(t, err)
+		second, err := svc.RotateToken(ctx, userID)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("異常系: 保存に失敗した場合はエラーを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalendarFeedRepository(t)
+		svc := NewCalendarFeedService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			UpsertCalendarFeed(ctx, mock.Anything).
+			Return(sqlc.CalendarFeed{}, errors.New("db error"))
+
+		token, err := svc.RotateToken(ctx, userID)
+
+		assert.Error(t, err)
+		assert.Empty(t, token)
+	})
+}
+
+func TestCalendarFeedService_RevokeToken(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: トークンを削除する", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalendarFeedRepository(t)
+		svc := NewCalendarFeedService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().DeleteCalendarFeed(ctx, userID).Return(int64(1), nil)
+
+		assert.NoError(t, svc.RevokeToken(ctx, userID))
+	})
+
+	t.Run("異常系: フィードがない場合はErrCalendarFeedNotFound", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalendarFeedRepository(t)
+		svc := NewCalendarFeedService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().DeleteCalendarFeed(ctx, userID).Return(int64(0), nil)
+
+		assert.ErrorIs(t, svc.RevokeToken(ctx, userID), ErrCalendarFeedNotFound)
+	})
+}
+
+func TestCalendarFeedService_Feed(t *testing.T) {
+	userID := int64(1)
+	token := "secret-token"
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: TodoをVTODOとして、期限付きのTodoをVEVENTとしても出力する", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalendarFeedRepository(t)
+		svc := NewCalendarFeedService(mockRepo)
+		ctx := context.Background()
+
+		clientID := pgtype.UUID{Bytes: [16]byte{0x12, 0x34}, Valid: true}
+		due := now.Add(48 * time.Hour)
+		mockRepo.EXPECT().GetCalendarFeedUserID(ctx, hashToken(token)).Return(userID, nil)
+		mockRepo.EXPECT().ListTodosByUser(ctx, userID).Return([]sqlc.Todo{
+			{ID: 1, UserID: userID, Title: "期限あり", Version: 3, ClientID: clientID, CreatedAt: now, UpdatedAt: now, DueAt: pgtype.Timestamptz{Time: due, Valid: true}},
+			{ID: 2, UserID: userID, Title: "期限なし", Version: 1, CreatedAt: now, UpdatedAt: now},
+		}, nil)
+
+		feed, err := svc.Feed(ctx, token)
+
+		require.NoError(t, err)
+		body := string(feed)
+		assert.Equal(t, 2, strings.Count(body, "BEGIN:VTODO"))
+		assert.Equal(t, 1, strings.Count(body, "BEGIN:VEVENT"))
+		assert.Contains(t, body, "UID:"+clientID.String()+"\r\n")
+		assert.Contains(t, body, "UID:"+clientID.String()+"-due\r\n")
+		assert.Contains(t, body, "SEQUENCE:2\r\n")
+	})
+
+	t.Run("異常系: 空のトークンはErrCalendarFeedNotFound", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalendarFeedRepository(t)
+		svc := NewCalendarFeedService(mockRepo)
+
+		_, err := svc.Feed(context.Background(), "")
+
+		assert.ErrorIs(t, err, ErrCalendarFeedNotFound)
+	})
+
+	t.Run("異常系: 未知のトークンはErrCalendarFeedNotFound", func(t *testing.T) {
+		mockRepo := mocks.NewMockCalendarFeedRepository(t)
+		svc := NewCalendarFeedService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().GetCalendarFeedUserID(ctx, hashToken(token)).Return(int64(0), pgx.ErrNoRows)
+
+		_, err := svc.Feed(ctx, token)
+
+		assert.ErrorIs(t, err, ErrCalendarFeedNotFound)
+	})
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type DependencyRepository interface {
+	GetTodoChangeSeqForUpdate(ctx context.Context, id int64) (int64, error)
+	GetTodoByID(ctx context.Context, arg sqlc.GetTodoByIDParams) (sqlc.Todo, error)
+	ListTodoDependencyEdges(ctx context.Context, userID int64) ([]sqlc.ListTodoDependencyEdgesRow, error)
+	CreateTodoDependency(ctx context.Context, arg sqlc.CreateTodoDependencyParams) (int64, error)
+	DeleteTodoDependency(ctx context.Context, arg sqlc.DeleteTodoDependencyParams) (int64, error)
+	ListTodoBlockers(ctx context.Context, arg sqlc.ListTodoBlockersParams) ([]sqlc.Todo, error)
+	ListTodoDependents(ctx context.Context, arg sqlc.ListTodoDependentsParams) ([]sqlc.Todo, error)
+}
+
+// sqlc.Querier が DependencyRepository を満たすことを保証
+var _ DependencyRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrBlockerNotFound    = errors.New("blocker todo not found")
+	ErrDependencyNotFound = errors.New("dependency not found")
+	ErrInvalidDependency  = errors.New("invalid dependency")
+	ErrDependencyCycle    = errors.New("dependency cycle")
+)
+
+// 依存関係を追加すると循環する場合のエラー
+// Path は依存元のTodoから、ブロッカーをたどって同じTodoに戻るまでのID（例: 1 -> 2 -> 3 -> 1）
+type DependencyCycleError struct {
+	Path []int64
+}
+
+func (e *DependencyCycleError) Error() string {
+	ids := make([]string, len(e.Path))
+	for i, id := range e.Path {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+	return fmt.Sprintf("%s: %s", ErrDependencyCycle, strings.Join(ids, " -> "))
+}
+
+func (e *DependencyCycleError) Unwrap() error {
+	return ErrDependencyCycle
+}
+
+// Todoのブロッカー（このTodoより先に終わらせるTodo）と、このTodoを待っているTodo
+type TodoDependencies struct {
+	Blockers   []sqlc.Todo
+	Dependents []sqlc.Todo
+}
+
+type DependencyService struct {
+	repo      DependencyRepository
+	txManager database.TxManager
+	// トランザクション内で使うリポジトリを作成する
+	withTx func(tx pgx.Tx) DependencyRepository
+}
+
+func NewDependencyService(repo DependencyRepository, pool *pgxpool.Pool) *DependencyService {
+	return &DependencyService{
+		repo:      repo,
+		txManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) DependencyRepository {
+			return sqlc.New(tx)
+		},
+	}
+}
+
+// Todoのブロッカーと、ブロックしているTodoを取得する（削除済みのTodoは含めない）
+func (s *DependencyService) GetDependencies(ctx context.Context, todoID, userID int64) (*TodoDependencies, error) {
+	blockers, err := s.repo.ListTodoBlockers(ctx, sqlc.ListTodoBlockersParams{TodoID: todoID, UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("list blockers: %w", err)
+	}
+	dependents, err := s.repo.ListTodoDependents(ctx, sqlc.ListTodoDependentsParams{BlockerID: todoID, UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("list dependents: %w", err)
+	}
+	return &TodoDependencies{Blockers: blockers, Dependents: dependents}, nil
+}
+
+// todoID のTodoが blockerID のTodoにブロックされていることを登録する
+// ユーザー行をロックして依存関係の変更を直列化するため、同時に追加しても循環は作られない
+func (s *DependencyService) AddBlocker(ctx context.Context, todoID, blockerID, userID int64) (*TodoDependencies, error) {
+	if todoID == blockerID {
+		return nil, ErrInvalidDependency
+	}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		if _, err := repo.GetTodoChangeSeqForUpdate(ctx, userID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("lock user: %w", err)
+		}
+
+		if _, err := repo.GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTodoNotFound
+			}
+			return fmt.Errorf("get todo: %w", err)
+		}
+		if _, err := repo.GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: blockerID, UserID: userID}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrBlockerNotFound
+			}
+			return fmt.Errorf("get blocker: %w", err)
+		}
+
+		edges, err := repo.ListTodoDependencyEdges(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("list dependencies: %w", err)
+		}
+		// ブロッカーが既に todoID を（間接的に）待っている場合、追加すると循環する
+		if path := findBlockerPath(edges, blockerID, todoID); path != nil {
+			return &DependencyCycleError{Path: append([]int64{todoID}, path...)}
+		}
+
+		if _, err := repo.CreateTodoDependency(ctx, sqlc.CreateTodoDependencyParams{
+			TodoID:    todoID,
+			BlockerID: blockerID,
+			UserID:    userID,
+		}); err != nil {
+			return fmt.Errorf("create dependency: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetDependencies(ctx, todoID, userID)
+}
+
+func (s *DependencyService) RemoveBlocker(ctx context.Context, todoID, blockerID, userID int64) error {
+	rows, err := s.repo.DeleteTodoDependency(ctx, sqlc.DeleteTodoDependencyParams{
+		TodoID:    todoID,
+		BlockerID: blockerID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrDependencyNotFound
+	}
+	return nil
+}
+
+// from のTodoからブロッカーをたどって to のTodoに到達する経路を返す（到達しない場合は nil）
+// 経路は from から to までのIDを順に並べたもの
+func findBlockerPath(edges []sqlc.ListTodoDependencyEdgesRow, from, to int64) []int64 {
+	blockers := make(map[int64][]int64)
+	for _, e := range edges {
+		blockers[e.TodoID] = append(blockers[e.TodoID], e.BlockerID)
+	}
+
+	// 幅優先探索で最短の経路を求める
+	prev := map[int64]int64{from: from}
+	queue := []int64{from}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == to {
+			var path []int64
+			for ; id != from; id = prev[id] {
+				path = append(path, id)
+			}
+			path = append(path, from)
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+			return path
+		}
+		for _, next := range blockers[id] {
+			if _, seen := prev[next]; !seen {
+				prev[next] = id
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+// 未完了のブロッカーを確認するリポジトリ（Todoを完了にする各サービスのリポジトリが満たす）
+type openBlockerRepository interface {
+	ListOpenBlockedTodoIDs(ctx context.Context, arg sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error)
+}
+
+// ids のTodoをまとめて完了にする場合に、未完了のブロッカーが残っていて完了にできないTodoを返す
+// Todoを完了にするすべての経路はこの関数でブロッカーを確認する（force の指定時は呼び出さない）
+// 一緒に完了にするTodoはブロッカーとして数えないが、完了にできないTodoはブロッカーとして残るため、見つからなくなるまで確認し直す
+// 確認から更新までの間にブロッカーが追加・再開されないよう、呼び出し側でユーザー行をロックしておく
+func findBlockedTodos(ctx context.Context, repo openBlockerRepository, userID int64, ids []int64) (map[int64]bool, error) {
+	blocked := make(map[int64]bool)
+	remaining := slices.Clone(ids)
+	for len(remaining) > 0 {
+		found, err := repo.ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{
+			UserID: userID,
+			Ids:    remaining,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list open blocked todos: %w", err)
+		}
+		if len(found) == 0 {
+			break
+		}
+		for _, id := range found {
+			blocked[id] = true
+		}
+		remaining = slices.DeleteFunc(remaining, func(id int64) bool { return blocked[id] })
+	}
+	return blocked, nil
+}
+
+// 1件のTodoを完了にする前の確認（未完了のブロッカーが残っていれば ErrTodoBlocked）
+func ensureTodoNotBlocked(ctx context.Context, repo openBlockerRepository, userID, todoID int64) error {
+	blocked, err := findBlockedTodos(ctx, repo, userID, []int64{todoID})
+	if err != nil {
+		return err
+	}
+	if blocked[todoID] {
+		return ErrTodoBlocked
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// トランザクション内でも同じモックリポジトリを使うDependencyService
+func newTxTestDependencyService(repo DependencyRepository) *DependencyService {
+	svc := NewDependencyService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) DependencyRepository { return repo }
+	return svc
+}
+
+func TestDependencyService_AddBlocker(t *testing.T) {
+	userID := int64(1)
+
+	// ユーザー行のロックと、依存元・ブロッカーのTodoの取得を期待する
+	expectTodos := func(mockRepo *mocks.MockDependencyRepository, ctx context.Context, ids ...int64) {
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(7), nil)
+		for _, id := range ids {
+			mockRepo.EXPECT().
+				GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: id, UserID: userID}).
+				Return(sqlc.Todo{ID: id, UserID: userID}, nil)
+		}
+	}
+
+	t.Run("正常系: ブロッカーを追加して依存関係を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockDependencyRepository(t)
+		svc := newTxTestDependencyService(mockRepo)
+		ctx := context.Background()
+
+		expectTodos(mockRepo, ctx, 1, 2)
+		mockRepo.EXPECT().
+			ListTodoDependencyEdges(ctx, userID).
+			Return([]sqlc.ListTodoDependencyEdgesRow{{TodoID: 3, BlockerID: 1}}, nil)
+		mockRepo.EXPECT().
+			CreateTodoDependency(ctx, sqlc.CreateTodoDependencyParams{TodoID: 1, BlockerID: 2, UserID: userID}).
+			Return(int64(1), nil)
+		mockRepo.EXPECT().
+			ListTodoBlockers(ctx, sqlc.ListTodoBlockersParams{TodoID: 1, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 2, UserID: userID, Title: "blocker"}}, nil)
+		mockRepo.EXPECT().
+			ListTodoDependents(ctx, sqlc.ListTodoDependentsParams{BlockerID: 1, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 3, UserID: userID, Title: "dependent"}}, nil)
+
+		deps, err := svc.AddBlocker(ctx, 1, 2, userID)
+
+		require.NoError(t, err)
+		require.Len(t, deps.Blockers, 1)
+		assert.Equal(t, int64(2), deps.Blockers[0].ID)
+		require.Len(t, deps.Dependents, 1)
+		assert.Equal(t, int64(3), deps.Dependents[0].ID)
+	})
+
+	t.Run("異常系: 間接的に循環する場合はDependencyCycleErrorを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockDependencyRepository(t)
+		svc := newTxTestDependencyService(mockRepo)
+		ctx := context.Background()
+
+		expectTodos(mockRepo, ctx, 1, 3)
+		// 3 は 2 に、2 は 1 にブロックされている
+		mockRepo.EXPECT().
+			ListTodoDependencyEdges(ctx, userID).
+			Return([]sqlc.ListTodoDependencyEdgesRow{
+				{TodoID: 3, BlockerID: 2},
+				{TodoID: 2, BlockerID: 1},
+			}, nil)
+
+		deps, err := svc.AddBlocker(ctx, 1, 3, userID)
+
+		assert.Nil(t, deps)
+		assert.ErrorIs(t, err, ErrDependencyCycle)
+		var cycle *DependencyCycleError
+		require.ErrorAs(t, err, &cycle)
+		assert.Equal(t, []int64{1, 3, 2, 1}, cycle.Path)
+		assert.Equal(t, "dependency cycle: 1 -> 3 -> 2 -> 1", cycle.Error())
+		mockRepo.AssertNotCalled(t, "CreateTodoDependency", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 自分自身はブロッカーにできない", func(t *testing.T) {
+		mockRepo := mocks.NewMockDependencyRepository(t)
+		svc := newTxTestDependencyService(mockRepo)
+
+		deps, err := svc.AddBlocker(context.Background(), 1, 1, userID)
+
+		assert.Nil(t, deps)
+		assert.ErrorIs(t, err, ErrInvalidDependency)
+	})
+
+	t.Run("異常系: Todoが存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockDependencyRepository(t)
+		svc := newTxTestDependencyService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: 1, UserID: userID}).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		deps, err := svc.AddBlocker(ctx, 1, 2, userID)
+
+		assert.Nil(t, deps)
+		assert.ErrorIs(t, err, ErrTodoNotFound)
+	})
+
+	t.Run("異常系: ブロッカーが存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockDependencyRepository(t)
+		svc := newTxTestDependencyService(mockRepo)
+		ctx := context.Background()
+
+		expectTodos(mockRepo, ctx, 1)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: 2, UserID: userID}).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		deps, err := svc.AddBlocker(ctx, 1, 2, userID)
+
+		assert.Nil(t, deps)
+		assert.ErrorIs(t, err, ErrBlockerNotFound)
+	})
+}
+
+func TestDependencyService_RemoveBlocker(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: ブロッカーを外す", func(t *testing.T) {
+		mockRepo := mocks.NewMockDependencyRepository(t)
+		svc := NewDependencyService(mockRepo, nil)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			DeleteTodoDependency(ctx, sqlc.DeleteTodoDependencyParams{TodoID: 1, BlockerID: 2, UserID: userID}).
+			Return(int64(1), nil)
+
+		err := svc.RemoveBlocker(ctx, 1, 2, userID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系: 依存関係が存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockDependencyRepository(t)
+		svc := NewDependencyService(mockRepo, nil)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			DeleteTodoDependency(ctx, mock.Anything).
+			Return(int64(0), nil)
+
+		err := svc.RemoveBlocker(ctx, 1, 2, userID)
+
+		assert.ErrorIs(t, err, ErrDependencyNotFound)
+	})
+}
+
+func TestFindBlockerPath(t *testing.T) {
+	edges := []sqlc.ListTodoDependencyEdgesRow{
+		{TodoID: 1, BlockerID: 2},
+		{TodoID: 2, BlockerID: 3},
+		{TodoID: 2, BlockerID: 4},
+		{TodoID: 4, BlockerID: 5},
+		{TodoID: 1, BlockerID: 5},
+	}
+
+	tests := []struct {
+		name     string
+		from, to int64
+		want     []int64
+	}{
+		{name: "正常系: 直接のブロッカー", from: 1, to: 2, want: []int64{1, 2}},
+		{name: "正常系: 間接のブロッカー", from: 2, to: 5, want: []int64{2, 4, 5}},
+		{name: "正常系: 最短の経路を返す", from: 1, to: 5, want: []int64{1, 5}},
+		{name: "正常系: 到達しない", from: 3, to: 1, want: nil},
+		{name: "正常系: 辺のないTodo", from: 9, to: 1, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, findBlockerPath(edges, tt.from, tt.to))
+		})
+	}
+}
+
+func TestFindBlockedTodos(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+	blockerParams := func(ids ...int64) sqlc.ListOpenBlockedTodoIDsParams {
+		return sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: ids}
+	}
+
+	t.Run("正常系: 完了にできないTodoにブロックされたTodoも見つからなくなるまで確認する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		ids := []int64{1, 2, 3}
+		// 3 は外部の未完了のTodoに、2 は 3 にブロックされている
+		mockRepo.EXPECT().ListOpenBlockedTodoIDs(ctx, blockerParams(1, 2, 3)).Return([]int64{3}, nil)
+		mockRepo.EXPECT().ListOpenBlockedTodoIDs(ctx, blockerParams(1, 2)).Return([]int64{2}, nil)
+		mockRepo.EXPECT().ListOpenBlockedTodoIDs(ctx, blockerParams(1)).Return([]int64{}, nil)
+
+		blocked, err := findBlockedTodos(ctx, mockRepo, userID, ids)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]bool{2: true, 3: true}, blocked)
+		// 呼び出し元のスライスは変更しない
+		assert.Equal(t, []int64{1, 2, 3}, ids)
+	})
+
+	t.Run("正常系: 空のIDでは確認しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+
+		blocked, err := findBlockedTodos(ctx, mockRepo, userID, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, blocked)
+	})
+
+	t.Run("異常系: 1件のTodoがブロックされていればErrTodoBlockedを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		mockRepo.EXPECT().ListOpenBlockedTodoIDs(ctx, blockerParams(4)).Return([]int64{4}, nil)
+
+		err := ensureTodoNotBlocked(ctx, mockRepo, userID, 4)
+
+		assert.ErrorIs(t, err, ErrTodoBlocked)
+	})
+}
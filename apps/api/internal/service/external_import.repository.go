@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type ExternalImportRepository interface {
+	NextTodoChangeSeq(ctx context.Context, id int64) (int64, error)
+	GetLastTodoPosition(ctx context.Context, userID int64) (string, error)
+	ListImportedSourceIDs(ctx context.Context, arg sqlc.ListImportedSourceIDsParams) ([]string, error)
+	EnsureProject(ctx context.Context, arg sqlc.EnsureProjectParams) (int64, error)
+	CopyImportedTodos(ctx context.Context, arg []sqlc.CopyImportedTodosParams) (int64, error)
+	GetUserSettings(ctx context.Context, userID int64) (sqlc.UserSetting, error)
+}
+
+// sqlc.Querier が ExternalImportRepository を満たすことを保証
+var _ ExternalImportRepository = (sqlc.Querier)(nil)
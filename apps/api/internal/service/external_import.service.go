@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+	"go-todo/internal/importer"
+	"go-todo/internal/importer/todoist"
+	"go-todo/internal/importer/trello"
+	"go-todo/internal/rank"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// 1回のインポートで受け付ける最大件数（エクスポートの内容はジョブのパラメーターとして保存される）
+	maxExternalImportItems = 10000
+	// 1トランザクションで作成する件数。チャンクごとに進捗を報告する
+	externalImportChunkSize = 200
+)
+
+var (
+	ErrUnknownImportSource = errors.New("unknown import source")
+	ErrTooManyImportItems  = errors.New("too many import items")
+)
+
+// インポート元のツール
+type ExternalImportSource string
+
+const (
+	ExternalImportSourceTodoist ExternalImportSource = "todoist"
+	ExternalImportSourceTrello  ExternalImportSource = "trello"
+)
+
+// インポートジョブのパラメーター
+type ExternalImportParams struct {
+	Source ExternalImportSource `json:"source"`
+	importer.ExternalExport
+}
+
+// インポートジョブの結果
+type ExternalImportResult struct {
+	Imported int32 `json:"imported"`
+	// 以前のインポートで作成済みのため作成しなかった件数
+	AlreadyImported int32                  `json:"already_imported"`
+	Skipped         []importer.SkippedItem `json:"skipped"`
+	// 対応するフィールドがなく取り込まなかった情報と、それを持っていた件数
+	Unmapped map[string]int `json:"unmapped"`
+}
+
+// 他のタスク管理ツールのエクスポートをTodoとして取り込む
+// 元のツールでのIDを保存し、同じエクスポートを何度インポートしても同じTodoは1件しか作成しない
+type ExternalImportService struct {
+	repo      ExternalImportRepository
+	txManager database.TxManager
+	withTx    func(pgx.Tx) ExternalImportRepository
+}
+
+func NewExternalImportService(repo ExternalImportRepository, pool *pgxpool.Pool) *ExternalImportService {
+	return &ExternalImportService{
+		repo:      repo,
+		txManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) ExternalImportRepository {
+			return sqlc.New(tx)
+		},
+	}
+}
+
+// エクスポートを読み込み、インポートジョブのパラメーターを作る
+// タイムゾーンのない期限はユーザー設定のタイムゾーンで解釈する
+// 形式が不正な場合は importer.ErrInvalidExport を返す
+func (s *ExternalImportService) ParseExport(ctx context.Context, userID int64, source ExternalImportSource, r io.Reader) (*ExternalImportParams, error) {
+	var parse func(io.Reader, *time.Location) (*importer.ExternalExport, error)
+	switch source {
+	case ExternalImportSourceTodoist:
+		parse = todoist.Parse
+	case ExternalImportSourceTrello:
+		// Trelloの期限は常にUTCの日時
+		parse = func(r io.Reader, _ *time.Location) (*importer.ExternalExport, error) {
+			return trello.Parse(r)
+		}
+	default:
+		return nil, ErrUnknownImportSource
+	}
+	settings, err := loadUserSettings(ctx, s.repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	export, err := parse(r, settings.Location)
+	if err != nil {
+		return nil, err
+	}
+	if len(export.Items) > maxExternalImportItems {
+		return nil, fmt.Errorf("%w: %d items (max %d)", ErrTooManyImportItems, len(export.Items), maxExternalImportItems)
+	}
+	return &ExternalImportParams{Source: source, ExternalExport: *export}, nil
+}
+
+// エクスポートの項目からTodoを作成するジョブ（JobHandler）
+// チャンクごとにコミットするため、中断されても作成済みのTodoは残り、再度インポートすると続きから作成される
+func (s *ExternalImportService) ImportJob(ctx context.Context, userID int64, params json.RawMessage, progress JobProgressFunc) (any, error) {
+	var p ExternalImportParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, fmt.Errorf("decode job params: %w", err)
+	}
+	if p.Source != ExternalImportSourceTodoist && p.Source != ExternalImportSourceTrello {
+		return nil, ErrUnknownImportSource
+	}
+
+	result := &ExternalImportResult{
+		Skipped:  p.Skipped,
+		Unmapped: p.Unmapped,
+	}
+	if result.Skipped == nil {
+		result.Skipped = []importer.SkippedItem{}
+	}
+	if result.Unmapped == nil {
+		result.Unmapped = map[string]int{}
+	}
+
+	total := int32(len(p.Items))
+	if err := progress(0, total); err != nil {
+		return result, err
+	}
+	projects := map[string]int64{}
+	for start := 0; start < len(p.Items); start += externalImportChunkSize {
+		chunk := p.Items[start:min(start+externalImportChunkSize, len(p.Items))]
+		if err := s.importChunk(ctx, userID, p.Source, chunk, projects, result); err != nil {
+			return result, err
+		}
+		if err := progress(int32(start+len(chunk)), total); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// 1チャンク分のTodoを1トランザクションで作成する
+// projects はプロジェクト名からIDへの対応で、コミットできたチャンクで作成したものを追加する
+func (s *ExternalImportService) importChunk(ctx context.Context, userID int64, source ExternalImportSource, chunk []importer.ExternalItem, projects map[string]int64, result *ExternalImportResult) error {
+	created := map[string]int64{}
+	var imported, already int32
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+
+		// ユーザー行のロックで、同じエクスポートの同時インポートと末尾の位置の計算を直列化する
+		seq, err := repo.NextTodoChangeSeq(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("next change sequence: %w", err)
+		}
+
+		ids := make([]string, len(chunk))
+		for i := range chunk {
+			ids[i] = chunk[i].SourceID
+		}
+		existing, err := repo.ListImportedSourceIDs(ctx, sqlc.ListImportedSourceIDsParams{
+			UserID:       userID,
+			ImportSource: string(source),
+			SourceIds:    ids,
+		})
+		if err != nil {
+			return fmt.Errorf("list imported source ids: %w", err)
+		}
+		exists := make(map[string]bool, len(existing))
+		for _, id := range existing {
+			exists[id] = true
+		}
+		items := make([]importer.ExternalItem, 0, len(chunk))
+		for _, item := range chunk {
+			if !exists[item.SourceID] {
+				items = append(items, item)
+			}
+		}
+		already = int32(len(chunk) - len(items))
+		if len(items) == 0 {
+			return nil
+		}
+
+		last, err := repo.GetLastTodoPosition(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("get last position: %w", err)
+		}
+		positions, err := rank.Append(last, len(items))
+		if err != nil {
+			return fmt.Errorf("append positions: %w", err)
+		}
+
+		project, err := loadNewTodoProject(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		rows := make([]sqlc.CopyImportedTodosParams, len(items))
+		for i, item := range items {
+			var projectID *int64
+			if name := projectName(item.Project); name != "" {
+				id, ok := projects[name]
+				if !ok {
+					id, ok = created[name]
+				}
+				if !ok {
+					id, err = repo.EnsureProject(ctx, sqlc.EnsureProjectParams{UserID: userID, Name: name})
+					if err != nil {
+						return fmt.Errorf("ensure project: %w", err)
+					}
+					created[name] = id
+				}
+				projectID = &id
+			}
+			src, sourceID := string(source), item.SourceID
+			rows[i] = sqlc.CopyImportedTodosParams{
+				UserID:         userID,
+				Title:          item.Title,
+				Description:    item.Description,
+				Completed:      item.Completed,
+				Position:       positions[i],
+				ChangeSeq:      seq,
+				DueAt:          timestamptz(item.DueAt),
+				ProjectID:      project.resolve(projectID),
+				Tags:           normalizeTags(item.Tags),
+				ImportSource:   &src,
+				ImportSourceID: &sourceID,
+			}
+		}
+		n, err := repo.CopyImportedTodos(ctx, rows)
+		if err != nil {
+			return fmt.Errorf("copy imported todos: %w", err)
+		}
+		imported = int32(n)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for name, id := range created {
+		projects[name] = id
+	}
+	result.Imported += imported
+	result.AlreadyImported += already
+	return nil
+}
+
+// 前後の空白を除き、長すぎる名前は MaxProjectNameLength 文字で切り詰める
+func projectName(name string) string {
+	name = strings.TrimSpace(name)
+	if r := []rune(name); len(r) > MaxProjectNameLength {
+		name = strings.TrimSpace(string(r[:MaxProjectNameLength]))
+	}
+	return name
+}
+
+// 前後の空白を除き、空のタグと重複を取り除く（順序は保つ）
+func normalizeTags(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		out = append(out, tag)
+	}
+	return out
+}
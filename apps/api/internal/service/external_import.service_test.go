@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/importer"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// トランザクション内でも同じモックリポジトリを使うExternalImportService
+func newTxTestExternalImportService(repo ExternalImportRepository) *ExternalImportService {
+	svc := NewExternalImportService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) ExternalImportRepository { return repo }
+	return svc
+}
+
+func encodeImportParams(t *testing.T, p *ExternalImportParams) json.RawMessage {
+	t.Helper()
+	b, err := json.Marshal(p)
+	require.NoError(t, err)
+	return b
+}
+
+func TestExternalImportService_ParseExport(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+
+	t.Run("正常系: 指定したツールの形式で読み込む", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := NewExternalImportService(repo, nil)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+
+		got, err := svc.ParseExport(ctx, userID, ExternalImportSourceTrello, strings.NewReader(
+			`{"id": "b1", "name": "Launch", "cards": [{"id": "c1", "name": "Write copy", "idList": "l1"}]}`,
+		))
+
+		require.NoError(t, err)
+		assert.Equal(t, ExternalImportSourceTrello, got.Source)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, "c1", got.Items[0].SourceID)
+		assert.Equal(t, map[string]int{"list": 1}, got.Unmapped)
+	})
+
+	t.Run("正常系: 日付だけの期限はユーザー設定のタイムゾーンで読む", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := NewExternalImportService(repo, nil)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{
+			UserID: userID, Timezone: "Asia/Tokyo", Locale: "ja", WeekStart: 1, DefaultSort: "created_at",
+		}, nil).Once()
+
+		got, err := svc.ParseExport(ctx, userID, ExternalImportSourceTodoist, strings.NewReader(
+			`[{"id": "1", "content": "Pay rent", "due": {"date": "2026-10-25"}}]`,
+		))
+
+		require.NoError(t, err)
+		require.Len(t, got.Items, 1)
+		assert.True(t, time.Date(2026, 10, 24, 15, 0, 0, 0, time.UTC).Equal(*got.Items[0].DueAt))
+	})
+
+	t.Run("異常系: 未対応のツールはErrUnknownImportSource", func(t *testing.T) {
+		svc := NewExternalImportService(mocks.NewMockExternalImportRepository(t), nil)
+
+		_, err := svc.ParseExport(ctx, userID, "asana", strings.NewReader(`{}`))
+
+		assert.ErrorIs(t, err, ErrUnknownImportSource)
+	})
+
+	t.Run("異常系: 形式が異なる場合はErrInvalidExport", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := NewExternalImportService(repo, nil)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+
+		_, err := svc.ParseExport(ctx, userID, ExternalImportSourceTrello, strings.NewReader(`{"items": []}`))
+
+		assert.ErrorIs(t, err, importer.ErrInvalidExport)
+	})
+
+	t.Run("異常系: 上限を超える件数はErrTooManyImportItems", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := NewExternalImportService(repo, nil)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+		var b strings.Builder
+		b.WriteString(`[`)
+		for i := 0; i <= maxExternalImportItems; i++ {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(`{"id": ` + strconv.Itoa(i) + `, "content": "x"}`)
+		}
+		b.WriteString(`]`)
+
+		_, err := svc.ParseExport(ctx, userID, ExternalImportSourceTodoist, strings.NewReader(b.String()))
+
+		assert.ErrorIs(t, err, ErrTooManyImportItems)
+	})
+}
+
+func TestExternalImportService_ImportJob(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+	due := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 作成済みのIDを除いてTodoを作成し、プロジェクトを名前で対応付ける", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := newTxTestExternalImportService(repo)
+		params := encodeImportParams(t, &ExternalImportParams{
+			Source: ExternalImportSourceTodoist,
+			ExternalExport: importer.ExternalExport{
+				Items: []importer.ExternalItem{
+					{SourceID: "1", Title: "Buy milk"},
+					{SourceID: "2", Title: "Pay rent", DueAt: &due, Project: " Home ", Tags: []string{"money", " money", ""}},
+					{SourceID: "3", Title: "Fix sink", Completed: true, Project: "Home"},
+				},
+				Skipped:  []importer.SkippedItem{{SourceID: "4", Reason: "deleted"}},
+				Unmapped: map[string]int{"priority": 1},
+			},
+		})
+
+		repo.EXPECT().NextTodoChangeSeq(mock.Anything, userID).Return(int64(7), nil)
+		repo.EXPECT().ListImportedSourceIDs(mock.Anything, sqlc.ListImportedSourceIDsParams{
+			UserID:       userID,
+			ImportSource: "todoist",
+			SourceIds:    []string{"1", "2", "3"},
+		}).Return([]string{"1"}, nil)
+		repo.EXPECT().GetLastTodoPosition(mock.Anything, userID).Return("V", nil)
+		repo.EXPECT().GetUserSettings(mock.Anything, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		// 同じ名前のプロジェクトは1回だけ作成する
+		repo.EXPECT().EnsureProject(mock.Anything, sqlc.EnsureProjectParams{UserID: userID, Name: "Home"}).Return(int64(30), nil).Once()
+		repo.EXPECT().CopyImportedTodos(mock.Anything, mock.MatchedBy(func(rows []sqlc.CopyImportedTodosParams) bool {
+			if len(rows) != 2 {
+				return false
+			}
+			pay, sink := rows[0], rows[1]
+			return pay.Title == "Pay rent" && pay.ChangeSeq == 7 && pay.DueAt.Valid && pay.DueAt.Time.Equal(due) &&
+				*pay.ProjectID == 30 && assert.ObjectsAreEqual([]string{"money"}, pay.Tags) &&
+				*pay.ImportSource == "todoist" && *pay.ImportSourceID == "2" &&
+				sink.Title == "Fix sink" && sink.Completed && *sink.ProjectID == 30 && sink.Tags != nil &&
+				*sink.ImportSourceID == "3" && pay.Position > "V" && sink.Position > pay.Position
+		})).Return(int64(2), nil)
+
+		var reported [][2]int32
+		got, err := svc.ImportJob(ctx, userID, params, func(processed, total int32) error {
+			reported = append(reported, [2]int32{processed, total})
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &ExternalImportResult{
+			Imported:        2,
+			AlreadyImported: 1,
+			Skipped:         []importer.SkippedItem{{SourceID: "4", Reason: "deleted"}},
+			Unmapped:        map[string]int{"priority": 1},
+		}, got)
+		assert.Equal(t, [][2]int32{{0, 3}, {3, 3}}, reported)
+	})
+
+	t.Run("正常系: すべて作成済みの場合は何も作成しない", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := newTxTestExternalImportService(repo)
+		params := encodeImportParams(t, &ExternalImportParams{
+			Source:         ExternalImportSourceTrello,
+			ExternalExport: importer.ExternalExport{Items: []importer.ExternalItem{{SourceID: "c1", Title: "Write copy"}}},
+		})
+
+		repo.EXPECT().NextTodoChangeSeq(mock.Anything, userID).Return(int64(8), nil)
+		repo.EXPECT().ListImportedSourceIDs(mock.Anything, mock.Anything).Return([]string{"c1"}, nil)
+
+		got, err := svc.ImportJob(ctx, userID, params, func(int32, int32) error { return nil })
+
+		require.NoError(t, err)
+		assert.Equal(t, &ExternalImportResult{
+			AlreadyImported: 1,
+			Skipped:         []importer.SkippedItem{},
+			Unmapped:        map[string]int{},
+		}, got)
+	})
+
+	t.Run("正常系: チャンクごとにコミットし、作成したプロジェクトは次のチャンクで再利用する", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := newTxTestExternalImportService(repo)
+		items := make([]importer.ExternalItem, externalImportChunkSize+1)
+		for i := range items {
+			items[i] = importer.ExternalItem{SourceID: strconv.Itoa(i), Title: "t", Project: "Launch"}
+		}
+		params := encodeImportParams(t, &ExternalImportParams{
+			Source:         ExternalImportSourceTrello,
+			ExternalExport: importer.ExternalExport{Items: items},
+		})
+
+		repo.EXPECT().NextTodoChangeSeq(mock.Anything, userID).Return(int64(9), nil).Times(2)
+		repo.EXPECT().ListImportedSourceIDs(mock.Anything, mock.Anything).Return([]string{}, nil).Times(2)
+		repo.EXPECT().GetLastTodoPosition(mock.Anything, userID).Return("", nil).Times(2)
+		repo.EXPECT().GetUserSettings(mock.Anything, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Times(2)
+		repo.EXPECT().EnsureProject(mock.Anything, mock.Anything).Return(int64(5), nil).Once()
+		repo.EXPECT().CopyImportedTodos(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, rows []sqlc.CopyImportedTodosParams) (int64, error) {
+				return int64(len(rows)), nil
+			}).Times(2)
+
+		var reported []int32
+		got, err := svc.ImportJob(ctx, userID, params, func(processed, total int32) error {
+			assert.Equal(t, int32(len(items)), total)
+			reported = append(reported, processed)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(len(items)), got.(*ExternalImportResult).Imported)
+		assert.Equal(t, []int32{0, externalImportChunkSize, int32(len(items))}, reported)
+	})
+
+	t.Run("正常系: プロジェクトのない項目はユーザー設定の既定のプロジェクトに入れる", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := newTxTestExternalImportService(repo)
+		params := encodeImportParams(t, &ExternalImportParams{
+			Source: ExternalImportSourceTodoist,
+			ExternalExport: importer.ExternalExport{Items: []importer.ExternalItem{
+				{SourceID: "1", Title: "Buy milk"},
+				{SourceID: "2", Title: "Pay rent", Project: "Home"},
+			}},
+		})
+		defaultProjectID := int64(40)
+
+		repo.EXPECT().NextTodoChangeSeq(mock.Anything, userID).Return(int64(7), nil)
+		repo.EXPECT().ListImportedSourceIDs(mock.Anything, mock.Anything).Return([]string{}, nil)
+		repo.EXPECT().GetLastTodoPosition(mock.Anything, userID).Return("", nil)
+		repo.EXPECT().GetUserSettings(mock.Anything, userID).Return(sqlc.UserSetting{
+			UserID: userID, Timezone: "UTC", Locale: "en", DefaultSort: "created_at", DefaultProjectID: &defaultProjectID,
+		}, nil)
+		repo.EXPECT().EnsureProject(mock.Anything, sqlc.EnsureProjectParams{UserID: userID, Name: "Home"}).Return(int64(30), nil)
+		repo.EXPECT().CopyImportedTodos(mock.Anything, mock.MatchedBy(func(rows []sqlc.CopyImportedTodosParams) bool {
+			return len(rows) == 2 && *rows[0].ProjectID == defaultProjectID && *rows[1].ProjectID == 30
+		})).Return(int64(2), nil)
+
+		got, err := svc.ImportJob(ctx, userID, params, func(int32, int32) error { return nil })
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), got.(*ExternalImportResult).Imported)
+	})
+
+	t.Run("異常系: キャンセルされた場合はそれまでの結果とエラーを返す", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := newTxTestExternalImportService(repo)
+		params := encodeImportParams(t, &ExternalImportParams{
+			Source:         ExternalImportSourceTodoist,
+			ExternalExport: importer.ExternalExport{Items: []importer.ExternalItem{{SourceID: "1", Title: "Buy milk"}}},
+		})
+
+		got, err := svc.ImportJob(ctx, userID, params, func(int32, int32) error { return context.Canceled })
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, int32(0), got.(*ExternalImportResult).Imported)
+	})
+
+	t.Run("異常系: Todoの作成に失敗した場合はエラーを返す", func(t *testing.T) {
+		repo := mocks.NewMockExternalImportRepository(t)
+		svc := newTxTestExternalImportService(repo)
+		params := encodeImportParams(t, &ExternalImportParams{
+			Source:         ExternalImportSourceTodoist,
+			ExternalExport: importer.ExternalExport{Items: []importer.ExternalItem{{SourceID: "1", Title: "Buy milk"}}},
+		})
+		dbErr := errors.New("db error")
+
+		repo.EXPECT().NextTodoChangeSeq(mock.Anything, userID).Return(int64(7), nil)
+		repo.EXPECT().ListImportedSourceIDs(mock.Anything, mock.Anything).Return([]string{}, nil)
+		repo.EXPECT().GetLastTodoPosition(mock.Anything, userID).Return("", nil)
+		repo.EXPECT().GetUserSettings(mock.Anything, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		repo.EXPECT().CopyImportedTodos(mock.Anything, mock.Anything).Return(0, dbErr)
+
+		_, err := svc.ImportJob(ctx, userID, params, func(int32, int32) error { return nil })
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("異常系: 未対応のツールのパラメーターはErrUnknownImportSource", func(t *testing.T) {
+		svc := newTxTestExternalImportService(mocks.NewMockExternalImportRepository(t))
+
+		_, err := svc.ImportJob(ctx, userID, json.RawMessage(`{"ids": [1]}`), func(int32, int32) error { return nil })
+
+		assert.ErrorIs(t, err, ErrUnknownImportSource)
+	})
+}
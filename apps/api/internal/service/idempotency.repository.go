@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type IdempotencyRepository interface {
+	AcquireIdempotencyKey(ctx context.Context, arg sqlc.AcquireIdempotencyKeyParams) (sqlc.IdempotencyKey, error)
+	GetIdempotencyKey(ctx context.Context, arg sqlc.GetIdempotencyKeyParams) (sqlc.IdempotencyKey, error)
+	SaveIdempotencyResponse(ctx context.Context, arg sqlc.SaveIdempotencyResponseParams) (int64, error)
+	DeleteIdempotencyKey(ctx context.Context, arg sqlc.DeleteIdempotencyKeyParams) (int64, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+// sqlc.Querier が IdempotencyRepository を満たすことを保証
+var _ IdempotencyRepository = (sqlc.Querier)(nil)
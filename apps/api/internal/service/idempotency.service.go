@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"go-todo/db/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrIdempotencyKeyReused   = errors.New("idempotency key reused with different request")
+	ErrIdempotencyKeyInFlight = errors.New("request with the same idempotency key is in progress")
+	ErrIdempotencyKeyLost     = errors.New("idempotency key lock was taken over by another request")
+)
+
+// 保存済みのレスポンス（リトライ時に再送する）
+type IdempotentResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Begin で確保したキー
+// ロックの期限を過ぎて別のリクエストに取り直された後は保存・解放できない
+type IdempotencyLock struct {
+	UserID      int64
+	Key         string
+	LockedUntil pgtype.Timestamptz
+}
+
+type IdempotencyService struct {
+	repo IdempotencyRepository
+	ttl  time.Duration
+	// 処理中のキーのロックの期限。過ぎると完了・解放されなかったキーを取り直せる
+	lockTimeout time.Duration
+	now         func() time.Time
+}
+
+func NewIdempotencyService(repo IdempotencyRepository, ttl, lockTimeout time.Duration) *IdempotencyService {
+	return &IdempotencyService{
+		repo:        repo,
+		ttl:         ttl,
+		lockTimeout: lockTimeout,
+		now:         time.Now,
+	}
+}
+
+// キーを確保する
+// 初回リクエストの場合は確保したキーを返し、呼び出し側で処理を実行して Complete か Release に渡す
+// 同一リクエストのリトライの場合は保存済みのレスポンスを返す
+// 処理中のままロックの期限が過ぎたキー（プロセスの停止などで完了・解放されなかったもの）は取り直す
+func (s *IdempotencyService) Begin(ctx context.Context, userID int64, key, requestHash string) (*IdempotentResponse, *IdempotencyLock, error) {
+	now := s.now()
+	acquired, err := s.repo.AcquireIdempotencyKey(ctx, sqlc.AcquireIdempotencyKeyParams{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   now.Add(s.ttl),
+		LockedUntil: pgtype.Timestamptz{Time: now.Add(s.lockTimeout), Valid: true},
+	})
+	if err == nil {
+		return nil, &IdempotencyLock{UserID: userID, Key: key, LockedUntil: acquired.LockedUntil}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, fmt.Errorf("acquire idempotency key: %w", err)
+	}
+
+	// 有効なキーが既に存在する
+	existing, err := s.repo.GetIdempotencyKey(ctx, sqlc.GetIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 取得までの間に先行リクエストが失敗して解放された
+		return nil, nil, ErrIdempotencyKeyInFlight
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("get idempotency key: %w", err)
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, nil, ErrIdempotencyKeyReused
+	}
+	if existing.StatusCode == nil {
+		return nil, nil, ErrIdempotencyKeyInFlight
+	}
+
+	header, err := decodeStoredHeader(existing.ResponseHeaders)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode stored headers: %w", err)
+	}
+	return &IdempotentResponse{
+		StatusCode: int(*existing.StatusCode),
+		Header:     header,
+		Body:       existing.ResponseBody,
+	}, nil, nil
+}
+
+// 保存済みのヘッダーを復元する
+// 複数の値を持つヘッダーを保存する前の形式（名前ごとに1つの値）も読める
+func decodeStoredHeader(data []byte) (http.Header, error) {
+	header := http.Header{}
+	if len(data) == 0 {
+		return header, nil
+	}
+	if err := json.Unmarshal(data, &header); err == nil {
+		return header, nil
+	}
+	var single map[string]string
+	if err := json.Unmarshal(data, &single); err != nil {
+		return nil, err
+	}
+	for k, v := range single {
+		header.Set(k, v)
+	}
+	return header, nil
+}
+
+// レスポンスを保存してキーを完了状態にする
+// クライアントが切断してリクエストのコンテキストがキャンセルされても保存する
+// ロックの期限切れ後に別のリクエストがキーを取り直していた場合は ErrIdempotencyKeyLost を返す
+func (s *IdempotencyService) Complete(ctx context.Context, lock IdempotencyLock, resp IdempotentResponse) error {
+	ctx = context.WithoutCancel(ctx)
+	header, err := json.Marshal(resp.Header)
+	if err != nil {
+		return fmt.Errorf("encode headers: %w", err)
+	}
+	statusCode := int32(resp.StatusCode)
+	affected, err := s.repo.SaveIdempotencyResponse(ctx, sqlc.SaveIdempotencyResponseParams{
+		StatusCode:      &statusCode,
+		ResponseHeaders: header,
+		ResponseBody:    resp.Body,
+		UserID:          lock.UserID,
+		Key:             lock.Key,
+		ClaimedUntil:    lock.LockedUntil,
+	})
+	if err != nil {
+		return fmt.Errorf("save idempotency response: %w", err)
+	}
+	if affected == 0 {
+		return ErrIdempotencyKeyLost
+	}
+	return nil
+}
+
+// 処理が失敗した場合にキーを解放し、クライアントが再試行できるようにする
+// Complete と同様に、リクエストのコンテキストがキャンセルされても解放する
+// 別のリクエストが取り直したキーは削除せず ErrIdempotencyKeyLost を返す
+func (s *IdempotencyService) Release(ctx context.Context, lock IdempotencyLock) error {
+	ctx = context.WithoutCancel(ctx)
+	affected, err := s.repo.DeleteIdempotencyKey(ctx, sqlc.DeleteIdempotencyKeyParams{
+		UserID:       lock.UserID,
+		Key:          lock.Key,
+		ClaimedUntil: lock.LockedUntil,
+	})
+	if err != nil {
+		return fmt.Errorf("delete idempotency key: %w", err)
+	}
+	if affected == 0 {
+		return ErrIdempotencyKeyLost
+	}
+	return nil
+}
+
+// 期限切れのキーを定期的に削除する（ctxがキャンセルされるまでブロックする）
+func (s *IdempotencyService) RunCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.repo.DeleteExpiredIdempotencyKeys(ctx)
+			if err != nil {
+				log.Printf("Failed to delete expired idempotency keys: %v", err)
+				continue
+			}
+			if deleted > 0 {
+				log.Printf("Deleted %d expired idempotency keys", deleted)
+			}
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIdempotencyService(repo IdempotencyRepository, now time.Time) *IdempotencyService {
+	svc := NewIdempotencyService(repo, time.Hour, time.Minute)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestIdempotencyService_Begin(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+	key := "key-123"
+	hash := "hash-abc"
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	acquireParams := sqlc.AcquireIdempotencyKeyParams{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: hash,
+		ExpiresAt:   now.Add(time.Hour),
+		LockedUntil: pgtype.Timestamptz{Time: now.Add(time.Minute), Valid: true},
+	}
+	getParams := sqlc.GetIdempotencyKeyParams{UserID: userID, Key: key}
+
+	t.Run("正常系: 初回リクエストはキーを確保してnilを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := newTestIdempotencyService(mockRepo, now)
+
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, acquireParams).
+			Return(sqlc.IdempotencyKey{UserID: userID, Key: key, RequestHash: hash, LockedUntil: acquireParams.LockedUntil}, nil)
+
+		resp, lock, err := svc.Begin(ctx, userID, key, hash)
+
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, &IdempotencyLock{UserID: userID, Key: key, LockedUntil: acquireParams.LockedUntil}, lock)
+	})
+
+	t.Run("正常系: 完了済みのリクエストは保存済みのレスポンスを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := newTestIdempotencyService(mockRepo, now)
+
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, acquireParams).
+			Return(sqlc.IdempotencyKey{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetIdempotencyKey(ctx, getParams).
+			Return(sqlc.IdempotencyKey{
+				UserID:          userID,
+				Key:             key,
+				RequestHash:     hash,
+				StatusCode:      ptrInt32(201),
+				ResponseHeaders: []byte(`{"Content-Type":["application/json"],"Vary":["Accept","Accept-Language"]}`),
+				ResponseBody:    []byte(`{"id":1}`),
+			}, nil)
+
+		resp, lock, err := svc.Begin(ctx, userID, key, hash)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Nil(t, lock)
+		assert.Equal(t, 201, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		assert.Equal(t, []string{"Accept", "Accept-Language"}, resp.Header.Values("Vary"))
+		assert.Equal(t, []byte(`{"id":1}`), resp.Body)
+	})
+
+	t.Run("正常系: 以前の形式（名前ごとに1つの値）で保存されたヘッダーも読める", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := newTestIdempotencyService(mockRepo, now)
+
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, acquireParams).
+			Return(sqlc.IdempotencyKey{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetIdempotencyKey(ctx, getParams).
+			Return(sqlc.IdempotencyKey{
+				RequestHash:     hash,
+				StatusCode:      ptrInt32(201),
+				ResponseHeaders: []byte(`{"Content-Type":"application/json"}`),
+			}, nil)
+
+		resp, lock, err := svc.Begin(ctx, userID, key, hash)
+
+		require.NoError(t, err)
+		assert.Nil(t, lock)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	})
+
+	t.Run("異常系: 異なるリクエストで再利用された場合はErrIdempotencyKeyReusedを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := newTestIdempotencyService(mockRepo, now)
+
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, acquireParams).
+			Return(sqlc.IdempotencyKey{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetIdempotencyKey(ctx, getParams).
+			Return(sqlc.IdempotencyKey{RequestHash: "other-hash", StatusCode: ptrInt32(201)}, nil)
+
+		resp, lock, err := svc.Begin(ctx, userID, key, hash)
+
+		assert.Nil(t, resp)
+		assert.Nil(t, lock)
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	})
+
+	t.Run("異常系: 処理中のリクエストがある場合はErrIdempotencyKeyInFlightを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := newTestIdempotencyService(mockRepo, now)
+
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, acquireParams).
+			Return(sqlc.IdempotencyKey{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetIdempotencyKey(ctx, getParams).
+			Return(sqlc.IdempotencyKey{RequestHash: hash}, nil)
+
+		resp, lock, err := svc.Begin(ctx, userID, key, hash)
+
+		assert.Nil(t, resp)
+		assert.Nil(t, lock)
+		assert.ErrorIs(t, err, ErrIdempotencyKeyInFlight)
+	})
+
+	t.Run("異常系: 取得前にキーが解放された場合はErrIdempotencyKeyInFlightを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := newTestIdempotencyService(mockRepo, now)
+
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, acquireParams).
+			Return(sqlc.IdempotencyKey{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetIdempotencyKey(ctx, getParams).
+			Return(sqlc.IdempotencyKey{}, pgx.ErrNoRows)
+
+		resp, lock, err := svc.Begin(ctx, userID, key, hash)
+
+		assert.Nil(t, resp)
+		assert.Nil(t, lock)
+		assert.ErrorIs(t, err, ErrIdempotencyKeyInFlight)
+	})
+
+	t.Run("異常系: その他のエラーをラップして返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := newTestIdempotencyService(mockRepo, now)
+
+		dbErr := errors.New("database error")
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, acquireParams).
+			Return(sqlc.IdempotencyKey{}, dbErr)
+
+		resp, lock, err := svc.Begin(ctx, userID, key, hash)
+
+		assert.Nil(t, resp)
+		assert.Nil(t, lock)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestIdempotencyService_Complete(t *testing.T) {
+	lock := IdempotencyLock{
+		UserID:      1,
+		Key:         "key-123",
+		LockedUntil: pgtype.Timestamptz{Time: time.Date(2026, 10, 19, 12, 1, 0, 0, time.UTC), Valid: true},
+	}
+
+	t.Run("正常系: レスポンスを保存できる", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+
+		ctx := context.Background()
+		mockRepo.EXPECT().
+			SaveIdempotencyResponse(mock.Anything, sqlc.SaveIdempotencyResponseParams{
+				StatusCode:      ptrInt32(201),
+				ResponseHeaders: []byte(`{"Content-Type":["application/json"],"Vary":["Accept","Accept-Language"]}`),
+				ResponseBody:    []byte(`{"id":1}`),
+				UserID:          1,
+				Key:             "key-123",
+				ClaimedUntil:    lock.LockedUntil,
+			}).
+			Return(1, nil)
+
+		err := svc.Complete(ctx, lock, IdempotentResponse{
+			StatusCode: 201,
+			Header:     http.Header{"Content-Type": {"application/json"}, "Vary": {"Accept", "Accept-Language"}},
+			Body:       []byte(`{"id":1}`),
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("正常系: リクエストのコンテキストがキャンセルされても保存する", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		mockRepo.EXPECT().
+			SaveIdempotencyResponse(mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil }), mock.Anything).
+			Return(1, nil)
+
+		err := svc.Complete(ctx, lock, IdempotentResponse{StatusCode: 201, Header: http.Header{}})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系: ロックの期限切れ後に別のリクエストが取り直したキーにはErrIdempotencyKeyLostを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+		svc := newTestIdempotencyService(mockRepo, now)
+
+		ctx := context.Background()
+		first := pgtype.Timestamptz{Time: now.Add(time.Minute), Valid: true}
+		second := pgtype.Timestamptz{Time: now.Add(2 * time.Minute), Valid: true}
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, mock.Anything).
+			Return(sqlc.IdempotencyKey{UserID: 1, Key: "key-123", LockedUntil: first}, nil).Once()
+		mockRepo.EXPECT().
+			AcquireIdempotencyKey(ctx, mock.Anything).
+			Return(sqlc.IdempotencyKey{UserID: 1, Key: "key-123", LockedUntil: second}, nil).Once()
+		// 先行リクエストのロックの期限はもう一致しない
+		mockRepo.EXPECT().
+			SaveIdempotencyResponse(mock.Anything, mock.MatchedBy(func(arg sqlc.SaveIdempotencyResponseParams) bool {
+				return arg.ClaimedUntil == first
+			})).
+			Return(0, nil)
+
+		_, stale, err := svc.Begin(ctx, 1, "key-123", "hash")
+		require.NoError(t, err)
+		_, current, err := svc.Begin(ctx, 1, "key-123", "hash")
+		require.NoError(t, err)
+		require.NotEqual(t, stale.LockedUntil, current.LockedUntil)
+
+		err = svc.Complete(ctx, *stale, IdempotentResponse{StatusCode: 201, Header: http.Header{}})
+
+		assert.ErrorIs(t, err, ErrIdempotencyKeyLost)
+	})
+
+	t.Run("異常系: 保存に失敗した場合はエラーを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+
+		dbErr := errors.New("database error")
+		mockRepo.EXPECT().
+			SaveIdempotencyResponse(mock.Anything, mock.Anything).
+			Return(0, dbErr)
+
+		err := svc.Complete(context.Background(), lock, IdempotentResponse{StatusCode: 201, Header: http.Header{}})
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.NotErrorIs(t, err, ErrIdempotencyKeyLost)
+	})
+}
+
+func TestIdempotencyService_Release(t *testing.T) {
+	lock := IdempotencyLock{
+		UserID:      1,
+		Key:         "key-123",
+		LockedUntil: pgtype.Timestamptz{Time: time.Date(2026, 10, 19, 12, 1, 0, 0, time.UTC), Valid: true},
+	}
+	deleteParams := sqlc.DeleteIdempotencyKeyParams{UserID: 1, Key: "key-123", ClaimedUntil: lock.LockedUntil}
+
+	t.Run("正常系: キーを削除できる", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+
+		ctx := context.Background()
+		mockRepo.EXPECT().
+			DeleteIdempotencyKey(mock.Anything, deleteParams).
+			Return(1, nil)
+
+		err := svc.Release(ctx, lock)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("正常系: リクエストのコンテキストがキャンセルされても解放する", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		mockRepo.EXPECT().
+			DeleteIdempotencyKey(mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil }), deleteParams).
+			Return(1, nil)
+
+		err := svc.Release(ctx, lock)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系: 別のリクエストが取り直したキーは削除せずErrIdempotencyKeyLostを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockIdempotencyRepository(t)
+		svc := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+
+		mockRepo.EXPECT().
+			DeleteIdempotencyKey(mock.Anything, deleteParams).
+			Return(0, nil)
+
+		err := svc.Release(context.Background(), lock)
+
+		assert.ErrorIs(t, err, ErrIdempotencyKeyLost)
+	})
+}
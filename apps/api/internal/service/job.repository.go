@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type JobRepository interface {
+	CreateJob(ctx context.Context, arg sqlc.CreateJobParams) (sqlc.Job, error)
+	GetJobByID(ctx context.Context, arg sqlc.GetJobByIDParams) (sqlc.Job, error)
+	FailExhaustedJobs(ctx context.Context, arg sqlc.FailExhaustedJobsParams) (int64, error)
+	ClaimNextJob(ctx context.Context, arg sqlc.ClaimNextJobParams) (sqlc.Job, error)
+	ExtendJobLease(ctx context.Context, arg sqlc.ExtendJobLeaseParams) (bool, error)
+	UpdateJobProgress(ctx context.Context, arg sqlc.UpdateJobProgressParams) (bool, error)
+	FinishJob(ctx context.Context, arg sqlc.FinishJobParams) (int64, error)
+	ReleaseJob(ctx context.Context, arg sqlc.ReleaseJobParams) (int64, error)
+	CancelJob(ctx context.Context, arg sqlc.CancelJobParams) (sqlc.Job, error)
+}
+
+// sqlc.Querier が JobRepository を満たすことを保証
+var _ JobRepository = (sqlc.Querier)(nil)
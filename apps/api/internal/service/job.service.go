@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go-todo/db/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type JobType string
+
+const (
+	JobTypeCompleteTodos JobType = "complete_todos"
+	JobTypeDeleteTodos   JobType = "delete_todos"
+	// 他のツールのエクスポートからのインポート（ExternalImportService.ImportJob）
+	JobTypeImportExternalTodos JobType = "import_external_todos"
+	// アカウントのデータ一式のエクスポート（AccountExportService.ExportJob）
+	JobTypeExportAccountData JobType = "export_account_data"
+)
+
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCanceled  JobStatus = "canceled"
+)
+
+var (
+	ErrJobNotFound         = errors.New("job not found")
+	ErrJobAlreadyFinished  = errors.New("job already finished")
+	ErrUnknownJobType      = errors.New("unknown job type")
+	ErrJobAttemptsExceeded = errors.New("job exceeded the maximum number of attempts")
+	ErrJobLeaseLost        = errors.New("job lease was taken over by another worker")
+)
+
+// ジョブの進捗を記録する
+// ジョブのキャンセルが要求されている場合はジョブのコンテキストがキャンセルされ、そのエラーを返す
+type JobProgressFunc func(processed, total int32) error
+
+// ジョブの本体。戻り値の結果はJSONとして保存される
+type JobHandler func(ctx context.Context, userID int64, params json.RawMessage, progress JobProgressFunc) (any, error)
+
+type JobService struct {
+	repo  JobRepository
+	lease time.Duration
+	// 実行を試みる最大回数。途中でワーカーが停止したジョブはリースの期限切れ後に再実行される
+	maxAttempts int32
+	handlers    map[JobType]JobHandler
+	now         func() time.Time
+
+	// 実行中のジョブのキャンセル関数（同一プロセス内のキャンセル要求を即座に伝えるため）
+	mu      sync.Mutex
+	running map[int64]context.CancelFunc
+}
+
+func NewJobService(repo JobRepository, lease time.Duration, maxAttempts int32) *JobService {
+	return &JobService{
+		repo:        repo,
+		lease:       lease,
+		maxAttempts: maxAttempts,
+		handlers:    map[JobType]JobHandler{},
+		now:         time.Now,
+		running:     map[int64]context.CancelFunc{},
+	}
+}
+
+// ジョブの種類ごとの処理を登録する（ワーカー起動前に呼び出す）
+func (s *JobService) RegisterHandler(jobType JobType, handler JobHandler) {
+	s.handlers[jobType] = handler
+}
+
+// ジョブを登録する。実行はワーカーが非同期に行う
+func (s *JobService) Enqueue(ctx context.Context, userID int64, jobType JobType, params any) (*sqlc.Job, error) {
+	if _, ok := s.handlers[jobType]; !ok {
+		return nil, ErrUnknownJobType
+	}
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("encode job params: %w", err)
+	}
+	job, err := s.repo.CreateJob(ctx, sqlc.CreateJobParams{
+		UserID: userID,
+		Type:   string(jobType),
+		Params: encoded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *JobService) GetJob(ctx context.Context, id, userID int64) (*sqlc.Job, error) {
+	job, err := s.repo.GetJobByID(ctx, sqlc.GetJobByIDParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ジョブのキャンセルを要求する
+// 待機中のジョブは即座にキャンセルされ、実行中のジョブは次の進捗報告の時点で中断される
+func (s *JobService) CancelJob(ctx context.Context, id, userID int64) (*sqlc.Job, error) {
+	job, err := s.repo.CancelJob(ctx, sqlc.CancelJobParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 存在しないのか、既に終了しているのかを区別する
+		if _, err := s.GetJob(ctx, id, userID); err != nil {
+			return nil, err
+		}
+		return nil, ErrJobAlreadyFinished
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if cancel, ok := s.running[job.ID]; ok {
+		cancel()
+	}
+	s.mu.Unlock()
+
+	return &job, nil
+}
+
+// ワーカーを起動してジョブを処理する（ctxがキャンセルされるまでブロックする）
+func (s *JobService) RunWorkers(ctx context.Context, workers int, pollInterval time.Duration) {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runWorker(ctx, pollInterval)
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *JobService) runWorker(ctx context.Context, pollInterval time.Duration) {
+	for {
+		processed, err := s.processNext(ctx)
+		if err != nil {
+			log.Printf("Failed to process job: %v", err)
+		}
+		if processed {
+			continue
+		}
+
+		// 待機中のジョブがなければ次のポーリングまで待つ
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// 待機中のジョブを1件取得して実行する。ジョブがなければ false を返す
+func (s *JobService) processNext(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+	// 実行のたびにワーカーが停止する（パニックでプロセスごと落ちる等）ジョブを繰り返し取得しないように失敗にする
+	message := ErrJobAttemptsExceeded.Error()
+	failed, err := s.repo.FailExhaustedJobs(ctx, sqlc.FailExhaustedJobsParams{
+		Error:       &message,
+		MaxAttempts: s.maxAttempts,
+	})
+	if err != nil {
+		return false, fmt.Errorf("fail exhausted jobs: %w", err)
+	}
+	if failed > 0 {
+		log.Printf("Failed %d job(s) that exceeded the maximum number of attempts", failed)
+	}
+
+	job, err := s.repo.ClaimNextJob(ctx, sqlc.ClaimNextJobParams{
+		LockedUntil: s.leaseUntil(),
+		MaxAttempts: s.maxAttempts,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("claim job: %w", err)
+	}
+	return true, s.execute(ctx, job)
+}
+
+func (s *JobService) execute(ctx context.Context, job sqlc.Job) error {
+	handler, ok := s.handlers[JobType(job.Type)]
+	if !ok {
+		return s.finish(ctx, job, JobStatusFailed, nil, ErrUnknownJobType)
+	}
+	// 取得前にキャンセルが要求されていた
+	if job.CancelRequested {
+		return s.finish(ctx, job, JobStatusCanceled, nil, nil)
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	s.mu.Lock()
+	s.running[job.ID] = cancel
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, job.ID)
+		s.mu.Unlock()
+	}()
+
+	// リースの期限切れ後に他のワーカーが取り直したことを検知した
+	// 以降の書き込みは取得時の試行回数で絞り込んでいるため、他のワーカーの実行を上書きしない
+	var leaseLost atomic.Bool
+
+	// 進捗の報告が長く途切れてもリースが切れないように、実行中は定期的に延長する
+	heartbeatDone := make(chan struct{})
+	heartbeatStopped := make(chan struct{})
+	go func() {
+		defer close(heartbeatStopped)
+		s.heartbeat(ctx, job, cancel, &leaseLost, heartbeatDone)
+	}()
+
+	progress := func(processed, total int32) error {
+		cancelRequested, err := s.repo.UpdateJobProgress(ctx, sqlc.UpdateJobProgressParams{
+			Processed:   processed,
+			Total:       total,
+			LockedUntil: s.leaseUntil(),
+			ID:          job.ID,
+			Attempts:    job.Attempts,
+		})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("update job progress: %w", err)
+		}
+		// 他のワーカーにリースを奪われた場合も処理を中断する
+		if errors.Is(err, pgx.ErrNoRows) {
+			leaseLost.Store(true)
+		}
+		if cancelRequested || leaseLost.Load() {
+			cancel()
+		}
+		return jobCtx.Err()
+	}
+
+	result, runErr := runJobHandler(jobCtx, handler, job, progress)
+	// 終了を記録した後にリースを延長しないように、先に延長を止める
+	close(heartbeatDone)
+	<-heartbeatStopped
+
+	switch {
+	case leaseLost.Load():
+		return fmt.Errorf("job %d: %w", job.ID, ErrJobLeaseLost)
+	case ctx.Err() != nil:
+		// シャットダウン時は他のワーカーが再実行できるように待機中に戻す（試行回数には数えない）
+		released, err := s.repo.ReleaseJob(context.WithoutCancel(ctx), sqlc.ReleaseJobParams{
+			ID:       job.ID,
+			Attempts: job.Attempts,
+		})
+		if err != nil {
+			return fmt.Errorf("release job: %w", err)
+		}
+		if released == 0 {
+			return fmt.Errorf("job %d: %w", job.ID, ErrJobLeaseLost)
+		}
+		return nil
+	case runErr == nil:
+		return s.finish(ctx, job, JobStatusSucceeded, result, nil)
+	case jobCtx.Err() != nil:
+		return s.finish(ctx, job, JobStatusCanceled, result, nil)
+	default:
+		return s.finish(ctx, job, JobStatusFailed, result, runErr)
+	}
+}
+
+// ジョブの本体を実行する。パニックはジョブの失敗として扱い、ワーカーを停止させない
+func runJobHandler(ctx context.Context, handler JobHandler, job sqlc.Job, progress JobProgressFunc) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Job panicked (job_id=%d, type=%s): %v\n%s", job.ID, job.Type, r, debug.Stack())
+			result = nil
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return handler(ctx, job.UserID, job.Params, progress)
+}
+
+// done が閉じられるまでリースの3分の1ごとにリースを延長する
+// キャンセルが要求された場合や、他のワーカーにリースを奪われた場合はジョブのコンテキストをキャンセルする
+func (s *JobService) heartbeat(ctx context.Context, job sqlc.Job, cancel context.CancelFunc, leaseLost *atomic.Bool, done <-chan struct{}) {
+	ticker := time.NewTicker(s.lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		cancelRequested, err := s.repo.ExtendJobLease(ctx, sqlc.ExtendJobLeaseParams{
+			LockedUntil: s.leaseUntil(),
+			ID:          job.ID,
+			Attempts:    job.Attempts,
+		})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			// 一時的な失敗は次の延長で回復できるため、ジョブは継続する
+			log.Printf("Failed to extend job lease (job_id=%d): %v", job.ID, err)
+			continue
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			leaseLost.Store(true)
+		}
+		if cancelRequested || leaseLost.Load() {
+			cancel()
+			return
+		}
+	}
+}
+
+// 他のワーカーがリースを取り直していた場合は結果を記録せず ErrJobLeaseLost を返す
+func (s *JobService) finish(ctx context.Context, job sqlc.Job, status JobStatus, result any, jobErr error) error {
+	params := sqlc.FinishJobParams{
+		Status:   string(status),
+		ID:       job.ID,
+		Attempts: job.Attempts,
+	}
+	if result != nil {
+		encoded, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("encode job result: %w", err)
+		}
+		// 型付きのnilポインタは結果なしとして扱う
+		if string(encoded) != "null" {
+			params.Result = encoded
+		}
+	}
+	if jobErr != nil {
+		message := jobErr.Error()
+		params.Error = &message
+	}
+	finished, err := s.repo.FinishJob(ctx, params)
+	if err != nil {
+		return fmt.Errorf("finish job: %w", err)
+	}
+	if finished == 0 {
+		return fmt.Errorf("job %d: %w", job.ID, ErrJobLeaseLost)
+	}
+	return nil
+}
+
+func (s *JobService) leaseUntil() pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: s.now().Add(s.lease), Valid: true}
+}
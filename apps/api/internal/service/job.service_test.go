@@ -0,0 +1,495 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJobService(repo JobRepository, now time.Time) *JobService {
+	svc := NewJobService(repo, time.Minute, 3)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestJobService_Enqueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: パラメータをJSONにしてジョブを登録する", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := NewJobService(mockRepo, time.Minute, 3)
+		svc.RegisterHandler(JobTypeDeleteTodos, func(context.Context, int64, json.RawMessage, JobProgressFunc) (any, error) {
+			return nil, nil
+		})
+
+		mockRepo.EXPECT().
+			CreateJob(ctx, sqlc.CreateJobParams{
+				UserID: 1,
+				Type:   "delete_todos",
+				Params: []byte(`{"ids":[1,2]}`),
+			}).
+			Return(sqlc.Job{ID: 10, UserID: 1, Type: "delete_todos", Status: "pending"}, nil)
+
+		job, err := svc.Enqueue(ctx, 1, JobTypeDeleteTodos, BulkTodoFilter{IDs: []int64{1, 2}})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), job.ID)
+	})
+
+	t.Run("異常系: 未登録のジョブ種別はErrUnknownJobTypeを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := NewJobService(mockRepo, time.Minute, 3)
+
+		job, err := svc.Enqueue(ctx, 1, "unknown", nil)
+
+		assert.Nil(t, job)
+		assert.ErrorIs(t, err, ErrUnknownJobType)
+	})
+}
+
+func TestJobService_GetJob(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: ジョブを取得できる", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := NewJobService(mockRepo, time.Minute, 3)
+
+		mockRepo.EXPECT().
+			GetJobByID(ctx, sqlc.GetJobByIDParams{ID: 10, UserID: 1}).
+			Return(sqlc.Job{ID: 10, UserID: 1}, nil)
+
+		job, err := svc.GetJob(ctx, 10, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), job.ID)
+	})
+
+	t.Run("異常系: 存在しない場合はErrJobNotFoundを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := NewJobService(mockRepo, time.Minute, 3)
+
+		mockRepo.EXPECT().
+			GetJobByID(ctx, sqlc.GetJobByIDParams{ID: 10, UserID: 1}).
+			Return(sqlc.Job{}, pgx.ErrNoRows)
+
+		job, err := svc.GetJob(ctx, 10, 1)
+
+		assert.Nil(t, job)
+		assert.ErrorIs(t, err, ErrJobNotFound)
+	})
+}
+
+func TestJobService_CancelJob(t *testing.T) {
+	ctx := context.Background()
+	params := sqlc.CancelJobParams{ID: 10, UserID: 1}
+
+	t.Run("正常系: 同一プロセスで実行中のジョブのコンテキストをキャンセルする", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := NewJobService(mockRepo, time.Minute, 3)
+		jobCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		svc.running[10] = cancel
+
+		mockRepo.EXPECT().
+			CancelJob(ctx, params).
+			Return(sqlc.Job{ID: 10, Status: "running", CancelRequested: true}, nil)
+
+		job, err := svc.CancelJob(ctx, 10, 1)
+
+		require.NoError(t, err)
+		assert.True(t, job.CancelRequested)
+		assert.ErrorIs(t, jobCtx.Err(), context.Canceled)
+	})
+
+	t.Run("異常系: 終了済みのジョブはErrJobAlreadyFinishedを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := NewJobService(mockRepo, time.Minute, 3)
+
+		mockRepo.EXPECT().
+			CancelJob(ctx, params).
+			Return(sqlc.Job{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetJobByID(ctx, sqlc.GetJobByIDParams{ID: 10, UserID: 1}).
+			Return(sqlc.Job{ID: 10, Status: "succeeded"}, nil)
+
+		job, err := svc.CancelJob(ctx, 10, 1)
+
+		assert.Nil(t, job)
+		assert.ErrorIs(t, err, ErrJobAlreadyFinished)
+	})
+
+	t.Run("異常系: 存在しない場合はErrJobNotFoundを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := NewJobService(mockRepo, time.Minute, 3)
+
+		mockRepo.EXPECT().
+			CancelJob(ctx, params).
+			Return(sqlc.Job{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetJobByID(ctx, sqlc.GetJobByIDParams{ID: 10, UserID: 1}).
+			Return(sqlc.Job{}, pgx.ErrNoRows)
+
+		job, err := svc.CancelJob(ctx, 10, 1)
+
+		assert.Nil(t, job)
+		assert.ErrorIs(t, err, ErrJobNotFound)
+	})
+}
+
+func TestJobService_processNext(t *testing.T) {
+	now := time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+	lease := pgtype.Timestamptz{Time: now.Add(time.Minute), Valid: true}
+	claim := sqlc.ClaimNextJobParams{LockedUntil: lease, MaxAttempts: 3}
+	claimed := sqlc.Job{ID: 10, UserID: 1, Type: string(JobTypeDeleteTodos), Status: "running", Params: []byte(`{}`), Attempts: 1}
+	// 試行回数の上限を超えたジョブがない
+	expectNoExhaustedJobs := func(mockRepo *mocks.MockJobRepository, ctx context.Context) {
+		mockRepo.EXPECT().
+			FailExhaustedJobs(ctx, sqlc.FailExhaustedJobsParams{Error: ptrString(ErrJobAttemptsExceeded.Error()), MaxAttempts: 3}).
+			Return(int64(0), nil)
+	}
+
+	t.Run("正常系: 待機中のジョブがなければfalseを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(sqlc.Job{}, pgx.ErrNoRows)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("正常系: 進捗を記録し、結果を保存して成功にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+		svc.RegisterHandler(JobTypeDeleteTodos, func(ctx context.Context, userID int64, params json.RawMessage, progress JobProgressFunc) (any, error) {
+			assert.Equal(t, int64(1), userID)
+			if err := progress(5, 10); err != nil {
+				return nil, err
+			}
+			return BulkJobResult{Affected: 10}, nil
+		})
+
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			UpdateJobProgress(ctx, sqlc.UpdateJobProgressParams{Processed: 5, Total: 10, LockedUntil: lease, ID: 10, Attempts: 1}).
+			Return(false, nil)
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "succeeded", Result: []byte(`{"affected":10}`), ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, processed)
+		assert.Empty(t, svc.running)
+	})
+
+	t.Run("正常系: 進捗報告でキャンセル要求を検知したら中断してキャンセル済みにする", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+		svc.RegisterHandler(JobTypeDeleteTodos, func(ctx context.Context, userID int64, params json.RawMessage, progress JobProgressFunc) (any, error) {
+			if err := progress(5, 10); err != nil {
+				return BulkJobResult{Affected: 5}, err
+			}
+			t.Fatal("progress should return an error after cancellation")
+			return nil, nil
+		})
+
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			UpdateJobProgress(ctx, mock.Anything).
+			Return(true, nil)
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "canceled", Result: []byte(`{"affected":5}`), ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, processed)
+	})
+
+	t.Run("正常系: 取得前にキャンセルが要求されていれば実行しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+		svc.RegisterHandler(JobTypeDeleteTodos, func(context.Context, int64, json.RawMessage, JobProgressFunc) (any, error) {
+			t.Fatal("handler should not be called")
+			return nil, nil
+		})
+
+		job := claimed
+		job.CancelRequested = true
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(job, nil)
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "canceled", ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		_, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系: 処理が失敗した場合はエラーメッセージを保存して失敗にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+		svc.RegisterHandler(JobTypeDeleteTodos, func(context.Context, int64, json.RawMessage, JobProgressFunc) (any, error) {
+			return nil, errors.New("boom")
+		})
+
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "failed", Error: ptrString("boom"), ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		_, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系: 未登録のジョブ種別は失敗にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "failed", Error: ptrString(ErrUnknownJobType.Error()), ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		_, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("正常系: シャットダウン時は実行中のジョブを待機中に戻す", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx, cancel := context.WithCancel(context.Background())
+		svc.RegisterHandler(JobTypeDeleteTodos, func(ctx context.Context, _ int64, _ json.RawMessage, _ JobProgressFunc) (any, error) {
+			cancel()
+			return nil, ctx.Err()
+		})
+
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			ReleaseJob(mock.Anything, sqlc.ReleaseJobParams{ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		_, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("正常系: 試行回数の上限を超えたジョブは失敗にして取得しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			FailExhaustedJobs(ctx, sqlc.FailExhaustedJobsParams{Error: ptrString(ErrJobAttemptsExceeded.Error()), MaxAttempts: 3}).
+			Return(int64(2), nil)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(sqlc.Job{}, pgx.ErrNoRows)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("異常系: 処理がパニックした場合はワーカーを止めずに失敗にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+		svc.RegisterHandler(JobTypeDeleteTodos, func(context.Context, int64, json.RawMessage, JobProgressFunc) (any, error) {
+			panic("boom")
+		})
+
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "failed", Error: ptrString("job panicked: boom"), ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, processed)
+		assert.Empty(t, svc.running)
+	})
+
+	t.Run("正常系: 進捗の報告がなくても実行中はリースを延長する", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		svc.lease = 30 * time.Millisecond
+		ctx := context.Background()
+		extended := make(chan struct{})
+		svc.RegisterHandler(JobTypeDeleteTodos, func(context.Context, int64, json.RawMessage, JobProgressFunc) (any, error) {
+			<-extended
+			return nil, nil
+		})
+
+		mockRepo.EXPECT().
+			FailExhaustedJobs(ctx, mock.Anything).
+			Return(int64(0), nil)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, mock.Anything).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			ExtendJobLease(ctx, sqlc.ExtendJobLeaseParams{LockedUntil: pgtype.Timestamptz{Time: now.Add(30 * time.Millisecond), Valid: true}, ID: 10, Attempts: 1}).
+			Run(func(context.Context, sqlc.ExtendJobLeaseParams) { close(extended) }).
+			Return(false, nil).
+			Once()
+		mockRepo.EXPECT().
+			ExtendJobLease(ctx, mock.Anything).
+			Return(false, nil).
+			Maybe()
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "succeeded", ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		_, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系: リースの期限切れ後に他のワーカーが取り直したジョブは先行ワーカーが終了を記録できない", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		first := newTestJobService(mockRepo, now)
+		second := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+		// 先行ワーカーの実行中にリースが切れ、後続のワーカーが同じジョブを取り直して完了させる
+		first.RegisterHandler(JobTypeDeleteTodos, func(context.Context, int64, json.RawMessage, JobProgressFunc) (any, error) {
+			processed, err := second.processNext(ctx)
+			require.NoError(t, err)
+			require.True(t, processed)
+			return BulkJobResult{Affected: 1}, nil
+		})
+		second.RegisterHandler(JobTypeDeleteTodos, func(context.Context, int64, json.RawMessage, JobProgressFunc) (any, error) {
+			return BulkJobResult{Affected: 2}, nil
+		})
+
+		takenOver := claimed
+		takenOver.Attempts = 2
+		mockRepo.EXPECT().
+			FailExhaustedJobs(ctx, mock.Anything).
+			Return(int64(0), nil).
+			Twice()
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(claimed, nil).
+			Once()
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(takenOver, nil).
+			Once()
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "succeeded", Result: []byte(`{"affected":2}`), ID: 10, Attempts: 2}).
+			Return(int64(1), nil)
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "succeeded", Result: []byte(`{"affected":1}`), ID: 10, Attempts: 1}).
+			Return(int64(0), nil)
+
+		processed, err := first.processNext(ctx)
+
+		assert.True(t, processed)
+		assert.ErrorIs(t, err, ErrJobLeaseLost)
+	})
+
+	t.Run("異常系: 進捗報告でリースを奪われたことを検知したら中断し、終了を記録しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		ctx := context.Background()
+		svc.RegisterHandler(JobTypeDeleteTodos, func(ctx context.Context, _ int64, _ json.RawMessage, progress JobProgressFunc) (any, error) {
+			if err := progress(5, 10); err != nil {
+				return nil, err
+			}
+			t.Fatal("progress should return an error after the lease is lost")
+			return nil, nil
+		})
+
+		expectNoExhaustedJobs(mockRepo, ctx)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, claim).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			UpdateJobProgress(ctx, mock.Anything).
+			Return(false, pgx.ErrNoRows)
+
+		processed, err := svc.processNext(ctx)
+
+		assert.True(t, processed)
+		assert.ErrorIs(t, err, ErrJobLeaseLost)
+	})
+
+	t.Run("正常系: リースの延長でキャンセル要求を検知したら中断する", func(t *testing.T) {
+		mockRepo := mocks.NewMockJobRepository(t)
+		svc := newTestJobService(mockRepo, now)
+		svc.lease = 30 * time.Millisecond
+		ctx := context.Background()
+		svc.RegisterHandler(JobTypeDeleteTodos, func(ctx context.Context, _ int64, _ json.RawMessage, _ JobProgressFunc) (any, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		mockRepo.EXPECT().
+			FailExhaustedJobs(ctx, mock.Anything).
+			Return(int64(0), nil)
+		mockRepo.EXPECT().
+			ClaimNextJob(ctx, mock.Anything).
+			Return(claimed, nil)
+		mockRepo.EXPECT().
+			ExtendJobLease(ctx, mock.Anything).
+			Return(true, nil).
+			Once()
+		mockRepo.EXPECT().
+			FinishJob(ctx, sqlc.FinishJobParams{Status: "canceled", ID: 10, Attempts: 1}).
+			Return(int64(1), nil)
+
+		_, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+	})
+}
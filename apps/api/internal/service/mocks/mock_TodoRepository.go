@@ -129,6 +129,236 @@ func (_c *MockTodoRepository_BatchDeleteTodos_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// BatchUpdateTodos provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) BatchUpdateTodos(ctx context.Context, arg sqlc.BatchUpdateTodosParams) ([]sqlc.Todo, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchUpdateTodos")
+	}
+
+	var r0 []sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.BatchUpdateTodosParams) ([]sqlc.Todo, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.BatchUpdateTodosParams) []sqlc.Todo); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.BatchUpdateTodosParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_BatchUpdateTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUpdateTodos'
+type MockTodoRepository_BatchUpdateTodos_Call struct {
+	*mock.Call
+}
+
+// BatchUpdateTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.BatchUpdateTodosParams
+func (_e *MockTodoRepository_Expecter) BatchUpdateTodos(ctx interface{}, arg interface{}) *MockTodoRepository_BatchUpdateTodos_Call {
+	return &MockTodoRepository_BatchUpdateTodos_Call{Call: _e.mock.On("BatchUpdateTodos", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_BatchUpdateTodos_Call) Run(run func(ctx context.Context, arg sqlc.BatchUpdateTodosParams)) *MockTodoRepository_BatchUpdateTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.BatchUpdateTodosParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_BatchUpdateTodos_Call) Return(_a0 []sqlc.Todo, _a1 error) *MockTodoRepository_BatchUpdateTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_BatchUpdateTodos_Call) RunAndReturn(run func(context.Context, sqlc.BatchUpdateTodosParams) ([]sqlc.Todo, error)) *MockTodoRepository_BatchUpdateTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyTodos provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) CopyTodos(ctx context.Context, arg []sqlc.CopyTodosParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyTodos")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []sqlc.CopyTodosParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []sqlc.CopyTodosParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []sqlc.CopyTodosParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_CopyTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyTodos'
+type MockTodoRepository_CopyTodos_Call struct {
+	*mock.Call
+}
+
+// CopyTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []sqlc.CopyTodosParams
+func (_e *MockTodoRepository_Expecter) CopyTodos(ctx interface{}, arg interface{}) *MockTodoRepository_CopyTodos_Call {
+	return &MockTodoRepository_CopyTodos_Call{Call: _e.mock.On("CopyTodos", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_CopyTodos_Call) Run(run func(ctx context.Context, arg []sqlc.CopyTodosParams)) *MockTodoRepository_CopyTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]sqlc.CopyTodosParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_CopyTodos_Call) Return(_a0 int64, _a1 error) *MockTodoRepository_CopyTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_CopyTodos_Call) RunAndReturn(run func(context.Context, []sqlc.CopyTodosParams) (int64, error)) *MockTodoRepository_CopyTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountTodosByFilter provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) CountTodosByFilter(ctx context.Context, arg sqlc.CountTodosByFilterParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTodosByFilter")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CountTodosByFilterParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CountTodosByFilterParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CountTodosByFilterParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_CountTodosByFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTodosByFilter'
+type MockTodoRepository_CountTodosByFilter_Call struct {
+	*mock.Call
+}
+
+// CountTodosByFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CountTodosByFilterParams
+func (_e *MockTodoRepository_Expecter) CountTodosByFilter(ctx interface{}, arg interface{}) *MockTodoRepository_CountTodosByFilter_Call {
+	return &MockTodoRepository_CountTodosByFilter_Call{Call: _e.mock.On("CountTodosByFilter", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_CountTodosByFilter_Call) Run(run func(ctx context.Context, arg sqlc.CountTodosByFilterParams)) *MockTodoRepository_CountTodosByFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CountTodosByFilterParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_CountTodosByFilter_Call) Return(_a0 int64, _a1 error) *MockTodoRepository_CountTodosByFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_CountTodosByFilter_Call) RunAndReturn(run func(context.Context, sqlc.CountTodosByFilterParams) (int64, error)) *MockTodoRepository_CountTodosByFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQuickAddTodo provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) CreateQuickAddTodo(ctx context.Context, arg sqlc.CreateQuickAddTodoParams) (sqlc.Todo, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQuickAddTodo")
+	}
+
+	var r0 sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateQuickAddTodoParams) (sqlc.Todo, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateQuickAddTodoParams) sqlc.Todo); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateQuickAddTodoParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_CreateQuickAddTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQuickAddTodo'
+type MockTodoRepository_CreateQuickAddTodo_Call struct {
+	*mock.Call
+}
+
+// CreateQuickAddTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateQuickAddTodoParams
+func (_e *MockTodoRepository_Expecter) CreateQuickAddTodo(ctx interface{}, arg interface{}) *MockTodoRepository_CreateQuickAddTodo_Call {
+	return &MockTodoRepository_CreateQuickAddTodo_Call{Call: _e.mock.On("CreateQuickAddTodo", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_CreateQuickAddTodo_Call) Run(run func(ctx context.Context, arg sqlc.CreateQuickAddTodoParams)) *MockTodoRepository_CreateQuickAddTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateQuickAddTodoParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_CreateQuickAddTodo_Call) Return(_a0 sqlc.Todo, _a1 error) *MockTodoRepository_CreateQuickAddTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_CreateQuickAddTodo_Call) RunAndReturn(run func(context.Context, sqlc.CreateQuickAddTodoParams) (sqlc.Todo, error)) *MockTodoRepository_CreateQuickAddTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTodo provides a mock function with given fields: ctx, arg
 func (_m *MockTodoRepository) CreateTodo(ctx context.Context, arg sqlc.CreateTodoParams) (sqlc.Todo, error) {
 	ret := _m.Called(ctx, arg)
@@ -187,21 +417,31 @@ func (_c *MockTodoRepository_CreateTodo_Call) RunAndReturn(run func(context.Cont
 }
 
 // DeleteTodo provides a mock function with given fields: ctx, arg
-func (_m *MockTodoRepository) DeleteTodo(ctx context.Context, arg sqlc.DeleteTodoParams) error {
+func (_m *MockTodoRepository) DeleteTodo(ctx context.Context, arg sqlc.DeleteTodoParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteTodo")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteTodoParams) error); ok {
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteTodoParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteTodoParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.DeleteTodoParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockTodoRepository_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
@@ -223,36 +463,36 @@ func (_c *MockTodoRepository_DeleteTodo_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockTodoRepository_DeleteTodo_Call) Return(_a0 error) *MockTodoRepository_DeleteTodo_Call {
-	_c.Call.Return(_a0)
+func (_c *MockTodoRepository_DeleteTodo_Call) Return(_a0 int64, _a1 error) *MockTodoRepository_DeleteTodo_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTodoRepository_DeleteTodo_Call) RunAndReturn(run func(context.Context, sqlc.DeleteTodoParams) error) *MockTodoRepository_DeleteTodo_Call {
+func (_c *MockTodoRepository_DeleteTodo_Call) RunAndReturn(run func(context.Context, sqlc.DeleteTodoParams) (int64, error)) *MockTodoRepository_DeleteTodo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTodoByID provides a mock function with given fields: ctx, arg
-func (_m *MockTodoRepository) GetTodoByID(ctx context.Context, arg sqlc.GetTodoByIDParams) (sqlc.Todo, error) {
+// EnsureProject provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) EnsureProject(ctx context.Context, arg sqlc.EnsureProjectParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTodoByID")
+		panic("no return value specified for EnsureProject")
 	}
 
-	var r0 sqlc.Todo
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetTodoByIDParams) (sqlc.Todo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.EnsureProjectParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetTodoByIDParams) sqlc.Todo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.EnsureProjectParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.Todo)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetTodoByIDParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.EnsureProjectParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -261,58 +501,56 @@ func (_m *MockTodoRepository) GetTodoByID(ctx context.Context, arg sqlc.GetTodoB
 	return r0, r1
 }
 
-// MockTodoRepository_GetTodoByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoByID'
-type MockTodoRepository_GetTodoByID_Call struct {
+// MockTodoRepository_EnsureProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureProject'
+type MockTodoRepository_EnsureProject_Call struct {
 	*mock.Call
 }
 
-// GetTodoByID is a helper method to define mock.On call
+// EnsureProject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.GetTodoByIDParams
-func (_e *MockTodoRepository_Expecter) GetTodoByID(ctx interface{}, arg interface{}) *MockTodoRepository_GetTodoByID_Call {
-	return &MockTodoRepository_GetTodoByID_Call{Call: _e.mock.On("GetTodoByID", ctx, arg)}
+//   - arg sqlc.EnsureProjectParams
+func (_e *MockTodoRepository_Expecter) EnsureProject(ctx interface{}, arg interface{}) *MockTodoRepository_EnsureProject_Call {
+	return &MockTodoRepository_EnsureProject_Call{Call: _e.mock.On("EnsureProject", ctx, arg)}
 }
 
-func (_c *MockTodoRepository_GetTodoByID_Call) Run(run func(ctx context.Context, arg sqlc.GetTodoByIDParams)) *MockTodoRepository_GetTodoByID_Call {
+func (_c *MockTodoRepository_EnsureProject_Call) Run(run func(ctx context.Context, arg sqlc.EnsureProjectParams)) *MockTodoRepository_EnsureProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.GetTodoByIDParams))
+		run(args[0].(context.Context), args[1].(sqlc.EnsureProjectParams))
 	})
 	return _c
 }
 
-func (_c *MockTodoRepository_GetTodoByID_Call) Return(_a0 sqlc.Todo, _a1 error) *MockTodoRepository_GetTodoByID_Call {
+func (_c *MockTodoRepository_EnsureProject_Call) Return(_a0 int64, _a1 error) *MockTodoRepository_EnsureProject_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTodoRepository_GetTodoByID_Call) RunAndReturn(run func(context.Context, sqlc.GetTodoByIDParams) (sqlc.Todo, error)) *MockTodoRepository_GetTodoByID_Call {
+func (_c *MockTodoRepository_EnsureProject_Call) RunAndReturn(run func(context.Context, sqlc.EnsureProjectParams) (int64, error)) *MockTodoRepository_EnsureProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTodosByIDs provides a mock function with given fields: ctx, arg
-func (_m *MockTodoRepository) GetTodosByIDs(ctx context.Context, arg sqlc.GetTodosByIDsParams) ([]sqlc.Todo, error) {
-	ret := _m.Called(ctx, arg)
+// GetLastTodoPosition provides a mock function with given fields: ctx, userID
+func (_m *MockTodoRepository) GetLastTodoPosition(ctx context.Context, userID int64) (string, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTodosByIDs")
+		panic("no return value specified for GetLastTodoPosition")
 	}
 
-	var r0 []sqlc.Todo
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetTodosByIDsParams) ([]sqlc.Todo, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (string, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetTodosByIDsParams) []sqlc.Todo); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) string); ok {
+		r0 = rf(ctx, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]sqlc.Todo)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetTodosByIDsParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -320,58 +558,56 @@ func (_m *MockTodoRepository) GetTodosByIDs(ctx context.Context, arg sqlc.GetTod
 	return r0, r1
 }
 
-// MockTodoRepository_GetTodosByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodosByIDs'
-type MockTodoRepository_GetTodosByIDs_Call struct {
+// MockTodoRepository_GetLastTodoPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastTodoPosition'
+type MockTodoRepository_GetLastTodoPosition_Call struct {
 	*mock.Call
 }
 
-// GetTodosByIDs is a helper method to define mock.On call
+// GetLastTodoPosition is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.GetTodosByIDsParams
-func (_e *MockTodoRepository_Expecter) GetTodosByIDs(ctx interface{}, arg interface{}) *MockTodoRepository_GetTodosByIDs_Call {
-	return &MockTodoRepository_GetTodosByIDs_Call{Call: _e.mock.On("GetTodosByIDs", ctx, arg)}
+//   - userID int64
+func (_e *MockTodoRepository_Expecter) GetLastTodoPosition(ctx interface{}, userID interface{}) *MockTodoRepository_GetLastTodoPosition_Call {
+	return &MockTodoRepository_GetLastTodoPosition_Call{Call: _e.mock.On("GetLastTodoPosition", ctx, userID)}
 }
 
-func (_c *MockTodoRepository_GetTodosByIDs_Call) Run(run func(ctx context.Context, arg sqlc.GetTodosByIDsParams)) *MockTodoRepository_GetTodosByIDs_Call {
+func (_c *MockTodoRepository_GetLastTodoPosition_Call) Run(run func(ctx context.Context, userID int64)) *MockTodoRepository_GetLastTodoPosition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.GetTodosByIDsParams))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockTodoRepository_GetTodosByIDs_Call) Return(_a0 []sqlc.Todo, _a1 error) *MockTodoRepository_GetTodosByIDs_Call {
+func (_c *MockTodoRepository_GetLastTodoPosition_Call) Return(_a0 string, _a1 error) *MockTodoRepository_GetLastTodoPosition_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTodoRepository_GetTodosByIDs_Call) RunAndReturn(run func(context.Context, sqlc.GetTodosByIDsParams) ([]sqlc.Todo, error)) *MockTodoRepository_GetTodosByIDs_Call {
+func (_c *MockTodoRepository_GetLastTodoPosition_Call) RunAndReturn(run func(context.Context, int64) (string, error)) *MockTodoRepository_GetLastTodoPosition_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTodosByUser provides a mock function with given fields: ctx, userID
-func (_m *MockTodoRepository) ListTodosByUser(ctx context.Context, userID int64) ([]sqlc.Todo, error) {
-	ret := _m.Called(ctx, userID)
+// GetNextTodoPosition provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) GetNextTodoPosition(ctx context.Context, arg sqlc.GetNextTodoPositionParams) (string, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTodosByUser")
+		panic("no return value specified for GetNextTodoPosition")
 	}
 
-	var r0 []sqlc.Todo
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]sqlc.Todo, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetNextTodoPositionParams) (string, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []sqlc.Todo); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetNextTodoPositionParams) string); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]sqlc.Todo)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetNextTodoPositionParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -379,55 +615,55 @@ func (_m *MockTodoRepository) ListTodosByUser(ctx context.Context, userID int64)
 	return r0, r1
 }
 
-// MockTodoRepository_ListTodosByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByUser'
-type MockTodoRepository_ListTodosByUser_Call struct {
+// MockTodoRepository_GetNextTodoPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextTodoPosition'
+type MockTodoRepository_GetNextTodoPosition_Call struct {
 	*mock.Call
 }
 
-// ListTodosByUser is a helper method to define mock.On call
+// GetNextTodoPosition is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-func (_e *MockTodoRepository_Expecter) ListTodosByUser(ctx interface{}, userID interface{}) *MockTodoRepository_ListTodosByUser_Call {
-	return &MockTodoRepository_ListTodosByUser_Call{Call: _e.mock.On("ListTodosByUser", ctx, userID)}
+//   - arg sqlc.GetNextTodoPositionParams
+func (_e *MockTodoRepository_Expecter) GetNextTodoPosition(ctx interface{}, arg interface{}) *MockTodoRepository_GetNextTodoPosition_Call {
+	return &MockTodoRepository_GetNextTodoPosition_Call{Call: _e.mock.On("GetNextTodoPosition", ctx, arg)}
 }
 
-func (_c *MockTodoRepository_ListTodosByUser_Call) Run(run func(ctx context.Context, userID int64)) *MockTodoRepository_ListTodosByUser_Call {
+func (_c *MockTodoRepository_GetNextTodoPosition_Call) Run(run func(ctx context.Context, arg sqlc.GetNextTodoPositionParams)) *MockTodoRepository_GetNextTodoPosition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(sqlc.GetNextTodoPositionParams))
 	})
 	return _c
 }
 
-func (_c *MockTodoRepository_ListTodosByUser_Call) Return(_a0 []sqlc.Todo, _a1 error) *MockTodoRepository_ListTodosByUser_Call {
+func (_c *MockTodoRepository_GetNextTodoPosition_Call) Return(_a0 string, _a1 error) *MockTodoRepository_GetNextTodoPosition_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTodoRepository_ListTodosByUser_Call) RunAndReturn(run func(context.Context, int64) ([]sqlc.Todo, error)) *MockTodoRepository_ListTodosByUser_Call {
+func (_c *MockTodoRepository_GetNextTodoPosition_Call) RunAndReturn(run func(context.Context, sqlc.GetNextTodoPositionParams) (string, error)) *MockTodoRepository_GetNextTodoPosition_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTodo provides a mock function with given fields: ctx, arg
-func (_m *MockTodoRepository) UpdateTodo(ctx context.Context, arg sqlc.UpdateTodoParams) (sqlc.Todo, error) {
+// GetPrevTodoPosition provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) GetPrevTodoPosition(ctx context.Context, arg sqlc.GetPrevTodoPositionParams) (string, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTodo")
+		panic("no return value specified for GetPrevTodoPosition")
 	}
 
-	var r0 sqlc.Todo
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateTodoParams) (sqlc.Todo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetPrevTodoPositionParams) (string, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateTodoParams) sqlc.Todo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetPrevTodoPositionParams) string); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.Todo)
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateTodoParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetPrevTodoPositionParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -436,31 +672,1124 @@ func (_m *MockTodoRepository) UpdateTodo(ctx context.Context, arg sqlc.UpdateTod
 	return r0, r1
 }
 
-// MockTodoRepository_UpdateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodo'
-type MockTodoRepository_UpdateTodo_Call struct {
+// MockTodoRepository_GetPrevTodoPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrevTodoPosition'
+type MockTodoRepository_GetPrevTodoPosition_Call struct {
 	*mock.Call
 }
 
-// UpdateTodo is a helper method to define mock.On call
+// GetPrevTodoPosition is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.UpdateTodoParams
-func (_e *MockTodoRepository_Expecter) UpdateTodo(ctx interface{}, arg interface{}) *MockTodoRepository_UpdateTodo_Call {
-	return &MockTodoRepository_UpdateTodo_Call{Call: _e.mock.On("UpdateTodo", ctx, arg)}
+//   - arg sqlc.GetPrevTodoPositionParams
+func (_e *MockTodoRepository_Expecter) GetPrevTodoPosition(ctx interface{}, arg interface{}) *MockTodoRepository_GetPrevTodoPosition_Call {
+	return &MockTodoRepository_GetPrevTodoPosition_Call{Call: _e.mock.On("GetPrevTodoPosition", ctx, arg)}
 }
 
-func (_c *MockTodoRepository_UpdateTodo_Call) Run(run func(ctx context.Context, arg sqlc.UpdateTodoParams)) *MockTodoRepository_UpdateTodo_Call {
+func (_c *MockTodoRepository_GetPrevTodoPosition_Call) Run(run func(ctx context.Context, arg sqlc.GetPrevTodoPositionParams)) *MockTodoRepository_GetPrevTodoPosition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.UpdateTodoParams))
+		run(args[0].(context.Context), args[1].(sqlc.GetPrevTodoPositionParams))
 	})
 	return _c
 }
 
-func (_c *MockTodoRepository_UpdateTodo_Call) Return(_a0 sqlc.Todo, _a1 error) *MockTodoRepository_UpdateTodo_Call {
+func (_c *MockTodoRepository_GetPrevTodoPosition_Call) Return(_a0 string, _a1 error) *MockTodoRepository_GetPrevTodoPosition_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTodoRepository_UpdateTodo_Call) RunAndReturn(run func(context.Context, sqlc.UpdateTodoParams) (sqlc.Todo, error)) *MockTodoRepository_UpdateTodo_Call {
+func (_c *MockTodoRepository_GetPrevTodoPosition_Call) RunAndReturn(run func(context.Context, sqlc.GetPrevTodoPositionParams) (string, error)) *MockTodoRepository_GetPrevTodoPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodoByID provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) GetTodoByID(ctx context.Context, arg sqlc.GetTodoByIDParams) (sqlc.Todo, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodoByID")
+	}
+
+	var r0 sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetTodoByIDParams) (sqlc.Todo, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetTodoByIDParams) sqlc.Todo); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetTodoByIDParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_GetTodoByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoByID'
+type MockTodoRepository_GetTodoByID_Call struct {
+	*mock.Call
+}
+
+// GetTodoByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetTodoByIDParams
+func (_e *MockTodoRepository_Expecter) GetTodoByID(ctx interface{}, arg interface{}) *MockTodoRepository_GetTodoByID_Call {
+	return &MockTodoRepository_GetTodoByID_Call{Call: _e.mock.On("GetTodoByID", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_GetTodoByID_Call) Run(run func(ctx context.Context, arg sqlc.GetTodoByIDParams)) *MockTodoRepository_GetTodoByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetTodoByIDParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_GetTodoByID_Call) Return(_a0 sqlc.Todo, _a1 error) *MockTodoRepository_GetTodoByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_GetTodoByID_Call) RunAndReturn(run func(context.Context, sqlc.GetTodoByIDParams) (sqlc.Todo, error)) *MockTodoRepository_GetTodoByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodoChangeSeq provides a mock function with given fields: ctx, id
+func (_m *MockTodoRepository) GetTodoChangeSeq(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodoChangeSeq")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_GetTodoChangeSeq_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoChangeSeq'
+type MockTodoRepository_GetTodoChangeSeq_Call struct {
+	*mock.Call
+}
+
+// GetTodoChangeSeq is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTodoRepository_Expecter) GetTodoChangeSeq(ctx interface{}, id interface{}) *MockTodoRepository_GetTodoChangeSeq_Call {
+	return &MockTodoRepository_GetTodoChangeSeq_Call{Call: _e.mock.On("GetTodoChangeSeq", ctx, id)}
+}
+
+func (_c *MockTodoRepository_GetTodoChangeSeq_Call) Run(run func(ctx context.Context, id int64)) *MockTodoRepository_GetTodoChangeSeq_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_GetTodoChangeSeq_Call) Return(_a0 int64, _a1 error) *MockTodoRepository_GetTodoChangeSeq_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_GetTodoChangeSeq_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockTodoRepository_GetTodoChangeSeq_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodoChangeSeqForUpdate provides a mock function with given fields: ctx, id
+func (_m *MockTodoRepository) GetTodoChangeSeqForUpdate(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodoChangeSeqForUpdate")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_GetTodoChangeSeqForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoChangeSeqForUpdate'
+type MockTodoRepository_GetTodoChangeSeqForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetTodoChangeSeqForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTodoRepository_Expecter) GetTodoChangeSeqForUpdate(ctx interface{}, id interface{}) *MockTodoRepository_GetTodoChangeSeqForUpdate_Call {
+	return &MockTodoRepository_GetTodoChangeSeqForUpdate_Call{Call: _e.mock.On("GetTodoChangeSeqForUpdate", ctx, id)}
+}
+
+func (_c *MockTodoRepository_GetTodoChangeSeqForUpdate_Call) Run(run func(ctx context.Context, id int64)) *MockTodoRepository_GetTodoChangeSeqForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_GetTodoChangeSeqForUpdate_Call) Return(_a0 int64, _a1 error) *MockTodoRepository_GetTodoChangeSeqForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_GetTodoChangeSeqForUpdate_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockTodoRepository_GetTodoChangeSeqForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodosByIDsForUpdate provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) GetTodosByIDsForUpdate(ctx context.Context, arg sqlc.GetTodosByIDsForUpdateParams) ([]sqlc.Todo, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodosByIDsForUpdate")
+	}
+
+	var r0 []sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetTodosByIDsForUpdateParams) ([]sqlc.Todo, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetTodosByIDsForUpdateParams) []sqlc.Todo); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetTodosByIDsForUpdateParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_GetTodosByIDsForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodosByIDsForUpdate'
+type MockTodoRepository_GetTodosByIDsForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetTodosByIDsForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetTodosByIDsForUpdateParams
+func (_e *MockTodoRepository_Expecter) GetTodosByIDsForUpdate(ctx interface{}, arg interface{}) *MockTodoRepository_GetTodosByIDsForUpdate_Call {
+	return &MockTodoRepository_GetTodosByIDsForUpdate_Call{Call: _e.mock.On("GetTodosByIDsForUpdate", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_GetTodosByIDsForUpdate_Call) Run(run func(ctx context.Context, arg sqlc.GetTodosByIDsForUpdateParams)) *MockTodoRepository_GetTodosByIDsForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetTodosByIDsForUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_GetTodosByIDsForUpdate_Call) Return(_a0 []sqlc.Todo, _a1 error) *MockTodoRepository_GetTodosByIDsForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_GetTodosByIDsForUpdate_Call) RunAndReturn(run func(context.Context, sqlc.GetTodosByIDsForUpdateParams) ([]sqlc.Todo, error)) *MockTodoRepository_GetTodosByIDsForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserSettings provides a mock function with given fields: ctx, userID
+func (_m *MockTodoRepository) GetUserSettings(ctx context.Context, userID int64) (sqlc.UserSetting, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSettings")
+	}
+
+	var r0 sqlc.UserSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (sqlc.UserSetting, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) sqlc.UserSetting); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(sqlc.UserSetting)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_GetUserSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSettings'
+type MockTodoRepository_GetUserSettings_Call struct {
+	*mock.Call
+}
+
+// GetUserSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockTodoRepository_Expecter) GetUserSettings(ctx interface{}, userID interface{}) *MockTodoRepository_GetUserSettings_Call {
+	return &MockTodoRepository_GetUserSettings_Call{Call: _e.mock.On("GetUserSettings", ctx, userID)}
+}
+
+func (_c *MockTodoRepository_GetUserSettings_Call) Run(run func(ctx context.Context, userID int64)) *MockTodoRepository_GetUserSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_GetUserSettings_Call) Return(_a0 sqlc.UserSetting, _a1 error) *MockTodoRepository_GetUserSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_GetUserSettings_Call) RunAndReturn(run func(context.Context, int64) (sqlc.UserSetting, error)) *MockTodoRepository_GetUserSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBlockedTodoIDs provides a mock function with given fields: ctx, userID
+func (_m *MockTodoRepository) ListBlockedTodoIDs(ctx context.Context, userID int64) ([]int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlockedTodoIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListBlockedTodoIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlockedTodoIDs'
+type MockTodoRepository_ListBlockedTodoIDs_Call struct {
+	*mock.Call
+}
+
+// ListBlockedTodoIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockTodoRepository_Expecter) ListBlockedTodoIDs(ctx interface{}, userID interface{}) *MockTodoRepository_ListBlockedTodoIDs_Call {
+	return &MockTodoRepository_ListBlockedTodoIDs_Call{Call: _e.mock.On("ListBlockedTodoIDs", ctx, userID)}
+}
+
+func (_c *MockTodoRepository_ListBlockedTodoIDs_Call) Run(run func(ctx context.Context, userID int64)) *MockTodoRepository_ListBlockedTodoIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListBlockedTodoIDs_Call) Return(_a0 []int64, _a1 error) *MockTodoRepository_ListBlockedTodoIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListBlockedTodoIDs_Call) RunAndReturn(run func(context.Context, int64) ([]int64, error)) *MockTodoRepository_ListBlockedTodoIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExistingTodoTitles provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) ListExistingTodoTitles(ctx context.Context, arg sqlc.ListExistingTodoTitlesParams) ([]string, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExistingTodoTitles")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListExistingTodoTitlesParams) ([]string, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListExistingTodoTitlesParams) []string); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListExistingTodoTitlesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListExistingTodoTitles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExistingTodoTitles'
+type MockTodoRepository_ListExistingTodoTitles_Call struct {
+	*mock.Call
+}
+
+// ListExistingTodoTitles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListExistingTodoTitlesParams
+func (_e *MockTodoRepository_Expecter) ListExistingTodoTitles(ctx interface{}, arg interface{}) *MockTodoRepository_ListExistingTodoTitles_Call {
+	return &MockTodoRepository_ListExistingTodoTitles_Call{Call: _e.mock.On("ListExistingTodoTitles", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_ListExistingTodoTitles_Call) Run(run func(ctx context.Context, arg sqlc.ListExistingTodoTitlesParams)) *MockTodoRepository_ListExistingTodoTitles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListExistingTodoTitlesParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListExistingTodoTitles_Call) Return(_a0 []string, _a1 error) *MockTodoRepository_ListExistingTodoTitles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListExistingTodoTitles_Call) RunAndReturn(run func(context.Context, sqlc.ListExistingTodoTitlesParams) ([]string, error)) *MockTodoRepository_ListExistingTodoTitles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOpenBlockedTodoIDs provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) ListOpenBlockedTodoIDs(ctx context.Context, arg sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOpenBlockedTodoIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListOpenBlockedTodoIDsParams) []int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListOpenBlockedTodoIDsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListOpenBlockedTodoIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOpenBlockedTodoIDs'
+type MockTodoRepository_ListOpenBlockedTodoIDs_Call struct {
+	*mock.Call
+}
+
+// ListOpenBlockedTodoIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListOpenBlockedTodoIDsParams
+func (_e *MockTodoRepository_Expecter) ListOpenBlockedTodoIDs(ctx interface{}, arg interface{}) *MockTodoRepository_ListOpenBlockedTodoIDs_Call {
+	return &MockTodoRepository_ListOpenBlockedTodoIDs_Call{Call: _e.mock.On("ListOpenBlockedTodoIDs", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_ListOpenBlockedTodoIDs_Call) Run(run func(ctx context.Context, arg sqlc.ListOpenBlockedTodoIDsParams)) *MockTodoRepository_ListOpenBlockedTodoIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListOpenBlockedTodoIDsParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListOpenBlockedTodoIDs_Call) Return(_a0 []int64, _a1 error) *MockTodoRepository_ListOpenBlockedTodoIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListOpenBlockedTodoIDs_Call) RunAndReturn(run func(context.Context, sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error)) *MockTodoRepository_ListOpenBlockedTodoIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodoChangesPage provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) ListTodoChangesPage(ctx context.Context, arg sqlc.ListTodoChangesPageParams) ([]sqlc.Todo, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodoChangesPage")
+	}
+
+	var r0 []sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListTodoChangesPageParams) ([]sqlc.Todo, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListTodoChangesPageParams) []sqlc.Todo); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListTodoChangesPageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListTodoChangesPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodoChangesPage'
+type MockTodoRepository_ListTodoChangesPage_Call struct {
+	*mock.Call
+}
+
+// ListTodoChangesPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListTodoChangesPageParams
+func (_e *MockTodoRepository_Expecter) ListTodoChangesPage(ctx interface{}, arg interface{}) *MockTodoRepository_ListTodoChangesPage_Call {
+	return &MockTodoRepository_ListTodoChangesPage_Call{Call: _e.mock.On("ListTodoChangesPage", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_ListTodoChangesPage_Call) Run(run func(ctx context.Context, arg sqlc.ListTodoChangesPageParams)) *MockTodoRepository_ListTodoChangesPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListTodoChangesPageParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodoChangesPage_Call) Return(_a0 []sqlc.Todo, _a1 error) *MockTodoRepository_ListTodoChangesPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodoChangesPage_Call) RunAndReturn(run func(context.Context, sqlc.ListTodoChangesPageParams) ([]sqlc.Todo, error)) *MockTodoRepository_ListTodoChangesPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodoIDsByFilter provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) ListTodoIDsByFilter(ctx context.Context, arg sqlc.ListTodoIDsByFilterParams) ([]int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodoIDsByFilter")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListTodoIDsByFilterParams) ([]int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListTodoIDsByFilterParams) []int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListTodoIDsByFilterParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListTodoIDsByFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodoIDsByFilter'
+type MockTodoRepository_ListTodoIDsByFilter_Call struct {
+	*mock.Call
+}
+
+// ListTodoIDsByFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListTodoIDsByFilterParams
+func (_e *MockTodoRepository_Expecter) ListTodoIDsByFilter(ctx interface{}, arg interface{}) *MockTodoRepository_ListTodoIDsByFilter_Call {
+	return &MockTodoRepository_ListTodoIDsByFilter_Call{Call: _e.mock.On("ListTodoIDsByFilter", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_ListTodoIDsByFilter_Call) Run(run func(ctx context.Context, arg sqlc.ListTodoIDsByFilterParams)) *MockTodoRepository_ListTodoIDsByFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListTodoIDsByFilterParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodoIDsByFilter_Call) Return(_a0 []int64, _a1 error) *MockTodoRepository_ListTodoIDsByFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodoIDsByFilter_Call) RunAndReturn(run func(context.Context, sqlc.ListTodoIDsByFilterParams) ([]int64, error)) *MockTodoRepository_ListTodoIDsByFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodoIDsByPosition provides a mock function with given fields: ctx, userID
+func (_m *MockTodoRepository) ListTodoIDsByPosition(ctx context.Context, userID int64) ([]int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodoIDsByPosition")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]int64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListTodoIDsByPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodoIDsByPosition'
+type MockTodoRepository_ListTodoIDsByPosition_Call struct {
+	*mock.Call
+}
+
+// ListTodoIDsByPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockTodoRepository_Expecter) ListTodoIDsByPosition(ctx interface{}, userID interface{}) *MockTodoRepository_ListTodoIDsByPosition_Call {
+	return &MockTodoRepository_ListTodoIDsByPosition_Call{Call: _e.mock.On("ListTodoIDsByPosition", ctx, userID)}
+}
+
+func (_c *MockTodoRepository_ListTodoIDsByPosition_Call) Run(run func(ctx context.Context, userID int64)) *MockTodoRepository_ListTodoIDsByPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodoIDsByPosition_Call) Return(_a0 []int64, _a1 error) *MockTodoRepository_ListTodoIDsByPosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodoIDsByPosition_Call) RunAndReturn(run func(context.Context, int64) ([]int64, error)) *MockTodoRepository_ListTodoIDsByPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodosByUser provides a mock function with given fields: ctx, userID
+func (_m *MockTodoRepository) ListTodosByUser(ctx context.Context, userID int64) ([]sqlc.Todo, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodosByUser")
+	}
+
+	var r0 []sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]sqlc.Todo, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []sqlc.Todo); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListTodosByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByUser'
+type MockTodoRepository_ListTodosByUser_Call struct {
+	*mock.Call
+}
+
+// ListTodosByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockTodoRepository_Expecter) ListTodosByUser(ctx interface{}, userID interface{}) *MockTodoRepository_ListTodosByUser_Call {
+	return &MockTodoRepository_ListTodosByUser_Call{Call: _e.mock.On("ListTodosByUser", ctx, userID)}
+}
+
+func (_c *MockTodoRepository_ListTodosByUser_Call) Run(run func(ctx context.Context, userID int64)) *MockTodoRepository_ListTodosByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodosByUser_Call) Return(_a0 []sqlc.Todo, _a1 error) *MockTodoRepository_ListTodosByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodosByUser_Call) RunAndReturn(run func(context.Context, int64) ([]sqlc.Todo, error)) *MockTodoRepository_ListTodosByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodosByUserManual provides a mock function with given fields: ctx, userID
+func (_m *MockTodoRepository) ListTodosByUserManual(ctx context.Context, userID int64) ([]sqlc.Todo, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodosByUserManual")
+	}
+
+	var r0 []sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]sqlc.Todo, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []sqlc.Todo); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListTodosByUserManual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByUserManual'
+type MockTodoRepository_ListTodosByUserManual_Call struct {
+	*mock.Call
+}
+
+// ListTodosByUserManual is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockTodoRepository_Expecter) ListTodosByUserManual(ctx interface{}, userID interface{}) *MockTodoRepository_ListTodosByUserManual_Call {
+	return &MockTodoRepository_ListTodosByUserManual_Call{Call: _e.mock.On("ListTodosByUserManual", ctx, userID)}
+}
+
+func (_c *MockTodoRepository_ListTodosByUserManual_Call) Run(run func(ctx context.Context, userID int64)) *MockTodoRepository_ListTodosByUserManual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodosByUserManual_Call) Return(_a0 []sqlc.Todo, _a1 error) *MockTodoRepository_ListTodosByUserManual_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListTodosByUserManual_Call) RunAndReturn(run func(context.Context, int64) ([]sqlc.Todo, error)) *MockTodoRepository_ListTodosByUserManual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsersWithLongTodoPositions provides a mock function with given fields: ctx, maxLength
+func (_m *MockTodoRepository) ListUsersWithLongTodoPositions(ctx context.Context, maxLength int32) ([]int64, error) {
+	ret := _m.Called(ctx, maxLength)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersWithLongTodoPositions")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]int64, error)); ok {
+		return rf(ctx, maxLength)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []int64); ok {
+		r0 = rf(ctx, maxLength)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, maxLength)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_ListUsersWithLongTodoPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersWithLongTodoPositions'
+type MockTodoRepository_ListUsersWithLongTodoPositions_Call struct {
+	*mock.Call
+}
+
+// ListUsersWithLongTodoPositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxLength int32
+func (_e *MockTodoRepository_Expecter) ListUsersWithLongTodoPositions(ctx interface{}, maxLength interface{}) *MockTodoRepository_ListUsersWithLongTodoPositions_Call {
+	return &MockTodoRepository_ListUsersWithLongTodoPositions_Call{Call: _e.mock.On("ListUsersWithLongTodoPositions", ctx, maxLength)}
+}
+
+func (_c *MockTodoRepository_ListUsersWithLongTodoPositions_Call) Run(run func(ctx context.Context, maxLength int32)) *MockTodoRepository_ListUsersWithLongTodoPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_ListUsersWithLongTodoPositions_Call) Return(_a0 []int64, _a1 error) *MockTodoRepository_ListUsersWithLongTodoPositions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_ListUsersWithLongTodoPositions_Call) RunAndReturn(run func(context.Context, int32) ([]int64, error)) *MockTodoRepository_ListUsersWithLongTodoPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NextTodoChangeSeq provides a mock function with given fields: ctx, id
+func (_m *MockTodoRepository) NextTodoChangeSeq(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextTodoChangeSeq")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_NextTodoChangeSeq_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NextTodoChangeSeq'
+type MockTodoRepository_NextTodoChangeSeq_Call struct {
+	*mock.Call
+}
+
+// NextTodoChangeSeq is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTodoRepository_Expecter) NextTodoChangeSeq(ctx interface{}, id interface{}) *MockTodoRepository_NextTodoChangeSeq_Call {
+	return &MockTodoRepository_NextTodoChangeSeq_Call{Call: _e.mock.On("NextTodoChangeSeq", ctx, id)}
+}
+
+func (_c *MockTodoRepository_NextTodoChangeSeq_Call) Run(run func(ctx context.Context, id int64)) *MockTodoRepository_NextTodoChangeSeq_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_NextTodoChangeSeq_Call) Return(_a0 int64, _a1 error) *MockTodoRepository_NextTodoChangeSeq_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_NextTodoChangeSeq_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockTodoRepository_NextTodoChangeSeq_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTodoDueAt provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) SetTodoDueAt(ctx context.Context, arg sqlc.SetTodoDueAtParams) (sqlc.Todo, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTodoDueAt")
+	}
+
+	var r0 sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SetTodoDueAtParams) (sqlc.Todo, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SetTodoDueAtParams) sqlc.Todo); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.SetTodoDueAtParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_SetTodoDueAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTodoDueAt'
+type MockTodoRepository_SetTodoDueAt_Call struct {
+	*mock.Call
+}
+
+// SetTodoDueAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.SetTodoDueAtParams
+func (_e *MockTodoRepository_Expecter) SetTodoDueAt(ctx interface{}, arg interface{}) *MockTodoRepository_SetTodoDueAt_Call {
+	return &MockTodoRepository_SetTodoDueAt_Call{Call: _e.mock.On("SetTodoDueAt", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_SetTodoDueAt_Call) Run(run func(ctx context.Context, arg sqlc.SetTodoDueAtParams)) *MockTodoRepository_SetTodoDueAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.SetTodoDueAtParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_SetTodoDueAt_Call) Return(_a0 sqlc.Todo, _a1 error) *MockTodoRepository_SetTodoDueAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_SetTodoDueAt_Call) RunAndReturn(run func(context.Context, sqlc.SetTodoDueAtParams) (sqlc.Todo, error)) *MockTodoRepository_SetTodoDueAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTodoPositions provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) SetTodoPositions(ctx context.Context, arg sqlc.SetTodoPositionsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTodoPositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SetTodoPositionsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTodoRepository_SetTodoPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTodoPositions'
+type MockTodoRepository_SetTodoPositions_Call struct {
+	*mock.Call
+}
+
+// SetTodoPositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.SetTodoPositionsParams
+func (_e *MockTodoRepository_Expecter) SetTodoPositions(ctx interface{}, arg interface{}) *MockTodoRepository_SetTodoPositions_Call {
+	return &MockTodoRepository_SetTodoPositions_Call{Call: _e.mock.On("SetTodoPositions", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_SetTodoPositions_Call) Run(run func(ctx context.Context, arg sqlc.SetTodoPositionsParams)) *MockTodoRepository_SetTodoPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.SetTodoPositionsParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_SetTodoPositions_Call) Return(_a0 error) *MockTodoRepository_SetTodoPositions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTodoRepository_SetTodoPositions_Call) RunAndReturn(run func(context.Context, sqlc.SetTodoPositionsParams) error) *MockTodoRepository_SetTodoPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodo provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) UpdateTodo(ctx context.Context, arg sqlc.UpdateTodoParams) (sqlc.Todo, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodo")
+	}
+
+	var r0 sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateTodoParams) (sqlc.Todo, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateTodoParams) sqlc.Todo); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateTodoParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_UpdateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodo'
+type MockTodoRepository_UpdateTodo_Call struct {
+	*mock.Call
+}
+
+// UpdateTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.UpdateTodoParams
+func (_e *MockTodoRepository_Expecter) UpdateTodo(ctx interface{}, arg interface{}) *MockTodoRepository_UpdateTodo_Call {
+	return &MockTodoRepository_UpdateTodo_Call{Call: _e.mock.On("UpdateTodo", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_UpdateTodo_Call) Run(run func(ctx context.Context, arg sqlc.UpdateTodoParams)) *MockTodoRepository_UpdateTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.UpdateTodoParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_UpdateTodo_Call) Return(_a0 sqlc.Todo, _a1 error) *MockTodoRepository_UpdateTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_UpdateTodo_Call) RunAndReturn(run func(context.Context, sqlc.UpdateTodoParams) (sqlc.Todo, error)) *MockTodoRepository_UpdateTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodoPosition provides a mock function with given fields: ctx, arg
+func (_m *MockTodoRepository) UpdateTodoPosition(ctx context.Context, arg sqlc.UpdateTodoPositionParams) (sqlc.Todo, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodoPosition")
+	}
+
+	var r0 sqlc.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateTodoPositionParams) (sqlc.Todo, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateTodoPositionParams) sqlc.Todo); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateTodoPositionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTodoRepository_UpdateTodoPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodoPosition'
+type MockTodoRepository_UpdateTodoPosition_Call struct {
+	*mock.Call
+}
+
+// UpdateTodoPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.UpdateTodoPositionParams
+func (_e *MockTodoRepository_Expecter) UpdateTodoPosition(ctx interface{}, arg interface{}) *MockTodoRepository_UpdateTodoPosition_Call {
+	return &MockTodoRepository_UpdateTodoPosition_Call{Call: _e.mock.On("UpdateTodoPosition", ctx, arg)}
+}
+
+func (_c *MockTodoRepository_UpdateTodoPosition_Call) Run(run func(ctx context.Context, arg sqlc.UpdateTodoPositionParams)) *MockTodoRepository_UpdateTodoPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.UpdateTodoPositionParams))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_UpdateTodoPosition_Call) Return(_a0 sqlc.Todo, _a1 error) *MockTodoRepository_UpdateTodoPosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTodoRepository_UpdateTodoPosition_Call) RunAndReturn(run func(context.Context, sqlc.UpdateTodoPositionParams) (sqlc.Todo, error)) *MockTodoRepository_UpdateTodoPosition_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, arg sqlc.CreateNotificationParams) (int64, error)
+	ListNotifications(ctx context.Context, arg sqlc.ListNotificationsParams) ([]sqlc.Notification, error)
+	CountUnreadNotifications(ctx context.Context, userID int64) (int64, error)
+	MarkNotificationRead(ctx context.Context, arg sqlc.MarkNotificationReadParams) (sqlc.Notification, error)
+	MarkAllNotificationsRead(ctx context.Context, arg sqlc.MarkAllNotificationsReadParams) (int64, error)
+	ListNotificationPreferences(ctx context.Context, userID int64) ([]sqlc.NotificationPreference, error)
+	UpsertNotificationPreference(ctx context.Context, arg sqlc.UpsertNotificationPreferenceParams) error
+}
+
+// sqlc.Querier が NotificationRepository を満たすことを保証
+var _ NotificationRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+	"go-todo/internal/notify"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotificationType string
+
+const (
+	NotificationTypeReminder   NotificationType = "reminder"
+	NotificationTypeSharedList NotificationType = "shared_list"
+	NotificationTypeAdmin      NotificationType = "admin"
+)
+
+// 設定画面に並べる順の通知の種類
+var notificationTypes = []NotificationType{
+	NotificationTypeReminder,
+	NotificationTypeSharedList,
+	NotificationTypeAdmin,
+}
+
+// ユーザーが無効にできるかどうか（運営からのお知らせは常に届ける）
+func (t NotificationType) optional() bool {
+	return t != NotificationTypeAdmin
+}
+
+func (t NotificationType) valid() bool {
+	for _, known := range notificationTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	DefaultNotificationPageSize = 20
+	MaxNotificationPageSize     = 100
+)
+
+var (
+	ErrNotificationNotFound          = errors.New("notification not found")
+	ErrInvalidNotificationCursor     = errors.New("invalid notification cursor")
+	ErrInvalidNotificationPreference = errors.New("invalid notification preference")
+)
+
+// 発行する通知
+type NewNotification struct {
+	UserID int64
+	Type   NotificationType
+	Title  string
+	Body   string
+	TodoID *int64
+	// リマインダーからの通知は同じリマインダーにつき1件だけ作られる
+	ReminderID *int64
+}
+
+// 通知を発行する
+// 呼び出し元のトランザクション内で保存するため、ロールバックされた場合は通知も作られない
+// ユーザーがその種類の通知を無効にしている場合は何もしない
+type NotificationEmitter interface {
+	Emit(ctx context.Context, tx pgx.Tx, n NewNotification) error
+}
+
+// 新しい順の通知の1ページ分
+type NotificationPage struct {
+	Notifications []sqlc.Notification
+	// 次のページがない場合は空
+	NextCursor string
+}
+
+// 種類ごとの通知の受け取り設定
+type NotificationPreference struct {
+	Type    NotificationType
+	Enabled bool
+}
+
+type NotificationService struct {
+	repo      NotificationRepository
+	txManager database.TxManager
+	withTx    func(pgx.Tx) NotificationRepository
+}
+
+func NewNotificationService(repo NotificationRepository, pool *pgxpool.Pool) *NotificationService {
+	return &NotificationService{
+		repo:      repo,
+		txManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) NotificationRepository {
+			return sqlc.New(tx)
+		},
+	}
+}
+
+var (
+	// NotificationEmitter を満たすことを保証
+	_ NotificationEmitter = (*NotificationService)(nil)
+	// リマインダーの in_app チャネルとして使う
+	_ notify.Sender = (*NotificationService)(nil)
+)
+
+func (s *NotificationService) Emit(ctx context.Context, tx pgx.Tx, n NewNotification) error {
+	return emitNotification(ctx, s.withTx(tx), n)
+}
+
+// リマインダーをアプリ内通知として保存する
+// 同じリマインダーの再送では通知を重複して作らない
+func (s *NotificationService) Send(ctx context.Context, msg notify.Message) error {
+	todoID := msg.TodoID
+	reminderID := msg.ReminderID
+	return emitNotification(ctx, s.repo, NewNotification{
+		UserID:     msg.UserID,
+		Type:       NotificationTypeReminder,
+		Title:      msg.Subject(),
+		Body:       msg.Body(),
+		TodoID:     &todoID,
+		ReminderID: &reminderID,
+	})
+}
+
+func emitNotification(ctx context.Context, repo NotificationRepository, n NewNotification) error {
+	if !n.Type.valid() {
+		return fmt.Errorf("unknown notification type %q", n.Type)
+	}
+	_, err := repo.CreateNotification(ctx, sqlc.CreateNotificationParams{
+		UserID:     n.UserID,
+		Type:       string(n.Type),
+		Title:      n.Title,
+		Body:       n.Body,
+		TodoID:     n.TodoID,
+		ReminderID: n.ReminderID,
+	})
+	return err
+}
+
+// 新しい順に通知を返す。cursor には前のページの NextCursor を渡す
+func (s *NotificationService) ListNotifications(ctx context.Context, userID int64, unreadOnly bool, cursor string, limit int) (*NotificationPage, error) {
+	if limit <= 0 {
+		limit = DefaultNotificationPageSize
+	}
+	limit = min(limit, MaxNotificationPageSize)
+	beforeID, err := decodeNotificationCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// 次のページの有無を判定するために1件多く取得する
+	notifications, err := s.repo.ListNotifications(ctx, sqlc.ListNotificationsParams{
+		UserID:     userID,
+		UnreadOnly: unreadOnly,
+		BeforeID:   beforeID,
+		MaxItems:   int32(limit + 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+	page := &NotificationPage{Notifications: notifications}
+	if len(notifications) > limit {
+		page.Notifications = notifications[:limit]
+		page.NextCursor = encodeNotificationCursor(notifications[limit-1].ID)
+	}
+	return page, nil
+}
+
+func (s *NotificationService) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	return s.repo.CountUnreadNotifications(ctx, userID)
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, id, userID int64) (*sqlc.Notification, error) {
+	notification, err := s.repo.MarkNotificationRead(ctx, sqlc.MarkNotificationReadParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotificationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// 未読の通知をすべて既読にし、既読にした件数を返す
+// upToID を指定した場合はそのID以前の通知だけを対象にする（一覧の取得後に届いた通知を残すため）
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID int64, upToID *int64) (int64, error) {
+	return s.repo.MarkAllNotificationsRead(ctx, sqlc.MarkAllNotificationsReadParams{
+		UserID: userID,
+		UpToID: upToID,
+	})
+}
+
+// すべての種類の受け取り設定を返す（未設定の種類は有効）
+func (s *NotificationService) GetPreferences(ctx context.Context, userID int64) ([]NotificationPreference, error) {
+	return getNotificationPreferences(ctx, s.repo, userID)
+}
+
+// 指定した種類の受け取り設定を更新し、更新後のすべての設定を返す
+func (s *NotificationService) UpdatePreferences(ctx context.Context, userID int64, prefs []NotificationPreference) ([]NotificationPreference, error) {
+	if err := validateNotificationPreferences(prefs); err != nil {
+		return nil, err
+	}
+
+	var result []NotificationPreference
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		if err := upsertNotificationPreferences(ctx, repo, userID, prefs); err != nil {
+			return err
+		}
+		var err error
+		result, err = getNotificationPreferences(ctx, repo, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// 受け取り設定の保存先（ユーザー設定の更新からも使う）
+type notificationPreferenceStore interface {
+	ListNotificationPreferences(ctx context.Context, userID int64) ([]sqlc.NotificationPreference, error)
+	UpsertNotificationPreference(ctx context.Context, arg sqlc.UpsertNotificationPreferenceParams) error
+}
+
+func validateNotificationPreferences(prefs []NotificationPreference) error {
+	for _, p := range prefs {
+		if !p.Type.valid() {
+			return fmt.Errorf("%w: unknown type %q", ErrInvalidNotificationPreference, p.Type)
+		}
+		if !p.Enabled && !p.Type.optional() {
+			return fmt.Errorf("%w: %s notifications cannot be disabled", ErrInvalidNotificationPreference, p.Type)
+		}
+	}
+	return nil
+}
+
+func upsertNotificationPreferences(ctx context.Context, repo notificationPreferenceStore, userID int64, prefs []NotificationPreference) error {
+	for _, p := range prefs {
+		if err := repo.UpsertNotificationPreference(ctx, sqlc.UpsertNotificationPreferenceParams{
+			UserID:  userID,
+			Type:    string(p.Type),
+			Enabled: p.Enabled,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getNotificationPreferences(ctx context.Context, repo notificationPreferenceStore, userID int64) ([]NotificationPreference, error) {
+	stored, err := repo.ListNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	enabled := make(map[NotificationType]bool, len(stored))
+	for _, p := range stored {
+		enabled[NotificationType(p.Type)] = p.Enabled
+	}
+	prefs := make([]NotificationPreference, len(notificationTypes))
+	for i, t := range notificationTypes {
+		e, ok := enabled[t]
+		prefs[i] = NotificationPreference{Type: t, Enabled: !ok || e}
+	}
+	return prefs, nil
+}
+
+const notificationCursorPrefix = "n1:"
+
+// カーソルは前のページの最後の通知IDを不透明な文字列にしたもの
+func encodeNotificationCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(notificationCursorPrefix + strconv.FormatInt(id, 10)))
+}
+
+// 空のカーソルは最初のページを表す
+func decodeNotificationCursor(cursor string) (*int64, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidNotificationCursor
+	}
+	value, ok := strings.CutPrefix(string(raw), notificationCursorPrefix)
+	if !ok {
+		return nil, ErrInvalidNotificationCursor
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, ErrInvalidNotificationCursor
+	}
+	return &id, nil
+}
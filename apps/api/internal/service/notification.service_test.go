@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/notify"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// トランザクション内でも同じモックリポジトリを使うNotificationService
+func newTxTestNotificationService(repo NotificationRepository) *NotificationService {
+	svc := NewNotificationService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) NotificationRepository { return repo }
+	return svc
+}
+
+func TestNotificationService_Emit(t *testing.T) {
+	t.Run("正常系: 呼び出し元のトランザクションで通知を保存する", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		txRepo := mocks.NewMockNotificationRepository(t)
+		svc := NewNotificationService(mockRepo, nil)
+		svc.withTx = func(pgx.Tx) NotificationRepository { return txRepo }
+		ctx := context.Background()
+
+		txRepo.EXPECT().
+			CreateNotification(ctx, sqlc.CreateNotificationParams{
+				UserID: 1,
+				Type:   "shared_list",
+				Title:  "Groceries was shared with you",
+			}).
+			Return(int64(1), nil)
+
+		err := svc.Emit(ctx, nil, NewNotification{
+			UserID: 1,
+			Type:   NotificationTypeSharedList,
+			Title:  "Groceries was shared with you",
+		})
+
+		require.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "CreateNotification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 未知の種類は保存しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := newTxTestNotificationService(mockRepo)
+
+		err := svc.Emit(context.Background(), nil, NewNotification{UserID: 1, Type: "digest", Title: "x"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestNotificationService_Send(t *testing.T) {
+	t.Run("正常系: リマインダーをアプリ内通知として保存する", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := NewNotificationService(mockRepo, nil)
+		ctx := context.Background()
+
+		msg := notify.Message{ReminderID: 5, UserID: 1, TodoID: 10, TodoTitle: "Write report"}
+		todoID := int64(10)
+		reminderID := int64(5)
+		mockRepo.EXPECT().
+			CreateNotification(ctx, sqlc.CreateNotificationParams{
+				UserID:     1,
+				Type:       "reminder",
+				Title:      "Reminder: Write report",
+				Body:       `"Write report" is waiting for you.`,
+				TodoID:     &todoID,
+				ReminderID: &reminderID,
+			}).
+			Return(int64(1), nil)
+
+		err := svc.Send(ctx, msg)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestNotificationService_ListNotifications(t *testing.T) {
+	userID := int64(1)
+	notifications := func(ids ...int64) []sqlc.Notification {
+		result := make([]sqlc.Notification, len(ids))
+		for i, id := range ids {
+			result[i] = sqlc.Notification{ID: id, UserID: userID, CreatedAt: time.Now()}
+		}
+		return result
+	}
+
+	t.Run("正常系: 次のページがある場合はカーソルを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := NewNotificationService(mockRepo, nil)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			ListNotifications(ctx, sqlc.ListNotificationsParams{UserID: userID, UnreadOnly: true, MaxItems: 3}).
+			Return(notifications(9, 7, 4), nil)
+
+		page, err := svc.ListNotifications(ctx, userID, true, "", 2)
+
+		require.NoError(t, err)
+		require.Len(t, page.Notifications, 2)
+		assert.Equal(t, int64(7), page.Notifications[1].ID)
+		require.NotEmpty(t, page.NextCursor)
+
+		// 次のページは最後の通知より前から取得する
+		beforeID := int64(7)
+		mockRepo.EXPECT().
+			ListNotifications(ctx, sqlc.ListNotificationsParams{UserID: userID, UnreadOnly: true, BeforeID: &beforeID, MaxItems: 3}).
+			Return(notifications(4), nil)
+
+		next, err := svc.ListNotifications(ctx, userID, true, page.NextCursor, 2)
+
+		require.NoError(t, err)
+		assert.Len(t, next.Notifications, 1)
+		assert.Empty(t, next.NextCursor)
+	})
+
+	t.Run("正常系: 件数の指定がない場合は既定の件数、上限を超える場合は上限まで取得する", func(t *testing.T) {
+		tests := []struct {
+			limit    int
+			maxItems int32
+		}{
+			{limit: 0, maxItems: DefaultNotificationPageSize + 1},
+			{limit: 1000, maxItems: MaxNotificationPageSize + 1},
+		}
+		for _, tt := range tests {
+			mockRepo := mocks.NewMockNotificationRepository(t)
+			svc := NewNotificationService(mockRepo, nil)
+			ctx := context.Background()
+
+			mockRepo.EXPECT().
+				ListNotifications(ctx, sqlc.ListNotificationsParams{UserID: userID, MaxItems: tt.maxItems}).
+				Return(notifications(), nil)
+
+			page, err := svc.ListNotifications(ctx, userID, false, "", tt.limit)
+
+			require.NoError(t, err)
+			assert.Empty(t, page.Notifications)
+		}
+	})
+
+	t.Run("異常系: 不正なカーソル", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := NewNotificationService(mockRepo, nil)
+
+		for _, cursor := range []string{"not base64!", encodeSyncToken(3), "bjE6MA"} {
+			page, err := svc.ListNotifications(context.Background(), userID, false, cursor, 10)
+
+			assert.Nil(t, page)
+			assert.ErrorIs(t, err, ErrInvalidNotificationCursor, cursor)
+		}
+	})
+}
+
+func TestNotificationService_MarkRead(t *testing.T) {
+	t.Run("異常系: 通知が存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := NewNotificationService(mockRepo, nil)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			MarkNotificationRead(ctx, sqlc.MarkNotificationReadParams{ID: 2, UserID: 1}).
+			Return(sqlc.Notification{}, pgx.ErrNoRows)
+
+		notification, err := svc.MarkRead(ctx, 2, 1)
+
+		assert.Nil(t, notification)
+		assert.ErrorIs(t, err, ErrNotificationNotFound)
+	})
+}
+
+func TestNotificationService_MarkAllRead(t *testing.T) {
+	t.Run("正常系: 指定したID以前の未読を既読にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := NewNotificationService(mockRepo, nil)
+		ctx := context.Background()
+
+		upToID := int64(42)
+		mockRepo.EXPECT().
+			MarkAllNotificationsRead(ctx, sqlc.MarkAllNotificationsReadParams{UserID: 1, UpToID: &upToID}).
+			Return(int64(3), nil)
+
+		marked, err := svc.MarkAllRead(ctx, 1, &upToID)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), marked)
+	})
+}
+
+func TestNotificationService_Preferences(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: 未設定の種類は有効として返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := NewNotificationService(mockRepo, nil)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			ListNotificationPreferences(ctx, userID).
+			Return([]sqlc.NotificationPreference{{UserID: userID, Type: "shared_list", Enabled: false}}, nil)
+
+		prefs, err := svc.GetPreferences(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []NotificationPreference{
+			{Type: NotificationTypeReminder, Enabled: true},
+			{Type: NotificationTypeSharedList, Enabled: false},
+			{Type: NotificationTypeAdmin, Enabled: true},
+		}, prefs)
+	})
+
+	t.Run("正常系: 指定した種類の設定を更新する", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := newTxTestNotificationService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			UpsertNotificationPreference(ctx, sqlc.UpsertNotificationPreferenceParams{UserID: userID, Type: "reminder", Enabled: false}).
+			Return(nil)
+		mockRepo.EXPECT().
+			ListNotificationPreferences(ctx, userID).
+			Return([]sqlc.NotificationPreference{{UserID: userID, Type: "reminder", Enabled: false}}, nil)
+
+		prefs, err := svc.UpdatePreferences(ctx, userID, []NotificationPreference{{Type: NotificationTypeReminder, Enabled: false}})
+
+		require.NoError(t, err)
+		assert.Equal(t, NotificationPreference{Type: NotificationTypeReminder, Enabled: false}, prefs[0])
+	})
+
+	t.Run("異常系: 無効にできない種類と未知の種類", func(t *testing.T) {
+		mockRepo := mocks.NewMockNotificationRepository(t)
+		svc := newTxTestNotificationService(mockRepo)
+
+		for _, p := range []NotificationPreference{
+			{Type: NotificationTypeAdmin, Enabled: false},
+			{Type: "digest", Enabled: true},
+		} {
+			prefs, err := svc.UpdatePreferences(context.Background(), userID, []NotificationPreference{p})
+
+			assert.Nil(t, prefs)
+			assert.ErrorIs(t, err, ErrInvalidNotificationPreference)
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type PersonalAccessTokenRepository interface {
+	CreatePersonalAccessToken(ctx context.Context, arg sqlc.CreatePersonalAccessTokenParams) (sqlc.PersonalAccessToken, error)
+	ListPersonalAccessTokens(ctx context.Context, userID int64) ([]sqlc.PersonalAccessToken, error)
+	DeletePersonalAccessToken(ctx context.Context, arg sqlc.DeletePersonalAccessTokenParams) (int64, error)
+	TouchPersonalAccessToken(ctx context.Context, tokenHash string) (int64, error)
+}
+
+// sqlc.Querier が PersonalAccessTokenRepository を満たすことを保証
+var _ PersonalAccessTokenRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"go-todo/db/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// トークンの先頭に付ける文字列（ログや設定ファイルに紛れ込んだトークンを見つけやすくする）
+	personalAccessTokenPrefix = "pat_"
+	personalAccessTokenBytes  = 32
+	// トークン名の最大文字数
+	MaxPersonalAccessTokenNameLength = 100
+)
+
+var (
+	ErrPersonalAccessTokenNotFound    = errors.New("personal access token not found")
+	ErrInvalidPersonalAccessTokenName = errors.New("invalid personal access token name")
+)
+
+// CalDAVクライアントなど、ブラウザのセッションを使えないクライアント向けのトークン
+type PersonalAccessTokenService struct {
+	repo PersonalAccessTokenRepository
+}
+
+func NewPersonalAccessTokenService(repo PersonalAccessTokenRepository) *PersonalAccessTokenService {
+	return &PersonalAccessTokenService{repo: repo}
+}
+
+// トークンを発行する
+// トークンはハッシュだけを保存するため、平文を返すのはこの時だけ
+func (s *PersonalAccessTokenService) CreateToken(ctx context.Context, userID int64, name string) (string, *sqlc.PersonalAccessToken, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxPersonalAccessTokenNameLength {
+		return "", nil, ErrInvalidPersonalAccessTokenName
+	}
+
+	raw := make([]byte, personalAccessTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, err
+	}
+	token := personalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+	pat, err := s.repo.CreatePersonalAccessToken(ctx, sqlc.CreatePersonalAccessTokenParams{
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hashToken(token),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &pat, nil
+}
+
+func (s *PersonalAccessTokenService) ListTokens(ctx context.Context, userID int64) ([]sqlc.PersonalAccessToken, error) {
+	return s.repo.ListPersonalAccessTokens(ctx, userID)
+}
+
+func (s *PersonalAccessTokenService) DeleteToken(ctx context.Context, id, userID int64) error {
+	affected, err := s.repo.DeletePersonalAccessToken(ctx, sqlc.DeletePersonalAccessTokenParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrPersonalAccessTokenNotFound
+	}
+	return nil
+}
+
+// トークンの持ち主のユーザーIDを返し、最終使用日時を更新する
+// 未知のトークンと退会済みユーザーのトークンは ErrPersonalAccessTokenNotFound を返す
+func (s *PersonalAccessTokenService) Authenticate(ctx context.Context, token string) (int64, error) {
+	if !strings.HasPrefix(token, personalAccessTokenPrefix) {
+		return 0, ErrPersonalAccessTokenNotFound
+	}
+	userID, err := s.repo.TouchPersonalAccessToken(ctx, hashToken(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrPersonalAccessTokenNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
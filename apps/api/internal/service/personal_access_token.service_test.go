@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalAccessTokenService_CreateToken(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: 接頭辞付きのトークンを返し、ハッシュだけを保存する", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+		ctx := context.Background()
+
+		var saved sqlc.CreatePersonalAccessTokenParams
+		mockRepo.EXPECT().
+			CreatePersonalAccessToken(ctx, mock.AnythingOfType("sqlc.CreatePersonalAccessTokenParams")).
+			Run(func(_ context.Context, arg sqlc.CreatePersonalAccessTokenParams) { saved = arg }).
+			Return(sqlc.PersonalAccessToken{ID: 10, UserID: userID, Name: "Phone"}, nil)
+
+		token, pat, err := svc.CreateToken(ctx, userID, "  Phone  ")
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(token, "pat_"))
+		assert.Equal(t, int64(10), pat.ID)
+		assert.Equal(t, userID, saved.UserID)
+		assert.Equal(t, "Phone", saved.Name)
+		assert.Equal(t, hashToken(token), saved.TokenHash)
+	})
+
+	t.Run("異常系: 名前が空の場合はエラー", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+
+		_, _, err := svc.CreateToken(context.Background(), userID, "   ")
+
+		assert.ErrorIs(t, err, ErrInvalidPersonalAccessTokenName)
+	})
+
+	t.Run("異常系: 名前が長すぎる場合はエラー", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+
+		_, _, err := svc.CreateToken(context.Background(), userID, strings.Repeat("あ", MaxPersonalAccessTokenNameLength+1))
+
+		assert.ErrorIs(t, err, ErrInvalidPersonalAccessTokenName)
+	})
+}
+
+func TestPersonalAccessTokenService_DeleteToken(t *testing.T) {
+	t.Run("正常系: トークンを削除できる", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			DeletePersonalAccessToken(ctx, sqlc.DeletePersonalAccessTokenParams{ID: 10, UserID: 1}).
+			Return(int64(1), nil)
+
+		assert.NoError(t, svc.DeleteToken(ctx, 10, 1))
+	})
+
+	t.Run("異常系: 他のユーザーのトークンや存在しないトークンは見つからない", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			DeletePersonalAccessToken(ctx, sqlc.DeletePersonalAccessTokenParams{ID: 10, UserID: 2}).
+			Return(int64(0), nil)
+
+		assert.ErrorIs(t, svc.DeleteToken(ctx, 10, 2), ErrPersonalAccessTokenNotFound)
+	})
+}
+
+func TestPersonalAccessTokenService_Authenticate(t *testing.T) {
+	t.Run("正常系: トークンの持ち主のユーザーIDを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+		ctx := context.Background()
+		token := "pat_secret"
+
+		mockRepo.EXPECT().TouchPersonalAccessToken(ctx, hashToken(token)).Return(int64(1), nil)
+
+		userID, err := svc.Authenticate(ctx, token)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), userID)
+	})
+
+	t.Run("異常系: 接頭辞のないトークンはDBを参照せずに拒否する", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+
+		_, err := svc.Authenticate(context.Background(), "secret")
+
+		assert.ErrorIs(t, err, ErrPersonalAccessTokenNotFound)
+	})
+
+	t.Run("異常系: 未知のトークンは見つからない", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().TouchPersonalAccessToken(ctx, mock.Anything).Return(int64(0), pgx.ErrNoRows)
+
+		_, err := svc.Authenticate(ctx, "pat_unknown")
+
+		assert.ErrorIs(t, err, ErrPersonalAccessTokenNotFound)
+	})
+
+	t.Run("異常系: DBのエラーはそのまま返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+		svc := NewPersonalAccessTokenService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().TouchPersonalAccessToken(ctx, mock.Anything).Return(int64(0), errors.New("db error"))
+
+		_, err := svc.Authenticate(ctx, "pat_secret")
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrPersonalAccessTokenNotFound)
+	})
+}
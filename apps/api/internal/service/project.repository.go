@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type ProjectRepository interface {
+	ListProjectsByUser(ctx context.Context, userID int64) ([]sqlc.Project, error)
+}
+
+// sqlc.Querier が ProjectRepository を満たすことを保証
+var _ ProjectRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go-todo/db/sqlc"
+)
+
+// プロジェクト名の最大文字数
+const MaxProjectNameLength = 100
+
+var ErrProjectNotFound = errors.New("project not found")
+
+// Todoをまとめるプロジェクト
+// プロジェクトは名前で識別し、インポートなどで名前が指定されたときに作成される
+type ProjectService struct {
+	repo ProjectRepository
+}
+
+func NewProjectService(repo ProjectRepository) *ProjectService {
+	return &ProjectService{repo: repo}
+}
+
+// ユーザーのプロジェクトを名前順に返す
+func (s *ProjectService) ListProjects(ctx context.Context, userID int64) ([]sqlc.Project, error) {
+	return s.repo.ListProjectsByUser(ctx, userID)
+}
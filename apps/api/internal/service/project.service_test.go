@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectService_ListProjects(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+
+	t.Run("正常系: ユーザーのプロジェクトを返す", func(t *testing.T) {
+		repo := mocks.NewMockProjectRepository(t)
+		svc := NewProjectService(repo)
+		projects := []sqlc.Project{{ID: 1, UserID: userID, Name: "Home"}, {ID: 2, UserID: userID, Name: "Work"}}
+
+		repo.EXPECT().ListProjectsByUser(mock.Anything, userID).Return(projects, nil)
+
+		got, err := svc.ListProjects(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, projects, got)
+	})
+
+	t.Run("異常系: 取得に失敗した場合はエラーを返す", func(t *testing.T) {
+		repo := mocks.NewMockProjectRepository(t)
+		svc := NewProjectService(repo)
+		dbErr := errors.New("db error")
+
+		repo.EXPECT().ListProjectsByUser(mock.Anything, userID).Return(nil, dbErr)
+
+		_, err := svc.ListProjects(ctx, userID)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+	"go-todo/internal/quickadd"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// クイック追加の解釈の条件
+type QuickAddOptions struct {
+	// IANAのタイムゾーン名（例: Asia/Tokyo）。空ならユーザー設定のタイムゾーン
+	Timezone string
+	// 3/4 のような日付を日/月の順で読む。nil ならユーザー設定のロケールから決める
+	DayFirst *bool
+}
+
+// 自然言語の1行（例: "Pay rent tomorrow 9am #home !high"）を解析してTodoを作成する
+type QuickAddService struct {
+	repo      TodoRepository
+	txManager database.TxManager
+	withTx    func(pgx.Tx) TodoRepository
+	now       func() time.Time
+}
+
+func NewQuickAddService(repo TodoRepository, pool *pgxpool.Pool) *QuickAddService {
+	return &QuickAddService{
+		repo:      repo,
+		txManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) TodoRepository {
+			return sqlc.New(tx)
+		},
+		now: time.Now,
+	}
+}
+
+// 保存せずに解析結果だけを返す
+// 件名が残らない場合は quickadd.ErrEmptyTitle を返す
+func (s *QuickAddService) Preview(ctx context.Context, userID int64, text string, opts QuickAddOptions) (*quickadd.Result, error) {
+	result, err := s.parse(ctx, userID, text, opts)
+	return result, err
+}
+
+// 解析結果からTodoを作成する。プロジェクトは存在しなければ作成する
+// プロジェクトの指定がなければユーザー設定の既定のプロジェクトに入れる
+func (s *QuickAddService) Create(ctx context.Context, userID int64, text string, description *string, opts QuickAddOptions) (*sqlc.Todo, error) {
+	result, err := s.parse(ctx, userID, text, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	params := sqlc.CreateQuickAddTodoParams{
+		UserID:      userID,
+		Title:       result.Title,
+		Description: description,
+		DueAt:       timestamptz(result.DueAt),
+		Tags:        normalizeTags(result.Tags),
+	}
+	if result.Priority != "" {
+		priority := string(result.Priority)
+		params.Priority = &priority
+	}
+	if result.Recurrence != "" {
+		params.Recurrence = &result.Recurrence
+	}
+
+	var todo sqlc.Todo
+	err = s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		positions, err := appendTodoPositions(ctx, repo, userID, 1)
+		if err != nil {
+			return err
+		}
+		params.Position = positions[0]
+		project, err := loadNewTodoProject(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		if name := projectName(result.Project); name != "" {
+			id, err := repo.EnsureProject(ctx, sqlc.EnsureProjectParams{UserID: userID, Name: name})
+			if err != nil {
+				return err
+			}
+			params.ProjectID = &id
+		}
+		params.ProjectID = project.resolve(params.ProjectID)
+		todo, err = repo.CreateQuickAddTodo(ctx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// ユーザー設定のタイムゾーン、週の始まり、ロケールに従って解析する
+func (s *QuickAddService) parse(ctx context.Context, userID int64, text string, opts QuickAddOptions) (*quickadd.Result, error) {
+	settings, err := loadUserSettings(ctx, s.repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	loc := settings.Location
+	if opts.Timezone != "" {
+		if loc, err = loadTimezone(opts.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	dayFirst := settings.DayFirst()
+	if opts.DayFirst != nil {
+		dayFirst = *opts.DayFirst
+	}
+	result, err := quickadd.Parse(text, quickadd.Options{
+		Now:       s.now(),
+		Location:  loc,
+		DayFirst:  dayFirst,
+		WeekStart: settings.WeekStart,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
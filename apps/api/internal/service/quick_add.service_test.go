@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/quickadd"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// 2026-10-21（水）10:30 JST に固定したQuickAddService
+func newTestQuickAddService(repo TodoRepository) *QuickAddService {
+	svc := NewQuickAddService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) TodoRepository { return repo }
+	svc.now = func() time.Time { return time.Date(2026, 10, 21, 1, 30, 0, 0, time.UTC) }
+	return svc
+}
+
+func TestQuickAddService_Preview(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+
+	t.Run("正常系: ユーザー設定のタイムゾーンで日時を解釈する", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{
+			UserID: userID, Timezone: "Asia/Tokyo", Locale: "ja", WeekStart: 1, DefaultSort: "created_at",
+		}, nil).Once()
+
+		got, err := svc.Preview(ctx, userID, "Pay rent tomorrow 9am #home !high", QuickAddOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Pay rent", got.Title)
+		require.NotNil(t, got.DueAt)
+		assert.True(t, got.DueAt.Equal(time.Date(2026, 10, 22, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, []string{"home"}, got.Tags)
+		assert.Equal(t, quickadd.PriorityHigh, got.Priority)
+	})
+
+	t.Run("正常系: 設定がなければUTC", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+
+		got, err := svc.Preview(ctx, userID, "Call mom tomorrow 9am", QuickAddOptions{})
+
+		require.NoError(t, err)
+		require.NotNil(t, got.DueAt)
+		assert.True(t, got.DueAt.Equal(time.Date(2026, 10, 22, 9, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("正常系: 指定したタイムゾーンは設定より優先する", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{
+			UserID: userID, Timezone: "Asia/Tokyo", Locale: "en", WeekStart: 1, DefaultSort: "created_at",
+		}, nil).Once()
+
+		got, err := svc.Preview(ctx, userID, "Call mom tomorrow 9am", QuickAddOptions{Timezone: "UTC"})
+
+		require.NoError(t, err)
+		require.NotNil(t, got.DueAt)
+		assert.True(t, got.DueAt.Equal(time.Date(2026, 10, 22, 9, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("正常系: ロケールの地域から日付の順を決める", func(t *testing.T) {
+		tests := []struct {
+			locale   string
+			dayFirst *bool
+			want     time.Month
+		}{
+			{locale: "en-US", want: time.March},
+			{locale: "en-GB", want: time.April},
+			{locale: "de", want: time.April},
+			{locale: "ja", want: time.March},
+			{locale: "en-US", dayFirst: ptrBool(true), want: time.April},
+		}
+		for _, tt := range tests {
+			repo := mocks.NewMockTodoRepository(t)
+			svc := newTestQuickAddService(repo)
+			repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{
+				UserID: userID, Timezone: "UTC", Locale: tt.locale, WeekStart: 1, DefaultSort: "created_at",
+			}, nil).Once()
+
+			got, err := svc.Preview(ctx, userID, "Dentist 3/4/2027", QuickAddOptions{DayFirst: tt.dayFirst})
+
+			require.NoError(t, err)
+			require.NotNil(t, got.DueAt)
+			assert.Equal(t, tt.want, got.DueAt.Month(), tt.locale)
+		}
+	})
+
+	t.Run("正常系: 週の始まりの設定でnext weekを解釈する", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{
+			UserID: userID, Timezone: "Asia/Tokyo", Locale: "en", WeekStart: 0, DefaultSort: "created_at",
+		}, nil).Once()
+
+		got, err := svc.Preview(ctx, userID, "Plan next week", QuickAddOptions{})
+
+		require.NoError(t, err)
+		require.NotNil(t, got.DueAt)
+		assert.Equal(t, time.Sunday, got.DueAt.In(mustLoadLocation(t, "Asia/Tokyo")).Weekday())
+	})
+
+	t.Run("異常系: 不正なタイムゾーンはErrInvalidTimezone", func(t *testing.T) {
+		for _, tz := range []string{"Mars/Olympus", "Local"} {
+			repo := mocks.NewMockTodoRepository(t)
+			svc := newTestQuickAddService(repo)
+			repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+
+			_, err := svc.Preview(ctx, userID, "Call mom", QuickAddOptions{Timezone: tz})
+
+			assert.ErrorIs(t, err, ErrInvalidTimezone, tz)
+		}
+	})
+
+	t.Run("異常系: 件名が残らない場合はErrEmptyTitle", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+
+		_, err := svc.Preview(ctx, userID, "tomorrow #home", QuickAddOptions{})
+
+		assert.ErrorIs(t, err, quickadd.ErrEmptyTitle)
+	})
+}
+
+func TestQuickAddService_Create(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+
+	t.Run("正常系: 解析結果からTodoを作成する", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		description := ptrString("Transfer to landlord")
+		priority := "high"
+		recurrence := "FREQ=MONTHLY"
+		projectID := int64(7)
+
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Twice()
+		repo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(3), nil).Once()
+		repo.EXPECT().GetLastTodoPosition(ctx, userID).Return("V", nil).Once()
+		repo.EXPECT().EnsureProject(ctx, sqlc.EnsureProjectParams{UserID: userID, Name: "Household"}).Return(projectID, nil).Once()
+		repo.EXPECT().CreateQuickAddTodo(ctx, sqlc.CreateQuickAddTodoParams{
+			UserID:      userID,
+			Title:       "Pay rent",
+			Description: description,
+			Position:    "W",
+			DueAt:       pgtype.Timestamptz{Time: time.Date(2026, 10, 22, 9, 0, 0, 0, time.UTC), Valid: true},
+			ProjectID:   &projectID,
+			Tags:        []string{"home"},
+			Priority:    &priority,
+			Recurrence:  &recurrence,
+		}).Return(sqlc.Todo{ID: 10, Title: "Pay rent"}, nil).Once()
+
+		got, err := svc.Create(ctx, userID, "Pay rent tomorrow 9am monthly #home !high +Household", description, QuickAddOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), got.ID)
+	})
+
+	t.Run("正常系: プロジェクトの指定がなければ既定のプロジェクトに入れる", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		defaultProjectID := int64(3)
+
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{
+			UserID: userID, Timezone: "UTC", Locale: "en", WeekStart: 1, DefaultSort: "created_at", DefaultProjectID: &defaultProjectID,
+		}, nil).Twice()
+		repo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(3), nil).Once()
+		repo.EXPECT().GetLastTodoPosition(ctx, userID).Return("V", nil).Once()
+		repo.EXPECT().CreateQuickAddTodo(ctx, sqlc.CreateQuickAddTodoParams{
+			UserID:    userID,
+			Title:     "Buy milk",
+			Position:  "W",
+			ProjectID: &defaultProjectID,
+			Tags:      []string{},
+		}).Return(sqlc.Todo{ID: 11, Title: "Buy milk"}, nil).Once()
+
+		got, err := svc.Create(ctx, userID, "Buy milk", nil, QuickAddOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Buy milk", got.Title)
+	})
+
+	t.Run("異常系: 解析に失敗した場合は保存しない", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+
+		_, err := svc.Create(ctx, userID, "Buy milk", nil, QuickAddOptions{Timezone: "Nowhere/City"})
+
+		assert.ErrorIs(t, err, ErrInvalidTimezone)
+	})
+
+	t.Run("異常系: 作成に失敗した場合はエラーを返す", func(t *testing.T) {
+		repo := mocks.NewMockTodoRepository(t)
+		svc := newTestQuickAddService(repo)
+		dbErr := errors.New("db error")
+
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Twice()
+		repo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(3), nil).Once()
+		repo.EXPECT().GetLastTodoPosition(ctx, userID).Return("V", nil).Once()
+		repo.EXPECT().CreateQuickAddTodo(ctx, mock.Anything).Return(sqlc.Todo{}, dbErr).Once()
+
+		_, err := svc.Create(ctx, userID, "Buy milk", nil, QuickAddOptions{})
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ReminderRepository interface {
+	GetTodoByID(ctx context.Context, arg sqlc.GetTodoByIDParams) (sqlc.Todo, error)
+	ListRemindersByTodo(ctx context.Context, arg sqlc.ListRemindersByTodoParams) ([]sqlc.Reminder, error)
+	CreateReminder(ctx context.Context, arg sqlc.CreateReminderParams) (sqlc.Reminder, error)
+	DeleteReminder(ctx context.Context, arg sqlc.DeleteReminderParams) (int64, error)
+	ClaimDueReminder(ctx context.Context, lockedUntil pgtype.Timestamptz) (sqlc.Reminder, error)
+	GetReminderTarget(ctx context.Context, id int64) (sqlc.GetReminderTargetRow, error)
+	FinishReminder(ctx context.Context, arg sqlc.FinishReminderParams) (int64, error)
+	RetryReminder(ctx context.Context, arg sqlc.RetryReminderParams) (int64, error)
+}
+
+// sqlc.Querier が ReminderRepository を満たすことを保証
+var _ ReminderRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/notify"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ReminderChannel string
+
+const (
+	ReminderChannelEmail   ReminderChannel = "email"
+	ReminderChannelInApp   ReminderChannel = "in_app"
+	ReminderChannelWebhook ReminderChannel = "webhook"
+)
+
+type ReminderStatus string
+
+const (
+	ReminderStatusPending  ReminderStatus = "pending"
+	ReminderStatusSent     ReminderStatus = "sent"
+	ReminderStatusFailed   ReminderStatus = "failed"
+	ReminderStatusCanceled ReminderStatus = "canceled"
+)
+
+// 期限からの相対時刻として指定できる範囲（分）
+const MaxReminderOffsetMinutes = 30 * 24 * 60
+
+var (
+	ErrReminderNotFound   = errors.New("reminder not found")
+	ErrInvalidReminder    = errors.New("invalid reminder")
+	ErrChannelUnavailable = errors.New("reminder channel unavailable")
+)
+
+// リマインダーの作成内容
+// RemindAt（絶対時刻）と OffsetMinutes（期限からの相対時刻。負の値は期限より前）のどちらか一方を指定する
+type ReminderInput struct {
+	Channel       ReminderChannel
+	RemindAt      *time.Time
+	OffsetMinutes *int32
+}
+
+// 配信予定時刻を付けたリマインダー
+// 期限からの相対時刻で指定し、Todoに期限がない場合は ScheduledAt が nil になる
+type ScheduledReminder struct {
+	sqlc.Reminder
+	ScheduledAt *time.Time
+}
+
+type ReminderService struct {
+	repo        ReminderRepository
+	lease       time.Duration
+	maxAttempts int32
+	retryDelay  time.Duration
+	senders     map[ReminderChannel]notify.Sender
+	now         func() time.Time
+}
+
+func NewReminderService(repo ReminderRepository, lease time.Duration, maxAttempts int32, retryDelay time.Duration) *ReminderService {
+	return &ReminderService{
+		repo:        repo,
+		lease:       lease,
+		maxAttempts: maxAttempts,
+		retryDelay:  retryDelay,
+		senders:     map[ReminderChannel]notify.Sender{},
+		now:         time.Now,
+	}
+}
+
+// 配信チャネルを登録する（スケジューラー起動前に呼び出す）
+// 登録されていないチャネルのリマインダーは作成できない
+func (s *ReminderService) RegisterChannel(channel ReminderChannel, sender notify.Sender) {
+	s.senders[channel] = sender
+}
+
+func (s *ReminderService) ListReminders(ctx context.Context, todoID, userID int64) ([]ScheduledReminder, error) {
+	todo, err := s.getTodo(ctx, todoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	reminders, err := s.repo.ListRemindersByTodo(ctx, sqlc.ListRemindersByTodoParams{
+		TodoID: todoID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	scheduled := make([]ScheduledReminder, len(reminders))
+	for i, r := range reminders {
+		scheduled[i] = ScheduledReminder{Reminder: r, ScheduledAt: scheduledAt(r, todo.DueAt)}
+	}
+	return scheduled, nil
+}
+
+func (s *ReminderService) CreateReminder(ctx context.Context, todoID, userID int64, input ReminderInput) (*ScheduledReminder, error) {
+	if err := s.validateInput(input); err != nil {
+		return nil, err
+	}
+	todo, err := s.getTodo(ctx, todoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	reminder, err := s.repo.CreateReminder(ctx, sqlc.CreateReminderParams{
+		UserID:        userID,
+		TodoID:        todoID,
+		Channel:       string(input.Channel),
+		RemindAt:      timestamptz(input.RemindAt),
+		OffsetMinutes: input.OffsetMinutes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ScheduledReminder{Reminder: reminder, ScheduledAt: scheduledAt(reminder, todo.DueAt)}, nil
+}
+
+func (s *ReminderService) DeleteReminder(ctx context.Context, id, userID int64) error {
+	affected, err := s.repo.DeleteReminder(ctx, sqlc.DeleteReminderParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrReminderNotFound
+	}
+	return nil
+}
+
+func (s *ReminderService) validateInput(input ReminderInput) error {
+	switch input.Channel {
+	case ReminderChannelEmail, ReminderChannelInApp, ReminderChannelWebhook:
+	default:
+		return fmt.Errorf("%w: unknown channel %q", ErrInvalidReminder, input.Channel)
+	}
+	if (input.RemindAt == nil) == (input.OffsetMinutes == nil) {
+		return fmt.Errorf("%w: exactly one of remind_at and offset_minutes is required", ErrInvalidReminder)
+	}
+	if input.OffsetMinutes != nil && (*input.OffsetMinutes < -MaxReminderOffsetMinutes || *input.OffsetMinutes > MaxReminderOffsetMinutes) {
+		return fmt.Errorf("%w: offset_minutes must be within ±%d", ErrInvalidReminder, MaxReminderOffsetMinutes)
+	}
+	if _, ok := s.senders[input.Channel]; !ok {
+		return ErrChannelUnavailable
+	}
+	return nil
+}
+
+func (s *ReminderService) getTodo(ctx context.Context, todoID, userID int64) (*sqlc.Todo, error) {
+	todo, err := s.repo.GetTodoByID(ctx, sqlc.GetTodoByIDParams{
+		ID:     todoID,
+		UserID: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTodoNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// 配信時刻を過ぎたリマインダーを配信する（ctxがキャンセルされるまでブロックする）
+// 停止中に配信時刻を過ぎたリマインダーも起動後に配信される
+func (s *ReminderService) RunScheduler(ctx context.Context, pollInterval time.Duration) {
+	for {
+		processed, err := s.processNext(ctx)
+		if err != nil {
+			log.Printf("Failed to process reminder: %v", err)
+		}
+		if processed {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// 配信時刻を過ぎたリマインダーを1件取得して配信する。対象がなければ false を返す
+func (s *ReminderService) processNext(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+	reminder, err := s.repo.ClaimDueReminder(ctx, s.leaseUntil())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("claim reminder: %w", err)
+	}
+	return true, s.deliver(ctx, reminder)
+}
+
+func (s *ReminderService) deliver(ctx context.Context, reminder sqlc.Reminder) error {
+	target, err := s.repo.GetReminderTarget(ctx, reminder.TodoID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return s.finish(ctx, reminder, ReminderStatusCanceled, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get reminder target: %w", err)
+	}
+	// 完了済み・削除済みのTodoには通知しない
+	if target.Completed || target.DeletedAt.Valid {
+		return s.finish(ctx, reminder, ReminderStatusCanceled, nil)
+	}
+	sender, ok := s.senders[ReminderChannel(reminder.Channel)]
+	if !ok {
+		return s.finish(ctx, reminder, ReminderStatusFailed, ErrChannelUnavailable)
+	}
+
+	msg := notify.Message{
+		ReminderID: reminder.ID,
+		UserID:     reminder.UserID,
+		Email:      target.Email,
+		Name:       target.Name,
+		TodoID:     reminder.TodoID,
+		TodoTitle:  target.Title,
+	}
+	if target.DueAt.Valid {
+		dueAt := target.DueAt.Time
+		msg.DueAt = &dueAt
+	}
+	if at := scheduledAt(reminder, target.DueAt); at != nil {
+		msg.ScheduledAt = *at
+	}
+
+	sendErr := sender.Send(ctx, msg)
+	switch {
+	case ctx.Err() != nil:
+		// シャットダウン時はリースの期限切れ後に再配信される
+		return nil
+	case sendErr == nil:
+		return s.finish(ctx, reminder, ReminderStatusSent, nil)
+	case reminder.Attempts >= s.maxAttempts:
+		return s.finish(ctx, reminder, ReminderStatusFailed, sendErr)
+	default:
+		return s.retry(ctx, reminder, sendErr)
+	}
+}
+
+func (s *ReminderService) finish(ctx context.Context, reminder sqlc.Reminder, status ReminderStatus, deliverErr error) error {
+	params := sqlc.FinishReminderParams{
+		Status:       string(status),
+		ID:           reminder.ID,
+		ClaimedUntil: reminder.LockedUntil,
+	}
+	if status == ReminderStatusSent {
+		params.FiredAt = pgtype.Timestamptz{Time: s.now(), Valid: true}
+	}
+	if deliverErr != nil {
+		message := deliverErr.Error()
+		params.LastError = &message
+	}
+	// リースを失っていた場合は他のスケジューラーの結果を優先する
+	if _, err := s.repo.FinishReminder(ctx, params); err != nil {
+		return fmt.Errorf("finish reminder: %w", err)
+	}
+	return nil
+}
+
+// 試行回数に比例した待ち時間の後に再配信する
+func (s *ReminderService) retry(ctx context.Context, reminder sqlc.Reminder, deliverErr error) error {
+	message := deliverErr.Error()
+	retryAt := s.now().Add(s.retryDelay * time.Duration(reminder.Attempts))
+	if _, err := s.repo.RetryReminder(ctx, sqlc.RetryReminderParams{
+		LastError:    &message,
+		RetryAt:      pgtype.Timestamptz{Time: retryAt, Valid: true},
+		ID:           reminder.ID,
+		ClaimedUntil: reminder.LockedUntil,
+	}); err != nil {
+		return fmt.Errorf("retry reminder: %w", err)
+	}
+	return nil
+}
+
+func (s *ReminderService) leaseUntil() pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: s.now().Add(s.lease), Valid: true}
+}
+
+// リマインダーの配信予定時刻
+func scheduledAt(reminder sqlc.Reminder, dueAt pgtype.Timestamptz) *time.Time {
+	if reminder.RemindAt.Valid {
+		at := reminder.RemindAt.Time
+		return &at
+	}
+	if reminder.OffsetMinutes == nil || !dueAt.Valid {
+		return nil
+	}
+	at := dueAt.Time.Add(time.Duration(*reminder.OffsetMinutes) * time.Minute)
+	return &at
+}
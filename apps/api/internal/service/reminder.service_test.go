@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/notify"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// 送信したメッセージを記録する notify.Sender
+type recordingSender struct {
+	sent []notify.Message
+	err  error
+}
+
+func (s *recordingSender) Send(_ context.Context, msg notify.Message) error {
+	s.sent = append(s.sent, msg)
+	return s.err
+}
+
+func newTestReminderService(repo ReminderRepository, now time.Time) *ReminderService {
+	svc := NewReminderService(repo, 2*time.Minute, 3, time.Minute)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestReminderService_CreateReminder(t *testing.T) {
+	userID := int64(1)
+	todoID := int64(10)
+	dueAt := time.Date(2026, 10, 30, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 期限からの相対時刻でリマインダーを作成し配信予定時刻を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, time.Now())
+		svc.RegisterChannel(ReminderChannelInApp, &recordingSender{})
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, DueAt: pgtype.Timestamptz{Time: dueAt, Valid: true}}, nil)
+		mockRepo.EXPECT().
+			CreateReminder(ctx, sqlc.CreateReminderParams{
+				UserID:        userID,
+				TodoID:        todoID,
+				Channel:       "in_app",
+				OffsetMinutes: ptrInt32(-30),
+			}).
+			Return(sqlc.Reminder{ID: 5, UserID: userID, TodoID: todoID, Channel: "in_app", OffsetMinutes: ptrInt32(-30), Status: "pending"}, nil)
+
+		reminder, err := svc.CreateReminder(ctx, todoID, userID, ReminderInput{
+			Channel:       ReminderChannelInApp,
+			OffsetMinutes: ptrInt32(-30),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), reminder.ID)
+		require.NotNil(t, reminder.ScheduledAt)
+		assert.Equal(t, dueAt.Add(-30*time.Minute), *reminder.ScheduledAt)
+	})
+
+	t.Run("異常系: 登録されていないチャネルはErrChannelUnavailableを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, time.Now())
+
+		reminder, err := svc.CreateReminder(context.Background(), todoID, userID, ReminderInput{
+			Channel:  ReminderChannelEmail,
+			RemindAt: &dueAt,
+		})
+
+		assert.Nil(t, reminder)
+		assert.ErrorIs(t, err, ErrChannelUnavailable)
+	})
+
+	t.Run("異常系: 入力が不正な場合はErrInvalidReminderを返す", func(t *testing.T) {
+		tooFar := int32(MaxReminderOffsetMinutes + 1)
+		tests := []struct {
+			name  string
+			input ReminderInput
+		}{
+			{name: "未知のチャネル", input: ReminderInput{Channel: "sms", RemindAt: &dueAt}},
+			{name: "時刻の指定なし", input: ReminderInput{Channel: ReminderChannelInApp}},
+			{name: "時刻の指定が両方", input: ReminderInput{Channel: ReminderChannelInApp, RemindAt: &dueAt, OffsetMinutes: ptrInt32(0)}},
+			{name: "相対時刻が範囲外", input: ReminderInput{Channel: ReminderChannelInApp, OffsetMinutes: &tooFar}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo := mocks.NewMockReminderRepository(t)
+				svc := newTestReminderService(mockRepo, time.Now())
+				svc.RegisterChannel(ReminderChannelInApp, &recordingSender{})
+
+				reminder, err := svc.CreateReminder(context.Background(), todoID, userID, tt.input)
+
+				assert.Nil(t, reminder)
+				assert.ErrorIs(t, err, ErrInvalidReminder)
+			})
+		}
+	})
+
+	t.Run("異常系: Todoが存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, time.Now())
+		svc.RegisterChannel(ReminderChannelInApp, &recordingSender{})
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, mock.Anything).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		reminder, err := svc.CreateReminder(ctx, todoID, userID, ReminderInput{
+			Channel:  ReminderChannelInApp,
+			RemindAt: &dueAt,
+		})
+
+		assert.Nil(t, reminder)
+		assert.ErrorIs(t, err, ErrTodoNotFound)
+	})
+}
+
+func TestReminderService_DeleteReminder(t *testing.T) {
+	t.Run("異常系: リマインダーが存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, time.Now())
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			DeleteReminder(ctx, sqlc.DeleteReminderParams{ID: 5, UserID: 1}).
+			Return(int64(0), nil)
+
+		err := svc.DeleteReminder(ctx, 5, 1)
+
+		assert.ErrorIs(t, err, ErrReminderNotFound)
+	})
+}
+
+func TestReminderService_processNext(t *testing.T) {
+	now := time.Date(2026, 10, 22, 12, 0, 0, 0, time.UTC)
+	claimedUntil := pgtype.Timestamptz{Time: now.Add(2 * time.Minute), Valid: true}
+	dueAt := pgtype.Timestamptz{Time: now.Add(10 * time.Minute), Valid: true}
+	claimed := sqlc.Reminder{
+		ID:            5,
+		UserID:        1,
+		TodoID:        10,
+		Channel:       "in_app",
+		OffsetMinutes: ptrInt32(-15),
+		Status:        "pending",
+		Attempts:      1,
+		LockedUntil:   claimedUntil,
+	}
+	target := sqlc.GetReminderTargetRow{Title: "Write report", DueAt: dueAt, Email: "user@example.com", Name: "User"}
+
+	t.Run("正常系: 配信して送信済みにする", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, now)
+		sender := &recordingSender{}
+		svc.RegisterChannel(ReminderChannelInApp, sender)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().ClaimDueReminder(ctx, claimedUntil).Return(claimed, nil)
+		mockRepo.EXPECT().GetReminderTarget(ctx, int64(10)).Return(target, nil)
+		mockRepo.EXPECT().
+			FinishReminder(ctx, sqlc.FinishReminderParams{
+				Status:       "sent",
+				FiredAt:      pgtype.Timestamptz{Time: now, Valid: true},
+				ID:           5,
+				ClaimedUntil: claimedUntil,
+			}).
+			Return(int64(1), nil)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, processed)
+		require.Len(t, sender.sent, 1)
+		msg := sender.sent[0]
+		assert.Equal(t, int64(5), msg.ReminderID)
+		assert.Equal(t, "Write report", msg.TodoTitle)
+		assert.Equal(t, "user@example.com", msg.Email)
+		assert.Equal(t, dueAt.Time.Add(-15*time.Minute), msg.ScheduledAt)
+		require.NotNil(t, msg.DueAt)
+		assert.Equal(t, dueAt.Time, *msg.DueAt)
+	})
+
+	t.Run("正常系: 完了済みのTodoのリマインダーはキャンセルする", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, now)
+		sender := &recordingSender{}
+		svc.RegisterChannel(ReminderChannelInApp, sender)
+		ctx := context.Background()
+
+		completed := target
+		completed.Completed = true
+		mockRepo.EXPECT().ClaimDueReminder(ctx, claimedUntil).Return(claimed, nil)
+		mockRepo.EXPECT().GetReminderTarget(ctx, int64(10)).Return(completed, nil)
+		mockRepo.EXPECT().
+			FinishReminder(ctx, sqlc.FinishReminderParams{
+				Status:       "canceled",
+				ID:           5,
+				ClaimedUntil: claimedUntil,
+			}).
+			Return(int64(1), nil)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, processed)
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("正常系: 配信に失敗した場合は試行回数に応じて待ってから再試行する", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, now)
+		svc.RegisterChannel(ReminderChannelInApp, &recordingSender{err: errors.New("connection refused")})
+		ctx := context.Background()
+
+		second := claimed
+		second.Attempts = 2
+		message := "connection refused"
+		mockRepo.EXPECT().ClaimDueReminder(ctx, claimedUntil).Return(second, nil)
+		mockRepo.EXPECT().GetReminderTarget(ctx, int64(10)).Return(target, nil)
+		mockRepo.EXPECT().
+			RetryReminder(ctx, sqlc.RetryReminderParams{
+				LastError:    &message,
+				RetryAt:      pgtype.Timestamptz{Time: now.Add(2 * time.Minute), Valid: true},
+				ID:           5,
+				ClaimedUntil: claimedUntil,
+			}).
+			Return(int64(1), nil)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, processed)
+	})
+
+	t.Run("異常系: 試行回数の上限に達したら失敗にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, now)
+		svc.RegisterChannel(ReminderChannelInApp, &recordingSender{err: errors.New("connection refused")})
+		ctx := context.Background()
+
+		last := claimed
+		last.Attempts = 3
+		message := "connection refused"
+		mockRepo.EXPECT().ClaimDueReminder(ctx, claimedUntil).Return(last, nil)
+		mockRepo.EXPECT().GetReminderTarget(ctx, int64(10)).Return(target, nil)
+		mockRepo.EXPECT().
+			FinishReminder(ctx, sqlc.FinishReminderParams{
+				Status:       "failed",
+				LastError:    &message,
+				ID:           5,
+				ClaimedUntil: claimedUntil,
+			}).
+			Return(int64(1), nil)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, processed)
+	})
+
+	t.Run("異常系: チャネルが登録されていない場合は失敗にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, now)
+		ctx := context.Background()
+
+		message := ErrChannelUnavailable.Error()
+		mockRepo.EXPECT().ClaimDueReminder(ctx, claimedUntil).Return(claimed, nil)
+		mockRepo.EXPECT().GetReminderTarget(ctx, int64(10)).Return(target, nil)
+		mockRepo.EXPECT().
+			FinishReminder(ctx, sqlc.FinishReminderParams{
+				Status:       "failed",
+				LastError:    &message,
+				ID:           5,
+				ClaimedUntil: claimedUntil,
+			}).
+			Return(int64(1), nil)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, processed)
+	})
+
+	t.Run("正常系: 配信対象がなければfalseを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockReminderRepository(t)
+		svc := newTestReminderService(mockRepo, now)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().ClaimDueReminder(ctx, claimedUntil).Return(sqlc.Reminder{}, pgx.ErrNoRows)
+
+		processed, err := svc.processNext(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+}
+
+func TestScheduledAt(t *testing.T) {
+	remindAt := time.Date(2026, 10, 22, 8, 0, 0, 0, time.UTC)
+	dueAt := time.Date(2026, 10, 23, 9, 0, 0, 0, time.UTC)
+	validDue := pgtype.Timestamptz{Time: dueAt, Valid: true}
+
+	tests := []struct {
+		name     string
+		reminder sqlc.Reminder
+		dueAt    pgtype.Timestamptz
+		want     *time.Time
+	}{
+		{name: "正常系: 絶対時刻", reminder: sqlc.Reminder{RemindAt: pgtype.Timestamptz{Time: remindAt, Valid: true}}, dueAt: validDue, want: &remindAt},
+		{name: "正常系: 期限より前", reminder: sqlc.Reminder{OffsetMinutes: ptrInt32(-60)}, dueAt: validDue, want: ptrTime(dueAt.Add(-time.Hour))},
+		{name: "正常系: 期限より後", reminder: sqlc.Reminder{OffsetMinutes: ptrInt32(30)}, dueAt: validDue, want: ptrTime(dueAt.Add(30 * time.Minute))},
+		{name: "正常系: 期限がない", reminder: sqlc.Reminder{OffsetMinutes: ptrInt32(-60)}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, scheduledAt(tt.reminder, tt.dueAt))
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
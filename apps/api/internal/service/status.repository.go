@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type StatusRepository interface {
+	GetTodoChangeSeqForUpdate(ctx context.Context, id int64) (int64, error)
+	ListOpenBlockedTodoIDs(ctx context.Context, arg sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error)
+	ListStatusesByUser(ctx context.Context, userID int64) ([]sqlc.Status, error)
+	CreateStatus(ctx context.Context, arg sqlc.CreateStatusParams) (sqlc.Status, error)
+	UpdateStatus(ctx context.Context, arg sqlc.UpdateStatusParams) (sqlc.Status, error)
+	ClearDoneStatus(ctx context.Context, userID int64) error
+	DeleteStatus(ctx context.Context, arg sqlc.DeleteStatusParams) error
+	CountTodosInStatus(ctx context.Context, arg sqlc.CountTodosInStatusParams) (int64, error)
+	GetTodoByID(ctx context.Context, arg sqlc.GetTodoByIDParams) (sqlc.Todo, error)
+	ListTodosByUserManual(ctx context.Context, userID int64) ([]sqlc.Todo, error)
+	SetTodoStatus(ctx context.Context, arg sqlc.SetTodoStatusParams) (sqlc.Todo, error)
+	ClearTodoStatus(ctx context.Context, arg sqlc.ClearTodoStatusParams) error
+	SyncTodoCompletedWithStatus(ctx context.Context, arg sqlc.SyncTodoCompletedWithStatusParams) error
+}
+
+// sqlc.Querier が StatusRepository を満たすことを保証
+var _ StatusRepository = (sqlc.Querier)(nil)
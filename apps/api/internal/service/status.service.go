@@ -0,0 +1,524 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ステータス名の最大文字数
+const MaxStatusNameLength = 50
+
+var (
+	ErrStatusNotFound     = errors.New("status not found")
+	ErrStatusNameConflict = errors.New("status name already exists")
+	ErrInvalidStatus      = errors.New("invalid status")
+	// 完了ステータスと、最後の未完了ステータスは削除・解除できない
+	ErrStatusRequired   = errors.New("status is required")
+	ErrWIPLimitExceeded = errors.New("wip limit exceeded")
+	// 先頭の未完了ステータスにはWIP制限を設定できない
+	ErrFirstStatusWIPLimit = errors.New("the first open status cannot have a wip limit")
+)
+
+// 移動先のステータスがWIP制限に達している場合のエラー
+type WIPLimitExceededError struct {
+	Status sqlc.Status
+}
+
+func (e *WIPLimitExceededError) Error() string {
+	return fmt.Sprintf("%s: %q allows %d todos", ErrWIPLimitExceeded, e.Status.Name, *e.Status.WipLimit)
+}
+
+func (e *WIPLimitExceededError) Unwrap() error {
+	return ErrWIPLimitExceeded
+}
+
+// ステータスが1つもないユーザーに作成する既定のステータス
+var defaultStatuses = []struct {
+	name   string
+	isDone bool
+}{
+	{name: "todo"},
+	{name: "doing"},
+	{name: "done", isDone: true},
+}
+
+// ステータスの変更内容（nil のフィールドは変更しない）
+type StatusPatch struct {
+	Name      *string
+	SortOrder *int32
+	// 0 の場合はWIP制限を解除する
+	WIPLimit *int32
+	IsDone   *bool
+}
+
+// ステータスごとにTodoをまとめたボード
+type Board struct {
+	Columns []BoardColumn
+}
+
+type BoardColumn struct {
+	Status sqlc.Status
+	Todos  []sqlc.Todo
+}
+
+type StatusService struct {
+	repo      StatusRepository
+	txManager database.TxManager
+	// トランザクション内で使うリポジトリを作成する
+	withTx func(tx pgx.Tx) StatusRepository
+}
+
+func NewStatusService(repo StatusRepository, pool *pgxpool.Pool) *StatusService {
+	return &StatusService{
+		repo:      repo,
+		txManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) StatusRepository {
+			return sqlc.New(tx)
+		},
+	}
+}
+
+// 並び順に並んだユーザーのステータス
+// 完了ステータスがちょうど1つ、未完了ステータスが1つ以上あることを前提とする
+type statusSet []sqlc.Status
+
+func (ss statusSet) find(id int64) (sqlc.Status, bool) {
+	for _, st := range ss {
+		if st.ID == id {
+			return st, true
+		}
+	}
+	return sqlc.Status{}, false
+}
+
+func (ss statusSet) findByName(name string) (sqlc.Status, bool) {
+	for _, st := range ss {
+		if strings.EqualFold(st.Name, name) {
+			return st, true
+		}
+	}
+	return sqlc.Status{}, false
+}
+
+func (ss statusSet) done() sqlc.Status {
+	for _, st := range ss {
+		if st.IsDone {
+			return st
+		}
+	}
+	return sqlc.Status{}
+}
+
+// ステータス未設定の未完了Todoが属する、先頭の未完了ステータス
+func (ss statusSet) initial() sqlc.Status {
+	for _, st := range ss {
+		if !st.IsDone {
+			return st
+		}
+	}
+	return sqlc.Status{}
+}
+
+// st を追加（同じIDがあれば置き換え）して並び順に並べ直した一覧
+// st が完了ステータスの場合、既存の完了ステータスは未完了に戻る
+func (ss statusSet) with(st sqlc.Status) statusSet {
+	result := slices.DeleteFunc(slices.Clone(ss), func(s sqlc.Status) bool { return s.ID == st.ID })
+	if st.IsDone {
+		for i := range result {
+			result[i].IsDone = false
+		}
+	}
+	result = append(result, st)
+	slices.SortStableFunc(result, func(a, b sqlc.Status) int {
+		if a.SortOrder != b.SortOrder {
+			return cmp.Compare(a.SortOrder, b.SortOrder)
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return result
+}
+
+func (ss statusSet) without(id int64) statusSet {
+	return slices.DeleteFunc(slices.Clone(ss), func(s sqlc.Status) bool { return s.ID == id })
+}
+
+// 先頭の未完了ステータスには作成・インポート・未完了への戻し・ステータス削除でTodoが暗黙に入るため、WIP制限を守れない
+func (ss statusSet) validateFirstWIPLimit() error {
+	if ss.initial().WipLimit != nil {
+		return ErrFirstStatusWIPLimit
+	}
+	return nil
+}
+
+func (ss statusSet) openCount() int {
+	n := 0
+	for _, st := range ss {
+		if !st.IsDone {
+			n++
+		}
+	}
+	return n
+}
+
+// Todoが実際に属するステータス
+// status_id が未設定のTodoは completed に応じて完了ステータスか先頭の未完了ステータスに属する
+func (ss statusSet) effectiveID(todo sqlc.Todo) int64 {
+	if todo.StatusID != nil {
+		return *todo.StatusID
+	}
+	if todo.Completed {
+		return ss.done().ID
+	}
+	return ss.initial().ID
+}
+
+// ユーザー行をロックしてステータス一覧を取得する
+// ステータスが1つもない場合は既定のステータスを作成する
+func loadStatuses(ctx context.Context, repo StatusRepository, userID int64) (statusSet, error) {
+	if _, err := repo.GetTodoChangeSeqForUpdate(ctx, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("lock user: %w", err)
+	}
+
+	statuses, err := repo.ListStatusesByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list statuses: %w", err)
+	}
+	if len(statuses) > 0 {
+		return statuses, nil
+	}
+
+	for i, def := range defaultStatuses {
+		st, err := repo.CreateStatus(ctx, sqlc.CreateStatusParams{
+			UserID:    userID,
+			Name:      def.name,
+			SortOrder: int32(i),
+			IsDone:    def.isDone,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create default status %q: %w", def.name, err)
+		}
+		statuses = append(statuses, st)
+	}
+	return statuses, nil
+}
+
+func (s *StatusService) ListStatuses(ctx context.Context, userID int64) ([]sqlc.Status, error) {
+	var statuses statusSet
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		statuses, err = loadStatuses(ctx, s.withTx(tx), userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// ステータスを末尾に追加する
+// isDone が true の場合は既存の完了ステータスを置き換え、各Todoの completed を付け直す
+func (s *StatusService) CreateStatus(ctx context.Context, userID int64, name string, wipLimit *int32, isDone bool) (*sqlc.Status, error) {
+	name = strings.TrimSpace(name)
+	wipLimit, err := normalizeWIPLimit(wipLimit)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateStatus(name, wipLimit, isDone); err != nil {
+		return nil, err
+	}
+
+	var created sqlc.Status
+	err = s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		statuses, err := loadStatuses(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		if _, ok := statuses.findByName(name); ok {
+			return ErrStatusNameConflict
+		}
+		sortOrder := statuses[len(statuses)-1].SortOrder + 1
+		if err := statuses.with(sqlc.Status{Name: name, SortOrder: sortOrder, WipLimit: wipLimit, IsDone: isDone}).validateFirstWIPLimit(); err != nil {
+			return err
+		}
+
+		if isDone {
+			if err := repo.ClearDoneStatus(ctx, userID); err != nil {
+				return fmt.Errorf("clear done status: %w", err)
+			}
+		}
+		created, err = repo.CreateStatus(ctx, sqlc.CreateStatusParams{
+			UserID:    userID,
+			Name:      name,
+			SortOrder: sortOrder,
+			WipLimit:  wipLimit,
+			IsDone:    isDone,
+		})
+		if err != nil {
+			return fmt.Errorf("create status: %w", err)
+		}
+		if isDone {
+			return syncCompletedWithStatus(ctx, repo, userID, created.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// ステータスを変更する
+// 完了ステータスの解除はできず、別のステータスを完了にすることで置き換える
+func (s *StatusService) UpdateStatus(ctx context.Context, id, userID int64, patch StatusPatch) (*sqlc.Status, error) {
+	var updated sqlc.Status
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		statuses, err := loadStatuses(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		current, ok := statuses.find(id)
+		if !ok {
+			return ErrStatusNotFound
+		}
+
+		params := sqlc.UpdateStatusParams{
+			Name:      current.Name,
+			SortOrder: current.SortOrder,
+			WipLimit:  current.WipLimit,
+			IsDone:    current.IsDone,
+			ID:        id,
+			UserID:    userID,
+		}
+		if patch.Name != nil {
+			params.Name = strings.TrimSpace(*patch.Name)
+			if other, ok := statuses.findByName(params.Name); ok && other.ID != id {
+				return ErrStatusNameConflict
+			}
+		}
+		if patch.SortOrder != nil {
+			params.SortOrder = *patch.SortOrder
+		}
+		if patch.WIPLimit != nil {
+			if params.WipLimit, err = normalizeWIPLimit(patch.WIPLimit); err != nil {
+				return err
+			}
+		}
+		if patch.IsDone != nil {
+			if current.IsDone && !*patch.IsDone {
+				return ErrStatusRequired
+			}
+			params.IsDone = *patch.IsDone
+		}
+		if err := validateStatus(params.Name, params.WipLimit, params.IsDone); err != nil {
+			return err
+		}
+		// 並べ替えや完了ステータスの入れ替えで先頭になるステータスも確認する
+		next := current
+		next.Name, next.SortOrder, next.WipLimit, next.IsDone = params.Name, params.SortOrder, params.WipLimit, params.IsDone
+		if err := statuses.with(next).validateFirstWIPLimit(); err != nil {
+			return err
+		}
+
+		becomesDone := params.IsDone && !current.IsDone
+		if becomesDone {
+			// 最後の未完了ステータスを完了にすると未完了のTodoの行き先がなくなる
+			if statuses.openCount() == 1 {
+				return ErrStatusRequired
+			}
+			if err := repo.ClearDoneStatus(ctx, userID); err != nil {
+				return fmt.Errorf("clear done status: %w", err)
+			}
+		}
+		updated, err = repo.UpdateStatus(ctx, params)
+		if err != nil {
+			return fmt.Errorf("update status: %w", err)
+		}
+		if becomesDone {
+			return syncCompletedWithStatus(ctx, repo, userID, updated.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// ステータスを削除する
+// 削除したステータスのTodoは status_id が外れ、先頭の未完了ステータスに移る
+func (s *StatusService) DeleteStatus(ctx context.Context, id, userID int64) error {
+	return s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		statuses, err := loadStatuses(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		current, ok := statuses.find(id)
+		if !ok {
+			return ErrStatusNotFound
+		}
+		if current.IsDone || statuses.openCount() == 1 {
+			return ErrStatusRequired
+		}
+		// 削除で先頭になるステータスのWIP制限は守れない
+		if err := statuses.without(id).validateFirstWIPLimit(); err != nil {
+			return err
+		}
+
+		// 外部キーの ON DELETE SET NULL ではバージョンと変更シーケンスが進まないため先に外す
+		if err := repo.ClearTodoStatus(ctx, sqlc.ClearTodoStatusParams{UserID: userID, StatusID: id}); err != nil {
+			return fmt.Errorf("clear todo status: %w", err)
+		}
+		if err := repo.DeleteStatus(ctx, sqlc.DeleteStatusParams{ID: id, UserID: userID}); err != nil {
+			return fmt.Errorf("delete status: %w", err)
+		}
+		return nil
+	})
+}
+
+// Todoのステータスを変更する。completed は移動先が完了ステータスかどうかで決まる
+// 移動先にWIP制限がある場合は、ユーザー行をロックした上で件数を数えるため同時の移動でも制限を超えない
+// 完了ステータスへの移動は、未完了のブロッカーが残っていれば force を指定しない限り ErrTodoBlocked にする
+func (s *StatusService) SetTodoStatus(ctx context.Context, todoID, userID, statusID int64, expectedVersions []int32, force bool) (*sqlc.Todo, error) {
+	var result sqlc.Todo
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		statuses, err := loadStatuses(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		target, ok := statuses.find(statusID)
+		if !ok {
+			return ErrStatusNotFound
+		}
+
+		todo, err := repo.GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTodoNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("get todo: %w", err)
+		}
+		// ユーザー行のロック中はTodoの更新が進まないため、ここで読んだバージョンは更新時まで変わらない
+		if expectedVersions != nil && !slices.Contains(expectedVersions, todo.Version) {
+			return &TodoVersionMismatchError{Current: &todo}
+		}
+		if statuses.effectiveID(todo) == statusID {
+			result = todo
+			return nil
+		}
+
+		if target.IsDone && !todo.Completed && !force {
+			if err := ensureTodoNotBlocked(ctx, repo, userID, todoID); err != nil {
+				return err
+			}
+		}
+
+		// 完了ステータスと先頭の未完了ステータスにはWIP制限を設定できないため、数えるのは未完了でステータスが明示されたTodoだけでよい
+		if target.WipLimit != nil {
+			count, err := repo.CountTodosInStatus(ctx, sqlc.CountTodosInStatusParams{UserID: userID, StatusID: &statusID})
+			if err != nil {
+				return fmt.Errorf("count todos in status: %w", err)
+			}
+			if count >= int64(*target.WipLimit) {
+				return &WIPLimitExceededError{Status: target}
+			}
+		}
+
+		result, err = repo.SetTodoStatus(ctx, sqlc.SetTodoStatusParams{
+			UserID:    userID,
+			StatusID:  statusID,
+			Completed: target.IsDone,
+			ID:        todoID,
+		})
+		if err != nil {
+			return fmt.Errorf("set todo status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ステータスごとにTodoをまとめる（各列のTodoは手動の並び順）
+func (s *StatusService) GetBoard(ctx context.Context, userID int64) (*Board, error) {
+	var statuses statusSet
+	var todos []sqlc.Todo
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		var err error
+		if statuses, err = loadStatuses(ctx, repo, userID); err != nil {
+			return err
+		}
+		if todos, err = repo.ListTodosByUserManual(ctx, userID); err != nil {
+			return fmt.Errorf("list todos: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	board := &Board{Columns: make([]BoardColumn, len(statuses))}
+	index := make(map[int64]int, len(statuses))
+	for i, st := range statuses {
+		board.Columns[i] = BoardColumn{Status: st, Todos: []sqlc.Todo{}}
+		index[st.ID] = i
+	}
+	for _, todo := range todos {
+		i := index[statuses.effectiveID(todo)]
+		board.Columns[i].Todos = append(board.Columns[i].Todos, todo)
+	}
+	return board, nil
+}
+
+// 完了ステータスが変わった後、ステータスを設定済みのTodoの completed を付け直す
+func syncCompletedWithStatus(ctx context.Context, repo StatusRepository, userID, doneStatusID int64) error {
+	if err := repo.SyncTodoCompletedWithStatus(ctx, sqlc.SyncTodoCompletedWithStatusParams{
+		UserID:       userID,
+		DoneStatusID: doneStatusID,
+	}); err != nil {
+		return fmt.Errorf("sync completed with status: %w", err)
+	}
+	return nil
+}
+
+// WIP制限の 0 は制限なしとして扱う
+func normalizeWIPLimit(limit *int32) (*int32, error) {
+	if limit == nil || *limit == 0 {
+		return nil, nil
+	}
+	if *limit < 0 {
+		return nil, ErrInvalidStatus
+	}
+	return limit, nil
+}
+
+func validateStatus(name string, wipLimit *int32, isDone bool) error {
+	if name == "" || utf8.RuneCountInString(name) > MaxStatusNameLength {
+		return ErrInvalidStatus
+	}
+	// 完了したTodoは溜まり続けるため、完了ステータスにはWIP制限を設定できない
+	if isDone && wipLimit != nil {
+		return ErrInvalidStatus
+	}
+	return nil
+}
@@ -0,0 +1,707 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// トランザクション内でも同じモックリポジトリを使うStatusService
+func newTxTestStatusService(repo StatusRepository) *StatusService {
+	svc := NewStatusService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) StatusRepository { return repo }
+	return svc
+}
+
+// todo(1) / doing(2, WIP制限2) / done(3) の既定的なステータス
+func testStatuses(userID int64) []sqlc.Status {
+	return []sqlc.Status{
+		{ID: 1, UserID: userID, Name: "todo", SortOrder: 0},
+		{ID: 2, UserID: userID, Name: "doing", SortOrder: 1, WipLimit: ptrInt32(2)},
+		{ID: 3, UserID: userID, Name: "done", SortOrder: 2, IsDone: true},
+	}
+}
+
+// ユーザー行のロックとステータス一覧の取得を期待する
+func expectStatuses(mockRepo *mocks.MockStatusRepository, ctx context.Context, userID int64, statuses []sqlc.Status) {
+	mockRepo.EXPECT().
+		GetTodoChangeSeqForUpdate(ctx, userID).
+		Return(int64(7), nil)
+	mockRepo.EXPECT().
+		ListStatusesByUser(ctx, userID).
+		Return(statuses, nil)
+}
+
+func TestStatusService_ListStatuses(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: ステータスがない場合は既定のステータスを作成する", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, []sqlc.Status{})
+		for i, name := range []string{"todo", "doing", "done"} {
+			mockRepo.EXPECT().
+				CreateStatus(ctx, sqlc.CreateStatusParams{
+					UserID:    userID,
+					Name:      name,
+					SortOrder: int32(i),
+					IsDone:    name == "done",
+				}).
+				Return(sqlc.Status{ID: int64(i + 1), UserID: userID, Name: name, SortOrder: int32(i), IsDone: name == "done"}, nil)
+		}
+
+		statuses, err := svc.ListStatuses(ctx, userID)
+
+		require.NoError(t, err)
+		require.Len(t, statuses, 3)
+		assert.Equal(t, "todo", statuses[0].Name)
+		assert.True(t, statuses[2].IsDone)
+	})
+
+	t.Run("正常系: 既存のステータスはそのまま返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+
+		statuses, err := svc.ListStatuses(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Len(t, statuses, 3)
+	})
+
+	t.Run("異常系: ユーザーが存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(0), pgx.ErrNoRows)
+
+		statuses, err := svc.ListStatuses(ctx, userID)
+
+		assert.Nil(t, statuses)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestStatusService_CreateStatus(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: 末尾に追加する", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			CreateStatus(ctx, sqlc.CreateStatusParams{
+				UserID:    userID,
+				Name:      "review",
+				SortOrder: 3,
+				WipLimit:  ptrInt32(3),
+			}).
+			Return(sqlc.Status{ID: 4, UserID: userID, Name: "review", SortOrder: 3, WipLimit: ptrInt32(3)}, nil)
+
+		status, err := svc.CreateStatus(ctx, userID, " review ", ptrInt32(3), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), status.ID)
+	})
+
+	t.Run("正常系: 完了ステータスとして追加すると既存の完了ステータスを置き換える", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			ClearDoneStatus(ctx, userID).
+			Return(nil)
+		mockRepo.EXPECT().
+			CreateStatus(ctx, sqlc.CreateStatusParams{
+				UserID:    userID,
+				Name:      "archived",
+				SortOrder: 3,
+				IsDone:    true,
+			}).
+			Return(sqlc.Status{ID: 4, UserID: userID, Name: "archived", SortOrder: 3, IsDone: true}, nil)
+		mockRepo.EXPECT().
+			SyncTodoCompletedWithStatus(ctx, sqlc.SyncTodoCompletedWithStatusParams{UserID: userID, DoneStatusID: 4}).
+			Return(nil)
+
+		status, err := svc.CreateStatus(ctx, userID, "archived", nil, true)
+
+		require.NoError(t, err)
+		assert.True(t, status.IsDone)
+	})
+
+	t.Run("正常系: WIP制限の0は制限なし", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			CreateStatus(ctx, sqlc.CreateStatusParams{UserID: userID, Name: "later", SortOrder: 3}).
+			Return(sqlc.Status{ID: 4, UserID: userID, Name: "later", SortOrder: 3}, nil)
+
+		status, err := svc.CreateStatus(ctx, userID, "later", ptrInt32(0), false)
+
+		require.NoError(t, err)
+		assert.Nil(t, status.WipLimit)
+	})
+
+	t.Run("異常系: 同じ名前のステータスがある（大文字小文字を区別しない）", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+
+		status, err := svc.CreateStatus(ctx, userID, "Doing", nil, false)
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, ErrStatusNameConflict)
+	})
+
+	invalid := []struct {
+		name     string
+		status   string
+		wipLimit *int32
+		isDone   bool
+	}{
+		{name: "異常系: 名前が空", status: "  "},
+		{name: "異常系: 名前が長すぎる", status: strings.Repeat("あ", MaxStatusNameLength+1)},
+		{name: "異常系: WIP制限が負", status: "review", wipLimit: ptrInt32(-1)},
+		{name: "異常系: 完了ステータスにWIP制限", status: "archived", wipLimit: ptrInt32(5), isDone: true},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockStatusRepository(t)
+			svc := newTxTestStatusService(mockRepo)
+
+			status, err := svc.CreateStatus(context.Background(), userID, tt.status, tt.wipLimit, tt.isDone)
+
+			assert.Nil(t, status)
+			assert.ErrorIs(t, err, ErrInvalidStatus)
+		})
+	}
+}
+
+func TestStatusService_UpdateStatus(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: 名前と並び順を変更しWIP制限を解除する", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			UpdateStatus(ctx, sqlc.UpdateStatusParams{
+				Name:      "in progress",
+				SortOrder: 5,
+				ID:        2,
+				UserID:    userID,
+			}).
+			Return(sqlc.Status{ID: 2, UserID: userID, Name: "in progress", SortOrder: 5}, nil)
+
+		status, err := svc.UpdateStatus(ctx, 2, userID, StatusPatch{
+			Name:      ptrString("in progress"),
+			SortOrder: ptrInt32(5),
+			WIPLimit:  ptrInt32(0),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "in progress", status.Name)
+		assert.Nil(t, status.WipLimit)
+	})
+
+	t.Run("正常系: 未完了ステータスを完了にすると完了ステータスが入れ替わる", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		statuses := testStatuses(userID)
+		statuses[1].WipLimit = nil
+		expectStatuses(mockRepo, ctx, userID, statuses)
+		mockRepo.EXPECT().
+			ClearDoneStatus(ctx, userID).
+			Return(nil)
+		mockRepo.EXPECT().
+			UpdateStatus(ctx, sqlc.UpdateStatusParams{
+				Name:      "todo",
+				SortOrder: 0,
+				IsDone:    true,
+				ID:        1,
+				UserID:    userID,
+			}).
+			Return(sqlc.Status{ID: 1, UserID: userID, Name: "todo", IsDone: true}, nil)
+		mockRepo.EXPECT().
+			SyncTodoCompletedWithStatus(ctx, sqlc.SyncTodoCompletedWithStatusParams{UserID: userID, DoneStatusID: 1}).
+			Return(nil)
+
+		status, err := svc.UpdateStatus(ctx, 1, userID, StatusPatch{IsDone: ptrBool(true)})
+
+		require.NoError(t, err)
+		assert.True(t, status.IsDone)
+	})
+
+	t.Run("異常系: 存在しないステータス", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+
+		status, err := svc.UpdateStatus(ctx, 99, userID, StatusPatch{Name: ptrString("x")})
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, ErrStatusNotFound)
+	})
+
+	t.Run("異常系: 他のステータスと同じ名前", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+
+		status, err := svc.UpdateStatus(ctx, 1, userID, StatusPatch{Name: ptrString("DONE")})
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, ErrStatusNameConflict)
+	})
+
+	t.Run("異常系: 完了ステータスを解除する", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+
+		status, err := svc.UpdateStatus(ctx, 3, userID, StatusPatch{IsDone: ptrBool(false)})
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, ErrStatusRequired)
+	})
+
+	t.Run("異常系: 最後の未完了ステータスを完了にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, []sqlc.Status{
+			{ID: 1, UserID: userID, Name: "todo"},
+			{ID: 3, UserID: userID, Name: "done", SortOrder: 1, IsDone: true},
+		})
+
+		status, err := svc.UpdateStatus(ctx, 1, userID, StatusPatch{IsDone: ptrBool(true)})
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, ErrStatusRequired)
+	})
+
+	t.Run("異常系: WIP制限のあるステータスを完了にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+
+		status, err := svc.UpdateStatus(ctx, 2, userID, StatusPatch{IsDone: ptrBool(true)})
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, ErrInvalidStatus)
+	})
+
+	tests := []struct {
+		name     string
+		statuses []sqlc.Status
+		id       int64
+		patch    StatusPatch
+	}{
+		{
+			name:     "異常系: 先頭の未完了ステータスにWIP制限を設定する",
+			statuses: testStatuses(userID),
+			id:       1,
+			patch:    StatusPatch{WIPLimit: ptrInt32(3)},
+		},
+		{
+			name:     "異常系: 並べ替えでWIP制限のあるステータスが先頭になる",
+			statuses: testStatuses(userID),
+			id:       2,
+			patch:    StatusPatch{SortOrder: ptrInt32(-1)},
+		},
+		{
+			name:     "異常系: 先頭のステータスを後ろへ移動してWIP制限のあるステータスが先頭になる",
+			statuses: testStatuses(userID),
+			id:       1,
+			patch:    StatusPatch{SortOrder: ptrInt32(5)},
+		},
+		{
+			name:     "異常系: 先頭のステータスを完了にしてWIP制限のあるステータスが先頭になる",
+			statuses: testStatuses(userID),
+			id:       1,
+			patch:    StatusPatch{IsDone: ptrBool(true)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockStatusRepository(t)
+			svc := newTxTestStatusService(mockRepo)
+			ctx := context.Background()
+
+			expectStatuses(mockRepo, ctx, userID, tt.statuses)
+
+			status, err := svc.UpdateStatus(ctx, tt.id, userID, tt.patch)
+
+			assert.Nil(t, status)
+			assert.ErrorIs(t, err, ErrFirstStatusWIPLimit)
+		})
+	}
+
+	t.Run("正常系: 完了ステータスの入れ替えで未完了に戻るステータスが先頭になる場合はWIP制限を確認しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, []sqlc.Status{
+			{ID: 3, UserID: userID, Name: "done", SortOrder: 0, IsDone: true},
+			{ID: 1, UserID: userID, Name: "todo", SortOrder: 1},
+			{ID: 2, UserID: userID, Name: "doing", SortOrder: 2, WipLimit: ptrInt32(2)},
+		})
+		mockRepo.EXPECT().
+			ClearDoneStatus(ctx, userID).
+			Return(nil)
+		mockRepo.EXPECT().
+			UpdateStatus(ctx, sqlc.UpdateStatusParams{Name: "todo", SortOrder: 1, IsDone: true, ID: 1, UserID: userID}).
+			Return(sqlc.Status{ID: 1, UserID: userID, Name: "todo", SortOrder: 1, IsDone: true}, nil)
+		mockRepo.EXPECT().
+			SyncTodoCompletedWithStatus(ctx, sqlc.SyncTodoCompletedWithStatusParams{UserID: userID, DoneStatusID: 1}).
+			Return(nil)
+
+		status, err := svc.UpdateStatus(ctx, 1, userID, StatusPatch{IsDone: ptrBool(true)})
+
+		require.NoError(t, err)
+		assert.True(t, status.IsDone)
+	})
+}
+
+func TestStatusService_DeleteStatus(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: Todoのステータスを外してから削除する", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		clear := mockRepo.EXPECT().
+			ClearTodoStatus(ctx, sqlc.ClearTodoStatusParams{UserID: userID, StatusID: 2}).
+			Return(nil)
+		mockRepo.EXPECT().
+			DeleteStatus(ctx, sqlc.DeleteStatusParams{ID: 2, UserID: userID}).
+			Return(nil).
+			NotBefore(clear.Call)
+
+		err := svc.DeleteStatus(ctx, 2, userID)
+
+		require.NoError(t, err)
+	})
+
+	tests := []struct {
+		name     string
+		statuses []sqlc.Status
+		id       int64
+		wantErr  error
+	}{
+		{
+			name:     "異常系: 存在しないステータス",
+			statuses: testStatuses(userID),
+			id:       99,
+			wantErr:  ErrStatusNotFound,
+		},
+		{
+			name:     "異常系: 完了ステータスは削除できない",
+			statuses: testStatuses(userID),
+			id:       3,
+			wantErr:  ErrStatusRequired,
+		},
+		{
+			name: "異常系: 最後の未完了ステータスは削除できない",
+			statuses: []sqlc.Status{
+				{ID: 1, UserID: userID, Name: "todo"},
+				{ID: 3, UserID: userID, Name: "done", SortOrder: 1, IsDone: true},
+			},
+			id:      1,
+			wantErr: ErrStatusRequired,
+		},
+		{
+			name:     "異常系: 先頭のステータスを削除するとWIP制限のあるステータスが先頭になる",
+			statuses: testStatuses(userID),
+			id:       1,
+			wantErr:  ErrFirstStatusWIPLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockStatusRepository(t)
+			svc := newTxTestStatusService(mockRepo)
+			ctx := context.Background()
+
+			expectStatuses(mockRepo, ctx, userID, tt.statuses)
+
+			err := svc.DeleteStatus(ctx, tt.id, userID)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestStatusService_SetTodoStatus(t *testing.T) {
+	userID := int64(1)
+	todoID := int64(10)
+
+	t.Run("正常系: 完了ステータスへ移動すると完了になる", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 2}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{todoID}}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			SetTodoStatus(ctx, sqlc.SetTodoStatusParams{UserID: userID, StatusID: 3, Completed: true, ID: todoID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Completed: true, StatusID: ptrInt64(3), Version: 3}, nil)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 3, []int32{2}, false)
+
+		require.NoError(t, err)
+		assert.True(t, todo.Completed)
+		assert.Equal(t, int32(3), todo.Version)
+	})
+
+	t.Run("正常系: 期待するバージョンのいずれかに一致すれば移動する", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 2}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{todoID}}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			SetTodoStatus(ctx, sqlc.SetTodoStatusParams{UserID: userID, StatusID: 3, Completed: true, ID: todoID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Completed: true, StatusID: ptrInt64(3), Version: 3}, nil)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 3, []int32{1, 2}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), todo.Version)
+	})
+
+	t.Run("正常系: WIP制限に空きがあれば移動する", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 1}, nil)
+		mockRepo.EXPECT().
+			CountTodosInStatus(ctx, sqlc.CountTodosInStatusParams{UserID: userID, StatusID: ptrInt64(2)}).
+			Return(int64(1), nil)
+		mockRepo.EXPECT().
+			SetTodoStatus(ctx, sqlc.SetTodoStatusParams{UserID: userID, StatusID: 2, ID: todoID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, StatusID: ptrInt64(2), Version: 2}, nil)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 2, nil, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, ptrInt64(2), todo.StatusID)
+	})
+
+	t.Run("正常系: ステータス未設定の未完了Todoを先頭のステータスへ移動しても更新しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 4}, nil)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 1, nil, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), todo.Version)
+		mockRepo.AssertNotCalled(t, "SetTodoStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: WIP制限に達している", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 1}, nil)
+		mockRepo.EXPECT().
+			CountTodosInStatus(ctx, sqlc.CountTodosInStatusParams{UserID: userID, StatusID: ptrInt64(2)}).
+			Return(int64(2), nil)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 2, nil, false)
+
+		assert.Nil(t, todo)
+		assert.ErrorIs(t, err, ErrWIPLimitExceeded)
+		var wip *WIPLimitExceededError
+		require.ErrorAs(t, err, &wip)
+		assert.Equal(t, "doing", wip.Status.Name)
+	})
+
+	t.Run("正常系: forceを指定するとブロッカーを確認せずに完了ステータスへ移動する", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 2}, nil)
+		mockRepo.EXPECT().
+			SetTodoStatus(ctx, sqlc.SetTodoStatusParams{UserID: userID, StatusID: 3, Completed: true, ID: todoID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Completed: true, StatusID: ptrInt64(3), Version: 3}, nil)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 3, nil, true)
+
+		require.NoError(t, err)
+		assert.True(t, todo.Completed)
+		mockRepo.AssertNotCalled(t, "ListOpenBlockedTodoIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: ブロッカーが残っているTodoは完了ステータスへ移動できない", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 2}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{todoID}}).
+			Return([]int64{todoID}, nil)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 3, nil, false)
+
+		assert.Nil(t, todo)
+		assert.ErrorIs(t, err, ErrTodoBlocked)
+		mockRepo.AssertNotCalled(t, "SetTodoStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: バージョンが一致しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 5}, nil)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 3, []int32{4}, false)
+
+		assert.Nil(t, todo)
+		var mismatch *TodoVersionMismatchError
+		require.ErrorAs(t, err, &mismatch)
+		assert.Equal(t, int32(5), mismatch.Current.Version)
+	})
+
+	t.Run("異常系: 存在しないステータス", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 99, nil, false)
+
+		assert.Nil(t, todo)
+		assert.ErrorIs(t, err, ErrStatusNotFound)
+	})
+
+	t.Run("異常系: 存在しないTodo", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		todo, err := svc.SetTodoStatus(ctx, todoID, userID, 2, nil, false)
+
+		assert.Nil(t, todo)
+		assert.ErrorIs(t, err, ErrTodoNotFound)
+	})
+}
+
+func TestStatusService_GetBoard(t *testing.T) {
+	userID := int64(1)
+
+	t.Run("正常系: Todoを属するステータスの列にまとめる", func(t *testing.T) {
+		mockRepo := mocks.NewMockStatusRepository(t)
+		svc := newTxTestStatusService(mockRepo)
+		ctx := context.Background()
+
+		expectStatuses(mockRepo, ctx, userID, testStatuses(userID))
+		mockRepo.EXPECT().
+			ListTodosByUserManual(ctx, userID).
+			Return([]sqlc.Todo{
+				{ID: 1, UserID: userID, Position: "A"},
+				{ID: 2, UserID: userID, Position: "B", StatusID: ptrInt64(2)},
+				{ID: 3, UserID: userID, Position: "C", Completed: true},
+				{ID: 4, UserID: userID, Position: "D"},
+			}, nil)
+
+		board, err := svc.GetBoard(ctx, userID)
+
+		require.NoError(t, err)
+		require.Len(t, board.Columns, 3)
+		ids := func(todos []sqlc.Todo) []int64 {
+			result := []int64{}
+			for _, todo := range todos {
+				result = append(result, todo.ID)
+			}
+			return result
+		}
+		assert.Equal(t, []int64{1, 4}, ids(board.Columns[0].Todos))
+		assert.Equal(t, []int64{2}, ids(board.Columns[1].Todos))
+		assert.Equal(t, []int64{3}, ids(board.Columns[2].Todos))
+	})
+}
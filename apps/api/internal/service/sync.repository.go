@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type SyncRepository interface {
+	GetTodoChangeSeqForUpdate(ctx context.Context, id int64) (int64, error)
+	ListOpenBlockedTodoIDs(ctx context.Context, arg sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error)
+	GetTodoByClientIDForUpdate(ctx context.Context, arg sqlc.GetTodoByClientIDForUpdateParams) (sqlc.Todo, error)
+	GetLastTodoPosition(ctx context.Context, userID int64) (string, error)
+	GetUserSettings(ctx context.Context, userID int64) (sqlc.UserSetting, error)
+	CreateSyncedTodo(ctx context.Context, arg sqlc.CreateSyncedTodoParams) (sqlc.Todo, error)
+	ApplySyncedTodo(ctx context.Context, arg sqlc.ApplySyncedTodoParams) (sqlc.Todo, error)
+	DeleteSyncedTodo(ctx context.Context, arg sqlc.DeleteSyncedTodoParams) (sqlc.Todo, error)
+	ListTodoChangesPage(ctx context.Context, arg sqlc.ListTodoChangesPageParams) ([]sqlc.Todo, error)
+}
+
+// sqlc.Querier が SyncRepository を満たすことを保証
+var _ SyncRepository = (sqlc.Querier)(nil)
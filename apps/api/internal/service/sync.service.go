@@ -0,0 +1,482 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+	"go-todo/internal/rank"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// 1回の同期で受け付ける操作数の上限
+const MaxSyncOperations = 500
+
+// 1回の同期で返す変更数の上限。残りは続きのトークンで取得する
+const SyncPageSize = 1000
+
+var (
+	ErrInvalidSyncToken      = errors.New("invalid sync token")
+	ErrTooManySyncOperations = errors.New("too many sync operations")
+)
+
+type SyncOpType string
+
+const (
+	SyncOpUpsert SyncOpType = "upsert"
+	SyncOpDelete SyncOpType = "delete"
+)
+
+// クライアントから送られてくる1件の操作
+// UpdatedAt はクライアント側で変更した日時で、フィールド単位の後勝ち判定に使う
+// ClearDescription は description に null が送られたことを表し、説明を削除する
+type SyncOperation struct {
+	ClientID         pgtype.UUID
+	Op               SyncOpType
+	UpdatedAt        time.Time
+	Title            *string
+	Description      *string
+	ClearDescription bool
+	Completed        *bool
+	// 未完了のブロッカーが残っていても完了にする
+	Force bool
+}
+
+type SyncResultStatus string
+
+const (
+	SyncStatusCreated   SyncResultStatus = "created"
+	SyncStatusUpdated   SyncResultStatus = "updated"
+	SyncStatusDeleted   SyncResultStatus = "deleted"
+	SyncStatusUnchanged SyncResultStatus = "unchanged"
+	SyncStatusRejected  SyncResultStatus = "rejected"
+)
+
+type SyncOperationResult struct {
+	ClientID pgtype.UUID
+	Status   SyncResultStatus
+	TodoID   *int64
+	Error    string
+}
+
+type SyncResolution string
+
+const (
+	SyncResolutionClient SyncResolution = "client"
+	SyncResolutionServer SyncResolution = "server"
+)
+
+// 同じフィールドがクライアントとサーバーの両方で変更されていた場合の記録
+type SyncConflict struct {
+	ClientID    pgtype.UUID
+	Field       string
+	ClientValue any
+	ServerValue any
+	Resolution  SyncResolution
+}
+
+// HasMore が true の場合、SyncToken は返した最後の変更の続きを表す
+type SyncResult struct {
+	SyncToken string
+	Changes   []sqlc.Todo
+	HasMore   bool
+	Conflicts []SyncConflict
+	Results   []SyncOperationResult
+}
+
+type SyncService struct {
+	repo      SyncRepository
+	txManager database.TxManager
+	withTx    func(tx pgx.Tx) SyncRepository
+	now       func() time.Time
+	pageSize  int
+}
+
+func NewSyncService(repo SyncRepository, pool *pgxpool.Pool) *SyncService {
+	return &SyncService{
+		repo:      repo,
+		txManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) SyncRepository {
+			return sqlc.New(tx)
+		},
+		now:      time.Now,
+		pageSize: SyncPageSize,
+	}
+}
+
+// クライアントの操作を適用し、同期トークン以降のサーバー側の変更を返す
+// 全体を1トランザクションで実行し、ユーザー行のロックで同一ユーザーの書き込みを直列化する
+func (s *SyncService) Sync(ctx context.Context, userID int64, token string, ops []SyncOperation) (*SyncResult, error) {
+	if len(ops) > MaxSyncOperations {
+		return nil, ErrTooManySyncOperations
+	}
+	since, afterID, err := decodeSyncCursor(token)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{
+		Changes:   []sqlc.Todo{},
+		Conflicts: []SyncConflict{},
+		Results:   make([]SyncOperationResult, 0, len(ops)),
+	}
+
+	err = s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+
+		if _, err := repo.GetTodoChangeSeqForUpdate(ctx, userID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("lock change sequence: %w", err)
+		}
+
+		for _, op := range ops {
+			opResult, conflicts, err := s.applyOperation(ctx, repo, userID, since, op)
+			if err != nil {
+				return err
+			}
+			result.Results = append(result.Results, opResult)
+			result.Conflicts = append(result.Conflicts, conflicts...)
+		}
+
+		// 1件多く読んで続きがあるかを判定する
+		changes, err := repo.ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{
+			UserID:  userID,
+			Since:   since,
+			AfterID: afterID,
+			MaxRows: int32(s.pageSize + 1),
+		})
+		if err != nil {
+			return fmt.Errorf("list changes: %w", err)
+		}
+		if len(changes) > s.pageSize {
+			changes = changes[:s.pageSize]
+			last := changes[len(changes)-1]
+			result.Changes = changes
+			result.HasMore = true
+			result.SyncToken = encodeSyncCursor(last.ChangeSeq, last.ID)
+			return nil
+		}
+		result.Changes = changes
+
+		seq, err := repo.GetTodoChangeSeqForUpdate(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("get change sequence: %w", err)
+		}
+		result.SyncToken = encodeSyncToken(seq)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *SyncService) applyOperation(ctx context.Context, repo SyncRepository, userID, since int64, op SyncOperation) (SyncOperationResult, []SyncConflict, error) {
+	result := SyncOperationResult{ClientID: op.ClientID}
+	if msg := validateSyncOperation(op); msg != "" {
+		result.Status = SyncStatusRejected
+		result.Error = msg
+		return result, nil, nil
+	}
+
+	// 未来日時はクライアントの時計ずれとみなしてサーバー時刻に丸める
+	if now := s.now(); op.UpdatedAt.After(now) {
+		op.UpdatedAt = now
+	}
+
+	existing, err := repo.GetTodoByClientIDForUpdate(ctx, sqlc.GetTodoByClientIDForUpdateParams{
+		UserID:   userID,
+		ClientID: op.ClientID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		if op.Op == SyncOpDelete {
+			// サーバーに存在しないTodoの削除は何もしない
+			result.Status = SyncStatusUnchanged
+			return result, nil, nil
+		}
+		if op.Title == nil {
+			result.Status = SyncStatusRejected
+			result.Error = "title is required to create a todo"
+			return result, nil, nil
+		}
+		completed := op.Completed != nil && *op.Completed
+		// ユーザー行はロック済みのため、末尾の位置を読んでから追加しても競合しない
+		last, err := repo.GetLastTodoPosition(ctx, userID)
+		if err != nil {
+			return result, nil, fmt.Errorf("get last position: %w", err)
+		}
+		position, err := rank.Between(last, "")
+		if err != nil {
+			return result, nil, fmt.Errorf("append position: %w", err)
+		}
+		project, err := loadNewTodoProject(ctx, repo, userID)
+		if err != nil {
+			return result, nil, err
+		}
+		todo, err := repo.CreateSyncedTodo(ctx, sqlc.CreateSyncedTodoParams{
+			UserID:         userID,
+			ClientID:       op.ClientID,
+			Title:          *op.Title,
+			Description:    op.Description,
+			Completed:      completed,
+			Position:       position,
+			ProjectID:      project.resolve(nil),
+			FieldUpdatedAt: op.UpdatedAt,
+		})
+		if err != nil {
+			return result, nil, fmt.Errorf("create todo: %w", err)
+		}
+		result.Status = SyncStatusCreated
+		result.TodoID = &todo.ID
+		return result, nil, nil
+	}
+	if err != nil {
+		return result, nil, fmt.Errorf("get todo by client id: %w", err)
+	}
+	result.TodoID = &existing.ID
+
+	// 削除済み（トゥームストーン）は復活させない
+	if existing.DeletedAt.Valid {
+		result.Status = SyncStatusUnchanged
+		if op.Op == SyncOpDelete {
+			return result, nil, nil
+		}
+		return result, []SyncConflict{{
+			ClientID:    op.ClientID,
+			Field:       "deleted",
+			ClientValue: false,
+			ServerValue: true,
+			Resolution:  SyncResolutionServer,
+		}}, nil
+	}
+
+	if op.Op == SyncOpDelete {
+		resolution, conflict := resolveSyncDelete(&existing, since, op.UpdatedAt)
+		if resolution == SyncResolutionServer {
+			result.Status = SyncStatusUnchanged
+			return result, []SyncConflict{{
+				ClientID:    op.ClientID,
+				Field:       "deleted",
+				ClientValue: true,
+				ServerValue: false,
+				Resolution:  SyncResolutionServer,
+			}}, nil
+		}
+		if _, err := repo.DeleteSyncedTodo(ctx, sqlc.DeleteSyncedTodoParams{
+			UserID: userID,
+			ID:     existing.ID,
+		}); err != nil {
+			return result, nil, fmt.Errorf("delete todo: %w", err)
+		}
+		result.Status = SyncStatusDeleted
+		if conflict {
+			return result, []SyncConflict{{
+				ClientID:    op.ClientID,
+				Field:       "deleted",
+				ClientValue: true,
+				ServerValue: false,
+				Resolution:  SyncResolutionClient,
+			}}, nil
+		}
+		return result, nil, nil
+	}
+
+	params, changed, conflicts := mergeSyncedTodo(&existing, since, op)
+	if !changed {
+		result.Status = SyncStatusUnchanged
+		return result, conflicts, nil
+	}
+	// 完了にする場合は他の経路と同じくブロッカーを確認し、ブロックされていれば操作全体を拒否する
+	if params.Completed && !existing.Completed && !op.Force {
+		err := ensureTodoNotBlocked(ctx, repo, userID, existing.ID)
+		if errors.Is(err, ErrTodoBlocked) {
+			result.Status = SyncStatusRejected
+			result.Error = "todo has open blockers"
+			return result, nil, nil
+		}
+		if err != nil {
+			return result, nil, err
+		}
+	}
+	if _, err := repo.ApplySyncedTodo(ctx, params); err != nil {
+		return result, nil, fmt.Errorf("apply todo: %w", err)
+	}
+	result.Status = SyncStatusUpdated
+	return result, conflicts, nil
+}
+
+func validateSyncOperation(op SyncOperation) string {
+	if !op.ClientID.Valid {
+		return "client_id is required"
+	}
+	if op.Op != SyncOpUpsert && op.Op != SyncOpDelete {
+		return "op must be upsert or delete"
+	}
+	if op.UpdatedAt.IsZero() {
+		return "updated_at is required"
+	}
+	if op.Title != nil && *op.Title == "" {
+		return "title must not be empty"
+	}
+	return ""
+}
+
+// 削除操作の解決
+// クライアントの同期以降にサーバー側で変更がなければそのまま削除する
+// 並行して変更されていた場合は、削除日時より後にサーバー側で変更されたフィールドがあればサーバーを優先する
+func resolveSyncDelete(existing *sqlc.Todo, since int64, deletedAt time.Time) (resolution SyncResolution, conflict bool) {
+	if existing.ChangeSeq <= since {
+		return SyncResolutionClient, false
+	}
+	for _, t := range []time.Time{existing.TitleUpdatedAt, existing.DescriptionUpdatedAt, existing.CompletedUpdatedAt} {
+		if t.After(deletedAt) {
+			return SyncResolutionServer, true
+		}
+	}
+	return SyncResolutionClient, true
+}
+
+// upsert操作をフィールド単位でマージする
+// クライアントの同期以降にサーバー側で変更がなければクライアントの値を採用する
+// 並行して変更されていた場合は、値が異なるフィールドごとに変更日時が新しい方を採用し、競合として記録する
+func mergeSyncedTodo(existing *sqlc.Todo, since int64, op SyncOperation) (sqlc.ApplySyncedTodoParams, bool, []SyncConflict) {
+	params := sqlc.ApplySyncedTodoParams{
+		UserID:               existing.UserID,
+		ID:                   existing.ID,
+		Title:                existing.Title,
+		Description:          existing.Description,
+		Completed:            existing.Completed,
+		TitleUpdatedAt:       existing.TitleUpdatedAt,
+		DescriptionUpdatedAt: existing.DescriptionUpdatedAt,
+		CompletedUpdatedAt:   existing.CompletedUpdatedAt,
+	}
+	concurrent := existing.ChangeSeq > since
+	changed := false
+	var conflicts []SyncConflict
+
+	// 1フィールド分の判定。クライアントの値を採用する場合は true を返す
+	resolve := func(field string, clientValue, serverValue any, serverUpdatedAt time.Time) bool {
+		if !concurrent {
+			return true
+		}
+		conflict := SyncConflict{
+			ClientID:    op.ClientID,
+			Field:       field,
+			ClientValue: clientValue,
+			ServerValue: serverValue,
+			Resolution:  SyncResolutionServer,
+		}
+		if op.UpdatedAt.After(serverUpdatedAt) {
+			conflict.Resolution = SyncResolutionClient
+		}
+		conflicts = append(conflicts, conflict)
+		return conflict.Resolution == SyncResolutionClient
+	}
+
+	if op.Title != nil && *op.Title != existing.Title {
+		if resolve("title", *op.Title, existing.Title, existing.TitleUpdatedAt) {
+			params.Title = *op.Title
+			params.TitleUpdatedAt = laterTime(existing.TitleUpdatedAt, op.UpdatedAt)
+			changed = true
+		}
+	}
+	if op.Description != nil && (existing.Description == nil || *op.Description != *existing.Description) {
+		if resolve("description", *op.Description, existing.Description, existing.DescriptionUpdatedAt) {
+			params.Description = op.Description
+			params.DescriptionUpdatedAt = laterTime(existing.DescriptionUpdatedAt, op.UpdatedAt)
+			changed = true
+		}
+	}
+	if op.ClearDescription && existing.Description != nil {
+		if resolve("description", nil, existing.Description, existing.DescriptionUpdatedAt) {
+			params.Description = nil
+			params.DescriptionUpdatedAt = laterTime(existing.DescriptionUpdatedAt, op.UpdatedAt)
+			changed = true
+		}
+	}
+	if op.Completed != nil && *op.Completed != existing.Completed {
+		if resolve("completed", *op.Completed, existing.Completed, existing.CompletedUpdatedAt) {
+			params.Completed = *op.Completed
+			params.CompletedUpdatedAt = laterTime(existing.CompletedUpdatedAt, op.UpdatedAt)
+			changed = true
+		}
+	}
+
+	return params, changed, conflicts
+}
+
+// フィールドの変更日時が巻き戻らないように新しい方を返す
+func laterTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+const syncTokenPrefix = "v1:"
+
+// 同期トークンは変更シーケンス番号を不透明な文字列にしたもの
+func encodeSyncToken(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(syncTokenPrefix + strconv.FormatInt(seq, 10)))
+}
+
+// 続きのトークンは途中まで返した変更の位置（変更シーケンス番号とID）を表す
+// 同じシーケンス番号の変更が複数あってもページの境界で取りこぼさないようにIDも含める
+func encodeSyncCursor(seq, id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(syncTokenPrefix + strconv.FormatInt(seq, 10) + ":" + strconv.FormatInt(id, 10)))
+}
+
+// 同期トークンと続きのトークンの両方を受け付ける
+// 同期トークンの場合 afterID は nil で、シーケンス番号より後の変更をすべて対象にする
+func decodeSyncCursor(token string) (since int64, afterID *int64, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, nil, ErrInvalidSyncToken
+	}
+	value, ok := strings.CutPrefix(string(raw), syncTokenPrefix)
+	seqPart, idPart, isCursor := strings.Cut(value, ":")
+	if !ok || !isCursor {
+		since, err := decodeSyncToken(token)
+		return since, nil, err
+	}
+	seq, err := strconv.ParseInt(seqPart, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, nil, ErrInvalidSyncToken
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, nil, ErrInvalidSyncToken
+	}
+	return seq, &id, nil
+}
+
+// 空のトークンは初回同期を表し、削除済みを含む全件を対象にする
+func decodeSyncToken(token string) (int64, error) {
+	if token == "" {
+		return -1, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidSyncToken
+	}
+	value, ok := strings.CutPrefix(string(raw), syncTokenPrefix)
+	if !ok {
+		return 0, ErrInvalidSyncToken
+	}
+	seq, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, ErrInvalidSyncToken
+	}
+	return seq, nil
+}
@@ -0,0 +1,669 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// トランザクション内でも同じモックリポジトリを使うSyncService
+func newTxTestSyncService(repo SyncRepository, now time.Time) *SyncService {
+	svc := NewSyncService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) SyncRepository { return repo }
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func testClientID() pgtype.UUID {
+	return pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+}
+
+func TestSyncToken(t *testing.T) {
+	t.Run("正常系: エンコードしたトークンをデコードできる", func(t *testing.T) {
+		for _, seq := range []int64{0, 1, 42, 1 << 40} {
+			got, err := decodeSyncToken(encodeSyncToken(seq))
+			require.NoError(t, err)
+			assert.Equal(t, seq, got)
+		}
+	})
+
+	t.Run("正常系: 空のトークンは初回同期として-1を返す", func(t *testing.T) {
+		got, err := decodeSyncToken("")
+		require.NoError(t, err)
+		assert.Equal(t, int64(-1), got)
+	})
+
+	t.Run("正常系: 続きのトークンからシーケンス番号とIDを取り出せる", func(t *testing.T) {
+		since, afterID, err := decodeSyncCursor(encodeSyncCursor(42, 7))
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), since)
+		require.NotNil(t, afterID)
+		assert.Equal(t, int64(7), *afterID)
+	})
+
+	t.Run("正常系: 続きでない同期トークンはIDなしで受け付ける", func(t *testing.T) {
+		since, afterID, err := decodeSyncCursor(encodeSyncToken(42))
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), since)
+		assert.Nil(t, afterID)
+
+		since, afterID, err = decodeSyncCursor("")
+		require.NoError(t, err)
+		assert.Equal(t, int64(-1), since)
+		assert.Nil(t, afterID)
+	})
+
+	t.Run("異常系: 不正な続きのトークンはErrInvalidSyncTokenを返す", func(t *testing.T) {
+		tests := []string{
+			"djE6MTo",    // "v1:1:"
+			"djE6MTph",   // "v1:1:a"
+			"djE6MTow",   // "v1:1:0"
+			"djE6LTE6MQ", // "v1:-1:1"
+		}
+		for _, token := range tests {
+			_, _, err := decodeSyncCursor(token)
+			assert.ErrorIs(t, err, ErrInvalidSyncToken, token)
+		}
+	})
+
+	t.Run("異常系: 不正なトークンはErrInvalidSyncTokenを返す", func(t *testing.T) {
+		tests := []string{
+			"not base64!",
+			"djE6",     // "v1:"
+			"djE6YWJj", // "v1:abc"
+			"djI6MQ",   // "v2:1"
+			"djE6LTE",  // "v1:-1"
+		}
+		for _, token := range tests {
+			_, err := decodeSyncToken(token)
+			assert.ErrorIs(t, err, ErrInvalidSyncToken, token)
+		}
+	})
+}
+
+func TestSyncService_Sync_Validation(t *testing.T) {
+	svc := NewSyncService(nil, nil)
+	ctx := context.Background()
+
+	t.Run("異常系: 操作数が上限を超える場合はErrTooManySyncOperationsを返す", func(t *testing.T) {
+		ops := make([]SyncOperation, MaxSyncOperations+1)
+
+		result, err := svc.Sync(ctx, 1, "", ops)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrTooManySyncOperations)
+	})
+
+	t.Run("異常系: 不正なトークンはErrInvalidSyncTokenを返す", func(t *testing.T) {
+		result, err := svc.Sync(ctx, 1, "invalid", nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidSyncToken)
+	})
+}
+
+func TestSyncService_Sync(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	now := base.Add(24 * time.Hour)
+	// トークン（シーケンス番号3）以降にサーバー側で変更された既存のTodo
+	newExisting := func() sqlc.Todo {
+		return sqlc.Todo{
+			ID:                   10,
+			UserID:               userID,
+			Title:                "server title",
+			ClientID:             testClientID(),
+			ChangeSeq:            5,
+			TitleUpdatedAt:       base.Add(2 * time.Hour),
+			DescriptionUpdatedAt: base,
+			CompletedUpdatedAt:   base,
+		}
+	}
+	expectLookup := func(mockRepo *mocks.MockSyncRepository, todo sqlc.Todo, err error) {
+		mockRepo.EXPECT().
+			GetTodoByClientIDForUpdate(ctx, sqlc.GetTodoByClientIDForUpdateParams{UserID: userID, ClientID: testClientID()}).
+			Return(todo, err)
+	}
+	expectChanges := func(mockRepo *mocks.MockSyncRepository, since int64, afterID *int64, changes []sqlc.Todo) {
+		mockRepo.EXPECT().
+			ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: since, AfterID: afterID, MaxRows: SyncPageSize + 1}).
+			Return(changes, nil)
+	}
+
+	t.Run("正常系: 並行した変更はフィールドごとにマージして競合を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		existing := newExisting()
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil).Once()
+		expectLookup(mockRepo, existing, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{10}}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			ApplySyncedTodo(ctx, sqlc.ApplySyncedTodoParams{
+				UserID:               userID,
+				ID:                   10,
+				Title:                "server title",
+				Completed:            true,
+				TitleUpdatedAt:       base.Add(2 * time.Hour),
+				DescriptionUpdatedAt: base,
+				CompletedUpdatedAt:   base.Add(90 * time.Minute),
+			}).
+			Return(sqlc.Todo{}, nil)
+		expectChanges(mockRepo, 3, nil, []sqlc.Todo{existing})
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(6), nil).Once()
+
+		result, err := svc.Sync(ctx, userID, encodeSyncToken(3), []SyncOperation{{
+			ClientID:  testClientID(),
+			Op:        SyncOpUpsert,
+			UpdatedAt: base.Add(90 * time.Minute),
+			Title:     ptrString("client title"),
+			Completed: ptrBool(true),
+		}})
+
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, SyncStatusUpdated, result.Results[0].Status)
+		require.Len(t, result.Conflicts, 2)
+		assert.Equal(t, "title", result.Conflicts[0].Field)
+		assert.Equal(t, SyncResolutionServer, result.Conflicts[0].Resolution)
+		assert.Equal(t, "completed", result.Conflicts[1].Field)
+		assert.Equal(t, SyncResolutionClient, result.Conflicts[1].Resolution)
+		assert.Equal(t, encodeSyncToken(6), result.SyncToken)
+		assert.False(t, result.HasMore)
+	})
+
+	t.Run("正常系: 未来日時の変更はサーバー時刻に丸めて判定する", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, base.Add(time.Hour))
+		existing := newExisting()
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil)
+		expectLookup(mockRepo, existing, nil)
+		expectChanges(mockRepo, 3, nil, []sqlc.Todo{})
+
+		result, err := svc.Sync(ctx, userID, encodeSyncToken(3), []SyncOperation{{
+			ClientID:  testClientID(),
+			Op:        SyncOpUpsert,
+			UpdatedAt: base.Add(48 * time.Hour),
+			Title:     ptrString("client title"),
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncStatusUnchanged, result.Results[0].Status)
+		require.Len(t, result.Conflicts, 1)
+		assert.Equal(t, SyncResolutionServer, result.Conflicts[0].Resolution)
+	})
+
+	t.Run("正常系: ブロッカーが残っているTodoを完了にする操作は拒否する", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		existing := newExisting()
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil)
+		expectLookup(mockRepo, existing, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{10}}).
+			Return([]int64{10}, nil)
+		expectChanges(mockRepo, 5, nil, []sqlc.Todo{})
+
+		result, err := svc.Sync(ctx, userID, encodeSyncToken(5), []SyncOperation{{
+			ClientID:  testClientID(),
+			Op:        SyncOpUpsert,
+			UpdatedAt: base.Add(3 * time.Hour),
+			Completed: ptrBool(true),
+		}})
+
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, SyncStatusRejected, result.Results[0].Status)
+		assert.Equal(t, "todo has open blockers", result.Results[0].Error)
+		mockRepo.AssertNotCalled(t, "ApplySyncedTodo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: forceを指定するとブロッカーを確認せずに完了にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		existing := newExisting()
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil).Once()
+		expectLookup(mockRepo, existing, nil)
+		mockRepo.EXPECT().
+			ApplySyncedTodo(ctx, mock.MatchedBy(func(p sqlc.ApplySyncedTodoParams) bool { return p.Completed })).
+			Return(sqlc.Todo{}, nil)
+		expectChanges(mockRepo, 5, nil, []sqlc.Todo{})
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(6), nil).Once()
+
+		result, err := svc.Sync(ctx, userID, encodeSyncToken(5), []SyncOperation{{
+			ClientID:  testClientID(),
+			Op:        SyncOpUpsert,
+			UpdatedAt: base.Add(3 * time.Hour),
+			Completed: ptrBool(true),
+			Force:     true,
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncStatusUpdated, result.Results[0].Status)
+		mockRepo.AssertNotCalled(t, "ListOpenBlockedTodoIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 削除後にサーバーで変更されたTodoは削除しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil)
+		expectLookup(mockRepo, newExisting(), nil)
+		expectChanges(mockRepo, 3, nil, []sqlc.Todo{})
+
+		result, err := svc.Sync(ctx, userID, encodeSyncToken(3), []SyncOperation{{
+			ClientID:  testClientID(),
+			Op:        SyncOpDelete,
+			UpdatedAt: base.Add(time.Hour),
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncStatusUnchanged, result.Results[0].Status)
+		require.Len(t, result.Conflicts, 1)
+		assert.Equal(t, "deleted", result.Conflicts[0].Field)
+		assert.Equal(t, SyncResolutionServer, result.Conflicts[0].Resolution)
+		mockRepo.AssertNotCalled(t, "DeleteSyncedTodo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: サーバーの変更より後の削除は競合として記録して削除する", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil)
+		expectLookup(mockRepo, newExisting(), nil)
+		mockRepo.EXPECT().
+			DeleteSyncedTodo(ctx, sqlc.DeleteSyncedTodoParams{UserID: userID, ID: 10}).
+			Return(sqlc.Todo{}, nil)
+		expectChanges(mockRepo, 3, nil, []sqlc.Todo{})
+
+		result, err := svc.Sync(ctx, userID, encodeSyncToken(3), []SyncOperation{{
+			ClientID:  testClientID(),
+			Op:        SyncOpDelete,
+			UpdatedAt: base.Add(3 * time.Hour),
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncStatusDeleted, result.Results[0].Status)
+		require.Len(t, result.Conflicts, 1)
+		assert.Equal(t, SyncResolutionClient, result.Conflicts[0].Resolution)
+	})
+
+	t.Run("正常系: 削除済みのTodoへのupsertは復活させずに競合を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		existing := newExisting()
+		existing.DeletedAt = pgtype.Timestamptz{Time: base, Valid: true}
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil)
+		expectLookup(mockRepo, existing, nil)
+		expectChanges(mockRepo, 3, nil, []sqlc.Todo{})
+
+		result, err := svc.Sync(ctx, userID, encodeSyncToken(3), []SyncOperation{{
+			ClientID:  testClientID(),
+			Op:        SyncOpUpsert,
+			UpdatedAt: base.Add(3 * time.Hour),
+			Title:     ptrString("client title"),
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncStatusUnchanged, result.Results[0].Status)
+		require.Len(t, result.Conflicts, 1)
+		assert.Equal(t, "deleted", result.Conflicts[0].Field)
+		assert.Equal(t, SyncResolutionServer, result.Conflicts[0].Resolution)
+		mockRepo.AssertNotCalled(t, "ApplySyncedTodo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: サーバーにないTodoは末尾に既定のプロジェクトで作成する", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		projectID := int64(4)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil)
+		expectLookup(mockRepo, sqlc.Todo{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().GetLastTodoPosition(ctx, userID).Return("", nil)
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{UserID: userID, DefaultProjectID: &projectID}, nil)
+		mockRepo.EXPECT().
+			CreateSyncedTodo(ctx, mock.MatchedBy(func(arg sqlc.CreateSyncedTodoParams) bool {
+				return arg.Title == "new" && arg.Description == nil && arg.Position != "" && arg.FieldUpdatedAt.Equal(base) &&
+					assert.ObjectsAreEqual(&projectID, arg.ProjectID)
+			})).
+			Return(sqlc.Todo{ID: 11}, nil)
+		expectChanges(mockRepo, -1, nil, []sqlc.Todo{})
+
+		result, err := svc.Sync(ctx, userID, "", []SyncOperation{{
+			ClientID:         testClientID(),
+			Op:               SyncOpUpsert,
+			UpdatedAt:        base,
+			Title:            ptrString("new"),
+			ClearDescription: true,
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncStatusCreated, result.Results[0].Status)
+		assert.Equal(t, int64(11), *result.Results[0].TodoID)
+	})
+
+	t.Run("正常系: 変更が上限を超える場合は続きのトークンを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		svc.pageSize = 2
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(9), nil).Once()
+		mockRepo.EXPECT().
+			ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: -1, MaxRows: 3}).
+			Return([]sqlc.Todo{{ID: 1, ChangeSeq: 4}, {ID: 2, ChangeSeq: 4}, {ID: 3, ChangeSeq: 4}}, nil)
+
+		result, err := svc.Sync(ctx, userID, "", nil)
+
+		require.NoError(t, err)
+		assert.True(t, result.HasMore)
+		require.Len(t, result.Changes, 2)
+		// 同じシーケンス番号の残りを取りこぼさないようにIDも続きの位置に含める
+		assert.Equal(t, encodeSyncCursor(4, 2), result.SyncToken)
+	})
+
+	t.Run("正常系: 続きのトークンで残りの変更を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		svc.pageSize = 2
+		afterID := int64(2)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(9), nil).Twice()
+		mockRepo.EXPECT().
+			ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: 4, AfterID: &afterID, MaxRows: 3}).
+			Return([]sqlc.Todo{{ID: 3, ChangeSeq: 4}}, nil)
+
+		result, err := svc.Sync(ctx, userID, encodeSyncCursor(4, 2), nil)
+
+		require.NoError(t, err)
+		assert.False(t, result.HasMore)
+		require.Len(t, result.Changes, 1)
+		assert.Equal(t, encodeSyncToken(9), result.SyncToken)
+	})
+
+	t.Run("異常系: ユーザーが存在しない場合はErrUserNotFoundを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockSyncRepository(t)
+		svc := newTxTestSyncService(mockRepo, now)
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(0), pgx.ErrNoRows)
+
+		result, err := svc.Sync(ctx, userID, "", nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestValidateSyncOperation(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		op   SyncOperation
+		want string
+	}{
+		{
+			name: "正常系: upsert",
+			op:   SyncOperation{ClientID: testClientID(), Op: SyncOpUpsert, UpdatedAt: now, Title: ptrString("title")},
+			want: "",
+		},
+		{
+			name: "正常系: delete",
+			op:   SyncOperation{ClientID: testClientID(), Op: SyncOpDelete, UpdatedAt: now},
+			want: "",
+		},
+		{
+			name: "異常系: client_idがない",
+			op:   SyncOperation{Op: SyncOpUpsert, UpdatedAt: now},
+			want: "client_id is required",
+		},
+		{
+			name: "異常系: 不明な操作",
+			op:   SyncOperation{ClientID: testClientID(), Op: "move", UpdatedAt: now},
+			want: "op must be upsert or delete",
+		},
+		{
+			name: "異常系: updated_atがない",
+			op:   SyncOperation{ClientID: testClientID(), Op: SyncOpUpsert},
+			want: "updated_at is required",
+		},
+		{
+			name: "異常系: 空のタイトル",
+			op:   SyncOperation{ClientID: testClientID(), Op: SyncOpUpsert, UpdatedAt: now, Title: ptrString("")},
+			want: "title must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validateSyncOperation(tt.op))
+		})
+	}
+}
+
+func TestMergeSyncedTodo(t *testing.T) {
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	newExisting := func() *sqlc.Todo {
+		return &sqlc.Todo{
+			ID:                   10,
+			UserID:               1,
+			Title:                "server title",
+			Description:          ptrString("server description"),
+			Completed:            false,
+			ClientID:             testClientID(),
+			ChangeSeq:            5,
+			TitleUpdatedAt:       base,
+			DescriptionUpdatedAt: base,
+			CompletedUpdatedAt:   base,
+		}
+	}
+
+	t.Run("正常系: 同期以降にサーバー側の変更がなければクライアントの値を競合なしで採用する", func(t *testing.T) {
+		existing := newExisting()
+		// クライアントの時計が遅れていても、サーバー側が変更されていなければクライアントを優先する
+		op := SyncOperation{
+			ClientID:  testClientID(),
+			Op:        SyncOpUpsert,
+			UpdatedAt: base.Add(-time.Hour),
+			Title:     ptrString("client title"),
+			Completed: ptrBool(true),
+		}
+
+		params, changed, conflicts := mergeSyncedTodo(existing, 5, op)
+
+		assert.True(t, changed)
+		assert.Empty(t, conflicts)
+		assert.Equal(t, "client title", params.Title)
+		assert.Equal(t, "server description", *params.Description)
+		assert.True(t, params.Completed)
+		// フィールドの変更日時は巻き戻らない
+		assert.Equal(t, base, params.TitleUpdatedAt)
+		assert.Equal(t, int64(10), params.ID)
+		assert.Equal(t, int64(1), params.UserID)
+	})
+
+	t.Run("正常系: 並行して変更された場合はフィールドごとに新しい方を採用して競合を記録する", func(t *testing.T) {
+		existing := newExisting()
+		existing.TitleUpdatedAt = base.Add(2 * time.Hour)
+		op := SyncOperation{
+			ClientID:    testClientID(),
+			Op:          SyncOpUpsert,
+			UpdatedAt:   base.Add(time.Hour),
+			Title:       ptrString("client title"),
+			Description: ptrString("client description"),
+		}
+
+		params, changed, conflicts := mergeSyncedTodo(existing, 3, op)
+
+		assert.True(t, changed)
+		// titleはサーバーの方が新しい
+		assert.Equal(t, "server title", params.Title)
+		assert.Equal(t, base.Add(2*time.Hour), params.TitleUpdatedAt)
+		// descriptionはクライアントの方が新しい
+		assert.Equal(t, "client description", *params.Description)
+		assert.Equal(t, base.Add(time.Hour), params.DescriptionUpdatedAt)
+
+		require.Len(t, conflicts, 2)
+		assert.Equal(t, "title", conflicts[0].Field)
+		assert.Equal(t, SyncResolutionServer, conflicts[0].Resolution)
+		assert.Equal(t, "client title", conflicts[0].ClientValue)
+		assert.Equal(t, "server title", conflicts[0].ServerValue)
+		assert.Equal(t, "description", conflicts[1].Field)
+		assert.Equal(t, SyncResolutionClient, conflicts[1].Resolution)
+	})
+
+	t.Run("正常系: 変更日時が同じ場合はサーバーを優先する", func(t *testing.T) {
+		existing := newExisting()
+		op := SyncOperation{
+			ClientID:  testClientID(),
+			Op:        SyncOpUpsert,
+			UpdatedAt: base,
+			Completed: ptrBool(true),
+		}
+
+		params, changed, conflicts := mergeSyncedTodo(existing, -1, op)
+
+		assert.False(t, changed)
+		assert.False(t, params.Completed)
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, SyncResolutionServer, conflicts[0].Resolution)
+	})
+
+	t.Run("正常系: 値が同じフィールドは変更も競合もなし", func(t *testing.T) {
+		existing := newExisting()
+		op := SyncOperation{
+			ClientID:    testClientID(),
+			Op:          SyncOpUpsert,
+			UpdatedAt:   base.Add(time.Hour),
+			Title:       ptrString("server title"),
+			Description: ptrString("server description"),
+			Completed:   ptrBool(false),
+		}
+
+		_, changed, conflicts := mergeSyncedTodo(existing, -1, op)
+
+		assert.False(t, changed)
+		assert.Empty(t, conflicts)
+	})
+
+	t.Run("正常系: サーバーのdescriptionがnullでもクライアントの値を設定できる", func(t *testing.T) {
+		existing := newExisting()
+		existing.Description = nil
+		op := SyncOperation{
+			ClientID:    testClientID(),
+			Op:          SyncOpUpsert,
+			UpdatedAt:   base.Add(time.Hour),
+			Description: ptrString("client description"),
+		}
+
+		params, changed, _ := mergeSyncedTodo(existing, -1, op)
+
+		assert.True(t, changed)
+		assert.Equal(t, "client description", *params.Description)
+	})
+
+	t.Run("正常系: nullが送られた場合はdescriptionを削除する", func(t *testing.T) {
+		existing := newExisting()
+		op := SyncOperation{
+			ClientID:         testClientID(),
+			Op:               SyncOpUpsert,
+			UpdatedAt:        base.Add(time.Hour),
+			ClearDescription: true,
+		}
+
+		params, changed, conflicts := mergeSyncedTodo(existing, 5, op)
+
+		assert.True(t, changed)
+		assert.Empty(t, conflicts)
+		assert.Nil(t, params.Description)
+		assert.Equal(t, base.Add(time.Hour), params.DescriptionUpdatedAt)
+	})
+
+	t.Run("正常系: 削除より後にサーバーでdescriptionが変更されていればサーバーを優先する", func(t *testing.T) {
+		existing := newExisting()
+		existing.DescriptionUpdatedAt = base.Add(2 * time.Hour)
+		op := SyncOperation{
+			ClientID:         testClientID(),
+			Op:               SyncOpUpsert,
+			UpdatedAt:        base.Add(time.Hour),
+			ClearDescription: true,
+		}
+
+		params, changed, conflicts := mergeSyncedTodo(existing, 3, op)
+
+		assert.False(t, changed)
+		assert.Equal(t, "server description", *params.Description)
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, "description", conflicts[0].Field)
+		assert.Nil(t, conflicts[0].ClientValue)
+		assert.Equal(t, SyncResolutionServer, conflicts[0].Resolution)
+	})
+
+	t.Run("正常系: descriptionが既にnullなら削除しても変更なし", func(t *testing.T) {
+		existing := newExisting()
+		existing.Description = nil
+		op := SyncOperation{
+			ClientID:         testClientID(),
+			Op:               SyncOpUpsert,
+			UpdatedAt:        base.Add(time.Hour),
+			ClearDescription: true,
+		}
+
+		_, changed, conflicts := mergeSyncedTodo(existing, 3, op)
+
+		assert.False(t, changed)
+		assert.Empty(t, conflicts)
+	})
+}
+
+func TestResolveSyncDelete(t *testing.T) {
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	existing := &sqlc.Todo{
+		ChangeSeq:            5,
+		TitleUpdatedAt:       base,
+		DescriptionUpdatedAt: base,
+		CompletedUpdatedAt:   base.Add(time.Hour),
+	}
+
+	tests := []struct {
+		name           string
+		since          int64
+		deletedAt      time.Time
+		wantResolution SyncResolution
+		wantConflict   bool
+	}{
+		{
+			name:           "同期以降にサーバー側の変更がなければ削除する",
+			since:          5,
+			deletedAt:      base.Add(-time.Hour),
+			wantResolution: SyncResolutionClient,
+			wantConflict:   false,
+		},
+		{
+			name:           "削除後にサーバー側で変更されていればサーバーを優先する",
+			since:          4,
+			deletedAt:      base.Add(30 * time.Minute),
+			wantResolution: SyncResolutionServer,
+			wantConflict:   true,
+		},
+		{
+			name:           "サーバー側の変更より後に削除されていれば削除する",
+			since:          4,
+			deletedAt:      base.Add(2 * time.Hour),
+			wantResolution: SyncResolutionClient,
+			wantConflict:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolution, conflict := resolveSyncDelete(existing, tt.since, tt.deletedAt)
+			assert.Equal(t, tt.wantResolution, resolution)
+			assert.Equal(t, tt.wantConflict, conflict)
+		})
+	}
+}
@@ -9,12 +9,35 @@ import (
 type TodoRepository interface {
 	GetTodoByID(ctx context.Context, arg sqlc.GetTodoByIDParams) (sqlc.Todo, error)
 	ListTodosByUser(ctx context.Context, userID int64) ([]sqlc.Todo, error)
+	ListTodosByUserManual(ctx context.Context, userID int64) ([]sqlc.Todo, error)
 	CreateTodo(ctx context.Context, arg sqlc.CreateTodoParams) (sqlc.Todo, error)
 	UpdateTodo(ctx context.Context, arg sqlc.UpdateTodoParams) (sqlc.Todo, error)
-	DeleteTodo(ctx context.Context, arg sqlc.DeleteTodoParams) error
-	GetTodosByIDs(ctx context.Context, arg sqlc.GetTodosByIDsParams) ([]sqlc.Todo, error)
+	SetTodoDueAt(ctx context.Context, arg sqlc.SetTodoDueAtParams) (sqlc.Todo, error)
+	DeleteTodo(ctx context.Context, arg sqlc.DeleteTodoParams) (int64, error)
+	GetTodosByIDsForUpdate(ctx context.Context, arg sqlc.GetTodosByIDsForUpdateParams) ([]sqlc.Todo, error)
 	BatchCompleteTodos(ctx context.Context, arg sqlc.BatchCompleteTodosParams) ([]sqlc.Todo, error)
 	BatchDeleteTodos(ctx context.Context, arg sqlc.BatchDeleteTodosParams) error
+	BatchUpdateTodos(ctx context.Context, arg sqlc.BatchUpdateTodosParams) ([]sqlc.Todo, error)
+	NextTodoChangeSeq(ctx context.Context, id int64) (int64, error)
+	CopyTodos(ctx context.Context, arg []sqlc.CopyTodosParams) (int64, error)
+	ListExistingTodoTitles(ctx context.Context, arg sqlc.ListExistingTodoTitlesParams) ([]string, error)
+	GetTodoChangeSeq(ctx context.Context, id int64) (int64, error)
+	GetTodoChangeSeqForUpdate(ctx context.Context, id int64) (int64, error)
+	ListTodoChangesPage(ctx context.Context, arg sqlc.ListTodoChangesPageParams) ([]sqlc.Todo, error)
+	CountTodosByFilter(ctx context.Context, arg sqlc.CountTodosByFilterParams) (int64, error)
+	ListTodoIDsByFilter(ctx context.Context, arg sqlc.ListTodoIDsByFilterParams) ([]int64, error)
+	GetLastTodoPosition(ctx context.Context, userID int64) (string, error)
+	GetNextTodoPosition(ctx context.Context, arg sqlc.GetNextTodoPositionParams) (string, error)
+	GetPrevTodoPosition(ctx context.Context, arg sqlc.GetPrevTodoPositionParams) (string, error)
+	UpdateTodoPosition(ctx context.Context, arg sqlc.UpdateTodoPositionParams) (sqlc.Todo, error)
+	ListTodoIDsByPosition(ctx context.Context, userID int64) ([]int64, error)
+	SetTodoPositions(ctx context.Context, arg sqlc.SetTodoPositionsParams) error
+	ListUsersWithLongTodoPositions(ctx context.Context, maxLength int32) ([]int64, error)
+	ListOpenBlockedTodoIDs(ctx context.Context, arg sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error)
+	ListBlockedTodoIDs(ctx context.Context, userID int64) ([]int64, error)
+	EnsureProject(ctx context.Context, arg sqlc.EnsureProjectParams) (int64, error)
+	CreateQuickAddTodo(ctx context.Context, arg sqlc.CreateQuickAddTodoParams) (sqlc.Todo, error)
+	GetUserSettings(ctx context.Context, userID int64) (sqlc.UserSetting, error)
 }
 
 // sqlc.Querier が TodoRepository を満たすことを保証
@@ -2,41 +2,305 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"time"
 
 	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+	"go-todo/internal/importer"
+	"go-todo/internal/rank"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-var ErrTodoNotFound = errors.New("todo not found")
+const (
+	// インポート時に1回のCOPYで書き込む件数
+	importChunkSize = 1000
+	// インポート結果に含める行エラーの最大件数
+	maxReportedImportErrors = 100
+	// プレビューで返すTodoの最大件数
+	maxPreviewImportItems = 1000
+	// 一括ジョブで指定できるIDの最大件数
+	MaxBulkJobIDs = 10000
+	// 一括ジョブで1回に処理する件数
+	bulkJobChunkSize = 500
+)
+
+var (
+	ErrTodoNotFound        = errors.New("todo not found")
+	ErrTodoVersionMismatch = errors.New("todo version mismatch")
+	ErrImportAborted       = errors.New("import aborted")
+	ErrInvalidBulkFilter   = errors.New("invalid bulk todo filter")
+	ErrTooManyBatchItems   = errors.New("too many batch items")
+	ErrInvalidMove         = errors.New("invalid todo move")
+	ErrMoveAnchorNotFound  = errors.New("move anchor todo not found")
+	// 未完了のブロッカーが残っているTodoは force を指定しない限り完了にできない
+	ErrTodoBlocked = errors.New("todo has open blockers")
+)
+
+// Todo一覧の並び順
+type TodoSort string
+
+const (
+	// 作成日時の新しい順（デフォルト）
+	TodoSortCreatedAt TodoSort = "created_at"
+	// ユーザーが並べ替えた順（position の昇順）
+	TodoSortManual TodoSort = "manual"
+)
+
+// 楽観的排他制御でバージョンが一致しなかった場合のエラー
+// Current には現在のTodoが入る（412レスポンスの本文に使用）
+type TodoVersionMismatchError struct {
+	Current *sqlc.Todo
+}
+
+func (e *TodoVersionMismatchError) Error() string {
+	return ErrTodoVersionMismatch.Error()
+}
+
+func (e *TodoVersionMismatchError) Unwrap() error {
+	return ErrTodoVersionMismatch
+}
 
 // バッチ処理の結果
 type BatchCompleteResult struct {
 	Succeeded []sqlc.Todo
 	Failed    []BatchFailedItem
+	Warnings  []BatchWarning
 }
 
 type BatchDeleteResult struct {
 	Succeeded []int64
 	Failed    []BatchFailedItem
+	Warnings  []BatchWarning
 }
 
+// バッチ処理で失敗した項目の理由
+type BatchErrorCode string
+
+const (
+	BatchErrorNotFound  BatchErrorCode = "not_found"
+	BatchErrorInvalidID BatchErrorCode = "invalid_id"
+	// atomicモードで他の項目が失敗したため処理しなかった
+	BatchErrorRolledBack BatchErrorCode = "rolled_back"
+	// 未完了のブロッカーが残っているため完了にしなかった（force で無視できる）
+	BatchErrorBlocked BatchErrorCode = "blocked"
+)
+
 type BatchFailedItem struct {
 	ID    int64
+	Code  BatchErrorCode
 	Error string
 }
 
+// バッチ処理の結果には影響しないが、リクエストを補正した内容
+type BatchWarningCode string
+
+const (
+	BatchWarningDuplicateID BatchWarningCode = "duplicate_id"
+	// 既に完了していたため何もせずに成功とした
+	BatchWarningAlreadyCompleted BatchWarningCode = "already_completed"
+)
+
+type BatchWarning struct {
+	ID      int64
+	Code    BatchWarningCode
+	Message string
+}
+
+// バッチ更新で適用する変更（nil のフィールドは変更しない）
+type TodoPatch struct {
+	Title       *string
+	Description *string
+	Completed   *bool
+}
+
+func (p TodoPatch) IsEmpty() bool {
+	return p.Title == nil && p.Description == nil && p.Completed == nil
+}
+
+type BatchUpdateOptions struct {
+	// 1件でも失敗した場合は何も更新しない
+	Atomic bool
+	// 更新を行わず、変更内容だけを返す
+	DryRun bool
+	// 完了にする場合に、未完了のブロッカーが残っていても完了にする
+	Force bool
+}
+
+type BatchUpdateResult struct {
+	Succeeded []sqlc.Todo
+	Failed    []BatchFailedItem
+	Warnings  []BatchWarning
+	Changes   []BatchUpdateChange
+}
+
+// Todoごとに値が変わるフィールド
+type BatchUpdateChange struct {
+	ID     int64
+	Fields []string
+}
+
+// strictモードのインポートが不正な行で中断された場合のエラー
+type ImportAbortedError struct {
+	LineError importer.LineError
+}
+
+func (e *ImportAbortedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrImportAborted.Error(), e.LineError.Error())
+}
+
+func (e *ImportAbortedError) Unwrap() error {
+	return ErrImportAborted
+}
+
+type BatchCreateItem struct {
+	Title       string
+	Description *string
+}
+
+type BatchCreateResult struct {
+	Succeeded []BatchCreatedItem
+	Failed    []BatchCreateFailedItem
+}
+
+// Index はリクエスト内での位置
+type BatchCreatedItem struct {
+	Index int
+	Todo  sqlc.Todo
+}
+
+type BatchCreateFailedItem struct {
+	Index int
+	Error string
+}
+
+// インポートの指定
+type ImportOptions struct {
+	// 最初の不正な行で中断してロールバックする
+	Strict bool
+	// 保存せずに、読み込んだTodoを返す
+	Preview bool
+	// 未削除のTodoや先に読み込んだTodoと件名が同じTodoを読み飛ばす
+	Dedupe bool
+}
+
+// プレビューの場合、Imported は保存する件数
+type ImportResult struct {
+	Imported int64
+	Failed   int
+	// 件名の重複で読み飛ばした件数
+	Skipped int
+	Errors  []importer.LineError
+	// プレビューで読み込んだTodo（先頭の maxPreviewImportItems 件）
+	Items []importer.Item
+}
+
+// 変更フィードの結果
+// HasMore が true の場合、NextToken は返した最後の変更の続きを表す
+type TodoChanges struct {
+	Todos      []sqlc.Todo
+	DeletedIDs []int64
+	NextToken  string
+	HasMore    bool
+}
+
+// 一括ジョブの対象を絞り込む条件（指定されていない条件は無視する）
+type BulkTodoFilter struct {
+	IDs           []int64    `json:"ids,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+	Completed     *bool      `json:"completed,omitempty"`
+	ProjectID     *int64     `json:"project_id,omitempty"`
+}
+
+func (f BulkTodoFilter) Validate() error {
+	// 空のIDリストは「すべて」と区別できないため受け付けない
+	if f.IDs != nil && len(f.IDs) == 0 {
+		return fmt.Errorf("%w: ids must not be empty", ErrInvalidBulkFilter)
+	}
+	if len(f.IDs) > MaxBulkJobIDs {
+		return fmt.Errorf("%w: ids must not exceed %d items", ErrInvalidBulkFilter, MaxBulkJobIDs)
+	}
+	return nil
+}
+
+// 一括完了ジョブのパラメータ
+type CompleteTodosJobParams struct {
+	BulkTodoFilter
+	// 未完了のブロッカーが残っているTodoも完了にする
+	Force bool `json:"force,omitempty"`
+}
+
+// 一括ジョブの結果
+// Skipped は未完了のブロッカーが残っているなどの理由で処理しなかった件数
+type BulkJobResult struct {
+	Affected int32 `json:"affected"`
+	Skipped  int32 `json:"skipped,omitempty"`
+}
+
 type TodoService struct {
-	repo TodoRepository
+	repo      TodoRepository
+	txManager database.TxManager
+	// バッチ処理で1回に受け付ける最大件数
+	maxBatchItems int
+	// 変更一覧で1回に返す最大件数
+	changesPageSize int
+	// トランザクション内で使うリポジトリを作成する
+	withTx func(tx pgx.Tx) TodoRepository
+}
+
+func NewTodoService(repo TodoRepository, pool *pgxpool.Pool, maxBatchItems int) *TodoService {
+	return &TodoService{
+		repo:            repo,
+		txManager:       database.NewTxManager(pool),
+		maxBatchItems:   maxBatchItems,
+		changesPageSize: SyncPageSize,
+		withTx: func(tx pgx.Tx) TodoRepository {
+			return sqlc.New(tx)
+		},
+	}
 }
 
-func NewTodoService(repo TodoRepository) *TodoService {
-	return &TodoService{repo: repo}
+// バッチ処理で1回に受け付ける最大件数
+func (s *TodoService) MaxBatchItems() int {
+	return s.maxBatchItems
 }
 
-func (s *TodoService) GetAllTodos(ctx context.Context, userID int64) ([]sqlc.Todo, error) {
-	return s.repo.ListTodosByUser(ctx, userID)
+// actionable が true の場合は未完了のブロッカーがないTodoだけを返す
+func (s *TodoService) GetAllTodos(ctx context.Context, userID int64, sort TodoSort, actionable bool) ([]sqlc.Todo, error) {
+	var todos []sqlc.Todo
+	var err error
+	if sort == TodoSortManual {
+		todos, err = s.repo.ListTodosByUserManual(ctx, userID)
+	} else {
+		todos, err = s.repo.ListTodosByUser(ctx, userID)
+	}
+	if err != nil || !actionable {
+		return todos, err
+	}
+
+	blockedIDs, err := s.repo.ListBlockedTodoIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list blocked todos: %w", err)
+	}
+	blocked := make(map[int64]bool, len(blockedIDs))
+	for _, id := range blockedIDs {
+		blocked[id] = true
+	}
+	result := make([]sqlc.Todo, 0, len(todos))
+	for _, todo := range todos {
+		if !blocked[todo.ID] {
+			result = append(result, todo)
+		}
+	}
+	return result, nil
 }
 
 func (s *TodoService) GetTodoByID(ctx context.Context, id, userID int64) (*sqlc.Todo, error) {
@@ -53,28 +317,320 @@ func (s *TodoService) GetTodoByID(ctx context.Context, id, userID int64) (*sqlc.
 	return &todo, nil
 }
 
-func (s *TodoService) CreateTodo(ctx context.Context, userID int64, title string, description *string) (*sqlc.Todo, error) {
-	todo, err := s.repo.CreateTodo(ctx, sqlc.CreateTodoParams{
-		UserID:      userID,
-		Title:       title,
-		Description: description,
+// 新しいTodoは手動の並び順の末尾に追加し、ユーザー設定の既定のプロジェクトに入れる
+func (s *TodoService) CreateTodo(ctx context.Context, userID int64, title string, description *string, dueAt *time.Time) (*sqlc.Todo, error) {
+	var todo sqlc.Todo
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		project, err := loadNewTodoProject(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		positions, err := appendTodoPositions(ctx, repo, userID, 1)
+		if err != nil {
+			return err
+		}
+		todo, err = repo.CreateTodo(ctx, sqlc.CreateTodoParams{
+			UserID:      userID,
+			Title:       title,
+			Description: description,
+			Position:    positions[0],
+			DueAt:       timestamptz(dueAt),
+			ProjectID:   project.resolve(nil),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// 複数のTodoを1トランザクションで作成する
+// タイトルが空の項目は失敗として記録し、それ以外を作成する（CreateTodo と同様に既定のプロジェクトに入れる）
+func (s *TodoService) BatchCreateTodos(ctx context.Context, userID int64, items []BatchCreateItem) (*BatchCreateResult, error) {
+	if len(items) > s.maxBatchItems {
+		return nil, ErrTooManyBatchItems
+	}
+
+	result := &BatchCreateResult{
+		Succeeded: []BatchCreatedItem{},
+		Failed:    []BatchCreateFailedItem{},
+	}
+
+	var validIndexes []int
+	for i, item := range items {
+		if item.Title == "" {
+			result.Failed = append(result.Failed, BatchCreateFailedItem{
+				Index: i,
+				Error: "Title is required",
+			})
+			continue
+		}
+		validIndexes = append(validIndexes, i)
+	}
+
+	if len(validIndexes) == 0 {
+		return result, nil
+	}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		project, err := loadNewTodoProject(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		positions, err := appendTodoPositions(ctx, repo, userID, len(validIndexes))
+		if err != nil {
+			return err
+		}
+		for n, i := range validIndexes {
+			todo, err := repo.CreateTodo(ctx, sqlc.CreateTodoParams{
+				UserID:      userID,
+				Title:       items[i].Title,
+				Description: items[i].Description,
+				Position:    positions[n],
+				ProjectID:   project.resolve(nil),
+			})
+			if err != nil {
+				return fmt.Errorf("create todo at index %d: %w", i, err)
+			}
+			result.Succeeded = append(result.Succeeded, BatchCreatedItem{Index: i, Todo: todo})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// インポート形式のReaderから読み込んだTodoを COPY で一括登録する
+// 全体を1トランザクションで実行し、strict が true の場合は最初の不正な行で中断してロールバックする
+// プレビューの場合は何も書き込まず、保存するTodoを返す
+func (s *TodoService) ImportTodos(ctx context.Context, userID int64, src importer.Reader, opts ImportOptions) (*ImportResult, error) {
+	result := &ImportResult{
+		Errors: []importer.LineError{},
+	}
+
+	if opts.Preview {
+		result.Items = []importer.Item{}
+		dedupe := newImportDeduper(s.repo, userID, opts.Dedupe)
+		err := readImportChunks(src, opts.Strict, result, func(chunk []importer.Item) error {
+			chunk, err := dedupe.filter(ctx, chunk, result)
+			if err != nil {
+				return err
+			}
+			result.Imported += int64(len(chunk))
+			n := min(len(chunk), maxPreviewImportItems-len(result.Items))
+			result.Items = append(result.Items, chunk[:n]...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+
+		// インポートした全件に同じ変更シーケンス番号を割り当てる
+		// ユーザー行が更新でロックされるため、以降の位置の計算と重複の確認は他の追加・移動と競合しない
+		seq, err := repo.NextTodoChangeSeq(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("next change sequence: %w", err)
+		}
+		last, err := repo.GetLastTodoPosition(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("get last position: %w", err)
+		}
+		project, err := loadNewTodoProject(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+		dedupe := newImportDeduper(repo, userID, opts.Dedupe)
+
+		rows := make([]sqlc.CopyTodosParams, 0, importChunkSize)
+		return readImportChunks(src, opts.Strict, result, func(chunk []importer.Item) error {
+			chunk, err := dedupe.filter(ctx, chunk, result)
+			if err != nil {
+				return err
+			}
+			if len(chunk) == 0 {
+				return nil
+			}
+			positions, err := rank.Append(last, len(chunk))
+			if err != nil {
+				return fmt.Errorf("append positions: %w", err)
+			}
+			last = positions[len(positions)-1]
+
+			rows = rows[:0]
+			for i, item := range chunk {
+				rows = append(rows, sqlc.CopyTodosParams{
+					UserID:      userID,
+					Title:       item.Title,
+					Description: item.Description,
+					Completed:   item.Completed,
+					Position:    positions[i],
+					ChangeSeq:   seq,
+					DueAt:       timestamptz(item.DueAt),
+					ProjectID:   project.resolve(nil),
+				})
+			}
+			n, err := repo.CopyTodos(ctx, rows)
+			if err != nil {
+				return fmt.Errorf("copy todos: %w", err)
+			}
+			result.Imported += n
+			return nil
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
+
+	return result, nil
+}
+
+// Readerから importChunkSize 件ずつ読み込んで fn に渡す
+// 不正な行は result に記録し、strict が true の場合は中断する
+func readImportChunks(src importer.Reader, strict bool, result *ImportResult, fn func([]importer.Item) error) error {
+	chunk := make([]importer.Item, 0, importChunkSize)
+	for {
+		item, err := src.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var lineErr *importer.LineError
+		if errors.As(err, &lineErr) {
+			if strict {
+				return &ImportAbortedError{LineError: *lineErr}
+			}
+			result.Failed++
+			if len(result.Errors) < maxReportedImportErrors {
+				result.Errors = append(result.Errors, *lineErr)
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk = append(chunk, *item)
+		if len(chunk) == importChunkSize {
+			if err := fn(chunk); err != nil {
+				return err
+			}
+			chunk = chunk[:0]
+		}
+	}
+	if len(chunk) == 0 {
+		return nil
+	}
+	return fn(chunk)
+}
+
+// インポートするTodoから件名が重複するものを取り除く
+type importDeduper struct {
+	repo    TodoRepository
+	userID  int64
+	enabled bool
+	// これまでに読み込んだ件名
+	seen map[string]struct{}
+}
+
+func newImportDeduper(repo TodoRepository, userID int64, enabled bool) *importDeduper {
+	return &importDeduper{repo: repo, userID: userID, enabled: enabled, seen: map[string]struct{}{}}
+}
+
+// 未削除のTodoか、先に読み込んだTodoと件名が同じTodoを取り除き、取り除いた件数を result に加える
+// 返すスライスは chunk の領域を再利用する
+func (d *importDeduper) filter(ctx context.Context, chunk []importer.Item, result *ImportResult) ([]importer.Item, error) {
+	if !d.enabled {
+		return chunk, nil
+	}
+	titles := make([]string, 0, len(chunk))
+	for _, item := range chunk {
+		if _, ok := d.seen[item.Title]; !ok {
+			titles = append(titles, item.Title)
+		}
+	}
+	existing, err := d.repo.ListExistingTodoTitles(ctx, sqlc.ListExistingTodoTitlesParams{
+		UserID: d.userID,
+		Titles: titles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list existing titles: %w", err)
+	}
+	for _, title := range existing {
+		d.seen[title] = struct{}{}
+	}
+
+	kept := chunk[:0]
+	for _, item := range chunk {
+		if _, ok := d.seen[item.Title]; ok {
+			result.Skipped++
+			continue
+		}
+		d.seen[item.Title] = struct{}{}
+		kept = append(kept, item)
+	}
+	return kept, nil
+}
+
+// expectedVersions のいずれかと現在のバージョンが一致する場合だけ更新する（nil の場合は検証しない。If-Match: *）
+// 完了にする場合は未完了のブロッカーがないことを確認する（force が true の場合は確認しない）
+func (s *TodoService) UpdateTodo(ctx context.Context, id, userID int64, expectedVersions []int32, title, description *string, completed *bool, force bool) (*sqlc.Todo, error) {
+	params := sqlc.UpdateTodoParams{
+		ID:               id,
+		UserID:           userID,
+		Title:            title,
+		Description:      description,
+		Completed:        completed,
+		ExpectedVersions: expectedVersions,
+	}
+
+	var todo sqlc.Todo
+	var err error
+	if completed == nil || !*completed || force {
+		todo, err = s.repo.UpdateTodo(ctx, params)
+	} else {
+		err = s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+			repo := s.withTx(tx)
+			// ブロッカーの追加と再開はユーザー行のロックで直列化されるため、確認後にブロックされることはない
+			if err := lockTodoPositions(ctx, repo, userID); err != nil {
+				return err
+			}
+			if err := ensureTodoNotBlocked(ctx, repo, userID, id); err != nil {
+				return err
+			}
+			todo, err = repo.UpdateTodo(ctx, params)
+			return err
+		})
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, s.resolveVersionMismatch(ctx, id, userID, expectedVersions)
+	}
+	if err != nil {
+		return nil, err
+	}
 	return &todo, nil
 }
 
-func (s *TodoService) UpdateTodo(ctx context.Context, id, userID int64, title, description *string, completed *bool) (*sqlc.Todo, error) {
-	todo, err := s.repo.UpdateTodo(ctx, sqlc.UpdateTodoParams{
-		ID:          id,
-		UserID:      userID,
-		Title:       title,
-		Description: description,
-		Completed:   completed,
+// 期限を設定する（dueAt が nil の場合は期限を外す）
+// 期限からの相対時刻で指定したリマインダーは、次の配信時に新しい期限を基準にする
+func (s *TodoService) SetDueAt(ctx context.Context, id, userID int64, expectedVersions []int32, dueAt *time.Time) (*sqlc.Todo, error) {
+	todo, err := s.repo.SetTodoDueAt(ctx, sqlc.SetTodoDueAtParams{
+		UserID:           userID,
+		DueAt:            timestamptz(dueAt),
+		ID:               id,
+		ExpectedVersions: expectedVersions,
 	})
 	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, ErrTodoNotFound
+		return nil, s.resolveVersionMismatch(ctx, id, userID, expectedVersions)
 	}
 	if err != nil {
 		return nil, err
@@ -82,21 +638,437 @@ func (s *TodoService) UpdateTodo(ctx context.Context, id, userID int64, title, d
 	return &todo, nil
 }
 
-func (s *TodoService) DeleteTodo(ctx context.Context, id, userID int64) error {
-	return s.repo.DeleteTodo(ctx, sqlc.DeleteTodoParams{
-		ID:     id,
-		UserID: userID,
+func (s *TodoService) DeleteTodo(ctx context.Context, id, userID int64, expectedVersions []int32) error {
+	rows, err := s.repo.DeleteTodo(ctx, sqlc.DeleteTodoParams{
+		ID:               id,
+		UserID:           userID,
+		ExpectedVersions: expectedVersions,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return s.resolveVersionMismatch(ctx, id, userID, expectedVersions)
+	}
+	return nil
+}
+
+// Todoを beforeID のTodoの直前、または afterID のTodoの直後に移動する（両方指定した場合はその間）
+// ユーザー行をロックして同じユーザーの移動を直列化するため、同時に移動しても並び順は壊れない
+func (s *TodoService) MoveTodo(ctx context.Context, id, userID int64, beforeID, afterID *int64) (*sqlc.Todo, error) {
+	if beforeID == nil && afterID == nil {
+		return nil, ErrInvalidMove
+	}
+	if (beforeID != nil && *beforeID == id) || (afterID != nil && *afterID == id) {
+		return nil, ErrInvalidMove
+	}
+
+	var moved sqlc.Todo
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+
+		if err := lockTodoPositions(ctx, repo, userID); err != nil {
+			return err
+		}
+		_, err := repo.GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: id, UserID: userID})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTodoNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("get todo: %w", err)
+		}
+
+		lower, upper, err := moveBounds(ctx, repo, id, userID, beforeID, afterID)
+		if err != nil {
+			return err
+		}
+		if upper != "" && lower >= upper {
+			// 同じ位置のTodoが並んでいて間にキーを作れないため、振り直してから求め直す
+			if err := rebalanceTodoPositions(ctx, repo, userID); err != nil {
+				return err
+			}
+			if lower, upper, err = moveBounds(ctx, repo, id, userID, beforeID, afterID); err != nil {
+				return err
+			}
+		}
+
+		position, err := rank.Between(lower, upper)
+		if err != nil {
+			return fmt.Errorf("position between %q and %q: %w", lower, upper, err)
+		}
+		moved, err = repo.UpdateTodoPosition(ctx, sqlc.UpdateTodoPositionParams{
+			UserID:   userID,
+			Position: position,
+			ID:       id,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &moved, nil
+}
+
+// 移動先の直前・直後のキーを返す（空文字は先頭・末尾を表す）
+func moveBounds(ctx context.Context, repo TodoRepository, id, userID int64, beforeID, afterID *int64) (string, string, error) {
+	var before, after *sqlc.Todo
+	var err error
+	if beforeID != nil {
+		if before, err = getMoveAnchor(ctx, repo, *beforeID, userID); err != nil {
+			return "", "", err
+		}
+	}
+	if afterID != nil {
+		if after, err = getMoveAnchor(ctx, repo, *afterID, userID); err != nil {
+			return "", "", err
+		}
+	}
+
+	switch {
+	case before != nil && after != nil:
+		// after が before より後ろにある場合は間に移動できない
+		if after.Position > before.Position || (after.Position == before.Position && after.ID > before.ID) {
+			return "", "", ErrInvalidMove
+		}
+		return after.Position, before.Position, nil
+	case after != nil:
+		upper, err := repo.GetNextTodoPosition(ctx, sqlc.GetNextTodoPositionParams{
+			UserID:         userID,
+			ExcludeID:      id,
+			AnchorPosition: after.Position,
+			AnchorID:       after.ID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return after.Position, "", nil
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("get next position: %w", err)
+		}
+		return after.Position, upper, nil
+	default:
+		lower, err := repo.GetPrevTodoPosition(ctx, sqlc.GetPrevTodoPositionParams{
+			UserID:         userID,
+			ExcludeID:      id,
+			AnchorPosition: before.Position,
+			AnchorID:       before.ID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", before.Position, nil
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("get previous position: %w", err)
+		}
+		return lower, before.Position, nil
+	}
+}
+
+func getMoveAnchor(ctx context.Context, repo TodoRepository, id, userID int64) (*sqlc.Todo, error) {
+	todo, err := repo.GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: id, UserID: userID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrMoveAnchorNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get anchor todo: %w", err)
+	}
+	return &todo, nil
+}
+
+// ユーザー行をロックし、同じユーザーのTodoの位置を変更する処理を直列化する
+func lockTodoPositions(ctx context.Context, repo TodoRepository, userID int64) error {
+	_, err := repo.GetTodoChangeSeqForUpdate(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("lock user: %w", err)
+	}
+	return nil
+}
+
+// 末尾に追加する n 件分の位置を返す
+func appendTodoPositions(ctx context.Context, repo TodoRepository, userID int64, n int) ([]string, error) {
+	if err := lockTodoPositions(ctx, repo, userID); err != nil {
+		return nil, err
+	}
+	last, err := repo.GetLastTodoPosition(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get last position: %w", err)
+	}
+	positions, err := rank.Append(last, n)
+	if err != nil {
+		return nil, fmt.Errorf("append positions: %w", err)
+	}
+	return positions, nil
+}
+
+// ユーザーのTodoの位置を等間隔のキーで振り直す（並び順は変わらない）
+// 位置が変わったTodoはバージョンと変更シーケンスを進め、ETagと同期の変更として扱われるようにする
+func rebalanceTodoPositions(ctx context.Context, repo TodoRepository, userID int64) error {
+	ids, err := repo.ListTodoIDsByPosition(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list todo ids by position: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := repo.SetTodoPositions(ctx, sqlc.SetTodoPositionsParams{
+		Ids:       ids,
+		Positions: rank.Spread(len(ids)),
+		UserID:    userID,
+	}); err != nil {
+		return fmt.Errorf("set todo positions: %w", err)
+	}
+	return nil
+}
+
+// 位置のキーが maxLength を超えたユーザーのTodoを振り直し、振り直したユーザー数を返す
+func (s *TodoService) RebalancePositions(ctx context.Context, maxLength int) (int, error) {
+	userIDs, err := s.repo.ListUsersWithLongTodoPositions(ctx, int32(maxLength))
+	if err != nil {
+		return 0, fmt.Errorf("list users with long positions: %w", err)
+	}
+
+	rebalanced := 0
+	for _, userID := range userIDs {
+		err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+			repo := s.withTx(tx)
+			if err := lockTodoPositions(ctx, repo, userID); err != nil {
+				return err
+			}
+			return rebalanceTodoPositions(ctx, repo, userID)
+		})
+		if errors.Is(err, ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return rebalanced, fmt.Errorf("rebalance positions (user_id=%d): %w", userID, err)
+		}
+		rebalanced++
+	}
+	return rebalanced, nil
+}
+
+// 位置のキーが長くなりすぎたTodoを定期的に振り直す
+func (s *TodoService) RunRebalance(ctx context.Context, interval time.Duration, maxLength int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.RebalancePositions(ctx, maxLength)
+			if err != nil {
+				log.Printf("Failed to rebalance todo positions: %v", err)
+			}
+			if n > 0 {
+				log.Printf("Rebalanced todo positions for %d users", n)
+			}
+		}
+	}
+}
+
+// 更新対象が0件だった原因（存在しない or バージョン不一致）を判定する
+func (s *TodoService) resolveVersionMismatch(ctx context.Context, id, userID int64, expectedVersions []int32) error {
+	if expectedVersions == nil {
+		return ErrTodoNotFound
+	}
+	current, err := s.GetTodoByID(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	return &TodoVersionMismatchError{Current: current}
+}
+
+// 同期トークン以降に作成・更新・削除されたTodoを返す
+// トークンが空の場合は全件（削除済みを含む）を対象にする
+// 変更が多い場合はページに分け、続きのトークンを返す
+func (s *TodoService) ListChanges(ctx context.Context, userID int64, token string) (*TodoChanges, error) {
+	since, afterID, err := decodeSyncCursor(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 一覧より先にシーケンスを読むことで、間にコミットされた変更を取りこぼさない
+	seq, err := s.repo.GetTodoChangeSeq(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 1件多く読んで続きがあるかを判定する
+	todos, err := s.repo.ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{
+		UserID:  userID,
+		Since:   since,
+		AfterID: afterID,
+		MaxRows: int32(s.changesPageSize + 1),
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TodoChanges{
+		Todos:      []sqlc.Todo{},
+		DeletedIDs: []int64{},
+	}
+	if len(todos) > s.changesPageSize {
+		todos = todos[:s.changesPageSize]
+		last := todos[len(todos)-1]
+		result.HasMore = true
+		result.NextToken = encodeSyncCursor(last.ChangeSeq, last.ID)
+	}
+	for _, todo := range todos {
+		if todo.ChangeSeq > seq {
+			seq = todo.ChangeSeq
+		}
+		if todo.DeletedAt.Valid {
+			result.DeletedIDs = append(result.DeletedIDs, todo.ID)
+			continue
+		}
+		result.Todos = append(result.Todos, todo)
+	}
+	if result.HasMore {
+		return result, nil
+	}
+	if seq < since {
+		seq = since
+	}
+	result.NextToken = encodeSyncToken(seq)
+
+	return result, nil
 }
 
-func (s *TodoService) BatchCompleteTodos(ctx context.Context, userID int64, ids []int64) (*BatchCompleteResult, error) {
+// Todoを一括完了する
+// 対象の行をロックしてから状態を確認するため、確認から更新までの間に他のリクエストで削除・完了されることはない
+// 未完了のブロッカーが残っているTodoは force を指定しない限り blocked として失敗にする
+func (s *TodoService) BatchCompleteTodos(ctx context.Context, userID int64, ids []int64, atomic, force bool) (*BatchCompleteResult, error) {
+	if len(ids) > s.maxBatchItems {
+		return nil, ErrTooManyBatchItems
+	}
+
+	ids, invalid, warnings := normalizeBatchIDs(ids)
 	result := &BatchCompleteResult{
 		Succeeded: []sqlc.Todo{},
-		Failed:    []BatchFailedItem{},
+		Failed:    invalid,
+		Warnings:  warnings,
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+
+		if !force {
+			if err := lockTodoPositions(ctx, repo, userID); err != nil {
+				return err
+			}
+		}
+		todos, err := lockBatchTodos(ctx, repo, userID, ids)
+		if err != nil {
+			return err
+		}
+
+		// 既に完了しているTodoは何もせずに成功として返す
+		var validIDs, targetIDs []int64
+		for _, id := range ids {
+			todo, ok := todos[id]
+			switch {
+			case !ok:
+				result.Failed = append(result.Failed, batchNotFoundItem(id))
+			case todo.Completed:
+				validIDs = append(validIDs, id)
+				result.Warnings = append(result.Warnings, BatchWarning{
+					ID:      id,
+					Code:    BatchWarningAlreadyCompleted,
+					Message: "Todo was already completed",
+				})
+			default:
+				validIDs = append(validIDs, id)
+				targetIDs = append(targetIDs, id)
+			}
+		}
+		if !force {
+			blocked, err := findBlockedTodos(ctx, repo, userID, targetIDs)
+			if err != nil {
+				return err
+			}
+			validIDs, targetIDs = excludeBlockedItems(validIDs, targetIDs, blocked, &result.Failed)
+		}
+
+		if atomic && len(result.Failed) > 0 {
+			result.Failed = append(result.Failed, rolledBackItems(validIDs)...)
+			return nil
+		}
+
+		if len(targetIDs) > 0 {
+			completedTodos, err := repo.BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{
+				Ids:    targetIDs,
+				UserID: userID,
+			})
+			if err != nil {
+				return err
+			}
+			for _, todo := range completedTodos {
+				todos[todo.ID] = todo
+			}
+		}
+		for _, id := range validIDs {
+			result.Succeeded = append(result.Succeeded, todos[id])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// バッチ処理の対象IDを正規化する
+// 重複したIDは最初の1件だけを残して警告を返し、正でないIDは失敗項目として返す
+// 戻り値のIDは入力の順序を保ち、失敗項目と合わせると入力のIDをちょうど1回ずつ含む
+func normalizeBatchIDs(ids []int64) ([]int64, []BatchFailedItem, []BatchWarning) {
+	valid := make([]int64, 0, len(ids))
+	invalid := []BatchFailedItem{}
+	warnings := []BatchWarning{}
+
+	seen := make(map[int64]bool, len(ids))
+	warned := make(map[int64]bool)
+	for _, id := range ids {
+		if seen[id] {
+			if !warned[id] {
+				warnings = append(warnings, BatchWarning{
+					ID:      id,
+					Code:    BatchWarningDuplicateID,
+					Message: "Duplicate ID was processed once",
+				})
+				warned[id] = true
+			}
+			continue
+		}
+		seen[id] = true
+
+		if id <= 0 {
+			invalid = append(invalid, BatchFailedItem{
+				ID:    id,
+				Code:  BatchErrorInvalidID,
+				Error: "ID must be a positive integer",
+			})
+			continue
+		}
+		valid = append(valid, id)
 	}
+	return valid, invalid, warnings
+}
 
-	// 存在チェック
-	existingTodos, err := s.repo.GetTodosByIDs(ctx, sqlc.GetTodosByIDsParams{
+// バッチ処理の対象をIDの昇順に行ロック付きで取得する
+// 戻り値に含まれないIDは存在しないか他のユーザーのTodoで、どちらも batchNotFoundItem として扱う
+func lockBatchTodos(ctx context.Context, repo TodoRepository, userID int64, ids []int64) (map[int64]sqlc.Todo, error) {
+	rows, err := repo.GetTodosByIDsForUpdate(ctx, sqlc.GetTodosByIDsForUpdateParams{
 		Ids:    ids,
 		UserID: userID,
 	})
@@ -104,83 +1076,354 @@ func (s *TodoService) BatchCompleteTodos(ctx context.Context, userID int64, ids
 		return nil, err
 	}
 
-	// 存在するIDのマップを作成
-	existingIDMap := make(map[int64]bool)
-	for _, todo := range existingTodos {
-		existingIDMap[todo.ID] = true
+	todos := make(map[int64]sqlc.Todo, len(rows))
+	for _, todo := range rows {
+		todos[todo.ID] = todo
 	}
+	return todos, nil
+}
 
-	// 存在しないIDを失敗として記録
-	var validIDs []int64
-	for _, id := range ids {
-		if existingIDMap[id] {
-			validIDs = append(validIDs, id)
-		} else {
-			result.Failed = append(result.Failed, BatchFailedItem{
+// 他のユーザーのTodoも存在しないTodoと同じ結果にし、IDの存在を推測できないようにする
+func batchNotFoundItem(id int64) BatchFailedItem {
+	return BatchFailedItem{ID: id, Code: BatchErrorNotFound, Error: "Todo not found"}
+}
+
+// ブロックされたTodoを処理対象から外し、blocked の失敗項目として記録する
+// validIDs は結果に含めるすべての項目、targetIDs はそのうち実際に完了にする項目
+func excludeBlockedItems(validIDs, targetIDs []int64, blocked map[int64]bool, failed *[]BatchFailedItem) ([]int64, []int64) {
+	if len(blocked) == 0 {
+		return validIDs, targetIDs
+	}
+	for _, id := range targetIDs {
+		if blocked[id] {
+			*failed = append(*failed, BatchFailedItem{
 				ID:    id,
-				Error: "Todo not found",
+				Code:  BatchErrorBlocked,
+				Error: "Todo has open blockers",
 			})
 		}
 	}
+	isBlocked := func(id int64) bool { return blocked[id] }
+	return slices.DeleteFunc(validIDs, isBlocked), slices.DeleteFunc(targetIDs, isBlocked)
+}
 
-	// 有効なIDがある場合のみバッチ更新
-	if len(validIDs) > 0 {
-		completedTodos, err := s.repo.BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{
-			Ids:    validIDs,
-			UserID: userID,
+// atomicモードで他の項目が失敗した場合に、処理可能だった項目を失敗として記録する
+func rolledBackItems(ids []int64) []BatchFailedItem {
+	items := make([]BatchFailedItem, len(ids))
+	for i, id := range ids {
+		items[i] = BatchFailedItem{
+			ID:    id,
+			Code:  BatchErrorRolledBack,
+			Error: "Rolled back because another todo in the batch failed",
+		}
+	}
+	return items
+}
+
+// 完了にする場合は BatchCompleteTodos と同様に、未完了のブロッカーが残っているTodoを blocked として失敗にする
+func (s *TodoService) BatchUpdateTodos(ctx context.Context, userID int64, ids []int64, patch TodoPatch, opts BatchUpdateOptions) (*BatchUpdateResult, error) {
+	if len(ids) > s.maxBatchItems {
+		return nil, ErrTooManyBatchItems
+	}
+
+	ids, invalid, warnings := normalizeBatchIDs(ids)
+	result := &BatchUpdateResult{
+		Succeeded: []sqlc.Todo{},
+		Failed:    invalid,
+		Warnings:  warnings,
+		Changes:   []BatchUpdateChange{},
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	checkBlockers := patch.Completed != nil && *patch.Completed && !opts.Force
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+
+		if checkBlockers {
+			if err := lockTodoPositions(ctx, repo, userID); err != nil {
+				return err
+			}
+		}
+		todos, err := lockBatchTodos(ctx, repo, userID, ids)
+		if err != nil {
+			return err
+		}
+
+		var validIDs, completingIDs []int64
+		for _, id := range ids {
+			todo, ok := todos[id]
+			if !ok {
+				result.Failed = append(result.Failed, batchNotFoundItem(id))
+				continue
+			}
+			validIDs = append(validIDs, id)
+			if !todo.Completed {
+				completingIDs = append(completingIDs, id)
+			}
+		}
+		if checkBlockers {
+			blocked, err := findBlockedTodos(ctx, repo, userID, completingIDs)
+			if err != nil {
+				return err
+			}
+			validIDs, _ = excludeBlockedItems(validIDs, completingIDs, blocked, &result.Failed)
+		}
+
+		// atomicモードでは1件でも失敗があれば全件を失敗扱いにする
+		if opts.Atomic && len(result.Failed) > 0 {
+			result.Failed = append(result.Failed, rolledBackItems(validIDs)...)
+			return nil
+		}
+
+		for _, id := range validIDs {
+			result.Changes = append(result.Changes, BatchUpdateChange{
+				ID:     id,
+				Fields: patch.changedFields(todos[id]),
+			})
+		}
+
+		if len(validIDs) == 0 {
+			return nil
+		}
+
+		// dry-runでは更新後の状態を計算して返すだけにする
+		if opts.DryRun {
+			for _, id := range validIDs {
+				result.Succeeded = append(result.Succeeded, patch.apply(todos[id]))
+			}
+			return nil
+		}
+
+		updatedTodos, err := repo.BatchUpdateTodos(ctx, sqlc.BatchUpdateTodosParams{
+			Ids:         validIDs,
+			UserID:      userID,
+			Title:       patch.Title,
+			Description: patch.Description,
+			Completed:   patch.Completed,
 		})
 		if err != nil {
-			return nil, err
+			return err
 		}
-		result.Succeeded = completedTodos
+		result.Succeeded = updatedTodos
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return result, nil
 }
 
-func (s *TodoService) BatchDeleteTodos(ctx context.Context, userID int64, ids []int64) (*BatchDeleteResult, error) {
+// パッチを適用した場合に値が変わるフィールド名
+func (p TodoPatch) changedFields(t sqlc.Todo) []string {
+	fields := []string{}
+	if p.Title != nil && *p.Title != t.Title {
+		fields = append(fields, "title")
+	}
+	if p.Description != nil && (t.Description == nil || *p.Description != *t.Description) {
+		fields = append(fields, "description")
+	}
+	if p.Completed != nil && *p.Completed != t.Completed {
+		fields = append(fields, "completed")
+	}
+	return fields
+}
+
+func (p TodoPatch) apply(t sqlc.Todo) sqlc.Todo {
+	if p.Title != nil {
+		t.Title = *p.Title
+	}
+	if p.Description != nil {
+		t.Description = p.Description
+	}
+	if p.Completed != nil {
+		t.Completed = *p.Completed
+	}
+	return t
+}
+
+// Todoを一括削除する（BatchCompleteTodosと同様に対象の行をロックする）
+func (s *TodoService) BatchDeleteTodos(ctx context.Context, userID int64, ids []int64, atomic bool) (*BatchDeleteResult, error) {
+	if len(ids) > s.maxBatchItems {
+		return nil, ErrTooManyBatchItems
+	}
+
+	ids, invalid, warnings := normalizeBatchIDs(ids)
 	result := &BatchDeleteResult{
 		Succeeded: []int64{},
-		Failed:    []BatchFailedItem{},
+		Failed:    invalid,
+		Warnings:  warnings,
+	}
+	if len(ids) == 0 {
+		return result, nil
 	}
 
-	// 存在チェック
-	existingTodos, err := s.repo.GetTodosByIDs(ctx, sqlc.GetTodosByIDsParams{
-		Ids:    ids,
-		UserID: userID,
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+
+		todos, err := lockBatchTodos(ctx, repo, userID, ids)
+		if err != nil {
+			return err
+		}
+
+		var targetIDs []int64
+		for _, id := range ids {
+			if _, ok := todos[id]; ok {
+				targetIDs = append(targetIDs, id)
+			} else {
+				result.Failed = append(result.Failed, batchNotFoundItem(id))
+			}
+		}
+
+		if atomic && len(result.Failed) > 0 {
+			result.Failed = append(result.Failed, rolledBackItems(targetIDs)...)
+			return nil
+		}
+		if len(targetIDs) == 0 {
+			return nil
+		}
+
+		if err := repo.BatchDeleteTodos(ctx, sqlc.BatchDeleteTodosParams{
+			Ids:    targetIDs,
+			UserID: userID,
+		}); err != nil {
+			return err
+		}
+		result.Succeeded = targetIDs
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	existingIDMap := make(map[int64]bool)
-	for _, todo := range existingTodos {
-		existingIDMap[todo.ID] = true
+	return result, nil
+}
+
+// 条件に一致する未完了のTodoをすべて完了にするジョブ（JobHandler）
+// 未完了のブロッカーが残っているTodoは force を指定しない限り完了にせず、Skipped に数える
+// ブロッカーはチャンクごとに確認するため、後のチャンクで完了にするブロッカーも未完了として扱う
+func (s *TodoService) CompleteTodosJob(ctx context.Context, userID int64, params json.RawMessage, progress JobProgressFunc) (any, error) {
+	var jobParams CompleteTodosJobParams
+	if err := json.Unmarshal(params, &jobParams); err != nil {
+		return nil, fmt.Errorf("decode job params: %w", err)
 	}
+	filter := jobParams.BulkTodoFilter
+	// 完了済みのTodoは対象外
+	incomplete := false
+	filter.Completed = &incomplete
 
-	var validIDs []int64
-	for _, id := range ids {
-		if existingIDMap[id] {
-			validIDs = append(validIDs, id)
-		} else {
-			result.Failed = append(result.Failed, BatchFailedItem{
-				ID:    id,
-				Error: "Todo not found",
+	return s.runBulkTodoJob(ctx, userID, filter, progress, func(ids []int64) (int32, error) {
+		if jobParams.Force {
+			_, err := s.repo.BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{
+				Ids:    ids,
+				UserID: userID,
 			})
+			return 0, err
 		}
+
+		var skipped int32
+		err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+			repo := s.withTx(tx)
+			if err := lockTodoPositions(ctx, repo, userID); err != nil {
+				return err
+			}
+			blocked, err := findBlockedTodos(ctx, repo, userID, ids)
+			if err != nil {
+				return err
+			}
+			targetIDs := slices.DeleteFunc(slices.Clone(ids), func(id int64) bool { return blocked[id] })
+			skipped = int32(len(ids) - len(targetIDs))
+			if len(targetIDs) == 0 {
+				return nil
+			}
+			_, err = repo.BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{
+				Ids:    targetIDs,
+				UserID: userID,
+			})
+			return err
+		})
+		return skipped, err
+	})
+}
+
+// 条件に一致するTodoをすべて削除するジョブ（JobHandler）
+func (s *TodoService) DeleteTodosJob(ctx context.Context, userID int64, params json.RawMessage, progress JobProgressFunc) (any, error) {
+	var filter BulkTodoFilter
+	if err := json.Unmarshal(params, &filter); err != nil {
+		return nil, fmt.Errorf("decode job params: %w", err)
 	}
 
-	// バッチ削除実行
-	if len(validIDs) > 0 {
-		err := s.repo.BatchDeleteTodos(ctx, sqlc.BatchDeleteTodosParams{
-			Ids:    validIDs,
+	return s.runBulkTodoJob(ctx, userID, filter, progress, func(ids []int64) (int32, error) {
+		return 0, s.repo.BatchDeleteTodos(ctx, sqlc.BatchDeleteTodosParams{
+			Ids:    ids,
 			UserID: userID,
 		})
+	})
+}
+
+// 対象のTodoをID順にチャンクごとに処理し、チャンクごとに進捗を報告する
+// 各チャンクは1つのトランザクションで更新されるため、中断されても処理済みのチャンクはそのまま残る
+// apply はチャンクのうち処理しなかった件数を返す
+func (s *TodoService) runBulkTodoJob(ctx context.Context, userID int64, filter BulkTodoFilter, progress JobProgressFunc, apply func(ids []int64) (int32, error)) (*BulkJobResult, error) {
+	var createdBefore pgtype.Timestamptz
+	if filter.CreatedBefore != nil {
+		createdBefore = timestamptz(filter.CreatedBefore)
+	}
+
+	total, err := s.repo.CountTodosByFilter(ctx, sqlc.CountTodosByFilterParams{
+		UserID:        userID,
+		Ids:           filter.IDs,
+		CreatedBefore: createdBefore,
+		Completed:     filter.Completed,
+		ProjectID:     filter.ProjectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := progress(0, int32(total)); err != nil {
+		return nil, err
+	}
+
+	result := &BulkJobResult{}
+	var afterID int64
+	for {
+		ids, err := s.repo.ListTodoIDsByFilter(ctx, sqlc.ListTodoIDsByFilterParams{
+			UserID:        userID,
+			AfterID:       afterID,
+			Ids:           filter.IDs,
+			CreatedBefore: createdBefore,
+			Completed:     filter.Completed,
+			ProjectID:     filter.ProjectID,
+			MaxRows:       bulkJobChunkSize,
+		})
 		if err != nil {
-			return nil, err
+			return result, err
+		}
+		if len(ids) == 0 {
+			return result, nil
+		}
+		skipped, err := apply(ids)
+		if err != nil {
+			return result, err
+		}
+
+		result.Affected += int32(len(ids)) - skipped
+		result.Skipped += skipped
+		afterID = ids[len(ids)-1]
+		// 実行中に追加されたTodoも処理されるため、合計は処理済み件数を下回らないようにする
+		processed := result.Affected + result.Skipped
+		if err := progress(processed, max(int32(total), processed)); err != nil {
+			return result, err
 		}
-		result.Succeeded = validIDs
 	}
+}
 
-	return result, nil
+// nil の場合は NULL になる
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
 }
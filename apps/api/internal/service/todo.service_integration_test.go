@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// マイグレーション済みのデータベースに接続する（TEST_DATABASE_URL が未設定の場合はスキップする）
+func newIntegrationPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("Integration test - requires TEST_DATABASE_URL")
+	}
+	pool, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// テスト用のユーザーを作成する。ユーザーのデータはテストの終了時に削除する
+func createIntegrationUser(t *testing.T, pool *pgxpool.Pool) int64 {
+	t.Helper()
+	ctx := context.Background()
+	user, err := sqlc.New(pool).CreateUser(ctx, sqlc.CreateUserParams{
+		Email:      "integration@example.com",
+		Name:       "integration",
+		Provider:   "integration-test",
+		ProviderID: fmt.Sprintf("%s-%d", t.Name(), time.Now().UnixNano()),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := pool.Exec(context.Background(), "DELETE FROM users WHERE id = $1", user.ID)
+		assert.NoError(t, err)
+	})
+	return user.ID
+}
+
+func TestTodoService_MoveTodo_Integration(t *testing.T) {
+	pool := newIntegrationPool(t)
+	ctx := context.Background()
+
+	t.Run("正常系: 振り直したTodoはバージョンと変更シーケンスが進む", func(t *testing.T) {
+		userID := createIntegrationUser(t, pool)
+		queries := sqlc.New(pool)
+		svc := NewTodoService(queries, pool, testMaxBatchItems)
+
+		// 同じ位置のTodoが並んでいるため、間に移動すると振り直しが必要になる
+		var ids []int64
+		for _, title := range []string{"a", "b", "c"} {
+			var id int64
+			err := pool.QueryRow(ctx, "INSERT INTO todos (user_id, title, position) VALUES ($1, $2, 'V') RETURNING id", userID, title).Scan(&id)
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+		seq, err := queries.GetTodoChangeSeq(ctx, userID)
+		require.NoError(t, err)
+
+		_, err = svc.MoveTodo(ctx, ids[2], userID, nil, &ids[0])
+		require.NoError(t, err)
+
+		changes, err := queries.ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: seq, MaxRows: 100})
+		require.NoError(t, err)
+		changed := map[int64]sqlc.Todo{}
+		for _, todo := range changes {
+			changed[todo.ID] = todo
+		}
+		for _, id := range ids {
+			require.Contains(t, changed, id)
+			assert.Greater(t, changed[id].Version, int32(1))
+		}
+		// 並び順は a, c, b になる
+		order, err := queries.ListTodoIDsByPosition(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{ids[0], ids[2], ids[1]}, order)
+	})
+}
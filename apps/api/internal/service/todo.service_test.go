@@ -3,13 +3,19 @@ package service
 import (
 	"context"
 	"errors"
+	"io"
+	"slices"
 	"testing"
+	"testing/quick"
 	"time"
 
 	"go-todo/db/sqlc"
+	"go-todo/internal/importer"
+	"go-todo/internal/rank"
 	"go-todo/internal/service/mocks"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -18,7 +24,7 @@ import (
 func TestTodoService_GetTodoByID(t *testing.T) {
 	t.Run("正常系: Todoを取得できる", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
 
 		ctx := context.Background()
 		todoID := int64(1)
@@ -52,7 +58,7 @@ func TestTodoService_GetTodoByID(t *testing.T) {
 
 	t.Run("異常系: ErrTodoNotFoundを返す", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
 
 		ctx := context.Background()
 		todoID := int64(999)
@@ -73,7 +79,7 @@ func TestTodoService_GetTodoByID(t *testing.T) {
 
 	t.Run("異常系: その他のエラーをそのまま返す", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
 
 		ctx := context.Background()
 		todoID := int64(1)
@@ -97,7 +103,7 @@ func TestTodoService_GetTodoByID(t *testing.T) {
 func TestTodoService_GetAllTodos(t *testing.T) {
 	t.Run("正常系: Todo一覧を取得できる", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
 
 		ctx := context.Background()
 		userID := int64(1)
@@ -126,7 +132,7 @@ func TestTodoService_GetAllTodos(t *testing.T) {
 			ListTodosByUser(ctx, userID).
 			Return(expectedTodos, nil)
 
-		result, err := svc.GetAllTodos(ctx, userID)
+		result, err := svc.GetAllTodos(ctx, userID, TodoSortCreatedAt, false)
 
 		require.NoError(t, err)
 		assert.Len(t, result, 2)
@@ -136,7 +142,7 @@ func TestTodoService_GetAllTodos(t *testing.T) {
 
 	t.Run("正常系: 空の一覧を返す", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
 
 		ctx := context.Background()
 		userID := int64(1)
@@ -145,23 +151,71 @@ func TestTodoService_GetAllTodos(t *testing.T) {
 			ListTodosByUser(ctx, userID).
 			Return([]sqlc.Todo{}, nil)
 
-		result, err := svc.GetAllTodos(ctx, userID)
+		result, err := svc.GetAllTodos(ctx, userID, TodoSortCreatedAt, false)
 
 		require.NoError(t, err)
 		assert.Len(t, result, 0)
 	})
+
+	t.Run("正常系: manualの場合は位置の順に返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+		userID := int64(1)
+		expectedTodos := []sqlc.Todo{
+			{ID: 2, UserID: userID, Title: "Todo 2", Position: "G"},
+			{ID: 1, UserID: userID, Title: "Todo 1", Position: "V"},
+		}
+
+		mockRepo.EXPECT().
+			ListTodosByUserManual(ctx, userID).
+			Return(expectedTodos, nil)
+
+		result, err := svc.GetAllTodos(ctx, userID, TodoSortManual, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedTodos, result)
+	})
+
+	t.Run("正常系: actionableの場合は未完了のブロッカーがあるTodoを除く", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			ListTodosByUserManual(ctx, userID).
+			Return([]sqlc.Todo{
+				{ID: 1, UserID: userID, Position: "A"},
+				{ID: 2, UserID: userID, Position: "B"},
+				{ID: 3, UserID: userID, Position: "C"},
+			}, nil)
+		mockRepo.EXPECT().
+			ListBlockedTodoIDs(ctx, userID).
+			Return([]int64{2}, nil)
+
+		result, err := svc.GetAllTodos(ctx, userID, TodoSortManual, true)
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, int64(1), result[0].ID)
+		assert.Equal(t, int64(3), result[1].ID)
+	})
 }
 
 func TestTodoService_CreateTodo(t *testing.T) {
 	t.Run("正常系: Todoを作成できる", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
 
 		ctx := context.Background()
 		userID := int64(1)
 		title := "New Todo"
 		description := ptrString("New Description")
 		now := time.Now()
+		dueAt := now.Add(24 * time.Hour)
 
 		expectedTodo := sqlc.Todo{
 			ID:          1,
@@ -171,17 +225,29 @@ func TestTodoService_CreateTodo(t *testing.T) {
 			Completed:   false,
 			CreatedAt:   now,
 			UpdatedAt:   now,
+			DueAt:       pgtype.Timestamptz{Time: dueAt, Valid: true},
 		}
 
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(3), nil)
+		mockRepo.EXPECT().
+			GetLastTodoPosition(ctx, userID).
+			Return("V", nil)
 		mockRepo.EXPECT().
 			CreateTodo(ctx, sqlc.CreateTodoParams{
 				UserID:      userID,
 				Title:       title,
 				Description: description,
+				Position:    "W",
+				DueAt:       pgtype.Timestamptz{Time: dueAt, Valid: true},
 			}).
 			Return(expectedTodo, nil)
 
-		result, err := svc.CreateTodo(ctx, userID, title, description)
+		result, err := svc.CreateTodo(ctx, userID, title, description, &dueAt)
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedTodo.ID, result.ID)
@@ -191,7 +257,7 @@ func TestTodoService_CreateTodo(t *testing.T) {
 
 	t.Run("正常系: descriptionなしでTodoを作成できる", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
 
 		ctx := context.Background()
 		userID := int64(1)
@@ -208,310 +274,2215 @@ func TestTodoService_CreateTodo(t *testing.T) {
 			UpdatedAt:   now,
 		}
 
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(3), nil)
+		mockRepo.EXPECT().
+			GetLastTodoPosition(ctx, userID).
+			Return("", nil)
 		mockRepo.EXPECT().
 			CreateTodo(ctx, sqlc.CreateTodoParams{
 				UserID:      userID,
 				Title:       title,
 				Description: nil,
+				Position:    "V",
 			}).
 			Return(expectedTodo, nil)
 
-		result, err := svc.CreateTodo(ctx, userID, title, nil)
+		result, err := svc.CreateTodo(ctx, userID, title, nil, nil)
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedTodo.ID, result.ID)
 		assert.Nil(t, result.Description)
 	})
-}
 
-func TestTodoService_UpdateTodo(t *testing.T) {
-	t.Run("正常系: Todoを更新できる", func(t *testing.T) {
+	t.Run("正常系: ユーザー設定の既定のプロジェクトに入れる", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
-
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		todoID := int64(1)
 		userID := int64(1)
-		newTitle := ptrString("Updated Title")
-		newDescription := ptrString("Updated Description")
-		completed := ptrBool(true)
-		now := time.Now()
-
-		expectedTodo := sqlc.Todo{
-			ID:          todoID,
-			UserID:      userID,
-			Title:       *newTitle,
-			Description: newDescription,
-			Completed:   *completed,
-			CreatedAt:   now,
-			UpdatedAt:   now,
-		}
+		projectID := int64(5)
 
 		mockRepo.EXPECT().
-			UpdateTodo(ctx, sqlc.UpdateTodoParams{
-				ID:          todoID,
-				UserID:      userID,
-				Title:       newTitle,
-				Description: newDescription,
-				Completed:   completed,
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{UserID: userID, Timezone: "UTC", Locale: "en", DefaultSort: "created_at", DefaultProjectID: &projectID}, nil)
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(3), nil)
+		mockRepo.EXPECT().
+			GetLastTodoPosition(ctx, userID).
+			Return("", nil)
+		mockRepo.EXPECT().
+			CreateTodo(ctx, sqlc.CreateTodoParams{
+				UserID:    userID,
+				Title:     "New Todo",
+				Position:  "V",
+				ProjectID: &projectID,
 			}).
-			Return(expectedTodo, nil)
+			Return(sqlc.Todo{ID: 1, UserID: userID, Title: "New Todo", ProjectID: &projectID}, nil)
 
-		result, err := svc.UpdateTodo(ctx, todoID, userID, newTitle, newDescription, completed)
+		result, err := svc.CreateTodo(ctx, userID, "New Todo", nil, nil)
 
 		require.NoError(t, err)
-		assert.Equal(t, *newTitle, result.Title)
-		assert.Equal(t, *completed, result.Completed)
+		assert.Equal(t, &projectID, result.ProjectID)
 	})
 
-	t.Run("異常系: ErrTodoNotFoundを返す", func(t *testing.T) {
+	t.Run("異常系: ユーザーが存在しない", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
-
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		todoID := int64(999)
-		userID := int64(1)
-		newTitle := ptrString("Updated Title")
 
 		mockRepo.EXPECT().
-			UpdateTodo(ctx, mock.Anything).
-			Return(sqlc.Todo{}, pgx.ErrNoRows)
+			GetUserSettings(ctx, int64(1)).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, int64(1)).
+			Return(int64(0), pgx.ErrNoRows)
 
-		result, err := svc.UpdateTodo(ctx, todoID, userID, newTitle, nil, nil)
+		result, err := svc.CreateTodo(ctx, 1, "New Todo", nil, nil)
 
 		assert.Nil(t, result)
-		assert.ErrorIs(t, err, ErrTodoNotFound)
+		assert.ErrorIs(t, err, ErrUserNotFound)
 	})
 }
 
-func TestTodoService_DeleteTodo(t *testing.T) {
-	t.Run("正常系: Todoを削除できる", func(t *testing.T) {
+func TestTodoService_BatchCreateTodos(t *testing.T) {
+	t.Run("正常系: 有効な項目を作成し、タイトルが空の項目を失敗として返す", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		userID := int64(1)
+
+		positions, err := rank.Append("V", 2)
+		require.NoError(t, err)
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(3), nil)
+		mockRepo.EXPECT().
+			GetLastTodoPosition(ctx, userID).
+			Return("V", nil)
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			CreateTodo(ctx, sqlc.CreateTodoParams{UserID: userID, Title: "a", Position: positions[0]}).
+			Return(sqlc.Todo{ID: 10, UserID: userID, Title: "a"}, nil)
+		mockRepo.EXPECT().
+			CreateTodo(ctx, sqlc.CreateTodoParams{UserID: userID, Title: "c", Description: ptrString("d"), Position: positions[1]}).
+			Return(sqlc.Todo{ID: 11, UserID: userID, Title: "c"}, nil)
+
+		result, err := svc.BatchCreateTodos(ctx, userID, []BatchCreateItem{
+			{Title: "a"},
+			{Title: ""},
+			{Title: "c", Description: ptrString("d")},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Succeeded, 2)
+		assert.Equal(t, 0, result.Succeeded[0].Index)
+		assert.Equal(t, int64(10), result.Succeeded[0].Todo.ID)
+		assert.Equal(t, 2, result.Succeeded[1].Index)
+		assert.Equal(t, []BatchCreateFailedItem{{Index: 1, Error: "Title is required"}}, result.Failed)
+	})
 
+	t.Run("正常系: ユーザー設定の既定のプロジェクトに入れる", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		todoID := int64(1)
 		userID := int64(1)
+		projectID := int64(5)
 
 		mockRepo.EXPECT().
-			DeleteTodo(ctx, sqlc.DeleteTodoParams{
-				ID:     todoID,
-				UserID: userID,
-			}).
-			Return(nil)
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(3), nil)
+		mockRepo.EXPECT().
+			GetLastTodoPosition(ctx, userID).
+			Return("", nil)
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{UserID: userID, Timezone: "UTC", Locale: "en", DefaultSort: "created_at", DefaultProjectID: &projectID}, nil)
+		mockRepo.EXPECT().
+			CreateTodo(ctx, mock.MatchedBy(func(arg sqlc.CreateTodoParams) bool {
+				return arg.ProjectID != nil && *arg.ProjectID == projectID
+			})).
+			Return(sqlc.Todo{ID: 10, UserID: userID, Title: "a", ProjectID: &projectID}, nil).
+			Twice()
 
-		err := svc.DeleteTodo(ctx, todoID, userID)
+		result, err := svc.BatchCreateTodos(ctx, userID, []BatchCreateItem{{Title: "a"}, {Title: "b"}})
 
-		assert.NoError(t, err)
+		require.NoError(t, err)
+		assert.Len(t, result.Succeeded, 2)
 	})
 
-	t.Run("異常系: エラーを返す", func(t *testing.T) {
+	t.Run("正常系: 有効な項目がなければトランザクションを開始しない", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		result, err := svc.BatchCreateTodos(context.Background(), 1, []BatchCreateItem{{Title: ""}})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Succeeded)
+		assert.Len(t, result.Failed, 1)
+	})
 
+	t.Run("異常系: 作成に失敗した場合はエラーを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		todoID := int64(1)
-		userID := int64(1)
 		dbErr := errors.New("database error")
 
 		mockRepo.EXPECT().
-			DeleteTodo(ctx, sqlc.DeleteTodoParams{
-				ID:     todoID,
-				UserID: userID,
-			}).
-			Return(dbErr)
+			GetTodoChangeSeqForUpdate(ctx, int64(1)).
+			Return(int64(3), nil)
+		mockRepo.EXPECT().
+			GetLastTodoPosition(ctx, int64(1)).
+			Return("", nil)
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, int64(1)).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			CreateTodo(ctx, mock.Anything).
+			Return(sqlc.Todo{}, dbErr)
 
-		err := svc.DeleteTodo(ctx, todoID, userID)
+		result, err := svc.BatchCreateTodos(ctx, 1, []BatchCreateItem{{Title: "a"}, {Title: "b"}})
 
+		assert.Nil(t, result)
 		assert.ErrorIs(t, err, dbErr)
 	})
 }
 
-func TestTodoService_BatchCompleteTodos(t *testing.T) {
-	t.Run("全てのTodoが存在する場合", func(t *testing.T) {
+func TestTodoService_ImportTodos(t *testing.T) {
+	userID := int64(1)
+	newReader := func() *stubImportReader {
+		return &stubImportReader{results: []stubImportResult{
+			{item: &importer.Item{Line: 1, Title: "a"}},
+			{err: &importer.LineError{Line: 2, Message: "title is required"}},
+			{item: &importer.Item{Line: 3, Title: "b", Completed: true}},
+		}}
+	}
+
+	t.Run("正常系: 有効な行をCOPYで登録し、不正な行を行番号付きで返す", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		userID := int64(1)
-		ids := []int64{1, 2}
 
-		existingTodos := []sqlc.Todo{
-			{ID: 1, UserID: userID, Completed: false},
-			{ID: 2, UserID: userID, Completed: false},
-		}
-		completedTodos := []sqlc.Todo{
-			{ID: 1, UserID: userID, Completed: true},
-			{ID: 2, UserID: userID, Completed: true},
-		}
+		positions, err := rank.Append("V", 2)
+		require.NoError(t, err)
 
 		mockRepo.EXPECT().
-			GetTodosByIDs(ctx, mock.Anything).
-			Return(existingTodos, nil)
+			NextTodoChangeSeq(ctx, userID).
+			Return(int64(5), nil)
 		mockRepo.EXPECT().
-			BatchCompleteTodos(ctx, mock.Anything).
-			Return(completedTodos, nil)
+			GetLastTodoPosition(ctx, userID).
+			Return("V", nil)
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			CopyTodos(ctx, []sqlc.CopyTodosParams{
+				{UserID: userID, Title: "a", Position: positions[0], ChangeSeq: 5},
+				{UserID: userID, Title: "b", Completed: true, Position: positions[1], ChangeSeq: 5},
+			}).
+			Return(int64(2), nil)
 
-		result, err := svc.BatchCompleteTodos(ctx, userID, ids)
+		result, err := svc.ImportTodos(ctx, userID, newReader(), ImportOptions{})
 
-		assert.NoError(t, err)
-		assert.Len(t, result.Succeeded, 2)
-		assert.Len(t, result.Failed, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Imported)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, []importer.LineError{{Line: 2, Message: "title is required"}}, result.Errors)
 	})
 
-	t.Run("一部のIDが存在しない場合", func(t *testing.T) {
+	t.Run("正常系: チャンクごとにCOPYする", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		userID := int64(1)
-		ids := []int64{1, 999}
 
-		existingTodos := []sqlc.Todo{
-			{ID: 1, UserID: userID},
-		}
-		completedTodos := []sqlc.Todo{
-			{ID: 1, UserID: userID, Completed: true},
+		reader := &stubImportReader{}
+		for i := 0; i < importChunkSize+1; i++ {
+			reader.results = append(reader.results, stubImportResult{item: &importer.Item{Line: i + 1, Title: "x"}})
 		}
 
+		var lastOfFirstChunk string
 		mockRepo.EXPECT().
-			GetTodosByIDs(ctx, mock.Anything).
-			Return(existingTodos, nil)
+			NextTodoChangeSeq(ctx, userID).
+			Return(int64(1), nil)
 		mockRepo.EXPECT().
-			BatchCompleteTodos(ctx, mock.Anything).
-			Return(completedTodos, nil)
+			GetLastTodoPosition(ctx, userID).
+			Return("", nil)
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			CopyTodos(ctx, mock.MatchedBy(func(rows []sqlc.CopyTodosParams) bool { return len(rows) == importChunkSize })).
+			RunAndReturn(func(_ context.Context, rows []sqlc.CopyTodosParams) (int64, error) {
+				lastOfFirstChunk = rows[len(rows)-1].Position
+				return int64(len(rows)), nil
+			}).Once()
+		mockRepo.EXPECT().
+			CopyTodos(ctx, mock.MatchedBy(func(rows []sqlc.CopyTodosParams) bool { return len(rows) == 1 })).
+			RunAndReturn(func(_ context.Context, rows []sqlc.CopyTodosParams) (int64, error) {
+				// 後のチャンクは前のチャンクより後ろに並ぶ
+				assert.Greater(t, rows[0].Position, lastOfFirstChunk)
+				return int64(len(rows)), nil
+			}).Once()
 
-		result, err := svc.BatchCompleteTodos(ctx, userID, ids)
+		result, err := svc.ImportTodos(ctx, userID, reader, ImportOptions{})
 
-		assert.NoError(t, err)
-		assert.Len(t, result.Succeeded, 1)
-		assert.Len(t, result.Failed, 1)
-		assert.Equal(t, int64(999), result.Failed[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(importChunkSize+1), result.Imported)
 	})
-}
 
-func TestTodoService_BatchDeleteTodos(t *testing.T) {
-	t.Run("全てのTodoが存在する場合", func(t *testing.T) {
+	t.Run("正常系: 期限をCOPYで登録する", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		userID := int64(1)
-		ids := []int64{1, 2}
+		due := time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)
 
-		existingTodos := []sqlc.Todo{
-			{ID: 1, UserID: userID},
-			{ID: 2, UserID: userID},
-		}
+		mockRepo.EXPECT().NextTodoChangeSeq(ctx, userID).Return(int64(5), nil)
+		mockRepo.EXPECT().GetLastTodoPosition(ctx, userID).Return("", nil)
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			CopyTodos(ctx, mock.MatchedBy(func(rows []sqlc.CopyTodosParams) bool {
+				return len(rows) == 1 && rows[0].DueAt == pgtype.Timestamptz{Time: due, Valid: true}
+			})).
+			Return(int64(1), nil)
+
+		reader := &stubImportReader{results: []stubImportResult{{item: &importer.Item{Line: 1, Title: "a", DueAt: &due}}}}
+		result, err := svc.ImportTodos(ctx, userID, reader, ImportOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.Imported)
+	})
+
+	t.Run("正常系: ユーザー設定の既定のプロジェクトに入れる", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		projectID := int64(5)
 
+		mockRepo.EXPECT().NextTodoChangeSeq(ctx, userID).Return(int64(5), nil)
+		mockRepo.EXPECT().GetLastTodoPosition(ctx, userID).Return("", nil)
 		mockRepo.EXPECT().
-			GetTodosByIDs(ctx, mock.Anything).
-			Return(existingTodos, nil)
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{UserID: userID, Timezone: "UTC", Locale: "en", DefaultSort: "created_at", DefaultProjectID: &projectID}, nil)
 		mockRepo.EXPECT().
-			BatchDeleteTodos(ctx, mock.Anything).
-			Return(nil)
+			CopyTodos(ctx, mock.MatchedBy(func(rows []sqlc.CopyTodosParams) bool {
+				return len(rows) == 2 && assert.ObjectsAreEqual(&projectID, rows[0].ProjectID) && assert.ObjectsAreEqual(&projectID, rows[1].ProjectID)
+			})).
+			Return(int64(2), nil)
 
-		result, err := svc.BatchDeleteTodos(ctx, userID, ids)
+		result, err := svc.ImportTodos(ctx, userID, newReader(), ImportOptions{})
 
-		assert.NoError(t, err)
-		assert.Len(t, result.Succeeded, 2)
-		assert.Len(t, result.Failed, 0)
-		assert.ElementsMatch(t, []int64{1, 2}, result.Succeeded)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Imported)
 	})
 
-	t.Run("一部のIDが存在しない場合", func(t *testing.T) {
+	t.Run("正常系: 重複を除く場合は既存のTodoと先に読み込んだTodoと同じ件名を読み飛ばす", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		userID := int64(1)
-		ids := []int64{1, 999}
-
-		existingTodos := []sqlc.Todo{
-			{ID: 1, UserID: userID},
-		}
 
+		mockRepo.EXPECT().NextTodoChangeSeq(ctx, userID).Return(int64(5), nil)
+		mockRepo.EXPECT().GetLastTodoPosition(ctx, userID).Return("", nil)
+		mockRepo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows)
 		mockRepo.EXPECT().
-			GetTodosByIDs(ctx, mock.Anything).
-			Return(existingTodos, nil)
+			ListExistingTodoTitles(ctx, sqlc.ListExistingTodoTitlesParams{UserID: userID, Titles: []string{"a", "b", "a"}}).
+			Return([]string{"b"}, nil)
 		mockRepo.EXPECT().
-			BatchDeleteTodos(ctx, mock.Anything).
-			Return(nil)
-
-		result, err := svc.BatchDeleteTodos(ctx, userID, ids)
+			CopyTodos(ctx, mock.MatchedBy(func(rows []sqlc.CopyTodosParams) bool {
+				return len(rows) == 1 && rows[0].Title == "a"
+			})).
+			Return(int64(1), nil)
+
+		reader := &stubImportReader{results: []stubImportResult{
+			{item: &importer.Item{Line: 1, Title: "a"}},
+			{item: &importer.Item{Line: 2, Title: "b"}},
+			{item: &importer.Item{Line: 3, Title: "a"}},
+		}}
+		result, err := svc.ImportTodos(ctx, userID, reader, ImportOptions{Dedupe: true})
 
-		assert.NoError(t, err)
-		assert.Len(t, result.Succeeded, 1)
-		assert.Equal(t, int64(1), result.Succeeded[0])
-		assert.Len(t, result.Failed, 1)
-		assert.Equal(t, int64(999), result.Failed[0].ID)
-		assert.Equal(t, "Todo not found", result.Failed[0].Error)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.Imported)
+		assert.Equal(t, 2, result.Skipped)
 	})
 
-	t.Run("全てのIDが存在しない場合", func(t *testing.T) {
+	t.Run("正常系: プレビューは書き込まずに保存するTodoを返す", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		userID := int64(1)
-		ids := []int64{998, 999}
 
 		mockRepo.EXPECT().
-			GetTodosByIDs(ctx, mock.Anything).
-			Return([]sqlc.Todo{}, nil)
+			ListExistingTodoTitles(ctx, sqlc.ListExistingTodoTitlesParams{UserID: userID, Titles: []string{"a", "b"}}).
+			Return([]string{"a"}, nil)
 
-		result, err := svc.BatchDeleteTodos(ctx, userID, ids)
+		result, err := svc.ImportTodos(ctx, userID, newReader(), ImportOptions{Preview: true, Dedupe: true})
 
-		assert.NoError(t, err)
-		assert.Len(t, result.Succeeded, 0)
-		assert.Len(t, result.Failed, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.Imported)
+		assert.Equal(t, 1, result.Skipped)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, []importer.Item{{Line: 3, Title: "b", Completed: true}}, result.Items)
 	})
 
-	t.Run("GetTodosByIDsでエラーが発生した場合", func(t *testing.T) {
+	t.Run("正常系: プレビューで返すTodoは上限までにする", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		reader := &stubImportReader{}
+		for i := 0; i < maxPreviewImportItems+1; i++ {
+			reader.results = append(reader.results, stubImportResult{item: &importer.Item{Line: i + 1, Title: "x"}})
+		}
+
+		result, err := svc.ImportTodos(ctx, userID, reader, ImportOptions{Preview: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(maxPreviewImportItems+1), result.Imported)
+		assert.Len(t, result.Items, maxPreviewImportItems)
+	})
+
+	t.Run("異常系: strictモードでは最初の不正な行で中断する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		userID := int64(1)
-		ids := []int64{1, 2}
-		dbErr := errors.New("database error")
 
 		mockRepo.EXPECT().
-			GetTodosByIDs(ctx, mock.Anything).
-			Return(nil, dbErr)
+			NextTodoChangeSeq(ctx, userID).
+			Return(int64(5), nil)
+		mockRepo.EXPECT().
+			GetLastTodoPosition(ctx, userID).
+			Return("", nil)
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
 
-		result, err := svc.BatchDeleteTodos(ctx, userID, ids)
+		result, err := svc.ImportTodos(ctx, userID, newReader(), ImportOptions{Strict: true})
 
 		assert.Nil(t, result)
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrImportAborted)
+		var aborted *ImportAbortedError
+		require.ErrorAs(t, err, &aborted)
+		assert.Equal(t, 2, aborted.LineError.Line)
 	})
 
-	t.Run("BatchDeleteTodosでエラーが発生した場合", func(t *testing.T) {
+	t.Run("異常系: 読み込みエラーはそのまま返す", func(t *testing.T) {
 		mockRepo := mocks.NewMockTodoRepository(t)
-		svc := NewTodoService(mockRepo)
+		svc := newTxTestTodoService(mockRepo)
 		ctx := context.Background()
-		userID := int64(1)
-		ids := []int64{1, 2}
-		dbErr := errors.New("database error")
-
-		existingTodos := []sqlc.Todo{
-			{ID: 1, UserID: userID},
-			{ID: 2, UserID: userID},
-		}
 
 		mockRepo.EXPECT().
-			GetTodosByIDs(ctx, mock.Anything).
-			Return(existingTodos, nil)
+			NextTodoChangeSeq(ctx, userID).
+			Return(int64(5), nil)
 		mockRepo.EXPECT().
-			BatchDeleteTodos(ctx, mock.Anything).
-			Return(dbErr)
+			GetLastTodoPosition(ctx, userID).
+			Return("", nil)
+		mockRepo.EXPECT().
+			GetUserSettings(ctx, userID).
+			Return(sqlc.UserSetting{}, pgx.ErrNoRows)
 
-		result, err := svc.BatchDeleteTodos(ctx, userID, ids)
+		reader := &stubImportReader{results: []stubImportResult{{err: importer.ErrLineTooLong}}}
+		result, err := svc.ImportTodos(ctx, userID, reader, ImportOptions{})
 
 		assert.Nil(t, result)
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, importer.ErrLineTooLong)
 	})
 }
 
-// ヘルパー関数
-func ptrString(s string) *string {
-	return &s
-}
+func TestTodoService_UpdateTodo(t *testing.T) {
+	t.Run("正常系: Todoを更新できる", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
 
-func ptrBool(b bool) *bool {
-	return &b
+		ctx := context.Background()
+		todoID := int64(1)
+		userID := int64(1)
+		newTitle := ptrString("Updated Title")
+		newDescription := ptrString("Updated Description")
+		completed := ptrBool(true)
+		expectedVersions := []int32{1}
+		now := time.Now()
+
+		expectedTodo := sqlc.Todo{
+			ID:          todoID,
+			UserID:      userID,
+			Title:       *newTitle,
+			Description: newDescription,
+			Completed:   *completed,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			Version:     2,
+		}
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{todoID}}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			UpdateTodo(ctx, sqlc.UpdateTodoParams{
+				ID:               todoID,
+				UserID:           userID,
+				Title:            newTitle,
+				Description:      newDescription,
+				Completed:        completed,
+				ExpectedVersions: expectedVersions,
+			}).
+			Return(expectedTodo, nil)
+
+		result, err := svc.UpdateTodo(ctx, todoID, userID, expectedVersions, newTitle, newDescription, completed, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, *newTitle, result.Title)
+		assert.Equal(t, *completed, result.Completed)
+		assert.Equal(t, int32(2), result.Version)
+	})
+
+	t.Run("異常系: 未完了のブロッカーがある場合は完了にできない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+
+		ctx := context.Background()
+		todoID := int64(1)
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{todoID}}).
+			Return([]int64{todoID}, nil)
+
+		result, err := svc.UpdateTodo(ctx, todoID, userID, nil, nil, nil, ptrBool(true), false)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrTodoBlocked)
+		mockRepo.AssertNotCalled(t, "UpdateTodo", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: forceを指定するとブロッカーを確認せずに完了にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+
+		ctx := context.Background()
+		todoID := int64(1)
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			UpdateTodo(ctx, sqlc.UpdateTodoParams{ID: todoID, UserID: userID, Completed: ptrBool(true)}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Completed: true, Version: 2}, nil)
+
+		result, err := svc.UpdateTodo(ctx, todoID, userID, nil, nil, nil, ptrBool(true), true)
+
+		require.NoError(t, err)
+		assert.True(t, result.Completed)
+	})
+
+	t.Run("異常系: バージョン不一致の場合は現在のTodoを含むエラーを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+		todoID := int64(1)
+		userID := int64(1)
+		newTitle := ptrString("Updated Title")
+		currentTodo := sqlc.Todo{
+			ID:      todoID,
+			UserID:  userID,
+			Title:   "Changed in another tab",
+			Version: 3,
+		}
+
+		mockRepo.EXPECT().
+			UpdateTodo(ctx, mock.Anything).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{
+				ID:     todoID,
+				UserID: userID,
+			}).
+			Return(currentTodo, nil)
+
+		result, err := svc.UpdateTodo(ctx, todoID, userID, []int32{2}, newTitle, nil, nil, false)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrTodoVersionMismatch)
+		var mismatch *TodoVersionMismatchError
+		require.ErrorAs(t, err, &mismatch)
+		assert.Equal(t, currentTodo.Title, mismatch.Current.Title)
+		assert.Equal(t, int32(3), mismatch.Current.Version)
+	})
+
+	t.Run("異常系: バージョン指定ありでTodoが存在しない場合はErrTodoNotFoundを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+		todoID := int64(999)
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			UpdateTodo(ctx, mock.Anything).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, mock.Anything).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		result, err := svc.UpdateTodo(ctx, todoID, userID, []int32{1}, ptrString("Updated Title"), nil, nil, false)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrTodoNotFound)
+	})
+
+	t.Run("異常系: ErrTodoNotFoundを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+		todoID := int64(999)
+		userID := int64(1)
+		newTitle := ptrString("Updated Title")
+
+		mockRepo.EXPECT().
+			UpdateTodo(ctx, mock.Anything).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		result, err := svc.UpdateTodo(ctx, todoID, userID, nil, newTitle, nil, nil, false)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrTodoNotFound)
+	})
+}
+
+func TestTodoService_DeleteTodo(t *testing.T) {
+	t.Run("正常系: Todoを削除できる", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+		todoID := int64(1)
+		userID := int64(1)
+
+		expectedVersions := []int32{1}
+
+		mockRepo.EXPECT().
+			DeleteTodo(ctx, sqlc.DeleteTodoParams{
+				ID:               todoID,
+				UserID:           userID,
+				ExpectedVersions: expectedVersions,
+			}).
+			Return(int64(1), nil)
+
+		err := svc.DeleteTodo(ctx, todoID, userID, expectedVersions)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 削除対象がない場合はErrTodoNotFoundを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			DeleteTodo(ctx, mock.Anything).
+			Return(int64(0), nil)
+
+		err := svc.DeleteTodo(ctx, 999, 1, nil)
+
+		assert.ErrorIs(t, err, ErrTodoNotFound)
+	})
+
+	t.Run("異常系: バージョン不一致の場合はTodoVersionMismatchErrorを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+		todoID := int64(1)
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			DeleteTodo(ctx, mock.Anything).
+			Return(int64(0), nil)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{
+				ID:     todoID,
+				UserID: userID,
+			}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 5}, nil)
+
+		err := svc.DeleteTodo(ctx, todoID, userID, []int32{4})
+
+		var mismatch *TodoVersionMismatchError
+		require.ErrorAs(t, err, &mismatch)
+		assert.Equal(t, int32(5), mismatch.Current.Version)
+	})
+
+	t.Run("異常系: エラーを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		ctx := context.Background()
+		todoID := int64(1)
+		userID := int64(1)
+		dbErr := errors.New("database error")
+
+		mockRepo.EXPECT().
+			DeleteTodo(ctx, sqlc.DeleteTodoParams{
+				ID:     todoID,
+				UserID: userID,
+			}).
+			Return(int64(0), dbErr)
+
+		err := svc.DeleteTodo(ctx, todoID, userID, nil)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestTodoService_SetDueAt(t *testing.T) {
+	todoID := int64(1)
+	userID := int64(1)
+
+	t.Run("正常系: 期限を設定できる", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		ctx := context.Background()
+		dueAt := time.Date(2026, 10, 30, 9, 0, 0, 0, time.UTC)
+
+		mockRepo.EXPECT().
+			SetTodoDueAt(ctx, sqlc.SetTodoDueAtParams{
+				UserID:           userID,
+				DueAt:            pgtype.Timestamptz{Time: dueAt, Valid: true},
+				ID:               todoID,
+				ExpectedVersions: []int32{2},
+			}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 3, DueAt: pgtype.Timestamptz{Time: dueAt, Valid: true}}, nil)
+
+		result, err := svc.SetDueAt(ctx, todoID, userID, []int32{2}, &dueAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), result.Version)
+		assert.Equal(t, dueAt, result.DueAt.Time)
+	})
+
+	t.Run("正常系: nilを渡すと期限を外す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			SetTodoDueAt(ctx, sqlc.SetTodoDueAtParams{
+				UserID: userID,
+				ID:     todoID,
+			}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 2}, nil)
+
+		result, err := svc.SetDueAt(ctx, todoID, userID, nil, nil)
+
+		require.NoError(t, err)
+		assert.False(t, result.DueAt.Valid)
+	})
+
+	t.Run("異常系: バージョン不一致の場合はTodoVersionMismatchErrorを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			SetTodoDueAt(ctx, mock.Anything).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Version: 5}, nil)
+
+		result, err := svc.SetDueAt(ctx, todoID, userID, []int32{4}, nil)
+
+		assert.Nil(t, result)
+		var mismatch *TodoVersionMismatchError
+		require.ErrorAs(t, err, &mismatch)
+		assert.Equal(t, int32(5), mismatch.Current.Version)
+	})
+}
+
+func TestTodoService_MoveTodo(t *testing.T) {
+	userID := int64(1)
+	todoID := int64(3)
+
+	// 移動対象と基準のTodoの取得を期待する
+	expectTodos := func(mockRepo *mocks.MockTodoRepository, ctx context.Context, todos ...sqlc.Todo) {
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(7), nil)
+		for _, todo := range todos {
+			mockRepo.EXPECT().
+				GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todo.ID, UserID: userID}).
+				Return(todo, nil)
+		}
+	}
+
+	t.Run("正常系: after_idのTodoと次のTodoの間に移動する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectTodos(mockRepo, ctx,
+			sqlc.Todo{ID: todoID, UserID: userID, Position: "k"},
+			sqlc.Todo{ID: 1, UserID: userID, Position: "G"},
+		)
+		mockRepo.EXPECT().
+			GetNextTodoPosition(ctx, sqlc.GetNextTodoPositionParams{
+				UserID:         userID,
+				ExcludeID:      todoID,
+				AnchorPosition: "G",
+				AnchorID:       1,
+			}).
+			Return("V", nil)
+		mockRepo.EXPECT().
+			UpdateTodoPosition(ctx, sqlc.UpdateTodoPositionParams{UserID: userID, Position: "O", ID: todoID}).
+			Return(sqlc.Todo{ID: todoID, UserID: userID, Position: "O", Version: 2}, nil)
+
+		result, err := svc.MoveTodo(ctx, todoID, userID, nil, ptrInt64(1))
+
+		require.NoError(t, err)
+		assert.Equal(t, "O", result.Position)
+		assert.Equal(t, int32(2), result.Version)
+	})
+
+	t.Run("正常系: before_idのTodoが先頭なら先頭に移動する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectTodos(mockRepo, ctx,
+			sqlc.Todo{ID: todoID, UserID: userID, Position: "k"},
+			sqlc.Todo{ID: 1, UserID: userID, Position: "G"},
+		)
+		mockRepo.EXPECT().
+			GetPrevTodoPosition(ctx, sqlc.GetPrevTodoPositionParams{
+				UserID:         userID,
+				ExcludeID:      todoID,
+				AnchorPosition: "G",
+				AnchorID:       1,
+			}).
+			Return("", pgx.ErrNoRows)
+		mockRepo.EXPECT().
+			UpdateTodoPosition(ctx, sqlc.UpdateTodoPositionParams{UserID: userID, Position: "F", ID: todoID}).
+			Return(sqlc.Todo{ID: todoID, Position: "F"}, nil)
+
+		result, err := svc.MoveTodo(ctx, todoID, userID, ptrInt64(1), nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "F", result.Position)
+	})
+
+	t.Run("正常系: 両方指定した場合はその間に移動する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectTodos(mockRepo, ctx,
+			sqlc.Todo{ID: todoID, UserID: userID, Position: "k"},
+			sqlc.Todo{ID: 1, UserID: userID, Position: "C"},
+			sqlc.Todo{ID: 2, UserID: userID, Position: "A"},
+		)
+		mockRepo.EXPECT().
+			UpdateTodoPosition(ctx, sqlc.UpdateTodoPositionParams{UserID: userID, Position: "B", ID: todoID}).
+			Return(sqlc.Todo{ID: todoID, Position: "B"}, nil)
+
+		result, err := svc.MoveTodo(ctx, todoID, userID, ptrInt64(1), ptrInt64(2))
+
+		require.NoError(t, err)
+		assert.Equal(t, "B", result.Position)
+	})
+
+	t.Run("正常系: 前後のTodoが同じ位置の場合は振り直してから移動する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		spread := rank.Spread(3)
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{ID: todoID, Position: "k"}, nil)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: 1, UserID: userID}).
+			Return(sqlc.Todo{ID: 1, Position: "V"}, nil).Once()
+		mockRepo.EXPECT().
+			GetNextTodoPosition(ctx, mock.MatchedBy(func(arg sqlc.GetNextTodoPositionParams) bool { return arg.AnchorPosition == "V" })).
+			Return("V", nil).Once()
+		mockRepo.EXPECT().
+			ListTodoIDsByPosition(ctx, userID).
+			Return([]int64{1, 2, todoID}, nil)
+		mockRepo.EXPECT().
+			SetTodoPositions(ctx, sqlc.SetTodoPositionsParams{Ids: []int64{1, 2, todoID}, Positions: spread, UserID: userID}).
+			Return(nil)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: 1, UserID: userID}).
+			Return(sqlc.Todo{ID: 1, Position: spread[0]}, nil).Once()
+		mockRepo.EXPECT().
+			GetNextTodoPosition(ctx, mock.MatchedBy(func(arg sqlc.GetNextTodoPositionParams) bool { return arg.AnchorPosition == spread[0] })).
+			Return(spread[1], nil).Once()
+		mockRepo.EXPECT().
+			UpdateTodoPosition(ctx, mock.MatchedBy(func(arg sqlc.UpdateTodoPositionParams) bool {
+				return arg.Position > spread[0] && arg.Position < spread[1]
+			})).
+			Return(sqlc.Todo{ID: todoID}, nil)
+
+		_, err := svc.MoveTodo(ctx, todoID, userID, nil, ptrInt64(1))
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系: 基準のTodoを指定していない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+
+		result, err := svc.MoveTodo(context.Background(), todoID, userID, nil, nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidMove)
+	})
+
+	t.Run("異常系: 自分自身を基準に指定した", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+
+		result, err := svc.MoveTodo(context.Background(), todoID, userID, ptrInt64(todoID), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidMove)
+	})
+
+	t.Run("異常系: after_idがbefore_idより後ろにある", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectTodos(mockRepo, ctx,
+			sqlc.Todo{ID: todoID, UserID: userID, Position: "k"},
+			sqlc.Todo{ID: 1, UserID: userID, Position: "A"},
+			sqlc.Todo{ID: 2, UserID: userID, Position: "C"},
+		)
+
+		result, err := svc.MoveTodo(ctx, todoID, userID, ptrInt64(1), ptrInt64(2))
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidMove)
+	})
+
+	t.Run("異常系: 移動するTodoが存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: todoID, UserID: userID}).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		result, err := svc.MoveTodo(ctx, todoID, userID, ptrInt64(1), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrTodoNotFound)
+	})
+
+	t.Run("異常系: 基準のTodoが存在しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectTodos(mockRepo, ctx, sqlc.Todo{ID: todoID, UserID: userID, Position: "k"})
+		mockRepo.EXPECT().
+			GetTodoByID(ctx, sqlc.GetTodoByIDParams{ID: 99, UserID: userID}).
+			Return(sqlc.Todo{}, pgx.ErrNoRows)
+
+		result, err := svc.MoveTodo(ctx, todoID, userID, ptrInt64(99), nil)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrMoveAnchorNotFound)
+	})
+}
+
+func TestTodoService_RebalancePositions(t *testing.T) {
+	t.Run("正常系: キーが長すぎるユーザーのTodoを振り直す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			ListUsersWithLongTodoPositions(ctx, int32(32)).
+			Return([]int64{1, 2}, nil)
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, int64(1)).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			ListTodoIDsByPosition(ctx, int64(1)).
+			Return([]int64{5, 6}, nil)
+		mockRepo.EXPECT().
+			SetTodoPositions(ctx, sqlc.SetTodoPositionsParams{Ids: []int64{5, 6}, Positions: rank.Spread(2), UserID: 1}).
+			Return(nil)
+		// 途中で削除されたユーザーはスキップする
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, int64(2)).
+			Return(int64(0), pgx.ErrNoRows)
+
+		n, err := svc.RebalancePositions(ctx, 32)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("異常系: 振り直しに失敗した場合はエラーを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		dbErr := errors.New("database error")
+
+		mockRepo.EXPECT().
+			ListUsersWithLongTodoPositions(ctx, int32(32)).
+			Return([]int64{1}, nil)
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, int64(1)).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			ListTodoIDsByPosition(ctx, int64(1)).
+			Return(nil, dbErr)
+
+		n, err := svc.RebalancePositions(ctx, 32)
+
+		assert.ErrorIs(t, err, dbErr)
+		assert.Equal(t, 0, n)
+	})
+}
+
+func TestTodoService_ListChanges(t *testing.T) {
+	t.Run("正常系: 作成・更新されたTodoと削除されたIDを分けて返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		ctx := context.Background()
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeq(ctx, userID).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: 3, MaxRows: SyncPageSize + 1}).
+			Return([]sqlc.Todo{
+				{ID: 1, UserID: userID, ChangeSeq: 4},
+				{ID: 2, UserID: userID, ChangeSeq: 5, DeletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+				{ID: 3, UserID: userID, ChangeSeq: 7},
+			}, nil)
+
+		result, err := svc.ListChanges(ctx, userID, encodeSyncToken(3))
+
+		require.NoError(t, err)
+		require.Len(t, result.Todos, 2)
+		assert.Equal(t, int64(1), result.Todos[0].ID)
+		assert.Equal(t, int64(3), result.Todos[1].ID)
+		assert.Equal(t, []int64{2}, result.DeletedIDs)
+		assert.Equal(t, encodeSyncToken(7), result.NextToken)
+		assert.False(t, result.HasMore)
+	})
+
+	t.Run("正常系: ページサイズを超える変更は続きのトークンで分けて返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		svc.changesPageSize = 2
+		ctx := context.Background()
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeq(ctx, userID).
+			Return(int64(9), nil).Twice()
+		mockRepo.EXPECT().
+			ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: 3, MaxRows: 3}).
+			Return([]sqlc.Todo{
+				{ID: 1, UserID: userID, ChangeSeq: 4},
+				{ID: 2, UserID: userID, ChangeSeq: 4, DeletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}},
+				{ID: 3, UserID: userID, ChangeSeq: 4},
+			}, nil)
+
+		first, err := svc.ListChanges(ctx, userID, encodeSyncToken(3))
+
+		require.NoError(t, err)
+		assert.True(t, first.HasMore)
+		require.Len(t, first.Todos, 1)
+		assert.Equal(t, int64(1), first.Todos[0].ID)
+		assert.Equal(t, []int64{2}, first.DeletedIDs)
+		assert.Equal(t, encodeSyncCursor(4, 2), first.NextToken)
+
+		// 同じシーケンス番号の残りから続ける
+		afterID := int64(2)
+		mockRepo.EXPECT().
+			ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: 4, AfterID: &afterID, MaxRows: 3}).
+			Return([]sqlc.Todo{{ID: 3, UserID: userID, ChangeSeq: 4}}, nil)
+
+		second, err := svc.ListChanges(ctx, userID, first.NextToken)
+
+		require.NoError(t, err)
+		assert.False(t, second.HasMore)
+		require.Len(t, second.Todos, 1)
+		assert.Equal(t, int64(3), second.Todos[0].ID)
+		assert.Equal(t, encodeSyncToken(9), second.NextToken)
+	})
+
+	t.Run("正常系: 一覧取得中にコミットされた変更があればトークンを進める", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		ctx := context.Background()
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeq(ctx, userID).
+			Return(int64(7), nil)
+		mockRepo.EXPECT().
+			ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: 7, MaxRows: SyncPageSize + 1}).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID, ChangeSeq: 8}}, nil)
+
+		result, err := svc.ListChanges(ctx, userID, encodeSyncToken(7))
+
+		require.NoError(t, err)
+		assert.Len(t, result.Todos, 1)
+		assert.Equal(t, encodeSyncToken(8), result.NextToken)
+	})
+
+	t.Run("正常系: トークンが空の場合は全件を対象にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		ctx := context.Background()
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeq(ctx, userID).
+			Return(int64(0), nil)
+		mockRepo.EXPECT().
+			ListTodoChangesPage(ctx, sqlc.ListTodoChangesPageParams{UserID: userID, Since: -1, MaxRows: SyncPageSize + 1}).
+			Return([]sqlc.Todo{}, nil)
+
+		result, err := svc.ListChanges(ctx, userID, "")
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Todos)
+		assert.Empty(t, result.DeletedIDs)
+		assert.Equal(t, encodeSyncToken(0), result.NextToken)
+	})
+
+	t.Run("異常系: 不正なトークンはErrInvalidSyncTokenを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		result, err := svc.ListChanges(context.Background(), 1, "invalid")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidSyncToken)
+	})
+
+	t.Run("異常系: ユーザーが存在しない場合はErrUserNotFoundを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeq(ctx, int64(1)).
+			Return(int64(0), pgx.ErrNoRows)
+
+		result, err := svc.ListChanges(ctx, 1, "")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestTodoService_BatchCompleteTodos(t *testing.T) {
+	userID := int64(1)
+	lockParams := func(ids ...int64) sqlc.GetTodosByIDsForUpdateParams {
+		return sqlc.GetTodosByIDsForUpdateParams{Ids: ids, UserID: userID}
+	}
+	blockerParams := func(ids ...int64) sqlc.ListOpenBlockedTodoIDsParams {
+		return sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: ids}
+	}
+	expectUserLock := func(mockRepo *mocks.MockTodoRepository, ctx context.Context) {
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(5), nil)
+	}
+
+	t.Run("全てのTodoが存在する場合", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		ids := []int64{1, 2}
+
+		existingTodos := []sqlc.Todo{
+			{ID: 1, UserID: userID, Completed: false},
+			{ID: 2, UserID: userID, Completed: false},
+		}
+		completedTodos := []sqlc.Todo{
+			{ID: 1, UserID: userID, Completed: true},
+			{ID: 2, UserID: userID, Completed: true},
+		}
+
+		expectUserLock(mockRepo, ctx)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, lockParams(1, 2)).
+			Return(existingTodos, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, blockerParams(1, 2)).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{1, 2}, UserID: userID}).
+			Return(completedTodos, nil)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, ids, false, false)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Succeeded, 2)
+		assert.Len(t, result.Failed, 0)
+	})
+
+	t.Run("存在しない・他のユーザーのTodoはどちらもnot_foundとして失敗を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		// 3 は他のユーザーのTodo（ユーザーで絞り込むため取得されない）
+		ids := []int64{1, 999, 3}
+
+		expectUserLock(mockRepo, ctx)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, lockParams(ids...)).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID}}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, blockerParams(1)).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{1}, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID, Completed: true}}, nil)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, ids, false, false)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Succeeded, 1)
+		require.Len(t, result.Failed, 2)
+		assert.Equal(t, BatchFailedItem{ID: 999, Code: BatchErrorNotFound, Error: "Todo not found"}, result.Failed[0])
+		assert.Equal(t, BatchFailedItem{ID: 3, Code: BatchErrorNotFound, Error: "Todo not found"}, result.Failed[1])
+	})
+
+	t.Run("完了済みのTodoは更新せずに警告付きで成功として返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectUserLock(mockRepo, ctx)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, lockParams(2, 1)).
+			Return([]sqlc.Todo{
+				{ID: 1, UserID: userID},
+				{ID: 2, UserID: userID, Completed: true, Version: 3},
+			}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, blockerParams(1)).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{1}, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID, Completed: true}}, nil)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, []int64{2, 1}, true, false)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Failed)
+		// 入力の順序で返す
+		require.Len(t, result.Succeeded, 2)
+		assert.Equal(t, sqlc.Todo{ID: 2, UserID: userID, Completed: true, Version: 3}, result.Succeeded[0])
+		assert.Equal(t, int64(1), result.Succeeded[1].ID)
+		assert.Equal(t, []BatchWarning{{ID: 2, Code: BatchWarningAlreadyCompleted, Message: "Todo was already completed"}}, result.Warnings)
+	})
+
+	t.Run("完了済みのTodoだけの場合は更新しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectUserLock(mockRepo, ctx)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, lockParams(2)).
+			Return([]sqlc.Todo{{ID: 2, UserID: userID, Completed: true}}, nil)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, []int64{2}, false, false)
+
+		require.NoError(t, err)
+		assert.Len(t, result.Succeeded, 1)
+		assert.Len(t, result.Warnings, 1)
+	})
+
+	t.Run("atomicモードでは1件でも失敗があれば何も完了にしない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectUserLock(mockRepo, ctx)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, lockParams(1, 2, 999)).
+			Return([]sqlc.Todo{
+				{ID: 1, UserID: userID},
+				{ID: 2, UserID: userID, Completed: true},
+			}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, blockerParams(1)).
+			Return([]int64{}, nil)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, []int64{1, 2, 999}, true, false)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Succeeded)
+		require.Len(t, result.Failed, 3)
+		assert.Equal(t, BatchErrorNotFound, result.Failed[0].Code)
+		assert.Equal(t, BatchFailedItem{ID: 1, Code: BatchErrorRolledBack, Error: "Rolled back because another todo in the batch failed"}, result.Failed[1])
+		assert.Equal(t, int64(2), result.Failed[2].ID)
+		assert.Equal(t, BatchErrorRolledBack, result.Failed[2].Code)
+	})
+
+	t.Run("ブロッカーが残っているTodoはblockedとして失敗を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectUserLock(mockRepo, ctx)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, lockParams(1, 2)).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID}, {ID: 2, UserID: userID}}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, blockerParams(1, 2)).
+			Return([]int64{2}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, blockerParams(1)).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{1}, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID, Completed: true}}, nil)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, []int64{1, 2}, false, false)
+
+		require.NoError(t, err)
+		require.Len(t, result.Succeeded, 1)
+		assert.Equal(t, int64(1), result.Succeeded[0].ID)
+		assert.Equal(t, []BatchFailedItem{{ID: 2, Code: BatchErrorBlocked, Error: "Todo has open blockers"}}, result.Failed)
+	})
+
+	t.Run("atomicモードではブロックされたTodoがあれば何も完了にしない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		expectUserLock(mockRepo, ctx)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, lockParams(1, 2)).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID}, {ID: 2, UserID: userID}}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, blockerParams(1, 2)).
+			Return([]int64{2}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, blockerParams(1)).
+			Return([]int64{}, nil)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, []int64{1, 2}, true, false)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Succeeded)
+		require.Len(t, result.Failed, 2)
+		assert.Equal(t, BatchErrorBlocked, result.Failed[0].Code)
+		assert.Equal(t, BatchFailedItem{ID: 1, Code: BatchErrorRolledBack, Error: "Rolled back because another todo in the batch failed"}, result.Failed[1])
+	})
+
+	t.Run("forceを指定するとブロッカーを確認せずに完了にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, lockParams(2)).
+			Return([]sqlc.Todo{{ID: 2, UserID: userID}}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{2}, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 2, UserID: userID, Completed: true}}, nil)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, []int64{2}, false, true)
+
+		require.NoError(t, err)
+		assert.Len(t, result.Succeeded, 1)
+		assert.Empty(t, result.Failed)
+		mockRepo.AssertNotCalled(t, "ListOpenBlockedTodoIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("更新時のエラーをそのまま返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		dbErr := errors.New("database error")
+
+		expectUserLock(mockRepo, ctx)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID}}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, mock.Anything).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, mock.Anything).
+			Return(nil, dbErr)
+
+		result, err := svc.BatchCompleteTodos(ctx, userID, []int64{1}, false, false)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestTodoService_BatchUpdateTodos(t *testing.T) {
+	userID := int64(1)
+	existingTodos := []sqlc.Todo{
+		{ID: 1, UserID: userID, Title: "a", Completed: true},
+		{ID: 2, UserID: userID, Title: "b", Completed: false},
+	}
+
+	t.Run("正常系: 存在するTodoを更新し、存在しないIDを失敗として返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		patch := TodoPatch{Completed: ptrBool(false)}
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, sqlc.GetTodosByIDsForUpdateParams{Ids: []int64{1, 2, 999}, UserID: userID}).
+			Return(existingTodos, nil)
+		mockRepo.EXPECT().
+			BatchUpdateTodos(ctx, sqlc.BatchUpdateTodosParams{
+				Ids:       []int64{1, 2},
+				UserID:    userID,
+				Completed: ptrBool(false),
+			}).
+			Return([]sqlc.Todo{
+				{ID: 1, UserID: userID, Title: "a", Completed: false},
+				{ID: 2, UserID: userID, Title: "b", Completed: false},
+			}, nil)
+
+		result, err := svc.BatchUpdateTodos(ctx, userID, []int64{1, 2, 999}, patch, BatchUpdateOptions{})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Succeeded, 2)
+		require.Len(t, result.Failed, 1)
+		assert.Equal(t, int64(999), result.Failed[0].ID)
+		assert.Equal(t, []BatchUpdateChange{
+			{ID: 1, Fields: []string{"completed"}},
+			{ID: 2, Fields: []string{}},
+		}, result.Changes)
+	})
+
+	t.Run("正常系: dry-runでは更新せずに更新後の状態を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		patch := TodoPatch{Title: ptrString("new"), Description: ptrString("desc")}
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(existingTodos, nil)
+
+		result, err := svc.BatchUpdateTodos(ctx, userID, []int64{1, 2}, patch, BatchUpdateOptions{DryRun: true})
+
+		require.NoError(t, err)
+		require.Len(t, result.Succeeded, 2)
+		assert.Equal(t, "new", result.Succeeded[0].Title)
+		assert.Equal(t, "desc", *result.Succeeded[1].Description)
+		assert.Empty(t, result.Failed)
+		assert.Equal(t, []string{"title", "description"}, result.Changes[0].Fields)
+		// 元のTodoは変更されない
+		assert.Equal(t, "a", existingTodos[0].Title)
+	})
+
+	t.Run("正常系: atomicモードでは1件でも失敗があれば何も更新しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		patch := TodoPatch{Completed: ptrBool(true)}
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(5), nil)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(existingTodos, nil)
+		// 完了済みの 1 はブロッカーを確認しない
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{2}}).
+			Return([]int64{}, nil)
+
+		result, err := svc.BatchUpdateTodos(ctx, userID, []int64{1, 999, 2}, patch, BatchUpdateOptions{Atomic: true})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Succeeded)
+		assert.Empty(t, result.Changes)
+		require.Len(t, result.Failed, 3)
+		assert.Equal(t, int64(999), result.Failed[0].ID)
+		assert.Equal(t, "Todo not found", result.Failed[0].Error)
+		assert.Equal(t, BatchErrorNotFound, result.Failed[0].Code)
+		assert.ElementsMatch(t, []int64{1, 2}, []int64{result.Failed[1].ID, result.Failed[2].ID})
+		assert.Equal(t, BatchErrorRolledBack, result.Failed[1].Code)
+	})
+
+	t.Run("正常系: 完了にする場合はブロッカーが残っているTodoをblockedとして失敗を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		patch := TodoPatch{Completed: ptrBool(true)}
+
+		mockRepo.EXPECT().
+			GetTodoChangeSeqForUpdate(ctx, userID).
+			Return(int64(5), nil)
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(existingTodos, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{2}}).
+			Return([]int64{2}, nil)
+		mockRepo.EXPECT().
+			BatchUpdateTodos(ctx, sqlc.BatchUpdateTodosParams{Ids: []int64{1}, UserID: userID, Completed: ptrBool(true)}).
+			Return([]sqlc.Todo{existingTodos[0]}, nil)
+
+		result, err := svc.BatchUpdateTodos(ctx, userID, []int64{1, 2}, patch, BatchUpdateOptions{})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Succeeded, 1)
+		assert.Equal(t, []BatchFailedItem{{ID: 2, Code: BatchErrorBlocked, Error: "Todo has open blockers"}}, result.Failed)
+		assert.Equal(t, []BatchUpdateChange{{ID: 1, Fields: []string{}}}, result.Changes)
+	})
+
+	t.Run("正常系: forceを指定するとブロッカーを確認せずに完了にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		patch := TodoPatch{Completed: ptrBool(true)}
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(existingTodos, nil)
+		mockRepo.EXPECT().
+			BatchUpdateTodos(ctx, sqlc.BatchUpdateTodosParams{Ids: []int64{1, 2}, UserID: userID, Completed: ptrBool(true)}).
+			Return([]sqlc.Todo{existingTodos[0], {ID: 2, UserID: userID, Title: "b", Completed: true}}, nil)
+
+		result, err := svc.BatchUpdateTodos(ctx, userID, []int64{1, 2}, patch, BatchUpdateOptions{Force: true})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Succeeded, 2)
+		assert.Empty(t, result.Failed)
+		mockRepo.AssertNotCalled(t, "ListOpenBlockedTodoIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 更新時のエラーをそのまま返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		dbErr := errors.New("database error")
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(existingTodos, nil)
+		mockRepo.EXPECT().
+			BatchUpdateTodos(ctx, mock.Anything).
+			Return(nil, dbErr)
+
+		result, err := svc.BatchUpdateTodos(ctx, userID, []int64{1, 2}, TodoPatch{Title: ptrString("x")}, BatchUpdateOptions{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestTodoService_BatchDeleteTodos(t *testing.T) {
+	t.Run("全てのTodoが存在する場合", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		userID := int64(1)
+		ids := []int64{1, 2}
+
+		existingTodos := []sqlc.Todo{
+			{ID: 1, UserID: userID},
+			{ID: 2, UserID: userID},
+		}
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(existingTodos, nil)
+		mockRepo.EXPECT().
+			BatchDeleteTodos(ctx, mock.Anything).
+			Return(nil)
+
+		result, err := svc.BatchDeleteTodos(ctx, userID, ids, false)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Succeeded, 2)
+		assert.Len(t, result.Failed, 0)
+		assert.ElementsMatch(t, []int64{1, 2}, result.Succeeded)
+	})
+
+	t.Run("一部のIDが存在しない場合", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		userID := int64(1)
+		ids := []int64{1, 999}
+
+		existingTodos := []sqlc.Todo{
+			{ID: 1, UserID: userID},
+		}
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(existingTodos, nil)
+		mockRepo.EXPECT().
+			BatchDeleteTodos(ctx, mock.Anything).
+			Return(nil)
+
+		result, err := svc.BatchDeleteTodos(ctx, userID, ids, false)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Succeeded, 1)
+		assert.Equal(t, int64(1), result.Succeeded[0])
+		assert.Len(t, result.Failed, 1)
+		assert.Equal(t, int64(999), result.Failed[0].ID)
+		assert.Equal(t, "Todo not found", result.Failed[0].Error)
+		assert.Equal(t, BatchErrorNotFound, result.Failed[0].Code)
+	})
+
+	t.Run("全てのIDが存在しない場合", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		userID := int64(1)
+		ids := []int64{998, 999}
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return([]sqlc.Todo{}, nil)
+
+		result, err := svc.BatchDeleteTodos(ctx, userID, ids, false)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Succeeded, 0)
+		assert.Len(t, result.Failed, 2)
+	})
+
+	t.Run("atomicモードでは1件でも失敗があれば何も削除しない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		userID := int64(1)
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return([]sqlc.Todo{{ID: 1, UserID: userID}}, nil)
+
+		result, err := svc.BatchDeleteTodos(ctx, userID, []int64{1, 2}, true)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Succeeded)
+		require.Len(t, result.Failed, 2)
+		assert.Equal(t, BatchFailedItem{ID: 2, Code: BatchErrorNotFound, Error: "Todo not found"}, result.Failed[0])
+		assert.Equal(t, BatchErrorRolledBack, result.Failed[1].Code)
+	})
+
+	t.Run("GetTodosByIDsForUpdateでエラーが発生した場合", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		userID := int64(1)
+		ids := []int64{1, 2}
+		dbErr := errors.New("database error")
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(nil, dbErr)
+
+		result, err := svc.BatchDeleteTodos(ctx, userID, ids, false)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+
+	t.Run("BatchDeleteTodosでエラーが発生した場合", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+		ctx := context.Background()
+		userID := int64(1)
+		ids := []int64{1, 2}
+		dbErr := errors.New("database error")
+
+		existingTodos := []sqlc.Todo{
+			{ID: 1, UserID: userID},
+			{ID: 2, UserID: userID},
+		}
+
+		mockRepo.EXPECT().
+			GetTodosByIDsForUpdate(ctx, mock.Anything).
+			Return(existingTodos, nil)
+		mockRepo.EXPECT().
+			BatchDeleteTodos(ctx, mock.Anything).
+			Return(dbErr)
+
+		result, err := svc.BatchDeleteTodos(ctx, userID, ids, false)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestBulkTodoFilter_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  BulkTodoFilter
+		wantErr bool
+	}{
+		{name: "正常系: 条件なし", filter: BulkTodoFilter{}, wantErr: false},
+		{name: "正常系: ID指定", filter: BulkTodoFilter{IDs: []int64{1}}, wantErr: false},
+		{name: "異常系: 空のIDリスト", filter: BulkTodoFilter{IDs: []int64{}}, wantErr: true},
+		{name: "異常系: IDが多すぎる", filter: BulkTodoFilter{IDs: make([]int64, MaxBulkJobIDs+1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidBulkFilter)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTodoService_CompleteTodosJob(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+	incomplete := false
+	createdBefore := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 未完了のTodoをチャンクごとに完了にし、進捗を報告する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+
+		mockRepo.EXPECT().
+			CountTodosByFilter(ctx, sqlc.CountTodosByFilterParams{
+				UserID:        userID,
+				CreatedBefore: pgtype.Timestamptz{Time: createdBefore, Valid: true},
+				Completed:     &incomplete,
+			}).
+			Return(int64(3), nil)
+		listParams := func(afterID int64) sqlc.ListTodoIDsByFilterParams {
+			return sqlc.ListTodoIDsByFilterParams{
+				UserID:        userID,
+				AfterID:       afterID,
+				CreatedBefore: pgtype.Timestamptz{Time: createdBefore, Valid: true},
+				Completed:     &incomplete,
+				MaxRows:       bulkJobChunkSize,
+			}
+		}
+		mockRepo.EXPECT().ListTodoIDsByFilter(ctx, listParams(0)).Return([]int64{1, 2}, nil)
+		mockRepo.EXPECT().ListTodoIDsByFilter(ctx, listParams(2)).Return([]int64{5}, nil)
+		mockRepo.EXPECT().ListTodoIDsByFilter(ctx, listParams(5)).Return([]int64{}, nil)
+		// チャンクごとにユーザー行をロックしてブロッカーを確認する
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil).Times(2)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{1, 2}}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{5}}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{1, 2}, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 1}, {ID: 2}}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{5}, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 5}}, nil)
+
+		var reported [][2]int32
+		progress := func(processed, total int32) error {
+			reported = append(reported, [2]int32{processed, total})
+			return nil
+		}
+
+		// completed: true が指定されていても未完了のTodoだけが対象になる
+		result, err := svc.CompleteTodosJob(ctx, userID, []byte(`{"created_before":"2025-10-20T00:00:00Z","completed":true}`), progress)
+
+		require.NoError(t, err)
+		assert.Equal(t, &BulkJobResult{Affected: 3}, result)
+		assert.Equal(t, [][2]int32{{0, 3}, {2, 3}, {3, 3}}, reported)
+	})
+
+	t.Run("正常系: ブロッカーが残っているTodoは完了にせずスキップした件数を返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+
+		mockRepo.EXPECT().CountTodosByFilter(ctx, mock.Anything).Return(int64(2), nil)
+		mockRepo.EXPECT().ListTodoIDsByFilter(ctx, mock.Anything).Return([]int64{1, 2}, nil).Once()
+		mockRepo.EXPECT().ListTodoIDsByFilter(ctx, mock.Anything).Return([]int64{}, nil).Once()
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{1, 2}}).
+			Return([]int64{1}, nil)
+		mockRepo.EXPECT().
+			ListOpenBlockedTodoIDs(ctx, sqlc.ListOpenBlockedTodoIDsParams{UserID: userID, Ids: []int64{2}}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{2}, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 2}}, nil)
+
+		var reported [][2]int32
+		progress := func(processed, total int32) error {
+			reported = append(reported, [2]int32{processed, total})
+			return nil
+		}
+
+		result, err := svc.CompleteTodosJob(ctx, userID, []byte(`{}`), progress)
+
+		require.NoError(t, err)
+		assert.Equal(t, &BulkJobResult{Affected: 1, Skipped: 1}, result)
+		assert.Equal(t, [][2]int32{{0, 2}, {2, 2}}, reported)
+	})
+
+	t.Run("正常系: forceを指定するとブロッカーを確認せずに完了にする", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+
+		mockRepo.EXPECT().CountTodosByFilter(ctx, mock.Anything).Return(int64(1), nil)
+		mockRepo.EXPECT().ListTodoIDsByFilter(ctx, mock.Anything).Return([]int64{3}, nil).Once()
+		mockRepo.EXPECT().ListTodoIDsByFilter(ctx, mock.Anything).Return([]int64{}, nil).Once()
+		mockRepo.EXPECT().
+			BatchCompleteTodos(ctx, sqlc.BatchCompleteTodosParams{Ids: []int64{3}, UserID: userID}).
+			Return([]sqlc.Todo{{ID: 3}}, nil)
+
+		result, err := svc.CompleteTodosJob(ctx, userID, []byte(`{"force":true}`), func(int32, int32) error { return nil })
+
+		require.NoError(t, err)
+		assert.Equal(t, &BulkJobResult{Affected: 1}, result)
+		mockRepo.AssertNotCalled(t, "ListOpenBlockedTodoIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 進捗報告がエラーを返したら中断する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := newTxTestTodoService(mockRepo)
+
+		mockRepo.EXPECT().CountTodosByFilter(ctx, mock.Anything).Return(int64(2), nil)
+		mockRepo.EXPECT().ListTodoIDsByFilter(ctx, mock.Anything).Return([]int64{1}, nil).Once()
+		mockRepo.EXPECT().GetTodoChangeSeqForUpdate(ctx, userID).Return(int64(5), nil).Once()
+		mockRepo.EXPECT().ListOpenBlockedTodoIDs(ctx, mock.Anything).Return([]int64{}, nil).Once()
+		mockRepo.EXPECT().BatchCompleteTodos(ctx, mock.Anything).Return([]sqlc.Todo{{ID: 1}}, nil).Once()
+
+		progress := func(processed, total int32) error {
+			if processed > 0 {
+				return context.Canceled
+			}
+			return nil
+		}
+
+		result, err := svc.CompleteTodosJob(ctx, userID, []byte(`{}`), progress)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, &BulkJobResult{Affected: 1}, result)
+	})
+}
+
+func TestTodoService_DeleteTodosJob(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+
+	t.Run("正常系: 指定したIDのTodoを削除する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		mockRepo.EXPECT().
+			CountTodosByFilter(ctx, sqlc.CountTodosByFilterParams{UserID: userID, Ids: []int64{1, 2}}).
+			Return(int64(1), nil)
+		mockRepo.EXPECT().
+			ListTodoIDsByFilter(ctx, sqlc.ListTodoIDsByFilterParams{UserID: userID, Ids: []int64{1, 2}, MaxRows: bulkJobChunkSize}).
+			Return([]int64{2}, nil)
+		mockRepo.EXPECT().
+			ListTodoIDsByFilter(ctx, sqlc.ListTodoIDsByFilterParams{UserID: userID, AfterID: 2, Ids: []int64{1, 2}, MaxRows: bulkJobChunkSize}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchDeleteTodos(ctx, sqlc.BatchDeleteTodosParams{Ids: []int64{2}, UserID: userID}).
+			Return(nil)
+
+		result, err := svc.DeleteTodosJob(ctx, userID, []byte(`{"ids":[1,2]}`), func(int32, int32) error { return nil })
+
+		require.NoError(t, err)
+		assert.Equal(t, &BulkJobResult{Affected: 1}, result)
+	})
+
+	t.Run("正常系: 指定したプロジェクトのTodoだけを削除する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+		projectID := int64(7)
+
+		mockRepo.EXPECT().
+			CountTodosByFilter(ctx, sqlc.CountTodosByFilterParams{UserID: userID, ProjectID: &projectID}).
+			Return(int64(1), nil)
+		mockRepo.EXPECT().
+			ListTodoIDsByFilter(ctx, sqlc.ListTodoIDsByFilterParams{UserID: userID, ProjectID: &projectID, MaxRows: bulkJobChunkSize}).
+			Return([]int64{3}, nil)
+		mockRepo.EXPECT().
+			ListTodoIDsByFilter(ctx, sqlc.ListTodoIDsByFilterParams{UserID: userID, AfterID: 3, ProjectID: &projectID, MaxRows: bulkJobChunkSize}).
+			Return([]int64{}, nil)
+		mockRepo.EXPECT().
+			BatchDeleteTodos(ctx, sqlc.BatchDeleteTodosParams{Ids: []int64{3}, UserID: userID}).
+			Return(nil)
+
+		result, err := svc.DeleteTodosJob(ctx, userID, []byte(`{"project_id":7}`), func(int32, int32) error { return nil })
+
+		require.NoError(t, err)
+		assert.Equal(t, &BulkJobResult{Affected: 1}, result)
+	})
+
+	t.Run("異常系: 不正なパラメータはエラーを返す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoRepository(t)
+		svc := NewTodoService(mockRepo, nil, testMaxBatchItems)
+
+		result, err := svc.DeleteTodosJob(ctx, userID, []byte(`{"ids":"x"}`), func(int32, int32) error { return nil })
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestNormalizeBatchIDs(t *testing.T) {
+	tests := []struct {
+		name         string
+		ids          []int64
+		wantValid    []int64
+		wantInvalid  []int64
+		wantWarnings []int64
+	}{
+		{name: "重複なし", ids: []int64{3, 1, 2}, wantValid: []int64{3, 1, 2}, wantInvalid: []int64{}, wantWarnings: []int64{}},
+		{name: "重複は最初の1件だけ残して1回だけ警告する", ids: []int64{1, 2, 1, 1}, wantValid: []int64{1, 2}, wantInvalid: []int64{}, wantWarnings: []int64{1}},
+		{name: "正でないIDは失敗にする", ids: []int64{0, 1, -5}, wantValid: []int64{1}, wantInvalid: []int64{0, -5}, wantWarnings: []int64{}},
+		{name: "重複した不正なIDは1件の失敗と警告にする", ids: []int64{-1, -1}, wantValid: []int64{}, wantInvalid: []int64{-1}, wantWarnings: []int64{-1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, invalid, warnings := normalizeBatchIDs(tt.ids)
+
+			assert.Equal(t, tt.wantValid, valid)
+			invalidIDs := []int64{}
+			for _, item := range invalid {
+				assert.Equal(t, BatchErrorInvalidID, item.Code)
+				invalidIDs = append(invalidIDs, item.ID)
+			}
+			assert.Equal(t, tt.wantInvalid, invalidIDs)
+			warningIDs := []int64{}
+			for _, w := range warnings {
+				assert.Equal(t, BatchWarningDuplicateID, w.Code)
+				warningIDs = append(warningIDs, w.ID)
+			}
+			assert.Equal(t, tt.wantWarnings, warningIDs)
+		})
+	}
+}
+
+func TestTodoService_BatchLimit(t *testing.T) {
+	mockRepo := mocks.NewMockTodoRepository(t)
+	svc := NewTodoService(mockRepo, nil, 2)
+	ctx := context.Background()
+	ids := []int64{1, 2, 3}
+
+	t.Run("異常系: 上限を超えるIDはErrTooManyBatchItemsを返す", func(t *testing.T) {
+		_, err := svc.BatchCompleteTodos(ctx, 1, ids, false, false)
+		assert.ErrorIs(t, err, ErrTooManyBatchItems)
+
+		_, err = svc.BatchDeleteTodos(ctx, 1, ids, false)
+		assert.ErrorIs(t, err, ErrTooManyBatchItems)
+
+		_, err = svc.BatchUpdateTodos(ctx, 1, ids, TodoPatch{Completed: ptrBool(true)}, BatchUpdateOptions{})
+		assert.ErrorIs(t, err, ErrTooManyBatchItems)
+
+		_, err = svc.BatchCreateTodos(ctx, 1, []BatchCreateItem{{Title: "a"}, {Title: "b"}, {Title: "c"}})
+		assert.ErrorIs(t, err, ErrTooManyBatchItems)
+	})
+}
+
+// バッチ処理のプロパティテスト用のインメモリリポジトリ
+// バッチ処理で使うメソッドだけを実装する
+type memoryBatchRepo struct {
+	TodoRepository
+	userID int64
+	todos  map[int64]sqlc.Todo
+	// Todoをブロックしているブロッカーのid
+	blockers map[int64]int64
+}
+
+func (r *memoryBatchRepo) GetTodoChangeSeqForUpdate(context.Context, int64) (int64, error) {
+	return 0, nil
+}
+
+func (r *memoryBatchRepo) ListOpenBlockedTodoIDs(_ context.Context, arg sqlc.ListOpenBlockedTodoIDsParams) ([]int64, error) {
+	var blocked []int64
+	for _, id := range arg.Ids {
+		blockerID, ok := r.blockers[id]
+		if !ok || slices.Contains(arg.Ids, blockerID) {
+			continue
+		}
+		if blocker, ok := r.todos[blockerID]; ok && !blocker.Completed {
+			blocked = append(blocked, id)
+		}
+	}
+	return blocked, nil
+}
+
+func (r *memoryBatchRepo) GetTodosByIDsForUpdate(_ context.Context, arg sqlc.GetTodosByIDsForUpdateParams) ([]sqlc.Todo, error) {
+	var todos []sqlc.Todo
+	for _, id := range arg.Ids {
+		if todo, ok := r.todos[id]; ok && todo.UserID == arg.UserID {
+			todos = append(todos, todo)
+		}
+	}
+	return todos, nil
+}
+
+func (r *memoryBatchRepo) BatchCompleteTodos(_ context.Context, arg sqlc.BatchCompleteTodosParams) ([]sqlc.Todo, error) {
+	var todos []sqlc.Todo
+	for _, id := range arg.Ids {
+		todo := r.todos[id]
+		todo.Completed = true
+		r.todos[id] = todo
+		todos = append(todos, todo)
+	}
+	return todos, nil
+}
+
+func (r *memoryBatchRepo) BatchDeleteTodos(_ context.Context, arg sqlc.BatchDeleteTodosParams) error {
+	for _, id := range arg.Ids {
+		delete(r.todos, id)
+	}
+	return nil
+}
+
+func (r *memoryBatchRepo) BatchUpdateTodos(_ context.Context, arg sqlc.BatchUpdateTodosParams) ([]sqlc.Todo, error) {
+	var todos []sqlc.Todo
+	for _, id := range arg.Ids {
+		todos = append(todos, r.todos[id])
+	}
+	return todos, nil
+}
+
+// ランダムな入力から、IDが重複・不正・存在しない・他のユーザー・完了済みの場合を含む状態を作る
+func newMemoryBatchRepo(seed []uint8) *memoryBatchRepo {
+	repo := &memoryBatchRepo{userID: 1, todos: map[int64]sqlc.Todo{}, blockers: map[int64]int64{}}
+	for i, b := range seed {
+		id := int64(i + 1)
+		switch b % 4 {
+		case 0: // 存在しない
+		case 1:
+			repo.todos[id] = sqlc.Todo{ID: id, UserID: repo.userID}
+			// 次のIDのTodoにブロックされている（未完了のTodoであれば完了にできない）
+			if b%8 == 5 {
+				repo.blockers[id] = id + 1
+			}
+		case 2:
+			repo.todos[id] = sqlc.Todo{ID: id, UserID: repo.userID, Completed: true}
+		case 3:
+			repo.todos[id] = sqlc.Todo{ID: id, UserID: repo.userID + 1}
+		}
+	}
+	return repo
+}
+
+func toBatchIDs(raw []int8) []int64 {
+	ids := make([]int64, len(raw))
+	for i, v := range raw {
+		// 範囲を狭めて重複と0以下のIDが出やすいようにする
+		ids[i] = int64(v % 12)
+	}
+	return ids
+}
+
+// Succeeded と Failed の和集合が入力のIDの集合と一致し、どのIDも1回だけ現れることを確認する
+func checkBatchPartition(input []int64, succeeded []int64, failed []BatchFailedItem) bool {
+	want := map[int64]bool{}
+	for _, id := range input {
+		want[id] = true
+	}
+	got := map[int64]int{}
+	for _, id := range succeeded {
+		got[id]++
+	}
+	for _, item := range failed {
+		got[item.ID]++
+	}
+	if len(got) != len(want) {
+		return false
+	}
+	for id, n := range got {
+		if n != 1 || !want[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTodoService_BatchProperties(t *testing.T) {
+	ctx := context.Background()
+	config := &quick.Config{MaxCount: 500}
+	newService := func(repo *memoryBatchRepo, maxItems int) *TodoService {
+		svc := NewTodoService(repo, nil, maxItems)
+		svc.txManager = fakeTxManager{}
+		svc.withTx = func(pgx.Tx) TodoRepository { return repo }
+		return svc
+	}
+
+	t.Run("BatchCompleteTodos: SucceededとFailedの和集合は入力と一致する", func(t *testing.T) {
+		property := func(seed []uint8, raw []int8, atomic, force bool) bool {
+			ids := toBatchIDs(raw)
+			if len(ids) == 0 {
+				return true
+			}
+			repo := newMemoryBatchRepo(seed)
+			result, err := newService(repo, len(ids)).BatchCompleteTodos(ctx, repo.userID, ids, atomic, force)
+			if err != nil {
+				return false
+			}
+			succeeded := make([]int64, len(result.Succeeded))
+			for i, todo := range result.Succeeded {
+				succeeded[i] = todo.ID
+			}
+			return checkBatchPartition(ids, succeeded, result.Failed)
+		}
+		assert.NoError(t, quick.Check(property, config))
+	})
+
+	t.Run("BatchDeleteTodos: SucceededとFailedの和集合は入力と一致する", func(t *testing.T) {
+		property := func(seed []uint8, raw []int8, atomic bool) bool {
+			ids := toBatchIDs(raw)
+			if len(ids) == 0 {
+				return true
+			}
+			repo := newMemoryBatchRepo(seed)
+			result, err := newService(repo, len(ids)).BatchDeleteTodos(ctx, repo.userID, ids, atomic)
+			if err != nil {
+				return false
+			}
+			return checkBatchPartition(ids, result.Succeeded, result.Failed)
+		}
+		assert.NoError(t, quick.Check(property, config))
+	})
+
+	t.Run("BatchUpdateTodos: SucceededとFailedの和集合は入力と一致する", func(t *testing.T) {
+		property := func(seed []uint8, raw []int8, atomic, dryRun, complete bool) bool {
+			ids := toBatchIDs(raw)
+			if len(ids) == 0 {
+				return true
+			}
+			repo := newMemoryBatchRepo(seed)
+			opts := BatchUpdateOptions{Atomic: atomic, DryRun: dryRun}
+			patch := TodoPatch{Title: ptrString("x")}
+			if complete {
+				patch.Completed = ptrBool(true)
+			}
+			result, err := newService(repo, len(ids)).BatchUpdateTodos(ctx, repo.userID, ids, patch, opts)
+			if err != nil {
+				return false
+			}
+			succeeded := make([]int64, len(result.Succeeded))
+			for i, todo := range result.Succeeded {
+				succeeded[i] = todo.ID
+			}
+			return checkBatchPartition(ids, succeeded, result.Failed)
+		}
+		assert.NoError(t, quick.Check(property, config))
+	})
+
+	t.Run("重複したIDごとに警告が1件だけ返る", func(t *testing.T) {
+		property := func(seed []uint8, raw []int8) bool {
+			ids := toBatchIDs(raw)
+			if len(ids) == 0 {
+				return true
+			}
+			counts := map[int64]int{}
+			for _, id := range ids {
+				counts[id]++
+			}
+			duplicated := 0
+			for _, n := range counts {
+				if n > 1 {
+					duplicated++
+				}
+			}
+			repo := newMemoryBatchRepo(seed)
+			result, err := newService(repo, len(ids)).BatchDeleteTodos(ctx, repo.userID, ids, false)
+			return err == nil && len(result.Warnings) == duplicated
+		}
+		assert.NoError(t, quick.Check(property, config))
+	})
+}
+
+// ヘルパー関数
+
+const testMaxBatchItems = 100
+
+// fn をそのまま実行するTxManager（リポジトリはモックを使う）
+type fakeTxManager struct{}
+
+func (fakeTxManager) RunInTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	return fn(nil)
+}
+
+// トランザクション内でも同じモックリポジトリを使うTodoService
+func newTxTestTodoService(repo TodoRepository) *TodoService {
+	svc := NewTodoService(repo, nil, testMaxBatchItems)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) TodoRepository { return repo }
+	return svc
+}
+
+// 固定の項目を返すimporter.Reader
+type stubImportReader struct {
+	results []stubImportResult
+}
+
+type stubImportResult struct {
+	item *importer.Item
+	err  error
+}
+
+func (r *stubImportReader) Next() (*importer.Item, error) {
+	if len(r.results) == 0 {
+		return nil, io.EOF
+	}
+	res := r.results[0]
+	r.results = r.results[1:]
+	return res.item, res.err
+}
+
+func ptrString(s string) *string {
+	return &s
+}
+
+func ptrBool(b bool) *bool {
+	return &b
+}
+
+func ptrInt32(i int32) *int32 {
+	return &i
+}
+
+func ptrInt64(i int64) *int64 {
+	return &i
 }
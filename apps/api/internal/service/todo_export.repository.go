@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type TodoExportRepository interface {
+	ListStatusesByUser(ctx context.Context, userID int64) ([]sqlc.Status, error)
+	ListTodosForExportPage(ctx context.Context, arg sqlc.ListTodosForExportPageParams) ([]sqlc.Todo, error)
+}
+
+// sqlc.Querier が TodoExportRepository を満たすことを保証
+var _ TodoExportRepository = (sqlc.Querier)(nil)
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+	"go-todo/internal/exporter"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// エクスポートで1回に読み込むTodoの件数
+const exportPageSize = 500
+
+// エクスポートの指定
+type ExportOptions struct {
+	Format         exporter.Format
+	IncludeDeleted bool
+	// 空なら exporter.DefaultColumns
+	Columns []exporter.Column
+}
+
+// ユーザーのTodoをファイルとして書き出す
+// Todoはページごとに読み込んで書き出すため、件数が多くてもメモリ使用量は増えない
+type TodoExportService struct {
+	repo TodoExportRepository
+	// 読み取り専用のスナップショットで、ページに分けて読み込んでも重複・欠落しないようにする
+	txManager database.TxManager
+	withTx    func(tx pgx.Tx) TodoExportRepository
+	pageSize  int32
+}
+
+func NewTodoExportService(repo TodoExportRepository, pool *pgxpool.Pool) *TodoExportService {
+	return &TodoExportService{
+		repo:      repo,
+		txManager: database.NewSnapshotTxManager(pool),
+		withTx: func(tx pgx.Tx) TodoExportRepository {
+			return sqlc.New(tx)
+		},
+		pageSize: exportPageSize,
+	}
+}
+
+// opts の形式でユーザーのTodoを並び順に w へ書き出す
+// 途中でエラーになった場合、w にはそれまでの出力が書き込まれている
+func (s *TodoExportService) ExportTodos(ctx context.Context, userID int64, opts ExportOptions, w io.Writer) error {
+	columns := opts.Columns
+	if len(columns) == 0 {
+		columns = exporter.DefaultColumns(opts.IncludeDeleted)
+	}
+	writer, err := exporter.NewWriter(opts.Format, w, columns)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+
+		// ステータスは件数が少ないため、先に名前を引けるようにしておく
+		statuses, err := repo.ListStatusesByUser(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("list statuses: %w", err)
+		}
+		statusNames := make(map[int64]string, len(statuses))
+		for _, st := range statuses {
+			statusNames[st.ID] = st.Name
+		}
+
+		var item exporter.Item
+		err = forEachTodoForExport(ctx, repo, sqlc.ListTodosForExportPageParams{
+			UserID:         userID,
+			IncludeDeleted: opts.IncludeDeleted,
+			MaxRows:        s.pageSize,
+		}, func(t *sqlc.Todo) error {
+			todoToExportItem(t, statusNames, &item)
+			return writer.Write(&item)
+		})
+		if err != nil {
+			return fmt.Errorf("export todos: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+type todoExportPageLister interface {
+	ListTodosForExportPage(ctx context.Context, arg sqlc.ListTodosForExportPageParams) ([]sqlc.Todo, error)
+}
+
+// Todoを並び順に arg.MaxRows 件ずつ読み込み、1件ずつ fn に渡す
+// 一度に読み込むのは1ページ分だけのため、件数に比例してメモリを使わない
+// ページの間で並び順が変わらないように、スナップショットのトランザクション内で呼び出す
+// fn がエラーを返した場合は読み込みを中断してそのエラーを返す
+func forEachTodoForExport(ctx context.Context, repo todoExportPageLister, arg sqlc.ListTodosForExportPageParams, fn func(*sqlc.Todo) error) error {
+	for {
+		todos, err := repo.ListTodosForExportPage(ctx, arg)
+		if err != nil {
+			return err
+		}
+		for i := range todos {
+			if err := fn(&todos[i]); err != nil {
+				return err
+			}
+		}
+		if len(todos) < int(arg.MaxRows) {
+			return nil
+		}
+		last := todos[len(todos)-1]
+		arg.AfterPosition, arg.AfterID = last.Position, last.ID
+	}
+}
+
+func todoToExportItem(t *sqlc.Todo, statusNames map[int64]string, item *exporter.Item) {
+	*item = exporter.Item{
+		ID:          t.ID,
+		ClientID:    t.ClientID.String(),
+		Title:       t.Title,
+		Description: t.Description,
+		Completed:   t.Completed,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		Version:     t.Version,
+	}
+	if t.StatusID != nil {
+		if name, ok := statusNames[*t.StatusID]; ok {
+			item.Status = &name
+		}
+	}
+	if t.DueAt.Valid {
+		due := t.DueAt.Time
+		item.DueAt = &due
+	}
+	if t.DeletedAt.Valid {
+		deleted := t.DeletedAt.Time
+		item.DeletedAt = &deleted
+	}
+}
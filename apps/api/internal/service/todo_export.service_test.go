@@ -0,0 +1,155 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/exporter"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// トランザクション内でも同じモックリポジトリを使うTodoExportService
+func newTxTestTodoExportService(repo TodoExportRepository) *TodoExportService {
+	svc := NewTodoExportService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) TodoExportRepository { return repo }
+	return svc
+}
+
+func TestTodoExportService_ExportTodos(t *testing.T) {
+	userID := int64(1)
+	created := time.Date(2026, 10, 20, 9, 30, 0, 0, time.UTC)
+	todos := []sqlc.Todo{
+		{ID: 1, UserID: userID, Title: "Buy milk", StatusID: ptrInt64(2), CreatedAt: created, UpdatedAt: created, Version: 1},
+		{ID: 2, UserID: userID, Title: "Old", CreatedAt: created, UpdatedAt: created, Version: 2,
+			DeletedAt: pgtype.Timestamptz{Time: created.Add(time.Hour), Valid: true}},
+	}
+
+	t.Run("正常系: ステータス名を引いて1件ずつ書き出す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoExportRepository(t)
+		svc := newTxTestTodoExportService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().ListStatusesByUser(ctx, userID).Return([]sqlc.Status{{ID: 2, Name: "doing"}}, nil)
+		mockRepo.EXPECT().
+			ListTodosForExportPage(ctx, sqlc.ListTodosForExportPageParams{UserID: userID, IncludeDeleted: true, MaxRows: exportPageSize}).
+			Return(todos, nil)
+
+		var buf bytes.Buffer
+		err := svc.ExportTodos(ctx, userID, ExportOptions{
+			Format:         exporter.FormatCSV,
+			IncludeDeleted: true,
+			Columns:        []exporter.Column{exporter.ColumnID, exporter.ColumnTitle, exporter.ColumnStatus, exporter.ColumnDeletedAt},
+		}, &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, "id,title,status,deleted_at\n1,Buy milk,doing,\n2,Old,,2026-10-20T10:30:00Z\n", buf.String())
+	})
+
+	t.Run("正常系: 並び順の続きからページごとに読み込む", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoExportRepository(t)
+		svc := newTxTestTodoExportService(mockRepo)
+		svc.pageSize = 1
+		ctx := context.Background()
+		todos := []sqlc.Todo{
+			{ID: 3, UserID: userID, Title: "a", Position: "V"},
+			{ID: 1, UserID: userID, Title: "b", Position: "X"},
+		}
+
+		mockRepo.EXPECT().ListStatusesByUser(ctx, userID).Return([]sqlc.Status{}, nil)
+		mockRepo.EXPECT().
+			ListTodosForExportPage(ctx, sqlc.ListTodosForExportPageParams{UserID: userID, MaxRows: 1}).
+			Return(todos[:1], nil)
+		mockRepo.EXPECT().
+			ListTodosForExportPage(ctx, sqlc.ListTodosForExportPageParams{UserID: userID, AfterPosition: "V", AfterID: 3, MaxRows: 1}).
+			Return(todos[1:], nil)
+		mockRepo.EXPECT().
+			ListTodosForExportPage(ctx, sqlc.ListTodosForExportPageParams{UserID: userID, AfterPosition: "X", AfterID: 1, MaxRows: 1}).
+			Return([]sqlc.Todo{}, nil)
+
+		var buf bytes.Buffer
+		err := svc.ExportTodos(ctx, userID, ExportOptions{
+			Format:  exporter.FormatCSV,
+			Columns: []exporter.Column{exporter.ColumnID, exporter.ColumnTitle},
+		}, &buf)
+
+		require.NoError(t, err)
+		assert.Equal(t, "id,title\n3,a\n1,b\n", buf.String())
+	})
+
+	t.Run("正常系: 列を指定しない場合は既定の列を書き出す", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoExportRepository(t)
+		svc := newTxTestTodoExportService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().ListStatusesByUser(ctx, userID).Return([]sqlc.Status{}, nil)
+		mockRepo.EXPECT().
+			ListTodosForExportPage(ctx, sqlc.ListTodosForExportPageParams{UserID: userID, MaxRows: exportPageSize}).
+			Return(todos[:1], nil)
+
+		var buf bytes.Buffer
+		err := svc.ExportTodos(ctx, userID, ExportOptions{Format: exporter.FormatCSV}, &buf)
+
+		require.NoError(t, err)
+		header, _, _ := strings.Cut(buf.String(), "\n")
+		assert.Equal(t, "id,client_id,title,description,completed,status,due_at,created_at,updated_at,version", header)
+	})
+
+	t.Run("異常系: 未知の形式はDBを参照せずにエラー", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoExportRepository(t)
+		svc := newTxTestTodoExportService(mockRepo)
+
+		err := svc.ExportTodos(context.Background(), userID, ExportOptions{Format: "xml"}, &bytes.Buffer{})
+
+		assert.ErrorIs(t, err, exporter.ErrUnknownFormat)
+	})
+
+	t.Run("異常系: 書き出しに失敗した場合は読み込みを中断する", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoExportRepository(t)
+		svc := newTxTestTodoExportService(mockRepo)
+		ctx := context.Background()
+		writeErr := errors.New("client disconnected")
+
+		mockRepo.EXPECT().ListStatusesByUser(ctx, userID).Return([]sqlc.Status{}, nil)
+		mockRepo.EXPECT().
+			ListTodosForExportPage(ctx, mock.Anything).
+			Return(todos, nil)
+
+		err := svc.ExportTodos(ctx, userID, ExportOptions{Format: exporter.FormatNDJSON}, failingWriter{err: writeErr})
+
+		assert.ErrorIs(t, err, writeErr)
+	})
+
+	t.Run("異常系: ステータスの取得に失敗した場合は何も書き出さない", func(t *testing.T) {
+		mockRepo := mocks.NewMockTodoExportRepository(t)
+		svc := newTxTestTodoExportService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.EXPECT().ListStatusesByUser(ctx, userID).Return(nil, errors.New("db error"))
+
+		var buf bytes.Buffer
+		err := svc.ExportTodos(ctx, userID, ExportOptions{Format: exporter.FormatJSON}, &buf)
+
+		assert.Error(t, err)
+		assert.Empty(t, buf.String())
+	})
+}
+
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"go-todo/db/sqlc"
+)
+
+type UserSettingsRepository interface {
+	GetUserSettings(ctx context.Context, userID int64) (sqlc.UserSetting, error)
+	UpsertUserSettings(ctx context.Context, arg sqlc.UpsertUserSettingsParams) (sqlc.UserSetting, error)
+	ProjectExists(ctx context.Context, arg sqlc.ProjectExistsParams) (bool, error)
+	ListNotificationPreferences(ctx context.Context, userID int64) ([]sqlc.NotificationPreference, error)
+	UpsertNotificationPreference(ctx context.Context, arg sqlc.UpsertNotificationPreferenceParams) error
+}
+
+// sqlc.Querier が UserSettingsRepository を満たすことを保証
+var _ UserSettingsRepository = (sqlc.Querier)(nil)
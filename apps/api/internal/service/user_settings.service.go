@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/database"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/text/language"
+)
+
+const (
+	DefaultTimezone  = "UTC"
+	DefaultLocale    = "en"
+	DefaultWeekStart = time.Monday
+)
+
+var (
+	ErrInvalidTimezone     = errors.New("invalid timezone")
+	ErrInvalidUserSettings = errors.New("invalid user settings")
+)
+
+// 数字だけの日付（3/4 など）を月/日の順で書く地域。それ以外の地域では日/月の順に読む
+var monthFirstRegions = map[string]bool{
+	"US": true, "CA": true, "PH": true,
+	"JP": true, "CN": true, "KR": true, "TW": true,
+	"HU": true, "LT": true, "MN": true,
+}
+
+// ユーザーの設定。未設定の項目は既定値になる
+type UserSettings struct {
+	// IANAのタイムゾーン名
+	Timezone string
+	// Timezone を読み込んだもの。「今日」や日付だけの期限はこのタイムゾーンで解釈する
+	Location *time.Location
+	// BCP 47の言語タグ
+	Locale           string
+	WeekStart        time.Weekday
+	DefaultSort      TodoSort
+	DefaultProjectID *int64
+	// GetSettings と UpdateSettings だけが設定する
+	Notifications []NotificationPreference
+}
+
+// 数字だけの日付を日/月の順で読むか（ロケールの地域から決める）
+func (s *UserSettings) DayFirst() bool {
+	tag, err := language.Parse(s.Locale)
+	if err != nil {
+		return false
+	}
+	region, _ := tag.Region()
+	return !monthFirstRegions[region.String()]
+}
+
+// 設定の変更。nil の項目は変更しない
+type UserSettingsPatch struct {
+	Timezone    *string
+	Locale      *string
+	WeekStart   *time.Weekday
+	DefaultSort *TodoSort
+	// 0 を指定すると既定のプロジェクトを解除する
+	DefaultProjectID *int64
+	// 指定した種類の通知の受け取り設定だけを変更する
+	Notifications []NotificationPreference
+}
+
+type UserSettingsService struct {
+	repo      UserSettingsRepository
+	txManager database.TxManager
+	withTx    func(pgx.Tx) UserSettingsRepository
+}
+
+func NewUserSettingsService(repo UserSettingsRepository, pool *pgxpool.Pool) *UserSettingsService {
+	return &UserSettingsService{
+		repo:      repo,
+		txManager: database.NewTxManager(pool),
+		withTx: func(tx pgx.Tx) UserSettingsRepository {
+			return sqlc.New(tx)
+		},
+	}
+}
+
+// 通知の受け取り設定を含むすべての設定を返す
+func (s *UserSettingsService) GetSettings(ctx context.Context, userID int64) (*UserSettings, error) {
+	settings, err := loadUserSettings(ctx, s.repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	settings.Notifications, err = getNotificationPreferences(ctx, s.repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// 「今日」や日付を解釈するタイムゾーンを返す
+func (s *UserSettingsService) Location(ctx context.Context, userID int64) (*time.Location, error) {
+	settings, err := loadUserSettings(ctx, s.repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	return settings.Location, nil
+}
+
+// エラーメッセージなどの言語を決めるロケール（BCP 47の言語タグ）を返す
+func (s *UserSettingsService) Locale(ctx context.Context, userID int64) (string, error) {
+	settings, err := loadUserSettings(ctx, s.repo, userID)
+	if err != nil {
+		return "", err
+	}
+	return settings.Locale, nil
+}
+
+// Todo一覧の既定の並び順を返す
+func (s *UserSettingsService) DefaultSort(ctx context.Context, userID int64) (TodoSort, error) {
+	settings, err := loadUserSettings(ctx, s.repo, userID)
+	if err != nil {
+		return "", err
+	}
+	return settings.DefaultSort, nil
+}
+
+// 指定した項目を更新し、更新後のすべての設定を返す
+// 不正な値は ErrInvalidTimezone、ErrInvalidUserSettings、ErrInvalidNotificationPreference を返す
+// 既定のプロジェクトが存在しない場合は ErrProjectNotFound を返す
+func (s *UserSettingsService) UpdateSettings(ctx context.Context, userID int64, patch UserSettingsPatch) (*UserSettings, error) {
+	if patch.Timezone != nil {
+		if _, err := loadTimezone(*patch.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	var locale string
+	if patch.Locale != nil {
+		tag, err := language.Parse(*patch.Locale)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid locale %q", ErrInvalidUserSettings, *patch.Locale)
+		}
+		locale = tag.String()
+	}
+	if patch.WeekStart != nil && (*patch.WeekStart < time.Sunday || *patch.WeekStart > time.Saturday) {
+		return nil, fmt.Errorf("%w: week start must be between 0 (Sunday) and 6 (Saturday)", ErrInvalidUserSettings)
+	}
+	if patch.DefaultSort != nil && *patch.DefaultSort != TodoSortCreatedAt && *patch.DefaultSort != TodoSortManual {
+		return nil, fmt.Errorf("%w: unknown sort %q", ErrInvalidUserSettings, *patch.DefaultSort)
+	}
+	if err := validateNotificationPreferences(patch.Notifications); err != nil {
+		return nil, err
+	}
+
+	var result *UserSettings
+	err := s.txManager.RunInTx(ctx, func(tx pgx.Tx) error {
+		repo := s.withTx(tx)
+		current, err := getUserSettingsRow(ctx, repo, userID)
+		if err != nil {
+			return err
+		}
+
+		params := sqlc.UpsertUserSettingsParams{
+			UserID:           userID,
+			Timezone:         current.Timezone,
+			Locale:           current.Locale,
+			WeekStart:        current.WeekStart,
+			DefaultSort:      current.DefaultSort,
+			DefaultProjectID: current.DefaultProjectID,
+		}
+		if patch.Timezone != nil {
+			params.Timezone = *patch.Timezone
+		}
+		if patch.Locale != nil {
+			params.Locale = locale
+		}
+		if patch.WeekStart != nil {
+			params.WeekStart = int16(*patch.WeekStart)
+		}
+		if patch.DefaultSort != nil {
+			params.DefaultSort = string(*patch.DefaultSort)
+		}
+		if patch.DefaultProjectID != nil {
+			params.DefaultProjectID = nil
+			if id := *patch.DefaultProjectID; id != 0 {
+				exists, err := repo.ProjectExists(ctx, sqlc.ProjectExistsParams{ID: id, UserID: userID})
+				if err != nil {
+					return err
+				}
+				if !exists {
+					return ErrProjectNotFound
+				}
+				params.DefaultProjectID = &id
+			}
+		}
+
+		row, err := repo.UpsertUserSettings(ctx, params)
+		if err != nil {
+			return err
+		}
+		if err := upsertNotificationPreferences(ctx, repo, userID, patch.Notifications); err != nil {
+			return err
+		}
+		result = userSettingsFromRow(row)
+		result.Notifications, err = getNotificationPreferences(ctx, repo, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type userSettingsGetter interface {
+	GetUserSettings(ctx context.Context, userID int64) (sqlc.UserSetting, error)
+}
+
+// ユーザーの設定を読み込む（通知の受け取り設定は含まない）
+// 日付を扱う処理はサーバーの時刻ではなく、この設定のタイムゾーンで「今日」を決める
+func loadUserSettings(ctx context.Context, repo userSettingsGetter, userID int64) (*UserSettings, error) {
+	row, err := getUserSettingsRow(ctx, repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	return userSettingsFromRow(row), nil
+}
+
+// 新しいTodoを入れるプロジェクト
+// Todoを作成するすべての経路（作成・一括作成・インポート・外部サービスからのインポート・クイック追加・同期・CalDAV）はこれでプロジェクトを決める
+type newTodoProject struct {
+	defaultID *int64
+}
+
+func loadNewTodoProject(ctx context.Context, repo userSettingsGetter, userID int64) (newTodoProject, error) {
+	row, err := getUserSettingsRow(ctx, repo, userID)
+	if err != nil {
+		return newTodoProject{}, err
+	}
+	return newTodoProject{defaultID: row.DefaultProjectID}, nil
+}
+
+// projectID の指定がなければユーザー設定の既定のプロジェクト（未設定なら nil）
+func (p newTodoProject) resolve(projectID *int64) *int64 {
+	if projectID != nil {
+		return projectID
+	}
+	return p.defaultID
+}
+
+// 設定の行を返す。保存されていない場合は既定値
+func getUserSettingsRow(ctx context.Context, repo userSettingsGetter, userID int64) (sqlc.UserSetting, error) {
+	row, err := repo.GetUserSettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return sqlc.UserSetting{
+			UserID:      userID,
+			Timezone:    DefaultTimezone,
+			Locale:      DefaultLocale,
+			WeekStart:   int16(DefaultWeekStart),
+			DefaultSort: string(TodoSortCreatedAt),
+		}, nil
+	}
+	if err != nil {
+		return sqlc.UserSetting{}, fmt.Errorf("get user settings: %w", err)
+	}
+	return row, nil
+}
+
+func userSettingsFromRow(row sqlc.UserSetting) *UserSettings {
+	// 保存後にタイムゾーンデータベースから消えた名前は UTC として扱う
+	loc, err := loadTimezone(row.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return &UserSettings{
+		Timezone:         row.Timezone,
+		Location:         loc,
+		Locale:           row.Locale,
+		WeekStart:        time.Weekday(row.WeekStart),
+		DefaultSort:      TodoSort(row.DefaultSort),
+		DefaultProjectID: row.DefaultProjectID,
+	}
+}
+
+// IANAのタイムゾーン名を読み込む。空なら UTC
+func loadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	// "Local" はサーバーのタイムゾーンになるため受け付けない
+	if name == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, name)
+	}
+	return loc, nil
+}
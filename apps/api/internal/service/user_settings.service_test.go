@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-todo/db/sqlc"
+	"go-todo/internal/service/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// トランザクション内でも同じモックリポジトリを使うUserSettingsService
+func newTxTestUserSettingsService(repo UserSettingsRepository) *UserSettingsService {
+	svc := NewUserSettingsService(repo, nil)
+	svc.txManager = fakeTxManager{}
+	svc.withTx = func(pgx.Tx) UserSettingsRepository { return repo }
+	return svc
+}
+
+func TestUserSettingsService_GetSettings(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+
+	t.Run("正常系: 保存されていない場合は既定値を返す", func(t *testing.T) {
+		repo := mocks.NewMockUserSettingsRepository(t)
+		svc := newTxTestUserSettingsService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+		repo.EXPECT().ListNotificationPreferences(ctx, userID).Return([]sqlc.NotificationPreference{}, nil).Once()
+
+		got, err := svc.GetSettings(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", got.Timezone)
+		assert.Equal(t, time.UTC, got.Location)
+		assert.Equal(t, "en", got.Locale)
+		assert.Equal(t, time.Monday, got.WeekStart)
+		assert.Equal(t, TodoSortCreatedAt, got.DefaultSort)
+		assert.Nil(t, got.DefaultProjectID)
+		assert.Len(t, got.Notifications, len(notificationTypes))
+		for _, p := range got.Notifications {
+			assert.True(t, p.Enabled)
+		}
+	})
+
+	t.Run("正常系: 保存された設定と通知の受け取り設定を返す", func(t *testing.T) {
+		repo := mocks.NewMockUserSettingsRepository(t)
+		svc := newTxTestUserSettingsService(repo)
+		projectID := int64(4)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{
+			UserID: userID, Timezone: "Asia/Tokyo", Locale: "ja", WeekStart: 0, DefaultSort: "manual", DefaultProjectID: &projectID,
+		}, nil).Once()
+		repo.EXPECT().ListNotificationPreferences(ctx, userID).Return([]sqlc.NotificationPreference{
+			{UserID: userID, Type: "reminder", Enabled: false},
+		}, nil).Once()
+
+		got, err := svc.GetSettings(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Tokyo", got.Location.String())
+		assert.Equal(t, time.Sunday, got.WeekStart)
+		assert.Equal(t, TodoSortManual, got.DefaultSort)
+		assert.Equal(t, &projectID, got.DefaultProjectID)
+		assert.Equal(t, NotificationPreference{Type: NotificationTypeReminder, Enabled: false}, got.Notifications[0])
+	})
+
+	t.Run("正常系: 読み込めなくなったタイムゾーンはUTCとして扱う", func(t *testing.T) {
+		repo := mocks.NewMockUserSettingsRepository(t)
+		svc := newTxTestUserSettingsService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{
+			UserID: userID, Timezone: "Gone/Away", Locale: "en", WeekStart: 1, DefaultSort: "created_at",
+		}, nil).Once()
+
+		got, err := svc.Location(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.UTC, got)
+	})
+
+	t.Run("正常系: 保存されていない場合のロケールは英語", func(t *testing.T) {
+		repo := mocks.NewMockUserSettingsRepository(t)
+		svc := newTxTestUserSettingsService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+
+		got, err := svc.Locale(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "en", got)
+	})
+
+	t.Run("異常系: 取得に失敗した場合はエラーを返す", func(t *testing.T) {
+		repo := mocks.NewMockUserSettingsRepository(t)
+		svc := newTxTestUserSettingsService(repo)
+		dbErr := errors.New("db error")
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, dbErr).Once()
+
+		_, err := svc.DefaultSort(ctx, userID)
+
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestUserSettingsService_UpdateSettings(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+
+	t.Run("正常系: 指定した項目だけを更新する", func(t *testing.T) {
+		repo := mocks.NewMockUserSettingsRepository(t)
+		svc := newTxTestUserSettingsService(repo)
+		projectID := int64(4)
+		saved := sqlc.UserSetting{
+			UserID: userID, Timezone: "Asia/Tokyo", Locale: "ja-JP", WeekStart: 1, DefaultSort: "manual", DefaultProjectID: &projectID,
+		}
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+		repo.EXPECT().ProjectExists(ctx, sqlc.ProjectExistsParams{ID: projectID, UserID: userID}).Return(true, nil).Once()
+		repo.EXPECT().UpsertUserSettings(ctx, sqlc.UpsertUserSettingsParams{
+			UserID:           userID,
+			Timezone:         "Asia/Tokyo",
+			Locale:           "ja-JP",
+			WeekStart:        1,
+			DefaultSort:      "manual",
+			DefaultProjectID: &projectID,
+		}).Return(saved, nil).Once()
+		repo.EXPECT().UpsertNotificationPreference(ctx, sqlc.UpsertNotificationPreferenceParams{
+			UserID: userID, Type: "reminder", Enabled: false,
+		}).Return(nil).Once()
+		repo.EXPECT().ListNotificationPreferences(ctx, userID).Return([]sqlc.NotificationPreference{
+			{UserID: userID, Type: "reminder", Enabled: false},
+		}, nil).Once()
+
+		timezone := "Asia/Tokyo"
+		locale := "ja-jp"
+		sort := TodoSortManual
+		got, err := svc.UpdateSettings(ctx, userID, UserSettingsPatch{
+			Timezone:         &timezone,
+			Locale:           &locale,
+			DefaultSort:      &sort,
+			DefaultProjectID: &projectID,
+			Notifications:    []NotificationPreference{{Type: NotificationTypeReminder, Enabled: false}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Tokyo", got.Timezone)
+		assert.Equal(t, "ja-JP", got.Locale)
+		assert.Equal(t, &projectID, got.DefaultProjectID)
+		assert.False(t, got.Notifications[0].Enabled)
+	})
+
+	t.Run("正常系: 0を指定すると既定のプロジェクトを解除する", func(t *testing.T) {
+		repo := mocks.NewMockUserSettingsRepository(t)
+		svc := newTxTestUserSettingsService(repo)
+		projectID := int64(4)
+		current := sqlc.UserSetting{
+			UserID: userID, Timezone: "Europe/Paris", Locale: "fr", WeekStart: 1, DefaultSort: "created_at", DefaultProjectID: &projectID,
+		}
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(current, nil).Once()
+		repo.EXPECT().UpsertUserSettings(ctx, sqlc.UpsertUserSettingsParams{
+			UserID:      userID,
+			Timezone:    "Europe/Paris",
+			Locale:      "fr",
+			WeekStart:   0,
+			DefaultSort: "created_at",
+		}).Return(sqlc.UserSetting{UserID: userID, Timezone: "Europe/Paris", Locale: "fr", WeekStart: 0, DefaultSort: "created_at"}, nil).Once()
+		repo.EXPECT().ListNotificationPreferences(ctx, userID).Return([]sqlc.NotificationPreference{}, nil).Once()
+
+		clear := int64(0)
+		weekStart := time.Sunday
+		got, err := svc.UpdateSettings(ctx, userID, UserSettingsPatch{DefaultProjectID: &clear, WeekStart: &weekStart})
+
+		require.NoError(t, err)
+		assert.Nil(t, got.DefaultProjectID)
+		assert.Equal(t, time.Sunday, got.WeekStart)
+	})
+
+	t.Run("異常系: 不正な値は保存しない", func(t *testing.T) {
+		invalidTimezone := "Mars/Olympus"
+		local := "Local"
+		invalidLocale := "not a locale"
+		invalidWeekStart := time.Weekday(7)
+		invalidSort := TodoSort("title")
+		tests := []struct {
+			name  string
+			patch UserSettingsPatch
+			want  error
+		}{
+			{name: "timezone", patch: UserSettingsPatch{Timezone: &invalidTimezone}, want: ErrInvalidTimezone},
+			{name: "local timezone", patch: UserSettingsPatch{Timezone: &local}, want: ErrInvalidTimezone},
+			{name: "locale", patch: UserSettingsPatch{Locale: &invalidLocale}, want: ErrInvalidUserSettings},
+			{name: "week start", patch: UserSettingsPatch{WeekStart: &invalidWeekStart}, want: ErrInvalidUserSettings},
+			{name: "sort", patch: UserSettingsPatch{DefaultSort: &invalidSort}, want: ErrInvalidUserSettings},
+			{
+				name:  "notification",
+				patch: UserSettingsPatch{Notifications: []NotificationPreference{{Type: NotificationTypeAdmin, Enabled: false}}},
+				want:  ErrInvalidNotificationPreference,
+			},
+		}
+		for _, tt := range tests {
+			svc := newTxTestUserSettingsService(mocks.NewMockUserSettingsRepository(t))
+
+			_, err := svc.UpdateSettings(ctx, userID, tt.patch)
+
+			assert.ErrorIs(t, err, tt.want, tt.name)
+		}
+	})
+
+	t.Run("異常系: 他のユーザーのプロジェクトはErrProjectNotFound", func(t *testing.T) {
+		repo := mocks.NewMockUserSettingsRepository(t)
+		svc := newTxTestUserSettingsService(repo)
+		repo.EXPECT().GetUserSettings(ctx, userID).Return(sqlc.UserSetting{}, pgx.ErrNoRows).Once()
+		repo.EXPECT().ProjectExists(ctx, sqlc.ProjectExistsParams{ID: 99, UserID: userID}).Return(false, nil).Once()
+
+		projectID := int64(99)
+		_, err := svc.UpdateSettings(ctx, userID, UserSettingsPatch{DefaultProjectID: &projectID})
+
+		assert.ErrorIs(t, err, ErrProjectNotFound)
+		repo.AssertNotCalled(t, "UpsertUserSettings", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserSettings_DayFirst(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   bool
+	}{
+		{locale: "en", want: false},
+		{locale: "en-US", want: false},
+		{locale: "en-GB", want: true},
+		{locale: "en-AU", want: true},
+		{locale: "fr", want: true},
+		{locale: "ja", want: false},
+		{locale: "", want: false},
+	}
+	for _, tt := range tests {
+		s := &UserSettings{Locale: tt.locale}
+
+		assert.Equal(t, tt.want, s.DayFirst(), tt.locale)
+	}
+}
@@ -0,0 +1,115 @@
+package takeout
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+var ErrEntryOpen = errors.New("previous takeout entry is still open")
+
+// アカウントのデータ一式をJSONファイルとしてZIPにまとめる
+// ZIPは1ファイルずつしか書き込めないため、ArrayWriter を Close するまで次のファイルは作れない
+type Writer struct {
+	zw       *zip.Writer
+	modified time.Time
+	open     *ArrayWriter
+}
+
+// modified はZIP内のファイルの更新日時として記録する
+func NewWriter(w io.Writer, modified time.Time) *Writer {
+	return &Writer{zw: zip.NewWriter(w), modified: modified}
+}
+
+func (w *Writer) create(name string) (io.Writer, error) {
+	if w.open != nil {
+		return nil, fmt.Errorf("%w: %s", ErrEntryOpen, w.open.name)
+	}
+	return w.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: w.modified,
+	})
+}
+
+// v を整形したJSONとして name のファイルに書き出す
+func (w *Writer) WriteJSON(name string, v any) error {
+	f, err := w.create(name)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+// 要素を1件ずつ書き出すJSON配列のファイルを作る
+// 件数が多いデータ（Todoなど）をすべてメモリに読み込まずに書き出すために使う
+func (w *Writer) CreateArray(name string) (*ArrayWriter, error) {
+	f, err := w.create(name)
+	if err != nil {
+		return nil, err
+	}
+	a := &ArrayWriter{w: w, f: f, name: name}
+	w.open = a
+	return a, nil
+}
+
+// ZIPの終端を書き出す（下位の io.Writer は閉じない）
+func (w *Writer) Close() error {
+	if w.open != nil {
+		return fmt.Errorf("%w: %s", ErrEntryOpen, w.open.name)
+	}
+	return w.zw.Close()
+}
+
+// JSON配列のファイルに要素を1件ずつ書き出す
+type ArrayWriter struct {
+	w     *Writer
+	f     io.Writer
+	name  string
+	count int
+}
+
+func (a *ArrayWriter) Write(v any) error {
+	b, err := json.MarshalIndent(v, "  ", "  ")
+	if err != nil {
+		return fmt.Errorf("write %s: %w", a.name, err)
+	}
+	sep := ",\n  "
+	if a.count == 0 {
+		sep = "[\n  "
+	}
+	if _, err := io.WriteString(a.f, sep); err != nil {
+		return err
+	}
+	if _, err := a.f.Write(b); err != nil {
+		return err
+	}
+	a.count++
+	return nil
+}
+
+// 配列を閉じ、次のファイルを作れるようにする
+func (a *ArrayWriter) Close() error {
+	end := "\n]\n"
+	if a.count == 0 {
+		end = "[]\n"
+	}
+	if _, err := io.WriteString(a.f, end); err != nil {
+		return err
+	}
+	a.w.open = nil
+	return nil
+}
+
+// 書き出した要素の件数
+func (a *ArrayWriter) Count() int {
+	return a.count
+}
@@ -0,0 +1,94 @@
+package takeout
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readEntries(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	entries := map[string][]byte{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		entries[f.Name] = b
+	}
+	return entries
+}
+
+func TestWriter(t *testing.T) {
+	modified := time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: JSONファイルと配列のファイルをZIPにまとめる", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, modified)
+
+		require.NoError(t, w.WriteJSON("profile.json", map[string]string{"name": "Alice"}))
+		todos, err := w.CreateArray("todos.json")
+		require.NoError(t, err)
+		require.NoError(t, todos.Write(map[string]any{"id": 1, "tags": []string{"home"}}))
+		require.NoError(t, todos.Write(map[string]any{"id": 2}))
+		assert.Equal(t, 2, todos.Count())
+		require.NoError(t, todos.Close())
+		empty, err := w.CreateArray("history/jobs.json")
+		require.NoError(t, err)
+		require.NoError(t, empty.Close())
+		require.NoError(t, w.Close())
+
+		entries := readEntries(t, buf.Bytes())
+		require.Len(t, entries, 3)
+
+		var profile map[string]string
+		require.NoError(t, json.Unmarshal(entries["profile.json"], &profile))
+		assert.Equal(t, "Alice", profile["name"])
+
+		var got []map[string]any
+		require.NoError(t, json.Unmarshal(entries["todos.json"], &got))
+		assert.Equal(t, []map[string]any{
+			{"id": float64(1), "tags": []any{"home"}},
+			{"id": float64(2)},
+		}, got)
+
+		assert.Equal(t, "[]\n", string(entries["history/jobs.json"]))
+	})
+
+	t.Run("正常系: ファイルの更新日時を記録する", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, modified)
+		require.NoError(t, w.WriteJSON("profile.json", struct{}{}))
+		require.NoError(t, w.Close())
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		assert.True(t, modified.Equal(zr.File[0].Modified))
+	})
+
+	t.Run("異常系: 配列を閉じる前に次のファイルは作れない", func(t *testing.T) {
+		w := NewWriter(io.Discard, modified)
+		_, err := w.CreateArray("todos.json")
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, w.WriteJSON("profile.json", nil), ErrEntryOpen)
+		_, err = w.CreateArray("tags.json")
+		assert.ErrorIs(t, err, ErrEntryOpen)
+		assert.ErrorIs(t, w.Close(), ErrEntryOpen)
+	})
+
+	t.Run("異常系: JSONにできない値はエラー", func(t *testing.T) {
+		w := NewWriter(io.Discard, modified)
+
+		assert.Error(t, w.WriteJSON("bad.json", make(chan int)))
+	})
+}
@@ -37,40 +37,230 @@ servers: [{
 			type:   "integer"
 			format: "int64"
 		}
+		version: {
+			type:        "integer"
+			format:      "int32"
+			description: "Version number for optimistic concurrency control, incremented on every update"
+		}
+		client_id: {
+			type:        "string"
+			format:      "uuid"
+			description: "Client-generated identifier used by offline sync"
+		}
+		position: {
+			type:        "string"
+			description: "Rank key for manual ordering. Todos sort by byte-wise comparison of this key (ties broken by id). Keys may be reassigned by the server without changing the order"
+		}
+		status_id: {
+			type:        "integer"
+			format:      "int64"
+			description: "Explicitly assigned status. When omitted, the todo belongs to the done status if completed and to the first open status otherwise"
+		}
+		blockers: {
+			type:        "array"
+			description: "Todos that must be finished before this one. Only included by getTodo"
+			items: "$ref": "#/components/schemas/TodoRef"
+		}
+		dependents: {
+			type:        "array"
+			description: "Todos blocked by this one. Only included by getTodo"
+			items: "$ref": "#/components/schemas/TodoRef"
+		}
+		due_at: {
+			type:        "string"
+			format:      "date-time"
+			description: "Due date. Reminders with offset_minutes are scheduled relative to it"
+		}
+		project_id: {
+			type:        "integer"
+			format:      "int64"
+			description: "Project the todo belongs to. Omitted when the todo has no project"
+		}
+		tags: {
+			type: "array"
+			items: type: "string"
+		}
+		priority: {
+			type:        "string"
+			enum: ["low", "medium", "high"]
+			description: "Priority. Omitted when not set"
+		}
+		recurrence: {
+			type:        "string"
+			description: "iCalendar RRULE describing how the todo repeats (e.g. FREQ=WEEKLY;BYDAY=MO). Omitted when the todo does not repeat"
+		}
+	}
+	required: ["id", "title", "completed", "user_id", "created_at", "updated_at", "version", "client_id", "position", "tags"]
+}
+
+#TodoRef: {
+	type: "object"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		title: type:     "string"
+		completed: type: "boolean"
 	}
-	required: ["id", "title", "completed", "user_id", "created_at", "updated_at"]
+	required: ["id", "title", "completed"]
 }
 
 #CreateTodoRequest: {
 	type: "object"
 	properties: {
-		title: type:       "string"
+		title: {
+			type:      "string"
+			minLength: 1
+		}
 		description: type: "string"
+		due_at: {
+			type:   "string"
+			format: "date-time"
+		}
 	}
 	required: ["title"]
 }
 
+#QuickAddRequest: {
+	type: "object"
+	properties: {
+		text: {
+			type:        "string"
+			minLength:   1
+			description: "Natural-language todo such as \"Pay rent tomorrow 9am #home !high\""
+		}
+		description: type: "string"
+		timezone: {
+			type:        "string"
+			description: "IANA time zone used to interpret dates and times (e.g. Asia/Tokyo). Defaults to the timezone user setting"
+		}
+		day_first: {
+			type:        "boolean"
+			description: "Read ambiguous numeric dates such as 3/4 as day/month instead of month/day. Defaults to the order used in the region of the locale user setting"
+		}
+	}
+	required: ["text"]
+}
+
+#QuickAddPreview: {
+	type: "object"
+	properties: {
+		title: {
+			type:        "string"
+			description: "Text left after removing the recognized expressions"
+		}
+		due_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		all_day: {
+			type:        "boolean"
+			description: "True when only a date was given. due_at is then midnight in the requested time zone"
+		}
+		tags: {
+			type: "array"
+			items: type: "string"
+		}
+		priority: {
+			type: "string"
+			enum: ["low", "medium", "high"]
+		}
+		project: {
+			type:        "string"
+			description: "Project name. The project is created on save if it does not exist"
+		}
+		recurrence: {
+			type:        "string"
+			description: "iCalendar RRULE"
+		}
+		ambiguities: {
+			type:        "array"
+			description: "Expressions that could be read in more than one way, with the reading that was chosen"
+			items: type: "string"
+		}
+	}
+	required: ["title", "all_day", "tags", "ambiguities"]
+}
+
 #UpdateTodoRequest: {
 	type: "object"
 	properties: {
-		title: type:       "string"
+		title: {
+			type:      "string"
+			minLength: 1
+		}
 		description: type: "string"
 		completed: type:   "boolean"
 	}
 }
 
+#MoveTodoRequest: {
+	type: "object"
+	description: "At least one of before_id or after_id is required. When both are given, the todo is placed between them"
+	properties: {
+		before_id: {
+			type:        "integer"
+			format:      "int64"
+			description: "Place the todo immediately before this todo"
+		}
+		after_id: {
+			type:        "integer"
+			format:      "int64"
+			description: "Place the todo immediately after this todo"
+		}
+	}
+}
+
+#AddTodoBlockerRequest: {
+	type: "object"
+	properties: blocker_id: {
+		type:        "integer"
+		format:      "int64"
+		description: "Todo that must be finished first"
+	}
+	required: ["blocker_id"]
+}
+
+#TodoDependencies: {
+	type: "object"
+	properties: {
+		blockers: {
+			type:        "array"
+			description: "Todos blocking this todo. A blocker is open while it is not completed"
+			items: "$ref": "#/components/schemas/TodoRef"
+		}
+		dependents: {
+			type:        "array"
+			description: "Todos blocked by this todo"
+			items: "$ref": "#/components/schemas/TodoRef"
+		}
+	}
+	required: ["blockers", "dependents"]
+}
+
+#SetTodoDueRequest: {
+	type: "object"
+	properties: due_at: {
+		type:        "string"
+		format:      "date-time"
+		nullable:    true
+		description: "New due date. null or omitted clears it"
+	}
+}
+
 // Todoのバッチ処理関連
 #BatchTodoRequest: {
 	type: "object"
 	properties: {
 		ids: {
-			type: "array"
+			type:        "array"
+			description: "Todo IDs. Duplicates are processed once and reported in warnings. The maximum number of IDs is configured on the server (100 by default)"
 			items: {
 				type:   "integer"
 				format: "int64"
 			}
 			minItems: 1
-			maxItems: 100
 		}
 	}
 	required: ["ids"]
@@ -87,8 +277,12 @@ servers: [{
 			type: "array"
 			items: "$ref": "#/components/schemas/BatchFailedItem"
 		}
+		warnings: {
+			type: "array"
+			items: "$ref": "#/components/schemas/BatchWarning"
+		}
 	}
-	required: ["succeeded", "failed"]
+	required: ["succeeded", "failed", "warnings"]
 }
 
 #BatchDeleteResponse: {
@@ -105,10 +299,183 @@ servers: [{
 			type: "array"
 			items: "$ref": "#/components/schemas/BatchFailedItem"
 		}
+		warnings: {
+			type: "array"
+			items: "$ref": "#/components/schemas/BatchWarning"
+		}
+	}
+	required: ["succeeded", "failed", "warnings"]
+}
+
+#BatchUpdateRequest: {
+	type: "object"
+	properties: {
+		ids: {
+			type:        "array"
+			description: "Todo IDs. Duplicates are processed once and reported in warnings. The maximum number of IDs is configured on the server (100 by default)"
+			items: {
+				type:   "integer"
+				format: "int64"
+			}
+			minItems: 1
+		}
+		patch: "$ref": "#/components/schemas/UpdateTodoRequest"
+	}
+	required: ["ids", "patch"]
+}
+
+#BatchUpdateResponse: {
+	type: "object"
+	properties: {
+		succeeded: {
+			type:        "array"
+			description: "Updated todos. In dry-run mode, the todos as they would be after the update"
+			items: "$ref": "#/components/schemas/Todo"
+		}
+		failed: {
+			type: "array"
+			items: "$ref": "#/components/schemas/BatchFailedItem"
+		}
+		warnings: {
+			type: "array"
+			items: "$ref": "#/components/schemas/BatchWarning"
+		}
+		changes: {
+			type:        "array"
+			description: "Fields whose value changes for each todo"
+			items: "$ref": "#/components/schemas/BatchUpdateChange"
+		}
+		dry_run: type: "boolean"
+	}
+	required: ["succeeded", "failed", "warnings", "changes", "dry_run"]
+}
+
+#BatchUpdateChange: {
+	type: "object"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		fields: {
+			type: "array"
+			items: {
+				type: "string"
+				enum: ["title", "description", "completed"]
+			}
+		}
+	}
+	required: ["id", "fields"]
+}
+
+#BatchCreateRequest: {
+	type: "object"
+	properties: items: {
+		type:        "array"
+		description: "The maximum number of items is configured on the server (100 by default)"
+		items: "$ref": "#/components/schemas/CreateTodoRequest"
+		minItems: 1
+	}
+	required: ["items"]
+}
+
+#BatchCreateResponse: {
+	type: "object"
+	properties: {
+		succeeded: {
+			type: "array"
+			items: "$ref": "#/components/schemas/BatchCreatedItem"
+		}
+		failed: {
+			type: "array"
+			items: "$ref": "#/components/schemas/BatchCreateFailedItem"
+		}
 	}
 	required: ["succeeded", "failed"]
 }
 
+#BatchCreatedItem: {
+	type: "object"
+	properties: {
+		index: {
+			type:        "integer"
+			description: "Position of the item in the request"
+		}
+		todo: "$ref": "#/components/schemas/Todo"
+	}
+	required: ["index", "todo"]
+}
+
+#BatchCreateFailedItem: {
+	type: "object"
+	properties: {
+		index: {
+			type:        "integer"
+			description: "Position of the item in the request"
+		}
+		error: type: "string"
+	}
+	required: ["index", "error"]
+}
+
+#ImportResponse: {
+	type: "object"
+	properties: {
+		imported: {
+			type:   "integer"
+			format: "int64"
+		}
+		failed: {
+			type:        "integer"
+			description: "Number of lines that were skipped because of errors"
+		}
+		skipped: {
+			type:        "integer"
+			description: "Number of todos that were skipped as duplicates (dedupe)"
+		}
+		errors: {
+			type:        "array"
+			description: "Line-numbered errors. Only the first 100 are reported"
+			items: "$ref": "#/components/schemas/ImportLineError"
+		}
+		items: {
+			type:        "array"
+			description: "Parsed todos that would be imported. Only returned in preview mode, and only the first 1000"
+			items: "$ref": "#/components/schemas/ImportPreviewItem"
+		}
+	}
+	required: ["imported", "failed", "skipped", "errors"]
+}
+
+#ImportPreviewItem: {
+	type: "object"
+	properties: {
+		line: type:        "integer"
+		title: type:       "string"
+		description: type: "string"
+		completed: type:   "boolean"
+		due_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		metadata: {
+			type:        "object"
+			description: "Format-specific data that does not map onto a todo field, such as todo.txt priority or unmapped CSV columns. It is not saved"
+			additionalProperties: type: "string"
+		}
+	}
+	required: ["line", "title", "completed"]
+}
+
+#ImportLineError: {
+	type: "object"
+	properties: {
+		line: type:    "integer"
+		message: type: "string"
+	}
+	required: ["line", "message"]
+}
+
 #BatchFailedItem: {
 	type: "object"
 	properties: {
@@ -116,275 +483,2746 @@ servers: [{
 			type:   "integer"
 			format: "int64"
 		}
-		error: type: "string"
-	}
-	required: ["id", "error"]
-}
-
-#ErrorResponse: {
-	type: "object"
-	properties: message: type: "string"
-	required: ["message"]
-}
-
-#HealthResponse: {
-	type: "object"
-	properties: status: type: "string"
-	required: ["status"]
-}
-
-#InfoResponse: {
-	type: "object"
-	properties: {
-		name: type:    "string"
-		version: type: "string"
+		code: {
+			type:        "string"
+			enum:        ["not_found", "invalid_id", "rolled_back", "blocked"]
+			description: "Machine-readable reason. not_found is also reported for todos owned by other users. rolled_back means the todo was valid but nothing was applied because another todo in an atomic batch failed. blocked means the todo still has open blockers; retry with force=true to complete it anyway"
+		}
+		error: type: "string"
+	}
+	required: ["id", "code", "error"]
+}
+
+#BatchWarning: {
+	type:        "object"
+	description: "An adjustment made to the request that did not cause the item to fail"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		code: {
+			type:        "string"
+			enum:        ["duplicate_id", "already_completed"]
+			description: "already_completed means the todo was already completed; it is reported in succeeded unchanged"
+		}
+		message: type: "string"
+	}
+	required: ["id", "code", "message"]
+}
+
+#TodoChangesResponse: {
+	type: "object"
+	properties: {
+		todos: {
+			type:        "array"
+			description: "Todos created or updated since the token"
+			items: "$ref": "#/components/schemas/Todo"
+		}
+		deleted_ids: {
+			type:        "array"
+			description: "IDs of todos deleted since the token"
+			items: {
+				type:   "integer"
+				format: "int64"
+			}
+		}
+		next_token: {
+			type:        "string"
+			description: "Token to pass as since on the next request. When has_more is true, it continues from the last returned change"
+		}
+		has_more: {
+			type:        "boolean"
+			description: "Whether more changes remain. Request again with next_token to fetch the next page"
+		}
+	}
+	required: ["todos", "deleted_ids", "next_token", "has_more"]
+}
+
+// オフライン同期関連
+#SyncRequest: {
+	type: "object"
+	properties: {
+		sync_token: {
+			type:        "string"
+			description: "Token returned by the previous sync. Omit for the initial sync"
+		}
+		operations: {
+			type: "array"
+			items: "$ref": "#/components/schemas/SyncOperation"
+			maxItems: 500
+		}
+	}
+	required: ["operations"]
+}
+
+#SyncOperation: {
+	type: "object"
+	properties: {
+		client_id: {
+			type:   "string"
+			format: "uuid"
+		}
+		op: {
+			type: "string"
+			enum: ["upsert", "delete"]
+		}
+		updated_at: {
+			type:        "string"
+			format:      "date-time"
+			description: "When the change was made on the client. Used for per-field last-writer-wins"
+		}
+		title: type: "string"
+		description: {
+			type:                             "string"
+			nullable:                         true
+			description:                      "null clears the description. Omit to leave it unchanged"
+			"x-go-type":                      "json.RawMessage"
+			"x-go-type-skip-optional-pointer": true
+		}
+		completed: type: "boolean"
+		force: {
+			type:        "boolean"
+			description: "Complete the todo even if it still has open blockers. Otherwise such an operation is rejected"
+		}
+	}
+	required: ["client_id", "op", "updated_at"]
+}
+
+#SyncResponse: {
+	type: "object"
+	properties: {
+		sync_token: {
+			type:        "string"
+			description: "Token to send with the next sync. When has_more is true, it continues from the last returned change"
+		}
+		changes: {
+			type:        "array"
+			description: "Server changes since the given sync token, including tombstones of deleted todos. At most 1000 per response"
+			items: "$ref": "#/components/schemas/SyncChange"
+		}
+		has_more: {
+			type:        "boolean"
+			description: "Whether more changes remain. Sync again with sync_token to fetch the next page"
+		}
+		conflicts: {
+			type: "array"
+			items: "$ref": "#/components/schemas/SyncConflict"
+		}
+		results: {
+			type: "array"
+			items: "$ref": "#/components/schemas/SyncOperationResult"
+		}
+	}
+	required: ["sync_token", "changes", "has_more", "conflicts", "results"]
+}
+
+#SyncChange: {
+	type: "object"
+	properties: {
+		todo: "$ref": "#/components/schemas/Todo"
+		deleted: type: "boolean"
+		change_seq: {
+			type:   "integer"
+			format: "int64"
+		}
+	}
+	required: ["todo", "deleted", "change_seq"]
+}
+
+#SyncConflict: {
+	type: "object"
+	properties: {
+		client_id: {
+			type:   "string"
+			format: "uuid"
+		}
+		field: {
+			type: "string"
+			enum: ["title", "description", "completed", "deleted"]
+		}
+		client_value: {}
+		server_value: {}
+		resolution: {
+			type:        "string"
+			enum:        ["client", "server"]
+			description: "Which side's value was kept"
+		}
+	}
+	required: ["client_id", "field", "resolution"]
+}
+
+#SyncOperationResult: {
+	type: "object"
+	properties: {
+		client_id: {
+			type:   "string"
+			format: "uuid"
+		}
+		status: {
+			type: "string"
+			enum: ["created", "updated", "deleted", "unchanged", "rejected"]
+		}
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		error: type: "string"
+	}
+	required: ["client_id", "status"]
+}
+
+// 非同期ジョブ関連
+#BulkTodoFilter: {
+	type:        "object"
+	description: "Selects the todos a bulk job operates on. Omitted criteria match every todo"
+	properties: {
+		ids: {
+			type: "array"
+			items: {
+				type:   "integer"
+				format: "int64"
+			}
+			minItems: 1
+			maxItems: 10000
+		}
+		created_before: {
+			type:        "string"
+			format:      "date-time"
+			description: "Only todos created before this time"
+		}
+		completed: {
+			type:        "boolean"
+			description: "Only todos with this completion state"
+		}
+		project_id: {
+			type:        "integer"
+			format:      "int64"
+			description: "Only todos in this project"
+		}
+	}
+}
+
+#CreateJobRequest: {
+	type: "object"
+	properties: {
+		type: {
+			type: "string"
+			enum: ["complete_todos", "delete_todos"]
+		}
+		filter: "$ref": "#/components/schemas/BulkTodoFilter"
+		force: {
+			type:        "boolean"
+			description: "complete_todos only. Complete todos even if they still have open blockers. Otherwise such todos are skipped and counted in result.skipped"
+		}
+	}
+	required: ["type", "filter"]
+}
+
+#Job: {
+	type: "object"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		type: type: "string"
+		status: {
+			type: "string"
+			enum: ["pending", "running", "succeeded", "failed", "canceled"]
+		}
+		total: {
+			type:        "integer"
+			format:      "int32"
+			description: "Number of items the job is expected to process"
+		}
+		processed: {
+			type:        "integer"
+			format:      "int32"
+			description: "Number of items processed so far"
+		}
+		cancel_requested: type: "boolean"
+		result: {
+			type:                 "object"
+			additionalProperties: true
+			description:          "Job-specific result, set when the job has finished"
+		}
+		error: type: "string"
+		created_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		started_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		finished_at: {
+			type:   "string"
+			format: "date-time"
+		}
+	}
+	required: ["id", "type", "status", "total", "processed", "cancel_requested", "created_at"]
+}
+
+// カンバンのステータス関連
+#Project: {
+	type: "object"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		name: type: "string"
+		created_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		updated_at: {
+			type:   "string"
+			format: "date-time"
+		}
+	}
+	required: ["id", "name", "created_at", "updated_at"]
+}
+
+#Status: {
+	type: "object"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		name: type: "string"
+		sort_order: {
+			type:        "integer"
+			format:      "int32"
+			description: "Column order on the board (ascending, ties broken by id)"
+		}
+		wip_limit: {
+			type:        "integer"
+			format:      "int32"
+			description: "Maximum number of todos that can be moved into this status. Omitted when unlimited. Never set on the done status or the first open status, which todos enter implicitly when created, imported, reopened or when their status is deleted"
+		}
+		is_done: {
+			type:        "boolean"
+			description: "Whether todos in this status are completed. Exactly one status per user is the done status"
+		}
+		created_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		updated_at: {
+			type:   "string"
+			format: "date-time"
+		}
+	}
+	required: ["id", "name", "sort_order", "is_done", "created_at", "updated_at"]
+}
+
+#CreateStatusRequest: {
+	type: "object"
+	properties: {
+		name: {
+			type:      "string"
+			minLength: 1
+			maxLength: 50
+		}
+		wip_limit: {
+			type:        "integer"
+			format:      "int32"
+			minimum:     0
+			description: "0 or omitted means unlimited. Not allowed on the done status or the first open status"
+		}
+		is_done: {
+			type:        "boolean"
+			description: "Make this the done status, replacing the current one"
+		}
+	}
+	required: ["name"]
+}
+
+#UpdateStatusRequest: {
+	type: "object"
+	properties: {
+		name: {
+			type:      "string"
+			minLength: 1
+			maxLength: 50
+		}
+		sort_order: {
+			type:   "integer"
+			format: "int32"
+		}
+		wip_limit: {
+			type:        "integer"
+			format:      "int32"
+			minimum:     0
+			description: "New WIP limit. 0 removes the limit. Not allowed on the done status or on a status that is, or is reordered to be, the first open status"
+		}
+		is_done: {
+			type:        "boolean"
+			description: "Only true is accepted for a status that is not already done; the previous done status becomes an open status"
+		}
+	}
+}
+
+#SetTodoStatusRequest: {
+	type: "object"
+	properties: status_id: {
+		type:   "integer"
+		format: "int64"
+	}
+	required: ["status_id"]
+}
+
+#Board: {
+	type: "object"
+	properties: columns: {
+		type: "array"
+		items: "$ref": "#/components/schemas/BoardColumn"
+	}
+	required: ["columns"]
+}
+
+#BoardColumn: {
+	type: "object"
+	properties: {
+		status: "$ref": "#/components/schemas/Status"
+		todos: {
+			type:        "array"
+			description: "Todos in manual order"
+			items: "$ref": "#/components/schemas/Todo"
+		}
+	}
+	required: ["status", "todos"]
+}
+
+// リマインダー・通知関連
+#Reminder: {
+	type: "object"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		todo_id: {
+			type:   "integer"
+			format: "int64"
+		}
+		channel: {
+			type: "string"
+			enum: ["email", "in_app", "webhook"]
+		}
+		remind_at: {
+			type:        "string"
+			format:      "date-time"
+			description: "Absolute delivery time"
+		}
+		offset_minutes: {
+			type:        "integer"
+			format:      "int32"
+			description: "Delivery time relative to the todo's due date. Negative values fire before the due date"
+		}
+		scheduled_at: {
+			type:        "string"
+			format:      "date-time"
+			description: "Computed delivery time. Omitted when the reminder is relative and the todo has no due date"
+		}
+		status: {
+			type: "string"
+			enum: ["pending", "sent", "failed", "canceled"]
+			description: "canceled means the todo was completed or deleted before delivery"
+		}
+		attempts: {
+			type:   "integer"
+			format: "int32"
+		}
+		last_error: type: "string"
+		fired_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		created_at: {
+			type:   "string"
+			format: "date-time"
+		}
+	}
+	required: ["id", "todo_id", "channel", "status", "attempts", "created_at"]
+}
+
+#CreateReminderRequest: {
+	type:        "object"
+	description: "Exactly one of remind_at and offset_minutes is required"
+	properties: {
+		channel: {
+			type: "string"
+			enum: ["email", "in_app", "webhook"]
+		}
+		remind_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		offset_minutes: {
+			type:        "integer"
+			format:      "int32"
+			minimum:     -43200
+			maximum:     43200
+			description: "Minutes relative to the due date (negative = before)"
+		}
+	}
+	required: ["channel"]
+}
+
+#Notification: {
+	type: "object"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		type: {
+			type: "string"
+			enum: ["reminder", "shared_list", "admin"]
+		}
+		title: type: "string"
+		body: type:  "string"
+		todo_id: {
+			type:   "integer"
+			format: "int64"
+		}
+		read_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		created_at: {
+			type:   "string"
+			format: "date-time"
+		}
+	}
+	required: ["id", "type", "title", "body", "created_at"]
+}
+
+#NotificationPage: {
+	type: "object"
+	properties: {
+		notifications: {
+			type:        "array"
+			description: "Notifications, newest first"
+			items: "$ref": "#/components/schemas/Notification"
+		}
+		next_cursor: {
+			type:        "string"
+			description: "Cursor for the next page. Omitted on the last page"
+		}
+	}
+	required: ["notifications"]
+}
+
+#NotificationUnreadCount: {
+	type: "object"
+	properties: count: {
+		type:   "integer"
+		format: "int64"
+	}
+	required: ["count"]
+}
+
+#MarkAllNotificationsReadResponse: {
+	type: "object"
+	properties: marked: {
+		type:        "integer"
+		format:      "int64"
+		description: "Number of notifications marked as read"
+	}
+	required: ["marked"]
+}
+
+#NotificationPreference: {
+	type: "object"
+	properties: {
+		type: {
+			type: "string"
+			enum: ["reminder", "shared_list", "admin"]
+		}
+		enabled: {
+			type:        "boolean"
+			description: "Whether notifications of this type are created. admin notifications cannot be disabled"
+		}
+	}
+	required: ["type", "enabled"]
+}
+
+#NotificationPreferences: {
+	type: "object"
+	properties: preferences: {
+		type: "array"
+		items: "$ref": "#/components/schemas/NotificationPreference"
+	}
+	required: ["preferences"]
+}
+
+// ユーザー設定関連
+#UserSettings: {
+	type: "object"
+	properties: {
+		timezone: {
+			type:        "string"
+			description: "IANA time zone (e.g. Asia/Tokyo). Dates without a time and relative dates such as \"tomorrow\" are interpreted in this time zone"
+		}
+		locale: {
+			type:        "string"
+			description: "BCP 47 language tag (e.g. en-US, ja)"
+		}
+		week_start: {
+			type:        "integer"
+			minimum:     0
+			maximum:     6
+			description: "First day of the week. 0 is Sunday and 6 is Saturday"
+		}
+		default_sort: {
+			type:        "string"
+			enum: ["created_at", "manual"]
+			description: "Sort order of listTodos when the sort parameter is omitted"
+		}
+		default_project_id: {
+			type:        "integer"
+			format:      "int64"
+			description: "Project of todos created without a project. Omitted when not set"
+		}
+		notifications: {
+			type:        "array"
+			description: "Preference of every notification type, as returned by getNotificationPreferences"
+			items: "$ref": "#/components/schemas/NotificationPreference"
+		}
+	}
+	required: ["timezone", "locale", "week_start", "default_sort", "notifications"]
+}
+
+#UpdateUserSettingsRequest: {
+	type:        "object"
+	description: "Fields to change. Omitted fields keep their current value"
+	properties: {
+		timezone: {
+			type:        "string"
+			description: "IANA time zone (e.g. Asia/Tokyo)"
+		}
+		locale: {
+			type:        "string"
+			description: "BCP 47 language tag (e.g. en-US, ja)"
+		}
+		week_start: {
+			type:    "integer"
+			minimum: 0
+			maximum: 6
+		}
+		default_sort: {
+			type: "string"
+			enum: ["created_at", "manual"]
+		}
+		default_project_id: {
+			type:        "integer"
+			format:      "int64"
+			description: "Project of todos created without a project. 0 clears the default project"
+		}
+		notifications: {
+			type:        "array"
+			description: "Notification types to update. Types not listed keep their current preference"
+			items: "$ref": "#/components/schemas/NotificationPreference"
+		}
+	}
+}
+
+// iCalendarフィード関連
+#CalendarFeed: {
+	type: "object"
+	properties: {
+		url: {
+			type:        "string"
+			format:      "uri"
+			description: "Secret feed URL to subscribe to from a calendar app. Anyone with this URL can read the todos"
+		}
+		token: {
+			type:        "string"
+			description: "Secret token contained in the URL. It is only returned when issued"
+		}
+	}
+	required: ["url", "token"]
+}
+
+// パーソナルアクセストークン関連
+#PersonalAccessToken: {
+	type: "object"
+	properties: {
+		id: {
+			type:   "integer"
+			format: "int64"
+		}
+		name: type: "string"
+		created_at: {
+			type:   "string"
+			format: "date-time"
+		}
+		last_used_at: {
+			type:        "string"
+			format:      "date-time"
+			description: "Omitted if the token has never been used"
+		}
+	}
+	required: ["id", "name", "created_at"]
+}
+
+#PersonalAccessTokens: {
+	type: "object"
+	properties: tokens: {
+		type: "array"
+		items: "$ref": "#/components/schemas/PersonalAccessToken"
+	}
+	required: ["tokens"]
+}
+
+#CreatePersonalAccessTokenRequest: {
+	type: "object"
+	properties: name: {
+		type:        "string"
+		minLength:   1
+		maxLength:   100
+		description: "Label to tell tokens apart, such as the device or app that uses it"
+	}
+	required: ["name"]
+}
+
+#CreatedPersonalAccessToken: {
+	type: "object"
+	properties: {
+		token: {
+			type:        "string"
+			description: "Secret token. Use it as the password of HTTP Basic authentication for CalDAV. It is only returned when issued"
+		}
+		personal_access_token: "$ref": "#/components/schemas/PersonalAccessToken"
+	}
+	required: ["token", "personal_access_token"]
+}
+
+#ErrorCode: {
+	type:        "string"
+	description: "Stable machine-readable error code. Clients should branch on this instead of the title"
+	enum: [
+		"bad_request", "unauthorized", "forbidden", "not_found", "method_not_allowed", "conflict", "request_too_large", "too_many_requests", "internal_error", "service_unavailable",
+		"validation_failed", "invalid_request_body", "invalid_id", "invalid_if_match", "if_match_required", "empty_patch",
+		"authentication_failed", "user_not_found",
+		"idempotency_key_too_long", "idempotency_key_reused", "idempotency_key_in_flight",
+		"todo_not_found", "title_required", "title_empty", "too_many_ids", "too_many_items",
+		"anchor_not_found", "invalid_position_anchor",
+		"blocker_not_found", "dependency_not_found", "self_dependency", "dependency_cycle", "todo_blocked",
+		"status_not_found", "unknown_status", "invalid_status", "invalid_status_set", "status_name_conflict", "wip_limit_exceeded", "first_status_wip_limit",
+		"invalid_sync_token", "too_many_operations",
+		"unsupported_content_type", "invalid_import_file", "unknown_import_source", "invalid_export", "too_many_import_items",
+		"invalid_format", "invalid_columns",
+		"unknown_job_type", "invalid_filter", "job_not_found", "job_finished",
+		"reminder_not_found", "invalid_reminder", "channel_unavailable", "notification_not_found", "invalid_cursor", "invalid_notification_preference",
+		"calendar_feed_not_found",
+		"personal_access_token_not_found", "invalid_token_name",
+		"invalid_download_link", "download_link_expired",
+		"invalid_timezone", "invalid_user_settings", "default_project_not_found",
+	]
+}
+
+#FieldError: {
+	type:        "object"
+	description: "A problem with a single request field"
+	properties: {
+		in: {
+			type: "string"
+			enum: ["body", "query", "path", "header"]
+			"x-enum-varnames": ["FieldErrorInBody", "FieldErrorInQuery", "FieldErrorInPath", "FieldErrorInHeader"]
+		}
+		field: {
+			type:        "string"
+			description: "Parameter name, or JSON Pointer (RFC 6901) into the request body such as /items/0/title"
+		}
+		message: {
+			type:        "string"
+			description: "Untranslated description of what is wrong with the field"
+		}
+	}
+	required: ["in", "field", "message"]
+}
+
+#Problem: {
+	type:        "object"
+	description: "Error response in the RFC 7807 Problem Details format (application/problem+json)"
+	properties: {
+		type: {
+			type:        "string"
+			format:      "uri-reference"
+			description: "URI identifying the problem type, urn:go-todo:problem:{code}"
+		}
+		title: {
+			type:        "string"
+			description: "Short human-readable summary in the language chosen by Accept-Language, or by the locale user setting when the header is absent. English and Japanese are available"
+		}
+		status: {
+			type:        "integer"
+			description: "HTTP status code"
+		}
+		detail: {
+			type:        "string"
+			description: "Untranslated explanation specific to this occurrence. Omitted when there is none"
+		}
+		instance: {
+			type:        "string"
+			format:      "uri-reference"
+			description: "Path of the request that caused the problem"
+		}
+		request_id: {
+			type:        "string"
+			description: "ID of the request, also returned in the X-Request-ID header. Include it when reporting the problem"
+		}
+		code: "$ref": "#/components/schemas/ErrorCode"
+		errors: {
+			type:        "array"
+			description: "Field-level errors. Only present for validation failures"
+			items: "$ref": "#/components/schemas/FieldError"
+		}
+	}
+	required: ["type", "title", "status", "code"]
+}
+
+#HealthResponse: {
+	type: "object"
+	properties: status: type: "string"
+	required: ["status"]
+}
+
+#InfoResponse: {
+	type: "object"
+	properties: {
+		name: type:    "string"
+		version: type: "string"
+	}
+	required: ["name", "version"]
+}
+
+// ETag関連のヘッダー定義
+#ETagHeader: {
+	description: "Entity tag of the returned representation"
+	schema: type: "string"
+}
+
+#IfMatchParam: {
+	name:        "If-Match"
+	in:          "header"
+	required:    false
+	description: "ETag of the todo being modified, as returned by getTodo, createTodo or updateTodo. A comma-separated list matches any of the listed ETags, and * matches any version. Requests without it fail with 428"
+	schema: type: "string"
+}
+
+#IdempotencyKeyParam: {
+	name:        "Idempotency-Key"
+	in:          "header"
+	required:    false
+	description: "Client-generated unique key. Retries with the same key replay the stored response instead of re-executing the request"
+	schema: {
+		type:      "string"
+		maxLength: 255
+	}
+}
+
+#AtomicParam: {
+	name:        "atomic"
+	in:          "query"
+	required:    false
+	description: "If true, nothing is applied when any of the todos fails"
+	schema: {
+		type:    "boolean"
+		default: false
+	}
+}
+
+#ForceParam: {
+	name:        "force"
+	in:          "query"
+	required:    false
+	description: "Complete todos even if they still have open blockers"
+	schema: {
+		type:    "boolean"
+		default: false
+	}
+}
+
+#IdempotencyConflictResponse: {
+	description: "Idempotency-Key was reused with a different request, or the original request is still in progress"
+	content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+}
+
+// パス定義
+paths: {
+	"/": get: {
+		summary:     "API information"
+		description: "Get API information including name and version"
+		operationId: "getInfo"
+		tags: ["general"]
+		responses: "200": {
+			description: "OK"
+			content: "application/json": schema: "$ref": "#/components/schemas/InfoResponse"
+		}
+	}
+	"/health": get: {
+		summary:     "Health check"
+		description: "Check if the API is running"
+		operationId: "getHealth"
+		tags: ["general"]
+		responses: "200": {
+			description: "OK"
+			content: "application/json": schema: "$ref": "#/components/schemas/HealthResponse"
+		}
+	}
+	"/todos": {
+		get: {
+			summary:     "List all todos"
+			description: "Get all todos for the authenticated user"
+			operationId: "listTodos"
+			tags: ["todos"]
+			security: [{cookieAuth: []}]
+			parameters: [{
+				name:        "sort"
+				in:          "query"
+				required:    false
+				description: "Sort order. created_at lists the newest first; manual follows the order set with POST /todos/{id}/move. Defaults to the default_sort user setting"
+				schema: {
+					type: "string"
+					enum: ["created_at", "manual"]
+				}
+			}, {
+				name:        "actionable"
+				in:          "query"
+				required:    false
+				description: "When true, only todos without open blockers are returned"
+				schema: {
+					type:    "boolean"
+					default: false
+				}
+			}, {
+				name:        "If-None-Match"
+				in:          "header"
+				required:    false
+				description: "Weak ETag of a previously fetched list"
+				schema: type: "string"
+			}]
+			responses: {
+				"200": {
+					description: "OK"
+					headers: ETag: #ETagHeader
+					content: "application/json": schema: {
+						type: "array"
+						items: "$ref": "#/components/schemas/Todo"
+					}
+				}
+				"304": {
+					description: "Not modified"
+					headers: ETag: #ETagHeader
+				}
+				"400": {
+					description: "Invalid query parameter"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+		post: {
+			summary:     "Create a new todo"
+			description: "Create a new todo with the provided information. The todo goes to the user's default project, if one is set"
+			operationId: "createTodo"
+			tags: ["todos"]
+			security: [{cookieAuth: []}]
+			parameters: [#IdempotencyKeyParam]
+			requestBody: {
+				required: true
+				content: "application/json": schema: "$ref": "#/components/schemas/CreateTodoRequest"
+			}
+			responses: {
+				"201": {
+					description: "Created"
+					headers: ETag: #ETagHeader
+					content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+				}
+				"400": {
+					description: "Bad request"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"409": #IdempotencyConflictResponse
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+	}
+	"/todos/changes": get: {
+		summary:     "List todo changes"
+		description: "Get todos created, updated or deleted since a change token. Changes are tracked with a server-assigned sequence rather than timestamps. At most 1000 changes are returned per response"
+		operationId: "listTodoChanges"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "since"
+			in:          "query"
+			required:    false
+			description: "Token returned by a previous call (or by POST /sync). Omit to fetch everything"
+			schema: type: "string"
+		}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/TodoChangesResponse"
+			}
+			"400": {
+				description: "Invalid token"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/{id}": {
+		get: {
+			summary:     "Get a todo by ID"
+			description: "Get a single todo by its ID"
+			operationId: "getTodo"
+			tags: ["todos"]
+			security: [{cookieAuth: []}]
+			parameters: [{
+				name:        "id"
+				in:          "path"
+				required:    true
+				description: "Todo ID"
+				schema: {type: "integer", minimum: 0}, format: "int64"
+			}]
+			responses: {
+				"200": {
+					description: "OK"
+					headers: ETag: #ETagHeader
+					content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+				}
+				"400": {
+					description: "Invalid ID"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"404": {
+					description: "Todo not found"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+		put: {
+			summary:     "Update a todo"
+			description: "Update an existing todo by ID"
+			operationId: "updateTodo"
+			tags: ["todos"]
+			security: [{cookieAuth: []}]
+			parameters: [{
+				name:        "id"
+				in:          "path"
+				required:    true
+				description: "Todo ID"
+				schema: {type: "integer", minimum: 0}, format: "int64"
+			}, #IfMatchParam, #ForceParam]
+			requestBody: {
+				required: true
+				content: "application/json": schema: "$ref": "#/components/schemas/UpdateTodoRequest"
+			}
+			responses: {
+				"200": {
+					description: "OK"
+					headers: ETag: #ETagHeader
+					content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+				}
+				"400": {
+					description: "Invalid ID or request body"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"404": {
+					description: "Todo not found"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"409": {
+					description: "The todo still has open blockers. Retry with force=true to complete it anyway"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"412": {
+					description: "Precondition failed (ETag mismatch)"
+					headers: ETag: #ETagHeader
+					content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+				}
+				"428": {
+					description: "Precondition required (If-Match header is missing)"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+		delete: {
+			summary:     "Delete a todo"
+			description: "Delete a todo by ID"
+			operationId: "deleteTodo"
+			tags: ["todos"]
+			security: [{cookieAuth: []}]
+			parameters: [{
+				name:        "id"
+				in:          "path"
+				required:    true
+				description: "Todo ID"
+				schema: {type: "integer", minimum: 0}, format: "int64"
+			}, #IfMatchParam]
+			responses: {
+				"204": description: "No Content"
+				"400": {
+					description: "Invalid ID"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"404": {
+					description: "Todo not found"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"412": {
+					description: "Precondition failed (ETag mismatch)"
+					headers: ETag: #ETagHeader
+					content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+				}
+				"428": {
+					description: "Precondition required (If-Match header is missing)"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+	}
+	"/todos/{id}/blockers": post: {
+		summary:     "Add a blocker"
+		description: "Declare that the todo is blocked by another todo. Adding an existing blocker is a no-op. Fails with 409 if the dependency would create a cycle"
+		operationId: "addTodoBlocker"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Todo ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/AddTodoBlockerRequest"
+		}
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/TodoDependencies"
+			}
+			"400": {
+				description: "Invalid request body or a todo blocking itself"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Todo or blocker not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": {
+				description: "The dependency would create a cycle"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/{id}/blockers/{blocker_id}": delete: {
+		summary:     "Remove a blocker"
+		description: "Remove a dependency added with addTodoBlocker"
+		operationId: "removeTodoBlocker"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Todo ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}, {
+			name:        "blocker_id"
+			in:          "path"
+			required:    true
+			description: "Blocker todo ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}]
+		responses: {
+			"204": description: "No Content"
+			"400": {
+				description: "Invalid ID"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Dependency not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/{id}/move": post: {
+		summary:     "Move a todo"
+		description: "Change the manual position of a todo relative to other todos. Only the moved todo is rewritten; moves by the same user are serialized"
+		operationId: "moveTodo"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Todo ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/MoveTodoRequest"
+		}
+		responses: {
+			"200": {
+				description: "Moved"
+				headers: ETag: #ETagHeader
+				content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+			}
+			"400": {
+				description: "Bad request (no anchor, anchor not found, or anchors in the wrong order)"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Todo not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/batch/complete": post: {
+		summary:     "Batch complete todos"
+		description: "Mark multiple todos as completed. The todos are locked for the duration of the operation. Todos that are already completed are left unchanged and reported in succeeded with an already_completed warning"
+		operationId: "batchCompleteTodos"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [#AtomicParam, #ForceParam, #IdempotencyKeyParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/BatchTodoRequest"
+		}
+		responses: {
+			"200": {
+				description: "Batch operation completed"
+				content: "application/json": schema: "$ref": "#/components/schemas/BatchCompleteResponse"
+			}
+			"400": {
+				description: "Bad request"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": #IdempotencyConflictResponse
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/batch/delete": post: {
+		summary:     "Batch delete todos"
+		description: "Soft delete multiple todos. The todos are locked for the duration of the operation"
+		operationId: "batchDeleteTodos"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [#AtomicParam, #IdempotencyKeyParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/BatchTodoRequest"
+		}
+		responses: {
+			"200": {
+				description: "Batch operation completed"
+				content: "application/json": schema: "$ref": "#/components/schemas/BatchDeleteResponse"
+			}
+			"400": {
+				description: "Bad request"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": #IdempotencyConflictResponse
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/batch/create": post: {
+		summary:     "Batch create todos"
+		description: "Create multiple todos in one transaction. Invalid items are reported and the rest are created"
+		operationId: "batchCreateTodos"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [#IdempotencyKeyParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/BatchCreateRequest"
+		}
+		responses: {
+			"200": {
+				description: "Batch operation completed"
+				content: "application/json": schema: "$ref": "#/components/schemas/BatchCreateResponse"
+			}
+			"400": {
+				description: "Bad request"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": #IdempotencyConflictResponse
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/quick-add": post: {
+		summary:     "Quick-add a todo"
+		description: """
+			Create a todo from a single line of natural language. Recognized expressions are removed from the title:
+			dates and times ("tomorrow 9am", "next friday", "in 3 days", "dec 5", "2026-12-05 18:00"),
+			#tags, +Project, priorities (!high, !medium, !low or !!!, !!, !1..!3)
+			and recurrences ("every monday", "every 2 weeks", "daily").
+			Dates and times are interpreted in the given time zone (by default the user's timezone setting),
+			and "next week" starts on the user's week_start day. A project that does not exist is created;
+			without a project the todo goes to the user's default project
+			"""
+		operationId: "quickAddTodo"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [#IdempotencyKeyParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/QuickAddRequest"
+		}
+		responses: {
+			"201": {
+				description: "Created"
+				headers: ETag: #ETagHeader
+				content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+			}
+			"400": {
+				description: "Bad request (invalid time zone or no title left after parsing)"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": #IdempotencyConflictResponse
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/quick-add/preview": post: {
+		summary:     "Preview a quick-add"
+		description: "Parse a quick-add line the same way as quickAddTodo without saving anything"
+		operationId: "previewQuickAddTodo"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/QuickAddRequest"
+		}
+		responses: {
+			"200": {
+				description: "Parsed todo"
+				content: "application/json": schema: "$ref": "#/components/schemas/QuickAddPreview"
+			}
+			"400": {
+				description: "Bad request (invalid time zone or no title left after parsing)"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/import": post: {
+		summary:     "Import todos"
+		description: """
+			Import todos from a file. The format is chosen by Content-Type:
+			application/x-ndjson (one object per line with title, description, completed and due_at),
+			text/plain (todo.txt), text/markdown (task list items such as "- [ ]" and "- [x]")
+			or text/csv (a header row followed by one todo per row).
+			Invalid lines are skipped and reported unless strict is set
+			"""
+		operationId: "importTodos"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "strict"
+			in:          "query"
+			required:    false
+			description: "If true, abort the whole import on the first invalid line"
+			schema: {
+				type:    "boolean"
+				default: false
+			}
+		}, {
+			name:        "preview"
+			in:          "query"
+			required:    false
+			description: "If true, return the parsed todos without saving anything"
+			schema: {
+				type:    "boolean"
+				default: false
+			}
+		}, {
+			name:        "dedupe"
+			in:          "query"
+			required:    false
+			description: "If true, skip todos whose title matches an existing todo or an earlier todo in the same file"
+			schema: {
+				type:    "boolean"
+				default: false
+			}
+		}, {
+			name:        "csv_title"
+			in:          "query"
+			required:    false
+			description: "CSV header of the title column (case-insensitive). Defaults to title"
+			schema: type: "string"
+		}, {
+			name:        "csv_description"
+			in:          "query"
+			required:    false
+			description: "CSV header of the description column (case-insensitive). Defaults to description"
+			schema: type: "string"
+		}, {
+			name:        "csv_completed"
+			in:          "query"
+			required:    false
+			description: "CSV header of the completed column (case-insensitive). Defaults to completed"
+			schema: type: "string"
+		}, {
+			name:        "csv_due_at"
+			in:          "query"
+			required:    false
+			description: "CSV header of the due_at column (case-insensitive). Defaults to due_at"
+			schema: type: "string"
+		}]
+		requestBody: {
+			required: true
+			content: "*/*": schema: {
+				type:   "string"
+				format: "binary"
+			}
+		}
+		responses: {
+			"200": {
+				description: "Import completed"
+				content: "application/json": schema: "$ref": "#/components/schemas/ImportResponse"
+			}
+			"400": {
+				description: "Unreadable request body, or a CSV file without a usable header row"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"415": {
+				description: "Unsupported Content-Type"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"422": {
+				description: "Import aborted because of an invalid line (strict mode)"
+				content: "application/json": schema: "$ref": "#/components/schemas/ImportResponse"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/import/{source}": post: {
+		summary:     "Import todos from another task manager"
+		description: """
+			Queue an import of a JSON export from another task manager. Poll GET /jobs/{id} for progress;
+			the job result reports the number of imported todos, todos skipped because they were imported before,
+			skipped items with reasons and the fields that have no counterpart in this API.
+			todoist accepts a Sync API response or backup ({"projects", "labels", "items", "notes"}) or a REST API task list;
+			trello accepts a board JSON export. Projects (Trello boards) become projects and labels become tags.
+			Items are identified by their source ID, so importing the same export again does not create duplicates
+			"""
+		operationId: "importExternalTodos"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "source"
+			in:          "path"
+			required:    true
+			description: "Task manager that produced the export"
+			schema: {
+				type: "string"
+				enum: ["todoist", "trello"]
+			}
+		}, #IdempotencyKeyParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: description: "The export file as produced by the task manager (an object, or an array for a Todoist REST API task list)"
+		}
+		responses: {
+			"202": {
+				description: "Accepted"
+				content: "application/json": schema: "$ref": "#/components/schemas/Job"
+			}
+			"400": {
+				description: "Unknown source, or a body that is not an export of the source"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": #IdempotencyConflictResponse
+			"413": {
+				description: "The export contains too many items"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/projects": get: {
+		summary:     "List projects"
+		description: "List the projects of the authenticated user in name order"
+		operationId: "listProjects"
+		tags: ["projects"]
+		security: [{cookieAuth: []}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: {
+					type: "array"
+					items: "$ref": "#/components/schemas/Project"
+				}
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/export": get: {
+		summary:     "Export todos"
+		description: "Stream all todos of the authenticated user as CSV, a JSON array or newline-delimited JSON. The response is written while the todos are read, so an error after the first byte aborts the connection instead of ending the body normally"
+		operationId: "exportTodos"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "format"
+			in:          "query"
+			required:    false
+			description: "Output format"
+			schema: {
+				type: "string"
+				enum: ["csv", "json", "ndjson"]
+				default: "json"
+			}
+		}, {
+			name:        "include_deleted"
+			in:          "query"
+			required:    false
+			description: "If true, soft-deleted todos are exported too"
+			schema: {
+				type:    "boolean"
+				default: false
+			}
+		}, {
+			name:        "columns"
+			in:          "query"
+			required:    false
+			description: "Comma-separated list of columns in output order. Defaults to every column except deleted_at, which is added when include_deleted is set"
+			style:       "form"
+			explode:     false
+			schema: {
+				type: "array"
+				items: {
+					type: "string"
+					enum: ["id", "client_id", "title", "description", "completed", "status", "due_at", "created_at", "updated_at", "deleted_at", "version"]
+				}
+				minItems: 1
+			}
+		}]
+		responses: {
+			"200": {
+				description: "Exported todos"
+				headers: "Content-Disposition": {
+					description: "Suggested file name for the download"
+					schema: type: "string"
+				}
+				content: {
+					"text/csv": schema: {
+						type:   "string"
+						format: "binary"
+					}
+					"application/json": schema: {
+						type:   "string"
+						format: "binary"
+					}
+					"application/x-ndjson": schema: {
+						type:   "string"
+						format: "binary"
+					}
+				}
+			}
+			"400": {
+				description: "Bad request"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/batch/update": post: {
+		summary:     "Batch update todos"
+		description: "Apply the same patch to multiple todos"
+		operationId: "batchUpdateTodos"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [#AtomicParam, {
+			name:        "dry_run"
+			in:          "query"
+			required:    false
+			description: "If true, report what would change without updating anything"
+			schema: {
+				type:    "boolean"
+				default: false
+			}
+		}, #ForceParam, #IdempotencyKeyParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/BatchUpdateRequest"
+		}
+		responses: {
+			"200": {
+				description: "Batch operation completed"
+				content: "application/json": schema: "$ref": "#/components/schemas/BatchUpdateResponse"
+			}
+			"400": {
+				description: "Bad request"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": #IdempotencyConflictResponse
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/jobs": post: {
+		summary:     "Start a background job"
+		description: "Queue a bulk operation on todos. Poll GET /jobs/{id} for progress and results"
+		operationId: "createJob"
+		tags: ["jobs"]
+		security: [{cookieAuth: []}]
+		parameters: [#IdempotencyKeyParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/CreateJobRequest"
+		}
+		responses: {
+			"202": {
+				description: "Accepted"
+				content: "application/json": schema: "$ref": "#/components/schemas/Job"
+			}
+			"400": {
+				description: "Bad request"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": #IdempotencyConflictResponse
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/jobs/{id}": get: {
+		summary:     "Get a job"
+		description: "Get the status, progress and result of a background job"
+		operationId: "getJob"
+		tags: ["jobs"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Job ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/Job"
+			}
+			"400": {
+				description: "Invalid ID"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Job not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/jobs/{id}/cancel": post: {
+		summary:     "Cancel a job"
+		description: "Cancel a pending job, or ask a running job to stop after the current chunk. Work already done is kept"
+		operationId: "cancelJob"
+		tags: ["jobs"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Job ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}]
+		responses: {
+			"202": {
+				description: "Cancellation accepted"
+				content: "application/json": schema: "$ref": "#/components/schemas/Job"
+			}
+			"400": {
+				description: "Invalid ID"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Job not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": {
+				description: "Job has already finished"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/statuses": {
+		get: {
+			summary:     "List statuses"
+			description: "List the board statuses in column order. Default statuses (todo, doing, done) are created on first use"
+			operationId: "listStatuses"
+			tags: ["statuses"]
+			security: [{cookieAuth: []}]
+			responses: {
+				"200": {
+					description: "OK"
+					content: "application/json": schema: {
+						type: "array"
+						items: "$ref": "#/components/schemas/Status"
+					}
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+		post: {
+			summary:     "Create a status"
+			description: "Add a status column at the end of the board"
+			operationId: "createStatus"
+			tags: ["statuses"]
+			security: [{cookieAuth: []}]
+			requestBody: {
+				required: true
+				content: "application/json": schema: "$ref": "#/components/schemas/CreateStatusRequest"
+			}
+			responses: {
+				"201": {
+					description: "Created"
+					content: "application/json": schema: "$ref": "#/components/schemas/Status"
+				}
+				"400": {
+					description: "Bad request"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"409": {
+					description: "A status with the same name already exists"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"422": {
+					description: "The status would be the first open status and has a WIP limit"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+	}
+	"/statuses/{id}": {
+		put: {
+			summary:     "Update a status"
+			description: "Rename, reorder or change the WIP limit of a status, or make it the done status. Changing the done status recomputes completed for todos with an assigned status"
+			operationId: "updateStatus"
+			tags: ["statuses"]
+			security: [{cookieAuth: []}]
+			parameters: [{
+				name:        "id"
+				in:          "path"
+				required:    true
+				description: "Status ID"
+				schema: {type: "integer", minimum: 0}, format: "int64"
+			}]
+			requestBody: {
+				required: true
+				content: "application/json": schema: "$ref": "#/components/schemas/UpdateStatusRequest"
+			}
+			responses: {
+				"200": {
+					description: "OK"
+					content: "application/json": schema: "$ref": "#/components/schemas/Status"
+				}
+				"400": {
+					description: "Bad request"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"404": {
+					description: "Status not found"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"409": {
+					description: "Name conflict, or the change would leave no done status or no open status"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"422": {
+					description: "The change would leave a WIP limit on the first open status"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+		delete: {
+			summary:     "Delete a status"
+			description: "Delete an open status. Its todos move to the first open status. The done status and the last open status cannot be deleted"
+			operationId: "deleteStatus"
+			tags: ["statuses"]
+			security: [{cookieAuth: []}]
+			parameters: [{
+				name:        "id"
+				in:          "path"
+				required:    true
+				description: "Status ID"
+				schema: {type: "integer", minimum: 0}, format: "int64"
+			}]
+			responses: {
+				"204": description: "No Content"
+				"400": {
+					description: "Invalid ID"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"404": {
+					description: "Status not found"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"409": {
+					description: "The status is required"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"422": {
+					description: "The status that would become the first open status has a WIP limit. Remove the limit first"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+	}
+	"/todos/{id}/status": put: {
+		summary:     "Change the status of a todo"
+		description: "Move a todo to another status column. completed becomes true exactly when the target is the done status. Fails with 409 if the target status has reached its WIP limit"
+		operationId: "setTodoStatus"
+		tags: ["statuses"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Todo ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}, #IfMatchParam, #ForceParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/SetTodoStatusRequest"
+		}
+		responses: {
+			"200": {
+				description: "OK"
+				headers: ETag: #ETagHeader
+				content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+			}
+			"400": {
+				description: "Bad request or unknown status"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Todo not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"409": {
+				description: "WIP limit reached, or moving into the done status while the todo still has open blockers"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"412": {
+				description: "Precondition failed (ETag mismatch)"
+				headers: ETag: #ETagHeader
+				content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+			}
+			"428": {
+				description: "Precondition required (If-Match header is missing)"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/board": get: {
+		summary:     "Get the board"
+		description: "Get todos grouped by status, with columns in status order"
+		operationId: "getBoard"
+		tags: ["statuses"]
+		security: [{cookieAuth: []}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/Board"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/{id}/due": put: {
+		summary:     "Set the due date of a todo"
+		description: "Set or clear the due date. Pending relative reminders follow the new due date"
+		operationId: "setTodoDue"
+		tags: ["todos"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Todo ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}, #IfMatchParam]
+		requestBody: {
+			required: true
+			content: "application/json": schema: "$ref": "#/components/schemas/SetTodoDueRequest"
+		}
+		responses: {
+			"200": {
+				description: "OK"
+				headers: ETag: #ETagHeader
+				content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+			}
+			"400": {
+				description: "Bad request"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Todo not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"412": {
+				description: "Precondition failed (ETag mismatch)"
+				headers: ETag: #ETagHeader
+				content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+			}
+			"428": {
+				description: "Precondition required (If-Match header is missing)"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/todos/{id}/reminders": {
+		get: {
+			summary:     "List reminders of a todo"
+			operationId: "listTodoReminders"
+			tags: ["notifications"]
+			security: [{cookieAuth: []}]
+			parameters: [{
+				name:        "id"
+				in:          "path"
+				required:    true
+				description: "Todo ID"
+				schema: {type: "integer", minimum: 0}, format: "int64"
+			}]
+			responses: {
+				"200": {
+					description: "OK"
+					content: "application/json": schema: {
+						type: "array"
+						items: "$ref": "#/components/schemas/Reminder"
+					}
+				}
+				"400": {
+					description: "Invalid ID"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"404": {
+					description: "Todo not found"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+		post: {
+			summary:     "Create a reminder"
+			description: "Schedule a reminder for a todo. Reminders whose time passed while the server was down are delivered on startup; reminders of completed or deleted todos are canceled. Fails with 400 if the channel is not configured on the server"
+			operationId: "createTodoReminder"
+			tags: ["notifications"]
+			security: [{cookieAuth: []}]
+			parameters: [{
+				name:        "id"
+				in:          "path"
+				required:    true
+				description: "Todo ID"
+				schema: {type: "integer", minimum: 0}, format: "int64"
+			}]
+			requestBody: {
+				required: true
+				content: "application/json": schema: "$ref": "#/components/schemas/CreateReminderRequest"
+			}
+			responses: {
+				"201": {
+					description: "Created"
+					content: "application/json": schema: "$ref": "#/components/schemas/Reminder"
+				}
+				"400": {
+					description: "Invalid reminder or unavailable channel"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"404": {
+					description: "Todo not found"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+	}
+	"/reminders/{id}": delete: {
+		summary:     "Delete a reminder"
+		operationId: "deleteReminder"
+		tags: ["notifications"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Reminder ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}]
+		responses: {
+			"204": description: "No Content"
+			"400": {
+				description: "Invalid ID"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Reminder not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/notifications": get: {
+		summary:     "List notifications"
+		description: "Get in-app notifications, newest first, one page at a time"
+		operationId: "listNotifications"
+		tags: ["notifications"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "unread"
+			in:          "query"
+			required:    false
+			description: "When true, only unread notifications are returned"
+			schema: {
+				type:    "boolean"
+				default: false
+			}
+		}, {
+			name:        "limit"
+			in:          "query"
+			required:    false
+			description: "Page size"
+			schema: {
+				type:    "integer"
+				minimum: 1
+				maximum: 100
+				default: 20
+			}
+		}, {
+			name:        "cursor"
+			in:          "query"
+			required:    false
+			description: "next_cursor returned by the previous page"
+			schema: type: "string"
+		}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/NotificationPage"
+			}
+			"400": {
+				description: "Invalid cursor"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
 	}
-	required: ["name", "version"]
-}
-
-// パス定義
-paths: {
-	"/": get: {
-		summary:     "API information"
-		description: "Get API information including name and version"
-		operationId: "getInfo"
-		tags: ["general"]
-		responses: "200": {
-			description: "OK"
-			content: "application/json": schema: "$ref": "#/components/schemas/InfoResponse"
+	"/notifications/unread-count": get: {
+		summary:     "Count unread notifications"
+		operationId: "getUnreadNotificationCount"
+		tags: ["notifications"]
+		security: [{cookieAuth: []}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/NotificationUnreadCount"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
 		}
 	}
-	"/health": get: {
-		summary:     "Health check"
-		description: "Check if the API is running"
-		operationId: "getHealth"
-		tags: ["general"]
-		responses: "200": {
-			description: "OK"
-			content: "application/json": schema: "$ref": "#/components/schemas/HealthResponse"
+	"/notifications/read-all": post: {
+		summary:     "Mark all notifications as read"
+		operationId: "markAllNotificationsRead"
+		tags: ["notifications"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "up_to_id"
+			in:          "query"
+			required:    false
+			description: "Only mark notifications with an ID up to this one, so that notifications arriving after the list was fetched stay unread"
+			schema: type: "integer", format: "int64"
+		}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/MarkAllNotificationsReadResponse"
+			}
+			"400": {
+				description: "Invalid query parameter"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
 		}
 	}
-	"/todos": {
+	"/notifications/preferences": {
 		get: {
-			summary:     "List all todos"
-			description: "Get all todos for the authenticated user"
-			operationId: "listTodos"
-			tags: ["todos"]
+			summary:     "Get notification preferences"
+			description: "Get the preference of every notification type. Types that were never configured are enabled"
+			operationId: "getNotificationPreferences"
+			tags: ["notifications"]
 			security: [{cookieAuth: []}]
 			responses: {
 				"200": {
 					description: "OK"
-					content: "application/json": schema: {
-						type: "array"
-						items: "$ref": "#/components/schemas/Todo"
-					}
+					content: "application/json": schema: "$ref": "#/components/schemas/NotificationPreferences"
 				}
 				"401": {
 					description: "Unauthorized"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 				"500": {
 					description: "Internal server error"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 			}
 		}
-		post: {
-			summary:     "Create a new todo"
-			description: "Create a new todo with the provided information"
-			operationId: "createTodo"
-			tags: ["todos"]
+		put: {
+			summary:     "Update notification preferences"
+			description: "Update the listed types. Types not listed keep their current preference"
+			operationId: "updateNotificationPreferences"
+			tags: ["notifications"]
 			security: [{cookieAuth: []}]
 			requestBody: {
 				required: true
-				content: "application/json": schema: "$ref": "#/components/schemas/CreateTodoRequest"
+				content: "application/json": schema: "$ref": "#/components/schemas/NotificationPreferences"
 			}
 			responses: {
-				"201": {
-					description: "Created"
-					content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+				"200": {
+					description: "OK"
+					content: "application/json": schema: "$ref": "#/components/schemas/NotificationPreferences"
 				}
 				"400": {
-					description: "Bad request"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					description: "Unknown type, or a type that cannot be disabled"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 				"401": {
 					description: "Unauthorized"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 				"500": {
 					description: "Internal server error"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 			}
 		}
 	}
-	"/todos/{id}": {
+	"/notifications/{id}/read": post: {
+		summary:     "Mark a notification as read"
+		operationId: "markNotificationRead"
+		tags: ["notifications"]
+		security: [{cookieAuth: []}]
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Notification ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/Notification"
+			}
+			"400": {
+				description: "Invalid ID"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Notification not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/users/me/settings": {
 		get: {
-			summary:     "Get a todo by ID"
-			description: "Get a single todo by its ID"
-			operationId: "getTodo"
-			tags: ["todos"]
+			summary:     "Get user settings"
+			description: "Get the settings of the authenticated user. Settings that were never changed have their default values"
+			operationId: "getUserSettings"
+			tags: ["account"]
 			security: [{cookieAuth: []}]
-			parameters: [{
-				name:        "id"
-				in:          "path"
-				required:    true
-				description: "Todo ID"
-				schema: type: "integer", format: "int64"
-			}]
 			responses: {
 				"200": {
 					description: "OK"
-					content: "application/json": schema: "$ref": "#/components/schemas/Todo"
-				}
-				"400": {
-					description: "Invalid ID"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/json": schema: "$ref": "#/components/schemas/UserSettings"
 				}
 				"401": {
 					description: "Unauthorized"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
-				}
-				"404": {
-					description: "Todo not found"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 				"500": {
 					description: "Internal server error"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 			}
 		}
-		put: {
-			summary:     "Update a todo"
-			description: "Update an existing todo by ID"
-			operationId: "updateTodo"
-			tags: ["todos"]
+		patch: {
+			summary:     "Update user settings"
+			description: "Update the given settings and return all settings"
+			operationId: "updateUserSettings"
+			tags: ["account"]
 			security: [{cookieAuth: []}]
-			parameters: [{
-				name:        "id"
-				in:          "path"
-				required:    true
-				description: "Todo ID"
-				schema: type: "integer", format: "int64"
-			}]
 			requestBody: {
 				required: true
-				content: "application/json": schema: "$ref": "#/components/schemas/UpdateTodoRequest"
+				content: "application/json": schema: "$ref": "#/components/schemas/UpdateUserSettingsRequest"
 			}
 			responses: {
 				"200": {
 					description: "OK"
-					content: "application/json": schema: "$ref": "#/components/schemas/Todo"
+					content: "application/json": schema: "$ref": "#/components/schemas/UserSettings"
 				}
 				"400": {
-					description: "Invalid ID or request body"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					description: "Unknown time zone, invalid locale or week start, unknown default project, or a notification type that cannot be disabled"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 				"401": {
 					description: "Unauthorized"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
-				"404": {
-					description: "Todo not found"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+	}
+	"/users/me/calendar-feed": {
+		post: {
+			summary:     "Issue a calendar feed URL"
+			description: "Issue a new secret iCalendar feed URL. Any previously issued URL stops working"
+			operationId: "createCalendarFeed"
+			tags: ["calendar"]
+			security: [{cookieAuth: []}]
+			responses: {
+				"201": {
+					description: "Created"
+					content: "application/json": schema: "$ref": "#/components/schemas/CalendarFeed"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 				"500": {
 					description: "Internal server error"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 			}
 		}
 		delete: {
-			summary:     "Delete a todo"
-			description: "Delete a todo by ID"
-			operationId: "deleteTodo"
-			tags: ["todos"]
+			summary:     "Revoke the calendar feed URL"
+			operationId: "deleteCalendarFeed"
+			tags: ["calendar"]
 			security: [{cookieAuth: []}]
-			parameters: [{
-				name:        "id"
-				in:          "path"
-				required:    true
-				description: "Todo ID"
-				schema: type: "integer", format: "int64"
-			}]
 			responses: {
 				"204": description: "No Content"
-				"400": {
-					description: "Invalid ID"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
-				}
 				"401": {
 					description: "Unauthorized"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 				"404": {
-					description: "Todo not found"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					description: "No calendar feed has been issued"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 				"500": {
 					description: "Internal server error"
-					content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 				}
 			}
 		}
 	}
-	"/todos/batch/complete": post: {
-		summary:     "Batch complete todos"
-		description: "Mark multiple todos as completed"
-		operationId: "batchCompleteTodos"
-		tags: ["todos"]
+	"/users/me/tokens": {
+		get: {
+			summary:     "List personal access tokens"
+			operationId: "listPersonalAccessTokens"
+			tags: ["tokens"]
+			security: [{cookieAuth: []}]
+			responses: {
+				"200": {
+					description: "OK"
+					content: "application/json": schema: "$ref": "#/components/schemas/PersonalAccessTokens"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+		post: {
+			summary:     "Issue a personal access token"
+			description: "Issue a token for clients that cannot use the session cookie, such as CalDAV clients (Basic authentication at /dav/ with the token as the password)"
+			operationId: "createPersonalAccessToken"
+			tags: ["tokens"]
+			security: [{cookieAuth: []}]
+			requestBody: {
+				required: true
+				content: "application/json": schema: "$ref": "#/components/schemas/CreatePersonalAccessTokenRequest"
+			}
+			responses: {
+				"201": {
+					description: "Created"
+					content: "application/json": schema: "$ref": "#/components/schemas/CreatedPersonalAccessToken"
+				}
+				"400": {
+					description: "Bad request"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"401": {
+					description: "Unauthorized"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+				"500": {
+					description: "Internal server error"
+					content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+				}
+			}
+		}
+	}
+	"/users/me/tokens/{id}": delete: {
+		summary:     "Revoke a personal access token"
+		operationId: "deletePersonalAccessToken"
+		tags: ["tokens"]
 		security: [{cookieAuth: []}]
-		requestBody: {
-			required: true
-			content: "application/json": schema: "$ref": "#/components/schemas/BatchTodoRequest"
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Personal access token ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}]
+		responses: {
+			"204": description: "No Content"
+			"400": {
+				description: "Invalid ID"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"401": {
+				description: "Unauthorized"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"404": {
+				description: "Token not found"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
 		}
+	}
+	"/users/me/export": post: {
+		summary:     "Export all account data"
+		description: """
+			Queue a job that packs all data of the authenticated user into a ZIP archive of JSON files:
+			profile, all todos including soft-deleted ones, tags, projects, statuses, dependencies,
+			notification preferences, personal access tokens (without secrets) and the job, notification and reminder history.
+			Poll GET /jobs/{id}; the result of the succeeded job holds download_url, expires_at and size.
+			The download URL is signed, needs no session and can be used only once before it expires
+			"""
+		operationId: "exportAccountData"
+		tags: ["account"]
+		security: [{cookieAuth: []}]
+		parameters: [#IdempotencyKeyParam]
 		responses: {
-			"200": {
-				description: "Batch operation completed"
-				content: "application/json": schema: "$ref": "#/components/schemas/BatchCompleteResponse"
+			"202": {
+				description: "Accepted"
+				content: "application/json": schema: "$ref": "#/components/schemas/Job"
 			}
 			"400": {
 				description: "Bad request"
-				content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 			}
 			"401": {
 				description: "Unauthorized"
-				content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 			}
+			"409": #IdempotencyConflictResponse
 			"500": {
 				description: "Internal server error"
-				content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 			}
 		}
 	}
-	"/todos/batch/delete": post: {
-		summary:     "Batch delete todos"
-		description: "Soft delete multiple todos"
-		operationId: "batchDeleteTodos"
-		tags: ["todos"]
+	"/exports/{id}": get: {
+		summary:     "Download an account data export"
+		description: "Download the ZIP archive produced by exportAccountData. Authenticated by the signature in the URL instead of the session cookie. The archive is streamed from storage and deleted shortly after it is downloaded"
+		operationId: "downloadAccountExport"
+		tags: ["account"]
+		security: []
+		parameters: [{
+			name:        "id"
+			in:          "path"
+			required:    true
+			description: "Export ID"
+			schema: {type: "integer", minimum: 0}, format: "int64"
+		}, {
+			name:        "expires"
+			in:          "query"
+			required:    true
+			description: "Expiry of the link as a Unix timestamp"
+			schema: type: "integer", format: "int64"
+		}, {
+			name:        "signature"
+			in:          "query"
+			required:    true
+			description: "Signature of the link"
+			schema: type: "string"
+		}]
+		responses: {
+			"200": {
+				description: "OK"
+				headers: "Content-Disposition": {
+					description: "Suggested file name for the download"
+					schema: type: "string"
+				}
+				content: "application/zip": schema: {
+					type:   "string"
+					format: "binary"
+				}
+			}
+			"400": {
+				description: "Invalid ID or expiry"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"403": {
+				description: "The signature does not match"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"410": {
+				description: "The link has expired or the export has already been downloaded"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/calendar/{token}": get: {
+		summary:     "Get the calendar feed"
+		description: "iCalendar feed of all todos as VTODO entries, plus a VEVENT at the due date of each todo that has one. Authenticated by the secret token in the URL instead of the session cookie"
+		operationId: "getCalendarFeed"
+		tags: ["calendar"]
+		security: []
+		parameters: [{
+			name:        "token"
+			in:          "path"
+			required:    true
+			description: "Token issued by createCalendarFeed"
+			schema: type: "string"
+		}]
+		responses: {
+			"200": {
+				description: "OK"
+				content: "text/calendar": schema: type: "string"
+			}
+			"404": {
+				description: "Unknown or revoked token"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+			"500": {
+				description: "Internal server error"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
+			}
+		}
+	}
+	"/sync": post: {
+		summary:     "Synchronize todos"
+		description: "Apply a batch of offline client operations and return server changes since the sync token"
+		operationId: "syncTodos"
+		tags: ["sync"]
 		security: [{cookieAuth: []}]
+		parameters: [#IdempotencyKeyParam]
 		requestBody: {
 			required: true
-			content: "application/json": schema: "$ref": "#/components/schemas/BatchTodoRequest"
+			content: "application/json": schema: "$ref": "#/components/schemas/SyncRequest"
 		}
 		responses: {
 			"200": {
-				description: "Batch operation completed"
-				content: "application/json": schema: "$ref": "#/components/schemas/BatchDeleteResponse"
+				description: "OK"
+				content: "application/json": schema: "$ref": "#/components/schemas/SyncResponse"
 			}
 			"400": {
-				description: "Bad request"
-				content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+				description: "Invalid sync token or too many operations"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 			}
 			"401": {
 				description: "Unauthorized"
-				content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 			}
+			"409": #IdempotencyConflictResponse
 			"500": {
 				description: "Internal server error"
-				content: "application/json": schema: "$ref": "#/components/schemas/ErrorResponse"
+				content: "application/problem+json": schema: "$ref": "#/components/schemas/Problem"
 			}
 		}
 	}
@@ -392,16 +3230,68 @@ paths: {
 
 components: {
 	schemas: {
-		Todo:                  #Todo
-		CreateTodoRequest:     #CreateTodoRequest
-		UpdateTodoRequest:     #UpdateTodoRequest
-		BatchTodoRequest:      #BatchTodoRequest
-		BatchCompleteResponse: #BatchCompleteResponse
-		BatchDeleteResponse:   #BatchDeleteResponse
-		BatchFailedItem:       #BatchFailedItem
-		ErrorResponse:         #ErrorResponse
-		HealthResponse:        #HealthResponse
-		InfoResponse:          #InfoResponse
+		Todo:                             #Todo
+		CreateTodoRequest:                #CreateTodoRequest
+		UpdateTodoRequest:                #UpdateTodoRequest
+		QuickAddRequest:                  #QuickAddRequest
+		QuickAddPreview:                  #QuickAddPreview
+		MoveTodoRequest:                  #MoveTodoRequest
+		TodoRef:                          #TodoRef
+		AddTodoBlockerRequest:            #AddTodoBlockerRequest
+		TodoDependencies:                 #TodoDependencies
+		SetTodoDueRequest:                #SetTodoDueRequest
+		Reminder:                         #Reminder
+		CreateReminderRequest:            #CreateReminderRequest
+		Notification:                     #Notification
+		NotificationPage:                 #NotificationPage
+		NotificationUnreadCount:          #NotificationUnreadCount
+		MarkAllNotificationsReadResponse: #MarkAllNotificationsReadResponse
+		NotificationPreference:           #NotificationPreference
+		NotificationPreferences:          #NotificationPreferences
+		UserSettings:                     #UserSettings
+		UpdateUserSettingsRequest:        #UpdateUserSettingsRequest
+		CalendarFeed:                     #CalendarFeed
+		PersonalAccessToken:              #PersonalAccessToken
+		PersonalAccessTokens:             #PersonalAccessTokens
+		CreatePersonalAccessTokenRequest: #CreatePersonalAccessTokenRequest
+		CreatedPersonalAccessToken:       #CreatedPersonalAccessToken
+		Status:                           #Status
+		CreateStatusRequest:              #CreateStatusRequest
+		UpdateStatusRequest:              #UpdateStatusRequest
+		SetTodoStatusRequest:             #SetTodoStatusRequest
+		Board:                            #Board
+		BoardColumn:                      #BoardColumn
+		BatchTodoRequest:                 #BatchTodoRequest
+		BatchCompleteResponse:            #BatchCompleteResponse
+		BatchDeleteResponse:              #BatchDeleteResponse
+		BatchCreateRequest:               #BatchCreateRequest
+		BatchCreateResponse:              #BatchCreateResponse
+		BatchCreatedItem:                 #BatchCreatedItem
+		BatchCreateFailedItem:            #BatchCreateFailedItem
+		ImportResponse:                   #ImportResponse
+		ImportLineError:                  #ImportLineError
+		ImportPreviewItem:                #ImportPreviewItem
+		BatchUpdateRequest:               #BatchUpdateRequest
+		BatchUpdateResponse:              #BatchUpdateResponse
+		BatchUpdateChange:                #BatchUpdateChange
+		BatchFailedItem:                  #BatchFailedItem
+		BatchWarning:                     #BatchWarning
+		TodoChangesResponse:              #TodoChangesResponse
+		SyncRequest:                      #SyncRequest
+		SyncOperation:                    #SyncOperation
+		SyncResponse:                     #SyncResponse
+		SyncChange:                       #SyncChange
+		SyncConflict:                     #SyncConflict
+		SyncOperationResult:              #SyncOperationResult
+		BulkTodoFilter:                   #BulkTodoFilter
+		CreateJobRequest:                 #CreateJobRequest
+		Job:                              #Job
+		Project:                          #Project
+		ErrorCode:                        #ErrorCode
+		FieldError:                       #FieldError
+		Problem:                          #Problem
+		HealthResponse:                   #HealthResponse
+		InfoResponse:                     #InfoResponse
 	}
 	securitySchemes: cookieAuth: {
 		type: "apiKey"
@@ -413,4 +3303,12 @@ components: {
 tags: [
 	{name: "general", description: "General endpoints"},
 	{name: "todos", description: "Todo management endpoints"},
+	{name: "sync", description: "Offline synchronization endpoints"},
+	{name: "jobs", description: "Background job endpoints"},
+	{name: "statuses", description: "Kanban status and board endpoints"},
+	{name: "notifications", description: "Reminder and in-app notification center endpoints"},
+	{name: "calendar", description: "iCalendar feed endpoints"},
+	{name: "tokens", description: "Personal access token endpoints"},
+	{name: "projects", description: "Project endpoints"},
+	{name: "account", description: "User settings and account data export endpoints"},
 ]
@@ -1,4 +1,4 @@
-import axios, { type AxiosRequestConfig, type AxiosResponse } from 'axios'
+import axios, { type AxiosError, type AxiosRequestConfig, type AxiosResponse } from 'axios'
 
 const baseURL = process.env.NEXT_PUBLIC_API_URL
 
@@ -44,4 +44,8 @@ export const customAxiosInstance = <T>(
   return promise
 }
 
+// Orvalが生成するフックのエラー型
+// APIのエラーレスポンスは application/problem+json（Problem スキーマ）で返る
+export type ErrorType<Error> = AxiosError<Error>
+
 export default axiosInstance
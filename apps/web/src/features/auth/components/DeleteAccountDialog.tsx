@@ -3,6 +3,7 @@
 import { useRouter } from 'next/navigation'
 import { useState } from 'react'
 import { Button } from '@/components/ui/button'
+import { Checkbox } from '@/components/ui/checkbox'
 import {
   Dialog,
   DialogContent,
@@ -11,6 +12,7 @@ import {
   DialogHeader,
   DialogTitle,
 } from '@/components/ui/dialog'
+import { Label } from '@/components/ui/label'
 import { useAuth } from '../hooks/useAuth'
 
 interface DeleteAccountDialogProps {
@@ -22,11 +24,19 @@ export function DeleteAccountDialog({ open, onOpenChange }: DeleteAccountDialogP
   const router = useRouter()
   const { deleteAccount, isDeletingAccount } = useAuth()
   const [error, setError] = useState<string | null>(null)
+  const [exportFirst, setExportFirst] = useState(true)
 
   const handleDelete = () => {
     setError(null)
-    deleteAccount(undefined, {
-      onSuccess: () => {
+    const variables = { exportData: exportFirst }
+    deleteAccount(variables, {
+      onSuccess: (link) => {
+        if (link) {
+          // The link works once and without a session, so start the download right away
+          const a = document.createElement('a')
+          a.href = link.download_url
+          a.click()
+        }
         onOpenChange(false)
         // Redirect to home page after successful deletion
         router.push('/')
@@ -55,6 +65,15 @@ export function DeleteAccountDialog({ open, onOpenChange }: DeleteAccountDialogP
             todos will be permanently deleted.
           </DialogDescription>
         </DialogHeader>
+        <div className="flex items-center gap-2">
+          <Checkbox
+            id="export-before-delete"
+            checked={exportFirst}
+            onCheckedChange={(checked) => setExportFirst(checked === true)}
+            disabled={isDeletingAccount}
+          />
+          <Label htmlFor="export-before-delete">Download a copy of my data first</Label>
+        </div>
         {error && (
           <div className="rounded-md bg-red-50 p-3 text-sm text-red-800 dark:bg-red-900/20 dark:text-red-400">
             {error}
@@ -11,6 +11,36 @@ interface User {
   provider: string
 }
 
+// Download link in the result of the export job queued when the account is deleted with export=true
+export interface AccountExportLink {
+  export_id: number
+  download_url: string
+  expires_at: string
+  size: number
+}
+
+interface Job {
+  id: number
+  status: 'pending' | 'running' | 'succeeded' | 'failed' | 'canceled'
+  result?: AccountExportLink
+  error?: string
+}
+
+const jobPollInterval = 1000
+
+// Poll the export job until it finishes; the account is deleted by the job once the export is saved
+async function waitForExportJob(queued: Job): Promise<AccountExportLink> {
+  let job = queued
+  while (job.status === 'pending' || job.status === 'running') {
+    await new Promise((resolve) => setTimeout(resolve, jobPollInterval))
+    job = (await axiosInstance.get<Job>(`/jobs/${job.id}`)).data
+  }
+  if (job.status !== 'succeeded' || !job.result) {
+    throw new Error(job.error ?? `Account export job ${job.status}`)
+  }
+  return job.result
+}
+
 const getMeQueryKey = ['me'] as const
 
 export function useAuth() {
@@ -40,8 +70,16 @@ export function useAuth() {
   })
 
   const deleteAccountMutation = useMutation({
-    mutationFn: async () => {
-      await axiosInstance.delete('/users/me')
+    mutationFn: async ({ exportData }: { exportData: boolean }) => {
+      if (!exportData) {
+        await axiosInstance.delete('/users/me')
+        return null
+      }
+      const response = await axiosInstance.delete<Job>('/users/me', { params: { export: true } })
+      const link = await waitForExportJob(response.data)
+      // The session is kept while the job runs so that it can be polled
+      await axiosInstance.post('/logout')
+      return link
     },
     onSuccess: () => {
       queryClient.setQueryData(getMeQueryKey, null)
@@ -11,6 +11,7 @@ import {
   DialogTitle,
 } from '@/components/ui/dialog'
 import { getListTodosQueryKey, type Todo, useDeleteTodo } from '../hooks'
+import { ifMatchHeaders } from '../lib/etag'
 
 interface TodoDeleteDialogProps {
   todo: Todo | null
@@ -27,7 +28,7 @@ export function TodoDeleteDialog({ todo, open, onOpenChange, onSuccess }: TodoDe
     if (!todo) return
 
     deleteMutation.mutate(
-      { id: todo.id },
+      { id: todo.id, headers: ifMatchHeaders(todo) },
       {
         onSuccess: () => {
           queryClient.invalidateQueries({ queryKey: getListTodosQueryKey() })
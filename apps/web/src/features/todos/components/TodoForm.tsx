@@ -1,12 +1,41 @@
 'use client'
 
 import { useQueryClient } from '@tanstack/react-query'
-import { useState } from 'react'
+import { useEffect, useState } from 'react'
 import { Button } from '@/components/ui/button'
 import { Input } from '@/components/ui/input'
 import { Label } from '@/components/ui/label'
 import { Textarea } from '@/components/ui/textarea'
-import { getListTodosQueryKey, type Todo, useCreateTodo, useUpdateTodo } from '../hooks'
+import {
+  getListTodosQueryKey,
+  type QuickAddPreview,
+  type Todo,
+  usePreviewQuickAddTodo,
+  useQuickAddTodo,
+  useUpdateTodo,
+} from '../hooks'
+import { ifMatchHeaders } from '../lib/etag'
+
+// 日付や時刻はブラウザのタイムゾーンで解釈する
+const timezone = Intl.DateTimeFormat().resolvedOptions().timeZone
+const PREVIEW_DELAY_MS = 300
+
+function describePreview(preview: QuickAddPreview): string[] {
+  const parts: string[] = []
+  if (preview.due_at) {
+    const due = new Date(preview.due_at)
+    parts.push(
+      preview.all_day
+        ? `Due ${due.toLocaleDateString()}`
+        : `Due ${due.toLocaleString(undefined, { dateStyle: 'medium', timeStyle: 'short' })}`,
+    )
+  }
+  if (preview.recurrence) parts.push(`Repeats ${preview.recurrence}`)
+  if (preview.priority) parts.push(`Priority ${preview.priority}`)
+  if (preview.project) parts.push(`Project ${preview.project}`)
+  for (const tag of preview.tags) parts.push(`#${tag}`)
+  return parts
+}
 
 interface TodoFormProps {
   todo?: Todo
@@ -19,13 +48,37 @@ export function TodoForm({ todo, onSuccess, onCancel }: TodoFormProps) {
   const [description, setDescription] = useState(todo?.description ?? '')
 
   const queryClient = useQueryClient()
-  const createMutation = useCreateTodo()
+  const createMutation = useQuickAddTodo()
   const updateMutation = useUpdateTodo()
+  const previewMutation = usePreviewQuickAddTodo()
+  const [preview, setPreview] = useState<QuickAddPreview | null>(null)
 
   const isEditing = !!todo
   const isPending = createMutation.isPending || updateMutation.isPending
   const isValid = title.trim().length > 0
 
+  // 新規作成では入力を自然言語として解析し、認識された期限やタグを表示する
+  const { mutate: previewQuickAdd } = previewMutation
+  useEffect(() => {
+    const text = title.trim()
+    if (isEditing || !text) {
+      setPreview(null)
+      return
+    }
+    const timer = setTimeout(() => {
+      previewQuickAdd(
+        { data: { text, timezone } },
+        {
+          onSuccess: (response) => setPreview(response),
+          onError: () => setPreview(null),
+        },
+      )
+    }, PREVIEW_DELAY_MS)
+    return () => clearTimeout(timer)
+  }, [title, isEditing, previewQuickAdd])
+
+  const previewParts = preview ? describePreview(preview) : []
+
   const handleSubmit = (e: React.FormEvent) => {
     e.preventDefault()
     if (!isValid) return
@@ -38,6 +91,7 @@ export function TodoForm({ todo, onSuccess, onCancel }: TodoFormProps) {
             title: title.trim(),
             description: description.trim() || undefined,
           },
+          headers: ifMatchHeaders(todo),
         },
         {
           onSuccess: () => {
@@ -50,8 +104,9 @@ export function TodoForm({ todo, onSuccess, onCancel }: TodoFormProps) {
       createMutation.mutate(
         {
           data: {
-            title: title.trim(),
+            text: title.trim(),
             description: description.trim() || undefined,
+            timezone,
           },
         },
         {
@@ -74,9 +129,14 @@ export function TodoForm({ todo, onSuccess, onCancel }: TodoFormProps) {
           id="title"
           value={title}
           onChange={(e) => setTitle(e.target.value)}
-          placeholder="Enter todo title"
+          placeholder={isEditing ? 'Enter todo title' : 'e.g. Pay rent tomorrow 9am #home !high'}
           disabled={isPending}
         />
+        {preview && previewParts.length > 0 && (
+          <p className="text-sm text-muted-foreground">
+            <span className="font-medium">{preview.title}</span> · {previewParts.join(' · ')}
+          </p>
+        )}
       </div>
       <div className="space-y-2">
         <Label htmlFor="description">Description</Label>
@@ -6,6 +6,7 @@ import { Button } from '@/components/ui/button'
 import { Card, CardContent } from '@/components/ui/card'
 import { Checkbox } from '@/components/ui/checkbox'
 import { getListTodosQueryKey, type Todo, useUpdateTodo } from '../hooks'
+import { ifMatchHeaders } from '../lib/etag'
 
 interface TodoItemProps {
   todo: Todo
@@ -26,6 +27,7 @@ export function TodoItem({ todo, onEdit, onDelete, isSelected, onToggleSelection
         data: {
           completed: !todo.completed,
         },
+        headers: ifMatchHeaders(todo),
       },
       {
         onSuccess: () => {
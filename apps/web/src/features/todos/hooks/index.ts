@@ -4,6 +4,8 @@ export type {
   BatchFailedItem,
   BatchTodoRequest,
   CreateTodoRequest,
+  QuickAddPreview,
+  QuickAddRequest,
   Todo,
   UpdateTodoRequest,
 } from '@/api/generated/todoAPI.schemas'
@@ -14,5 +16,7 @@ export {
   useCreateTodo,
   useDeleteTodo,
   useListTodos,
+  usePreviewQuickAddTodo,
+  useQuickAddTodo,
   useUpdateTodo,
 } from '@/api/generated/todos'
@@ -0,0 +1,6 @@
+import type { Todo } from '@/api/generated/todoAPI.schemas'
+
+// 楽観的排他制御用のIf-Matchヘッダーを生成（サーバーのETagはバージョン番号）
+export const ifMatchHeaders = (todo: Todo) => ({
+  'If-Match': `"${todo.version}"`,
+})